@@ -1292,27 +1292,29 @@ func (x *FollowFanData) GetFanCount() uint32 {
 	return 0
 }
 
-type RelationshipRequest struct {
+type ListFollowRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TargetId      string                 `protobuf:"bytes,1,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Cursor        uint32                 `protobuf:"varint,2,opt,name=cursor,proto3" json:"cursor,omitempty"` // 上一页返回的 next_cursor，第一页传 0
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`   // 每页数量，默认20，最大100
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RelationshipRequest) Reset() {
-	*x = RelationshipRequest{}
+func (x *ListFollowRequest) Reset() {
+	*x = ListFollowRequest{}
 	mi := &file_api_conduit_v1_conduit_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RelationshipRequest) String() string {
+func (x *ListFollowRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RelationshipRequest) ProtoMessage() {}
+func (*ListFollowRequest) ProtoMessage() {}
 
-func (x *RelationshipRequest) ProtoReflect() protoreflect.Message {
+func (x *ListFollowRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_conduit_v1_conduit_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1324,41 +1326,61 @@ func (x *RelationshipRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RelationshipRequest.ProtoReflect.Descriptor instead.
-func (*RelationshipRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListFollowRequest.ProtoReflect.Descriptor instead.
+func (*ListFollowRequest) Descriptor() ([]byte, []int) {
 	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *RelationshipRequest) GetTargetId() string {
+func (x *ListFollowRequest) GetUserId() string {
 	if x != nil {
-		return x.TargetId
+		return x.UserId
 	}
 	return ""
 }
 
-type RelationshipReply struct {
+func (x *ListFollowRequest) GetCursor() uint32 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+func (x *ListFollowRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type FollowUserData struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	Res           *Res                   `protobuf:"bytes,2,opt,name=res,proto3" json:"res,omitempty"`
-	Data          *RelationshipData      `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Image         string                 `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"` // 头像
+	Bio           string                 `protobuf:"bytes,4,opt,name=bio,proto3" json:"bio,omitempty"`
+	IsFollowing   bool                   `protobuf:"varint,5,opt,name=is_following,json=isFollowing,proto3" json:"is_following,omitempty"`      // 当前用户是否已关注对方
+	IsFollowedBy  bool                   `protobuf:"varint,6,opt,name=is_followed_by,json=isFollowedBy,proto3" json:"is_followed_by,omitempty"` // 当前用户是否被对方关注
+	IsMutual      bool                   `protobuf:"varint,7,opt,name=is_mutual,json=isMutual,proto3" json:"is_mutual,omitempty"`               // 是否互相关注
+	IsFriend      bool                   `protobuf:"varint,8,opt,name=is_friend,json=isFriend,proto3" json:"is_friend,omitempty"`               // 是否是好友
+	Handle        string                 `protobuf:"bytes,9,opt,name=handle,proto3" json:"handle,omitempty"`                                    // 唯一的@用户名
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RelationshipReply) Reset() {
-	*x = RelationshipReply{}
+func (x *FollowUserData) Reset() {
+	*x = FollowUserData{}
 	mi := &file_api_conduit_v1_conduit_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RelationshipReply) String() string {
+func (x *FollowUserData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RelationshipReply) ProtoMessage() {}
+func (*FollowUserData) ProtoMessage() {}
 
-func (x *RelationshipReply) ProtoReflect() protoreflect.Message {
+func (x *FollowUserData) ProtoReflect() protoreflect.Message {
 	mi := &file_api_conduit_v1_conduit_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1370,58 +1392,99 @@ func (x *RelationshipReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RelationshipReply.ProtoReflect.Descriptor instead.
-func (*RelationshipReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use FollowUserData.ProtoReflect.Descriptor instead.
+func (*FollowUserData) Descriptor() ([]byte, []int) {
 	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *RelationshipReply) GetCode() int32 {
+func (x *FollowUserData) GetUserId() uint32 {
 	if x != nil {
-		return x.Code
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *RelationshipReply) GetRes() *Res {
+func (x *FollowUserData) GetUsername() string {
 	if x != nil {
-		return x.Res
+		return x.Username
 	}
-	return nil
+	return ""
 }
 
-func (x *RelationshipReply) GetData() *RelationshipData {
+func (x *FollowUserData) GetImage() string {
 	if x != nil {
-		return x.Data
+		return x.Image
 	}
-	return nil
+	return ""
 }
 
-type RelationshipData struct {
+func (x *FollowUserData) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *FollowUserData) GetIsFollowing() bool {
+	if x != nil {
+		return x.IsFollowing
+	}
+	return false
+}
+
+func (x *FollowUserData) GetIsFollowedBy() bool {
+	if x != nil {
+		return x.IsFollowedBy
+	}
+	return false
+}
+
+func (x *FollowUserData) GetIsMutual() bool {
+	if x != nil {
+		return x.IsMutual
+	}
+	return false
+}
+
+func (x *FollowUserData) GetIsFriend() bool {
+	if x != nil {
+		return x.IsFriend
+	}
+	return false
+}
+
+func (x *FollowUserData) GetHandle() string {
+	if x != nil {
+		return x.Handle
+	}
+	return ""
+}
+
+type ListFollowReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	IsFollowing   bool                   `protobuf:"varint,1,opt,name=is_following,json=isFollowing,proto3" json:"is_following,omitempty"`      // 当前用户是否已关注对方
-	IsFollowedBy  bool                   `protobuf:"varint,2,opt,name=is_followed_by,json=isFollowedBy,proto3" json:"is_followed_by,omitempty"` // 当前用户是否被对方关注
-	IsMutual      bool                   `protobuf:"varint,3,opt,name=is_mutual,json=isMutual,proto3" json:"is_mutual,omitempty"`               // 是否互相关注
-	IsBlocked     bool                   `protobuf:"varint,4,opt,name=is_blocked,json=isBlocked,proto3" json:"is_blocked,omitempty"`            // 当前用户是否已拉黑对方
-	IsBlockedBy   bool                   `protobuf:"varint,5,opt,name=is_blocked_by,json=isBlockedBy,proto3" json:"is_blocked_by,omitempty"`    // 当前用户是否被对方拉黑
-	IsFriend      bool                   `protobuf:"varint,6,opt,name=is_friend,json=isFriend,proto3" json:"is_friend,omitempty"`               // 是否是好友（如果互关=好友，可以合并，目前互关和好友不是等价的）
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Res           *Res                   `protobuf:"bytes,2,opt,name=res,proto3" json:"res,omitempty"`
+	Data          []*FollowUserData      `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	NextCursor    uint32                 `protobuf:"varint,4,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	HasMore       bool                   `protobuf:"varint,5,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RelationshipData) Reset() {
-	*x = RelationshipData{}
+func (x *ListFollowReply) Reset() {
+	*x = ListFollowReply{}
 	mi := &file_api_conduit_v1_conduit_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RelationshipData) String() string {
+func (x *ListFollowReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RelationshipData) ProtoMessage() {}
+func (*ListFollowReply) ProtoMessage() {}
 
-func (x *RelationshipData) ProtoReflect() protoreflect.Message {
+func (x *ListFollowReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_conduit_v1_conduit_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1433,76 +1496,117 @@ func (x *RelationshipData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RelationshipData.ProtoReflect.Descriptor instead.
-func (*RelationshipData) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListFollowReply.ProtoReflect.Descriptor instead.
+func (*ListFollowReply) Descriptor() ([]byte, []int) {
 	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *RelationshipData) GetIsFollowing() bool {
+func (x *ListFollowReply) GetCode() int32 {
 	if x != nil {
-		return x.IsFollowing
+		return x.Code
 	}
-	return false
+	return 0
 }
 
-func (x *RelationshipData) GetIsFollowedBy() bool {
+func (x *ListFollowReply) GetRes() *Res {
 	if x != nil {
-		return x.IsFollowedBy
+		return x.Res
 	}
-	return false
+	return nil
 }
 
-func (x *RelationshipData) GetIsMutual() bool {
+func (x *ListFollowReply) GetData() []*FollowUserData {
 	if x != nil {
-		return x.IsMutual
+		return x.Data
 	}
-	return false
+	return nil
 }
 
-func (x *RelationshipData) GetIsBlocked() bool {
+func (x *ListFollowReply) GetNextCursor() uint32 {
 	if x != nil {
-		return x.IsBlocked
+		return x.NextCursor
 	}
-	return false
+	return 0
 }
 
-func (x *RelationshipData) GetIsBlockedBy() bool {
+func (x *ListFollowReply) GetHasMore() bool {
 	if x != nil {
-		return x.IsBlockedBy
+		return x.HasMore
 	}
 	return false
 }
 
-func (x *RelationshipData) GetIsFriend() bool {
+type SuggestUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"` // 返回数量，默认20，最大100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestUsersRequest) Reset() {
+	*x = SuggestUsersRequest{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestUsersRequest) ProtoMessage() {}
+
+func (x *SuggestUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[23]
 	if x != nil {
-		return x.IsFriend
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-// NID_FRIENDS_REQ
-type CanAddFriendReq struct {
+// Deprecated: Use SuggestUsersRequest.ProtoReflect.Descriptor instead.
+func (*SuggestUsersRequest) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SuggestUsersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SuggestUserData struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TargetId      string                 `protobuf:"bytes,1,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Image         string                 `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`                                 // 头像
+	MutualCount   int32                  `protobuf:"varint,4,opt,name=mutual_count,json=mutualCount,proto3" json:"mutual_count,omitempty"` // 共同关注数
+	SharedTags    int32                  `protobuf:"varint,5,opt,name=shared_tags,json=sharedTags,proto3" json:"shared_tags,omitempty"`    // 相同标签数
+	Score         float64                `protobuf:"fixed64,6,opt,name=score,proto3" json:"score,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CanAddFriendReq) Reset() {
-	*x = CanAddFriendReq{}
-	mi := &file_api_conduit_v1_conduit_proto_msgTypes[23]
+func (x *SuggestUserData) Reset() {
+	*x = SuggestUserData{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CanAddFriendReq) String() string {
+func (x *SuggestUserData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CanAddFriendReq) ProtoMessage() {}
+func (*SuggestUserData) ProtoMessage() {}
 
-func (x *CanAddFriendReq) ProtoReflect() protoreflect.Message {
-	mi := &file_api_conduit_v1_conduit_proto_msgTypes[23]
+func (x *SuggestUserData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1513,42 +1617,77 @@ func (x *CanAddFriendReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CanAddFriendReq.ProtoReflect.Descriptor instead.
-func (*CanAddFriendReq) Descriptor() ([]byte, []int) {
-	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use SuggestUserData.ProtoReflect.Descriptor instead.
+func (*SuggestUserData) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *CanAddFriendReq) GetTargetId() string {
+func (x *SuggestUserData) GetUserId() uint32 {
 	if x != nil {
-		return x.TargetId
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SuggestUserData) GetUsername() string {
+	if x != nil {
+		return x.Username
 	}
 	return ""
 }
 
-type CanAddFriendRes struct {
+func (x *SuggestUserData) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *SuggestUserData) GetMutualCount() int32 {
+	if x != nil {
+		return x.MutualCount
+	}
+	return 0
+}
+
+func (x *SuggestUserData) GetSharedTags() int32 {
+	if x != nil {
+		return x.SharedTags
+	}
+	return 0
+}
+
+func (x *SuggestUserData) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type SuggestUsersReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
 	Res           *Res                   `protobuf:"bytes,2,opt,name=res,proto3" json:"res,omitempty"`
-	Data          *AddFriendRes          `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Data          []*SuggestUserData     `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CanAddFriendRes) Reset() {
-	*x = CanAddFriendRes{}
-	mi := &file_api_conduit_v1_conduit_proto_msgTypes[24]
+func (x *SuggestUsersReply) Reset() {
+	*x = SuggestUsersReply{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CanAddFriendRes) String() string {
+func (x *SuggestUsersReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CanAddFriendRes) ProtoMessage() {}
+func (*SuggestUsersReply) ProtoMessage() {}
 
-func (x *CanAddFriendRes) ProtoReflect() protoreflect.Message {
-	mi := &file_api_conduit_v1_conduit_proto_msgTypes[24]
+func (x *SuggestUsersReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1559,53 +1698,54 @@ func (x *CanAddFriendRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CanAddFriendRes.ProtoReflect.Descriptor instead.
-func (*CanAddFriendRes) Descriptor() ([]byte, []int) {
-	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use SuggestUsersReply.ProtoReflect.Descriptor instead.
+func (*SuggestUsersReply) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *CanAddFriendRes) GetCode() int32 {
+func (x *SuggestUsersReply) GetCode() int32 {
 	if x != nil {
 		return x.Code
 	}
 	return 0
 }
 
-func (x *CanAddFriendRes) GetRes() *Res {
+func (x *SuggestUsersReply) GetRes() *Res {
 	if x != nil {
 		return x.Res
 	}
 	return nil
 }
 
-func (x *CanAddFriendRes) GetData() *AddFriendRes {
+func (x *SuggestUsersReply) GetData() []*SuggestUserData {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-type AddFriendRes struct {
+type DismissSuggestionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	TargetId      string                 `protobuf:"bytes,1,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddFriendRes) Reset() {
-	*x = AddFriendRes{}
-	mi := &file_api_conduit_v1_conduit_proto_msgTypes[25]
+func (x *DismissSuggestionRequest) Reset() {
+	*x = DismissSuggestionRequest{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddFriendRes) String() string {
+func (x *DismissSuggestionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddFriendRes) ProtoMessage() {}
+func (*DismissSuggestionRequest) ProtoMessage() {}
 
-func (x *AddFriendRes) ProtoReflect() protoreflect.Message {
-	mi := &file_api_conduit_v1_conduit_proto_msgTypes[25]
+func (x *DismissSuggestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1616,44 +1756,7434 @@ func (x *AddFriendRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddFriendRes.ProtoReflect.Descriptor instead.
-func (*AddFriendRes) Descriptor() ([]byte, []int) {
-	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use DismissSuggestionRequest.ProtoReflect.Descriptor instead.
+func (*DismissSuggestionRequest) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{26}
 }
 
-// NID_MESSAGE_REQ
-type Message struct {
+func (x *DismissSuggestionRequest) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+type DismissSuggestionReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Avatar        string                 `protobuf:"bytes,1,opt,name=avatar,proto3" json:"avatar,omitempty"`             //头像
-	FromUserName  string                 `protobuf:"bytes,2,opt,name=fromUserName,proto3" json:"fromUserName,omitempty"` // 发送消息用户的用户名
-	From          string                 `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`                 // 发送消息用户uuid
-	To            string                 `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`                     // 发送给对端用户的uuid
-	Content       string                 `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`           // 文本消息内容
-	MessageType   uint32                 `protobuf:"varint,6,opt,name=messageType,proto3" json:"messageType,omitempty"`  // 消息类型，1.单聊 2.群聊
-	ContentType   uint32                 `protobuf:"varint,7,opt,name=contentType,proto3" json:"contentType,omitempty"`  // 消息内容类型：1.文字 2.普通文件 3.图片 4.音频 5.视频 6.语音聊天 7.视频聊天
-	Type          string                 `protobuf:"bytes,8,opt,name=type,proto3" json:"type,omitempty"`                 // 消息传输类型：如果是心跳消息，该内容为heatbeat,在线视频或者音频为webrtc
-	Url           string                 `protobuf:"bytes,9,opt,name=url,proto3" json:"url,omitempty"`                   // 图片，视频，语音的路径
-	FileSuffix    string                 `protobuf:"bytes,10,opt,name=fileSuffix,proto3" json:"fileSuffix,omitempty"`    // 文件后缀，如果通过二进制头不能解析文件后缀，使用该后缀
-	File          []byte                 `protobuf:"bytes,11,opt,name=file,proto3" json:"file,omitempty"`                // 如果是图片，文件，视频等的二进制
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Res           *Res                   `protobuf:"bytes,2,opt,name=res,proto3" json:"res,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Message) Reset() {
-	*x = Message{}
-	mi := &file_api_conduit_v1_conduit_proto_msgTypes[26]
+func (x *DismissSuggestionReply) Reset() {
+	*x = DismissSuggestionReply{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Message) String() string {
+func (x *DismissSuggestionReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Message) ProtoMessage() {}
+func (*DismissSuggestionReply) ProtoMessage() {}
+
+func (x *DismissSuggestionReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DismissSuggestionReply.ProtoReflect.Descriptor instead.
+func (*DismissSuggestionReply) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DismissSuggestionReply) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *DismissSuggestionReply) GetRes() *Res {
+	if x != nil {
+		return x.Res
+	}
+	return nil
+}
+
+type ListLoginHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cursor        uint32                 `protobuf:"varint,1,opt,name=cursor,proto3" json:"cursor,omitempty"` // 上一页返回的 next_cursor，第一页传 0
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`   // 每页数量，默认20，最大100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLoginHistoryRequest) Reset() {
+	*x = ListLoginHistoryRequest{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLoginHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLoginHistoryRequest) ProtoMessage() {}
+
+func (x *ListLoginHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLoginHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListLoginHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListLoginHistoryRequest) GetCursor() uint32 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+func (x *ListLoginHistoryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type LoginHistoryData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Source        string                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"` // password/sms/websocket
+	Ip            string                 `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip,omitempty"`
+	UserAgent     string                 `protobuf:"bytes,4,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	Platform      string                 `protobuf:"bytes,5,opt,name=platform,proto3" json:"platform,omitempty"` // ios/android/windows/macos/linux/unknown，或客户端上报的平台
+	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginHistoryData) Reset() {
+	*x = LoginHistoryData{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginHistoryData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginHistoryData) ProtoMessage() {}
+
+func (x *LoginHistoryData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginHistoryData.ProtoReflect.Descriptor instead.
+func (*LoginHistoryData) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *LoginHistoryData) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LoginHistoryData) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *LoginHistoryData) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *LoginHistoryData) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *LoginHistoryData) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *LoginHistoryData) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListLoginHistoryReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Res           *Res                   `protobuf:"bytes,2,opt,name=res,proto3" json:"res,omitempty"`
+	Data          []*LoginHistoryData    `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	NextCursor    uint32                 `protobuf:"varint,4,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	HasMore       bool                   `protobuf:"varint,5,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLoginHistoryReply) Reset() {
+	*x = ListLoginHistoryReply{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLoginHistoryReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLoginHistoryReply) ProtoMessage() {}
+
+func (x *ListLoginHistoryReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLoginHistoryReply.ProtoReflect.Descriptor instead.
+func (*ListLoginHistoryReply) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListLoginHistoryReply) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListLoginHistoryReply) GetRes() *Res {
+	if x != nil {
+		return x.Res
+	}
+	return nil
+}
+
+func (x *ListLoginHistoryReply) GetData() []*LoginHistoryData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListLoginHistoryReply) GetNextCursor() uint32 {
+	if x != nil {
+		return x.NextCursor
+	}
+	return 0
+}
+
+func (x *ListLoginHistoryReply) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+type ChangeHandleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Handle        string                 `protobuf:"bytes,1,opt,name=handle,proto3" json:"handle,omitempty"` // 4-20位小写字母、数字、下划线，字母开头，可以带@前缀
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeHandleRequest) Reset() {
+	*x = ChangeHandleRequest{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeHandleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeHandleRequest) ProtoMessage() {}
+
+func (x *ChangeHandleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeHandleRequest.ProtoReflect.Descriptor instead.
+func (*ChangeHandleRequest) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ChangeHandleRequest) GetHandle() string {
+	if x != nil {
+		return x.Handle
+	}
+	return ""
+}
+
+type ChangeHandleReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Res           *Res                   `protobuf:"bytes,2,opt,name=res,proto3" json:"res,omitempty"`
+	Handle        string                 `protobuf:"bytes,3,opt,name=handle,proto3" json:"handle,omitempty"` // 规范化之后的用户名
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeHandleReply) Reset() {
+	*x = ChangeHandleReply{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeHandleReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeHandleReply) ProtoMessage() {}
+
+func (x *ChangeHandleReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeHandleReply.ProtoReflect.Descriptor instead.
+func (*ChangeHandleReply) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ChangeHandleReply) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ChangeHandleReply) GetRes() *Res {
+	if x != nil {
+		return x.Res
+	}
+	return nil
+}
+
+func (x *ChangeHandleReply) GetHandle() string {
+	if x != nil {
+		return x.Handle
+	}
+	return ""
+}
+
+type SearchUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keyword       string                 `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword,omitempty"`    // @用户名前缀、用户名前缀，或者完整的手机号
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`         // 分页页码，从1开始
+	PageSize      int32                  `protobuf:"varint,3,opt,name=pageSize,proto3" json:"pageSize,omitempty"` // 分页每页数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchUsersRequest) Reset() {
+	*x = SearchUsersRequest{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUsersRequest) ProtoMessage() {}
+
+func (x *SearchUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUsersRequest.ProtoReflect.Descriptor instead.
+func (*SearchUsersRequest) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SearchUsersRequest) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *SearchUsersRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SearchUsersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type SearchUsersReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Res           *Res                   `protobuf:"bytes,2,opt,name=res,proto3" json:"res,omitempty"`
+	Data          []*FollowUserData      `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	HasMore       bool                   `protobuf:"varint,4,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchUsersReply) Reset() {
+	*x = SearchUsersReply{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchUsersReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUsersReply) ProtoMessage() {}
+
+func (x *SearchUsersReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUsersReply.ProtoReflect.Descriptor instead.
+func (*SearchUsersReply) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SearchUsersReply) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *SearchUsersReply) GetRes() *Res {
+	if x != nil {
+		return x.Res
+	}
+	return nil
+}
+
+func (x *SearchUsersReply) GetData() []*FollowUserData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *SearchUsersReply) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+type GetPrivacySettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPrivacySettingsRequest) Reset() {
+	*x = GetPrivacySettingsRequest{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPrivacySettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPrivacySettingsRequest) ProtoMessage() {}
+
+func (x *GetPrivacySettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPrivacySettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetPrivacySettingsRequest) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{35}
+}
+
+// 可见范围、私信范围的取值：everyone/followers/friends/nobody，followers 指关注了我的人
+type PrivacySettingsData struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	WhoCanMessage        string                 `protobuf:"bytes,1,opt,name=who_can_message,json=whoCanMessage,proto3" json:"who_can_message,omitempty"`                       // 谁可以给我发私信
+	MomentVisibility     string                 `protobuf:"bytes,2,opt,name=moment_visibility,json=momentVisibility,proto3" json:"moment_visibility,omitempty"`                // 动态默认可见范围
+	FollowListVisibility string                 `protobuf:"bytes,3,opt,name=follow_list_visibility,json=followListVisibility,proto3" json:"follow_list_visibility,omitempty"`  // 谁可以查看我的关注、粉丝列表
+	LastSeenVisibility   string                 `protobuf:"bytes,4,opt,name=last_seen_visibility,json=lastSeenVisibility,proto3" json:"last_seen_visibility,omitempty"`        // 谁可以看到我的最后活跃时间
+	DiscoverableByPhone  bool                   `protobuf:"varint,5,opt,name=discoverable_by_phone,json=discoverableByPhone,proto3" json:"discoverable_by_phone,omitempty"`    // 允许别人通过手机号搜索到我
+	DiscoverableBySearch bool                   `protobuf:"varint,6,opt,name=discoverable_by_search,json=discoverableBySearch,proto3" json:"discoverable_by_search,omitempty"` // 允许出现在用户名搜索和推荐里
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *PrivacySettingsData) Reset() {
+	*x = PrivacySettingsData{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrivacySettingsData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrivacySettingsData) ProtoMessage() {}
+
+func (x *PrivacySettingsData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrivacySettingsData.ProtoReflect.Descriptor instead.
+func (*PrivacySettingsData) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *PrivacySettingsData) GetWhoCanMessage() string {
+	if x != nil {
+		return x.WhoCanMessage
+	}
+	return ""
+}
+
+func (x *PrivacySettingsData) GetMomentVisibility() string {
+	if x != nil {
+		return x.MomentVisibility
+	}
+	return ""
+}
+
+func (x *PrivacySettingsData) GetFollowListVisibility() string {
+	if x != nil {
+		return x.FollowListVisibility
+	}
+	return ""
+}
+
+func (x *PrivacySettingsData) GetLastSeenVisibility() string {
+	if x != nil {
+		return x.LastSeenVisibility
+	}
+	return ""
+}
+
+func (x *PrivacySettingsData) GetDiscoverableByPhone() bool {
+	if x != nil {
+		return x.DiscoverableByPhone
+	}
+	return false
+}
+
+func (x *PrivacySettingsData) GetDiscoverableBySearch() bool {
+	if x != nil {
+		return x.DiscoverableBySearch
+	}
+	return false
+}
+
+// 没有传的字段保持不变
+type UpdatePrivacySettingsRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	WhoCanMessage        *string                `protobuf:"bytes,1,opt,name=who_can_message,json=whoCanMessage,proto3,oneof" json:"who_can_message,omitempty"`
+	MomentVisibility     *string                `protobuf:"bytes,2,opt,name=moment_visibility,json=momentVisibility,proto3,oneof" json:"moment_visibility,omitempty"`
+	FollowListVisibility *string                `protobuf:"bytes,3,opt,name=follow_list_visibility,json=followListVisibility,proto3,oneof" json:"follow_list_visibility,omitempty"`
+	LastSeenVisibility   *string                `protobuf:"bytes,4,opt,name=last_seen_visibility,json=lastSeenVisibility,proto3,oneof" json:"last_seen_visibility,omitempty"`
+	DiscoverableByPhone  *bool                  `protobuf:"varint,5,opt,name=discoverable_by_phone,json=discoverableByPhone,proto3,oneof" json:"discoverable_by_phone,omitempty"`
+	DiscoverableBySearch *bool                  `protobuf:"varint,6,opt,name=discoverable_by_search,json=discoverableBySearch,proto3,oneof" json:"discoverable_by_search,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *UpdatePrivacySettingsRequest) Reset() {
+	*x = UpdatePrivacySettingsRequest{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePrivacySettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePrivacySettingsRequest) ProtoMessage() {}
+
+func (x *UpdatePrivacySettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePrivacySettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePrivacySettingsRequest) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *UpdatePrivacySettingsRequest) GetWhoCanMessage() string {
+	if x != nil && x.WhoCanMessage != nil {
+		return *x.WhoCanMessage
+	}
+	return ""
+}
+
+func (x *UpdatePrivacySettingsRequest) GetMomentVisibility() string {
+	if x != nil && x.MomentVisibility != nil {
+		return *x.MomentVisibility
+	}
+	return ""
+}
+
+func (x *UpdatePrivacySettingsRequest) GetFollowListVisibility() string {
+	if x != nil && x.FollowListVisibility != nil {
+		return *x.FollowListVisibility
+	}
+	return ""
+}
+
+func (x *UpdatePrivacySettingsRequest) GetLastSeenVisibility() string {
+	if x != nil && x.LastSeenVisibility != nil {
+		return *x.LastSeenVisibility
+	}
+	return ""
+}
+
+func (x *UpdatePrivacySettingsRequest) GetDiscoverableByPhone() bool {
+	if x != nil && x.DiscoverableByPhone != nil {
+		return *x.DiscoverableByPhone
+	}
+	return false
+}
+
+func (x *UpdatePrivacySettingsRequest) GetDiscoverableBySearch() bool {
+	if x != nil && x.DiscoverableBySearch != nil {
+		return *x.DiscoverableBySearch
+	}
+	return false
+}
+
+type PrivacySettingsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Res           *Res                   `protobuf:"bytes,2,opt,name=res,proto3" json:"res,omitempty"`
+	Data          *PrivacySettingsData   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrivacySettingsReply) Reset() {
+	*x = PrivacySettingsReply{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrivacySettingsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrivacySettingsReply) ProtoMessage() {}
+
+func (x *PrivacySettingsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrivacySettingsReply.ProtoReflect.Descriptor instead.
+func (*PrivacySettingsReply) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *PrivacySettingsReply) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *PrivacySettingsReply) GetRes() *Res {
+	if x != nil {
+		return x.Res
+	}
+	return nil
+}
+
+func (x *PrivacySettingsReply) GetData() *PrivacySettingsData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type UpdateSearchPrivacyRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	DiscoverableByPhone bool                   `protobuf:"varint,1,opt,name=discoverable_by_phone,json=discoverableByPhone,proto3" json:"discoverable_by_phone,omitempty"` // 允许别人通过手机号搜索到我
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *UpdateSearchPrivacyRequest) Reset() {
+	*x = UpdateSearchPrivacyRequest{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSearchPrivacyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSearchPrivacyRequest) ProtoMessage() {}
+
+func (x *UpdateSearchPrivacyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSearchPrivacyRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSearchPrivacyRequest) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *UpdateSearchPrivacyRequest) GetDiscoverableByPhone() bool {
+	if x != nil {
+		return x.DiscoverableByPhone
+	}
+	return false
+}
+
+type UpdateSearchPrivacyReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Res           *Res                   `protobuf:"bytes,2,opt,name=res,proto3" json:"res,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSearchPrivacyReply) Reset() {
+	*x = UpdateSearchPrivacyReply{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSearchPrivacyReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSearchPrivacyReply) ProtoMessage() {}
+
+func (x *UpdateSearchPrivacyReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSearchPrivacyReply.ProtoReflect.Descriptor instead.
+func (*UpdateSearchPrivacyReply) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *UpdateSearchPrivacyReply) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *UpdateSearchPrivacyReply) GetRes() *Res {
+	if x != nil {
+		return x.Res
+	}
+	return nil
+}
+
+type ListProfileVisitorsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cursor        int64                  `protobuf:"varint,1,opt,name=cursor,proto3" json:"cursor,omitempty"` // 上一页返回的 next_cursor，第一页传 0
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`   // 每页数量，默认20，最大100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProfileVisitorsRequest) Reset() {
+	*x = ListProfileVisitorsRequest{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProfileVisitorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProfileVisitorsRequest) ProtoMessage() {}
+
+func (x *ListProfileVisitorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProfileVisitorsRequest.ProtoReflect.Descriptor instead.
+func (*ListProfileVisitorsRequest) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListProfileVisitorsRequest) GetCursor() int64 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+func (x *ListProfileVisitorsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type VisitorData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Image         string                 `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`                          // 头像
+	VisitedAt     *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=visited_at,json=visitedAt,proto3" json:"visited_at,omitempty"` // 最近一次访问时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VisitorData) Reset() {
+	*x = VisitorData{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VisitorData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VisitorData) ProtoMessage() {}
+
+func (x *VisitorData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VisitorData.ProtoReflect.Descriptor instead.
+func (*VisitorData) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *VisitorData) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *VisitorData) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *VisitorData) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *VisitorData) GetVisitedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.VisitedAt
+	}
+	return nil
+}
+
+type ListProfileVisitorsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Res           *Res                   `protobuf:"bytes,2,opt,name=res,proto3" json:"res,omitempty"`
+	Data          []*VisitorData         `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	NextCursor    int64                  `protobuf:"varint,4,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	HasMore       bool                   `protobuf:"varint,5,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProfileVisitorsReply) Reset() {
+	*x = ListProfileVisitorsReply{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProfileVisitorsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProfileVisitorsReply) ProtoMessage() {}
+
+func (x *ListProfileVisitorsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProfileVisitorsReply.ProtoReflect.Descriptor instead.
+func (*ListProfileVisitorsReply) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListProfileVisitorsReply) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListProfileVisitorsReply) GetRes() *Res {
+	if x != nil {
+		return x.Res
+	}
+	return nil
+}
+
+func (x *ListProfileVisitorsReply) GetData() []*VisitorData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListProfileVisitorsReply) GetNextCursor() int64 {
+	if x != nil {
+		return x.NextCursor
+	}
+	return 0
+}
+
+func (x *ListProfileVisitorsReply) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+type UpdateVisitPrivacyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HideVisits    bool                   `protobuf:"varint,1,opt,name=hide_visits,json=hideVisits,proto3" json:"hide_visits,omitempty"` // 浏览别人主页时不出现在对方的访客列表
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateVisitPrivacyRequest) Reset() {
+	*x = UpdateVisitPrivacyRequest{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateVisitPrivacyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVisitPrivacyRequest) ProtoMessage() {}
+
+func (x *UpdateVisitPrivacyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVisitPrivacyRequest.ProtoReflect.Descriptor instead.
+func (*UpdateVisitPrivacyRequest) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *UpdateVisitPrivacyRequest) GetHideVisits() bool {
+	if x != nil {
+		return x.HideVisits
+	}
+	return false
+}
+
+type UpdateVisitPrivacyReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Res           *Res                   `protobuf:"bytes,2,opt,name=res,proto3" json:"res,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateVisitPrivacyReply) Reset() {
+	*x = UpdateVisitPrivacyReply{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateVisitPrivacyReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVisitPrivacyReply) ProtoMessage() {}
+
+func (x *UpdateVisitPrivacyReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVisitPrivacyReply.ProtoReflect.Descriptor instead.
+func (*UpdateVisitPrivacyReply) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *UpdateVisitPrivacyReply) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *UpdateVisitPrivacyReply) GetRes() *Res {
+	if x != nil {
+		return x.Res
+	}
+	return nil
+}
+
+type BanUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Until         *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=until,proto3" json:"until,omitempty"` // 封禁截止时间
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BanUserRequest) Reset() {
+	*x = BanUserRequest{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BanUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BanUserRequest) ProtoMessage() {}
+
+func (x *BanUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BanUserRequest.ProtoReflect.Descriptor instead.
+func (*BanUserRequest) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *BanUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *BanUserRequest) GetUntil() *timestamp.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+func (x *BanUserRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type UnbanUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnbanUserRequest) Reset() {
+	*x = UnbanUserRequest{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnbanUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnbanUserRequest) ProtoMessage() {}
+
+func (x *UnbanUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnbanUserRequest.ProtoReflect.Descriptor instead.
+func (*UnbanUserRequest) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *UnbanUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UnbanUserRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type BanUserReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Res           *Res                   `protobuf:"bytes,2,opt,name=res,proto3" json:"res,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BanUserReply) Reset() {
+	*x = BanUserReply{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BanUserReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BanUserReply) ProtoMessage() {}
+
+func (x *BanUserReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BanUserReply.ProtoReflect.Descriptor instead.
+func (*BanUserReply) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *BanUserReply) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *BanUserReply) GetRes() *Res {
+	if x != nil {
+		return x.Res
+	}
+	return nil
+}
+
+type ListBanAuditsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 为空时查询全部记录
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`                  // 分页页码，从1开始
+	PageSize      int32                  `protobuf:"varint,3,opt,name=pageSize,proto3" json:"pageSize,omitempty"`          // 分页每页数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBanAuditsRequest) Reset() {
+	*x = ListBanAuditsRequest{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBanAuditsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBanAuditsRequest) ProtoMessage() {}
+
+func (x *ListBanAuditsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBanAuditsRequest.ProtoReflect.Descriptor instead.
+func (*ListBanAuditsRequest) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ListBanAuditsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListBanAuditsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListBanAuditsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type BanAuditData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`             // 被封禁的用户
+	OperatorId    uint32                 `protobuf:"varint,3,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"` // 操作的管理员
+	Action        string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`                            // ban/unban
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	BannedUntil   *timestamp.Timestamp   `protobuf:"bytes,6,opt,name=banned_until,json=bannedUntil,proto3" json:"banned_until,omitempty"` // 封禁截止时间，解封记录为空
+	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BanAuditData) Reset() {
+	*x = BanAuditData{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BanAuditData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BanAuditData) ProtoMessage() {}
+
+func (x *BanAuditData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BanAuditData.ProtoReflect.Descriptor instead.
+func (*BanAuditData) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *BanAuditData) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BanAuditData) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *BanAuditData) GetOperatorId() uint32 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *BanAuditData) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *BanAuditData) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BanAuditData) GetBannedUntil() *timestamp.Timestamp {
+	if x != nil {
+		return x.BannedUntil
+	}
+	return nil
+}
+
+func (x *BanAuditData) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListBanAuditsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Res           *Res                   `protobuf:"bytes,2,opt,name=res,proto3" json:"res,omitempty"`
+	Data          []*BanAuditData        `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	Total         int32                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,6,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBanAuditsReply) Reset() {
+	*x = ListBanAuditsReply{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBanAuditsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBanAuditsReply) ProtoMessage() {}
+
+func (x *ListBanAuditsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBanAuditsReply.ProtoReflect.Descriptor instead.
+func (*ListBanAuditsReply) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListBanAuditsReply) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListBanAuditsReply) GetRes() *Res {
+	if x != nil {
+		return x.Res
+	}
+	return nil
+}
+
+func (x *ListBanAuditsReply) GetData() []*BanAuditData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListBanAuditsReply) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListBanAuditsReply) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListBanAuditsReply) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type RelationshipRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TargetId      string                 `protobuf:"bytes,1,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RelationshipRequest) Reset() {
+	*x = RelationshipRequest{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RelationshipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelationshipRequest) ProtoMessage() {}
+
+func (x *RelationshipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelationshipRequest.ProtoReflect.Descriptor instead.
+func (*RelationshipRequest) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *RelationshipRequest) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+type RelationshipReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Res           *Res                   `protobuf:"bytes,2,opt,name=res,proto3" json:"res,omitempty"`
+	Data          *RelationshipData      `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RelationshipReply) Reset() {
+	*x = RelationshipReply{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RelationshipReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelationshipReply) ProtoMessage() {}
+
+func (x *RelationshipReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelationshipReply.ProtoReflect.Descriptor instead.
+func (*RelationshipReply) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *RelationshipReply) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *RelationshipReply) GetRes() *Res {
+	if x != nil {
+		return x.Res
+	}
+	return nil
+}
+
+func (x *RelationshipReply) GetData() *RelationshipData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type RelationshipData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsFollowing   bool                   `protobuf:"varint,1,opt,name=is_following,json=isFollowing,proto3" json:"is_following,omitempty"`      // 当前用户是否已关注对方
+	IsFollowedBy  bool                   `protobuf:"varint,2,opt,name=is_followed_by,json=isFollowedBy,proto3" json:"is_followed_by,omitempty"` // 当前用户是否被对方关注
+	IsMutual      bool                   `protobuf:"varint,3,opt,name=is_mutual,json=isMutual,proto3" json:"is_mutual,omitempty"`               // 是否互相关注
+	IsBlocked     bool                   `protobuf:"varint,4,opt,name=is_blocked,json=isBlocked,proto3" json:"is_blocked,omitempty"`            // 当前用户是否已拉黑对方
+	IsBlockedBy   bool                   `protobuf:"varint,5,opt,name=is_blocked_by,json=isBlockedBy,proto3" json:"is_blocked_by,omitempty"`    // 当前用户是否被对方拉黑
+	IsFriend      bool                   `protobuf:"varint,6,opt,name=is_friend,json=isFriend,proto3" json:"is_friend,omitempty"`               // 是否是好友（如果互关=好友，可以合并，目前互关和好友不是等价的）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RelationshipData) Reset() {
+	*x = RelationshipData{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RelationshipData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelationshipData) ProtoMessage() {}
+
+func (x *RelationshipData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelationshipData.ProtoReflect.Descriptor instead.
+func (*RelationshipData) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *RelationshipData) GetIsFollowing() bool {
+	if x != nil {
+		return x.IsFollowing
+	}
+	return false
+}
+
+func (x *RelationshipData) GetIsFollowedBy() bool {
+	if x != nil {
+		return x.IsFollowedBy
+	}
+	return false
+}
+
+func (x *RelationshipData) GetIsMutual() bool {
+	if x != nil {
+		return x.IsMutual
+	}
+	return false
+}
+
+func (x *RelationshipData) GetIsBlocked() bool {
+	if x != nil {
+		return x.IsBlocked
+	}
+	return false
+}
+
+func (x *RelationshipData) GetIsBlockedBy() bool {
+	if x != nil {
+		return x.IsBlockedBy
+	}
+	return false
+}
+
+func (x *RelationshipData) GetIsFriend() bool {
+	if x != nil {
+		return x.IsFriend
+	}
+	return false
+}
+
+// NID_FRIENDS_REQ
+type CanAddFriendReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TargetId      string                 `protobuf:"bytes,1,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanAddFriendReq) Reset() {
+	*x = CanAddFriendReq{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanAddFriendReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanAddFriendReq) ProtoMessage() {}
+
+func (x *CanAddFriendReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanAddFriendReq.ProtoReflect.Descriptor instead.
+func (*CanAddFriendReq) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *CanAddFriendReq) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+type CanAddFriendRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Res           *Res                   `protobuf:"bytes,2,opt,name=res,proto3" json:"res,omitempty"`
+	Data          *AddFriendRes          `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanAddFriendRes) Reset() {
+	*x = CanAddFriendRes{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanAddFriendRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanAddFriendRes) ProtoMessage() {}
+
+func (x *CanAddFriendRes) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanAddFriendRes.ProtoReflect.Descriptor instead.
+func (*CanAddFriendRes) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *CanAddFriendRes) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *CanAddFriendRes) GetRes() *Res {
+	if x != nil {
+		return x.Res
+	}
+	return nil
+}
+
+func (x *CanAddFriendRes) GetData() *AddFriendRes {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type AddFriendRes struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	CanAdd            bool                   `protobuf:"varint,1,opt,name=can_add,json=canAdd,proto3" json:"can_add,omitempty"`                                    // 是否可以发起好友申请
+	IsFriend          bool                   `protobuf:"varint,2,opt,name=is_friend,json=isFriend,proto3" json:"is_friend,omitempty"`                              // 是否已经是好友
+	PendingRequestId  uint32                 `protobuf:"varint,3,opt,name=pending_request_id,json=pendingRequestId,proto3" json:"pending_request_id,omitempty"`    // 我发出的、对方还没处理的申请id
+	IncomingRequestId uint32                 `protobuf:"varint,4,opt,name=incoming_request_id,json=incomingRequestId,proto3" json:"incoming_request_id,omitempty"` // 对方发给我的、我还没处理的申请id，发起申请会直接同意
+	Reason            string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`                                                   // 不能添加时的原因
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *AddFriendRes) Reset() {
+	*x = AddFriendRes{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddFriendRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddFriendRes) ProtoMessage() {}
+
+func (x *AddFriendRes) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddFriendRes.ProtoReflect.Descriptor instead.
+func (*AddFriendRes) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *AddFriendRes) GetCanAdd() bool {
+	if x != nil {
+		return x.CanAdd
+	}
+	return false
+}
+
+func (x *AddFriendRes) GetIsFriend() bool {
+	if x != nil {
+		return x.IsFriend
+	}
+	return false
+}
+
+func (x *AddFriendRes) GetPendingRequestId() uint32 {
+	if x != nil {
+		return x.PendingRequestId
+	}
+	return 0
+}
+
+func (x *AddFriendRes) GetIncomingRequestId() uint32 {
+	if x != nil {
+		return x.IncomingRequestId
+	}
+	return 0
+}
+
+func (x *AddFriendRes) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type FriendRequestData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	FromId        uint32                 `protobuf:"varint,2,opt,name=from_id,json=fromId,proto3" json:"from_id,omitempty"` // 申请人
+	ToId          uint32                 `protobuf:"varint,3,opt,name=to_id,json=toId,proto3" json:"to_id,omitempty"`       // 被申请人
+	Greeting      string                 `protobuf:"bytes,4,opt,name=greeting,proto3" json:"greeting,omitempty"`            // 打招呼内容
+	Status        uint32                 `protobuf:"varint,5,opt,name=status,proto3" json:"status,omitempty"`               // 0.待处理 1.已同意 2.已拒绝 3.已忽略
+	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	HandledAt     *timestamp.Timestamp   `protobuf:"bytes,7,opt,name=handled_at,json=handledAt,proto3" json:"handled_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FriendRequestData) Reset() {
+	*x = FriendRequestData{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FriendRequestData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FriendRequestData) ProtoMessage() {}
+
+func (x *FriendRequestData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FriendRequestData.ProtoReflect.Descriptor instead.
+func (*FriendRequestData) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *FriendRequestData) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *FriendRequestData) GetFromId() uint32 {
+	if x != nil {
+		return x.FromId
+	}
+	return 0
+}
+
+func (x *FriendRequestData) GetToId() uint32 {
+	if x != nil {
+		return x.ToId
+	}
+	return 0
+}
+
+func (x *FriendRequestData) GetGreeting() string {
+	if x != nil {
+		return x.Greeting
+	}
+	return ""
+}
+
+func (x *FriendRequestData) GetStatus() uint32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *FriendRequestData) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *FriendRequestData) GetHandledAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.HandledAt
+	}
+	return nil
+}
+
+type SendFriendRequestReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TargetId      string                 `protobuf:"bytes,1,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	Greeting      string                 `protobuf:"bytes,2,opt,name=greeting,proto3" json:"greeting,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendFriendRequestReq) Reset() {
+	*x = SendFriendRequestReq{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendFriendRequestReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendFriendRequestReq) ProtoMessage() {}
+
+func (x *SendFriendRequestReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendFriendRequestReq.ProtoReflect.Descriptor instead.
+func (*SendFriendRequestReq) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *SendFriendRequestReq) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *SendFriendRequestReq) GetGreeting() string {
+	if x != nil {
+		return x.Greeting
+	}
+	return ""
+}
+
+type FriendRequestReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Res           *Res                   `protobuf:"bytes,2,opt,name=res,proto3" json:"res,omitempty"`
+	Data          *FriendRequestData     `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FriendRequestReply) Reset() {
+	*x = FriendRequestReply{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FriendRequestReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FriendRequestReply) ProtoMessage() {}
+
+func (x *FriendRequestReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FriendRequestReply.ProtoReflect.Descriptor instead.
+func (*FriendRequestReply) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *FriendRequestReply) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *FriendRequestReply) GetRes() *Res {
+	if x != nil {
+		return x.Res
+	}
+	return nil
+}
+
+func (x *FriendRequestReply) GetData() *FriendRequestData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type HandleFriendRequestReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     uint32                 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Action        string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"` // accept / decline / ignore
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HandleFriendRequestReq) Reset() {
+	*x = HandleFriendRequestReq{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HandleFriendRequestReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandleFriendRequestReq) ProtoMessage() {}
+
+func (x *HandleFriendRequestReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandleFriendRequestReq.ProtoReflect.Descriptor instead.
+func (*HandleFriendRequestReq) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *HandleFriendRequestReq) GetRequestId() uint32 {
+	if x != nil {
+		return x.RequestId
+	}
+	return 0
+}
+
+func (x *HandleFriendRequestReq) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+type ListFriendRequestsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Direction     string                 `protobuf:"bytes,1,opt,name=direction,proto3" json:"direction,omitempty"` // incoming 收到的(默认) / outgoing 发出的
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFriendRequestsReq) Reset() {
+	*x = ListFriendRequestsReq{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFriendRequestsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFriendRequestsReq) ProtoMessage() {}
+
+func (x *ListFriendRequestsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFriendRequestsReq.ProtoReflect.Descriptor instead.
+func (*ListFriendRequestsReq) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ListFriendRequestsReq) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+type ListFriendRequestsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Res           *Res                   `protobuf:"bytes,2,opt,name=res,proto3" json:"res,omitempty"`
+	Data          []*FriendRequestData   `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFriendRequestsReply) Reset() {
+	*x = ListFriendRequestsReply{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFriendRequestsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFriendRequestsReply) ProtoMessage() {}
+
+func (x *ListFriendRequestsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFriendRequestsReply.ProtoReflect.Descriptor instead.
+func (*ListFriendRequestsReply) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ListFriendRequestsReply) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListFriendRequestsReply) GetRes() *Res {
+	if x != nil {
+		return x.Res
+	}
+	return nil
+}
+
+func (x *ListFriendRequestsReply) GetData() []*FriendRequestData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type FriendData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Since         *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=since,proto3" json:"since,omitempty"` // 成为好友的时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FriendData) Reset() {
+	*x = FriendData{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FriendData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FriendData) ProtoMessage() {}
+
+func (x *FriendData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FriendData.ProtoReflect.Descriptor instead.
+func (*FriendData) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *FriendData) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *FriendData) GetSince() *timestamp.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+type ListFriendsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`         // 分页页码，从1开始
+	PageSize      int32                  `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize,omitempty"` // 分页每页数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFriendsReq) Reset() {
+	*x = ListFriendsReq{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFriendsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFriendsReq) ProtoMessage() {}
+
+func (x *ListFriendsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFriendsReq.ProtoReflect.Descriptor instead.
+func (*ListFriendsReq) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ListFriendsReq) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListFriendsReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListFriendsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Res           *Res                   `protobuf:"bytes,2,opt,name=res,proto3" json:"res,omitempty"`
+	Data          []*FriendData          `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	Total         int32                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,6,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFriendsReply) Reset() {
+	*x = ListFriendsReply{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFriendsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFriendsReply) ProtoMessage() {}
+
+func (x *ListFriendsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFriendsReply.ProtoReflect.Descriptor instead.
+func (*ListFriendsReply) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ListFriendsReply) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListFriendsReply) GetRes() *Res {
+	if x != nil {
+		return x.Res
+	}
+	return nil
+}
+
+func (x *ListFriendsReply) GetData() []*FriendData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListFriendsReply) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListFriendsReply) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListFriendsReply) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type RemoveFriendReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TargetId      string                 `protobuf:"bytes,1,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFriendReq) Reset() {
+	*x = RemoveFriendReq{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFriendReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFriendReq) ProtoMessage() {}
+
+func (x *RemoveFriendReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFriendReq.ProtoReflect.Descriptor instead.
+func (*RemoveFriendReq) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *RemoveFriendReq) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+type RemoveFriendReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Res           *Res                   `protobuf:"bytes,2,opt,name=res,proto3" json:"res,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFriendReply) Reset() {
+	*x = RemoveFriendReply{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFriendReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFriendReply) ProtoMessage() {}
+
+func (x *RemoveFriendReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFriendReply.ProtoReflect.Descriptor instead.
+func (*RemoveFriendReply) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *RemoveFriendReply) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *RemoveFriendReply) GetRes() *Res {
+	if x != nil {
+		return x.Res
+	}
+	return nil
+}
+
+// NID_BLOCK_REQ
+type BlockUserReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TargetId      string                 `protobuf:"bytes,1,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockUserReq) Reset() {
+	*x = BlockUserReq{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockUserReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockUserReq) ProtoMessage() {}
+
+func (x *BlockUserReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockUserReq.ProtoReflect.Descriptor instead.
+func (*BlockUserReq) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *BlockUserReq) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+type UnblockUserReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TargetId      string                 `protobuf:"bytes,1,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnblockUserReq) Reset() {
+	*x = UnblockUserReq{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnblockUserReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockUserReq) ProtoMessage() {}
+
+func (x *UnblockUserReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockUserReq.ProtoReflect.Descriptor instead.
+func (*UnblockUserReq) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *UnblockUserReq) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+type BlockReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Res           *Res                   `protobuf:"bytes,2,opt,name=res,proto3" json:"res,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockReply) Reset() {
+	*x = BlockReply{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockReply) ProtoMessage() {}
+
+func (x *BlockReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockReply.ProtoReflect.Descriptor instead.
+func (*BlockReply) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *BlockReply) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *BlockReply) GetRes() *Res {
+	if x != nil {
+		return x.Res
+	}
+	return nil
+}
+
+type BlockData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`         // 被拉黑的用户
+	BlockedAt     *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=blocked_at,json=blockedAt,proto3" json:"blocked_at,omitempty"` // 拉黑时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockData) Reset() {
+	*x = BlockData{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockData) ProtoMessage() {}
+
+func (x *BlockData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockData.ProtoReflect.Descriptor instead.
+func (*BlockData) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *BlockData) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *BlockData) GetBlockedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.BlockedAt
+	}
+	return nil
+}
+
+type ListBlocksReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`         // 分页页码，从1开始
+	PageSize      int32                  `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize,omitempty"` // 分页每页数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBlocksReq) Reset() {
+	*x = ListBlocksReq{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBlocksReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlocksReq) ProtoMessage() {}
+
+func (x *ListBlocksReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlocksReq.ProtoReflect.Descriptor instead.
+func (*ListBlocksReq) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ListBlocksReq) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListBlocksReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListBlocksReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Res           *Res                   `protobuf:"bytes,2,opt,name=res,proto3" json:"res,omitempty"`
+	Data          []*BlockData           `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	Total         int32                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,6,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBlocksReply) Reset() {
+	*x = ListBlocksReply{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBlocksReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlocksReply) ProtoMessage() {}
+
+func (x *ListBlocksReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlocksReply.ProtoReflect.Descriptor instead.
+func (*ListBlocksReply) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ListBlocksReply) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListBlocksReply) GetRes() *Res {
+	if x != nil {
+		return x.Res
+	}
+	return nil
+}
+
+func (x *ListBlocksReply) GetData() []*BlockData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListBlocksReply) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListBlocksReply) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListBlocksReply) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// NID_MESSAGE_REQ
+type Message struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Avatar        string                 `protobuf:"bytes,1,opt,name=avatar,proto3" json:"avatar,omitempty"`             //头像
+	FromUserName  string                 `protobuf:"bytes,2,opt,name=fromUserName,proto3" json:"fromUserName,omitempty"` // 发送消息用户的用户名
+	From          string                 `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`                 // 发送消息用户uuid
+	To            string                 `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`                     // 发送给对端用户的uuid
+	Content       string                 `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`           // 文本消息内容
+	MessageType   uint32                 `protobuf:"varint,6,opt,name=messageType,proto3" json:"messageType,omitempty"`  // 消息类型，1.单聊 2.群聊
+	ContentType   uint32                 `protobuf:"varint,7,opt,name=contentType,proto3" json:"contentType,omitempty"`  // 消息内容类型：1.文字 2.普通文件 3.图片 4.音频 5.视频 6.语音聊天 7.视频聊天
+	Type          string                 `protobuf:"bytes,8,opt,name=type,proto3" json:"type,omitempty"`                 // 消息传输类型：如果是心跳消息，该内容为heatbeat,在线视频或者音频为webrtc
+	Url           string                 `protobuf:"bytes,9,opt,name=url,proto3" json:"url,omitempty"`                   // 图片，视频，语音的路径
+	FileSuffix    string                 `protobuf:"bytes,10,opt,name=fileSuffix,proto3" json:"fileSuffix,omitempty"`    // 文件后缀，如果通过二进制头不能解析文件后缀，使用该后缀
+	File          []byte                 `protobuf:"bytes,11,opt,name=file,proto3" json:"file,omitempty"`                // 如果是图片，文件，视频等的二进制
+	Mentions      []string               `protobuf:"bytes,12,rep,name=mentions,proto3" json:"mentions,omitempty"`        // 群聊中@的用户id，"all"表示@所有人
+	MessageId     uint32                 `protobuf:"varint,13,opt,name=messageId,proto3" json:"messageId,omitempty"`     // 消息落库后的id，由服务端填充
+	Silent        bool                   `protobuf:"varint,14,opt,name=silent,proto3" json:"silent,omitempty"`           // 接收者开启了免打扰，客户端正常展示但不推送、不提醒
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Message) Reset() {
+	*x = Message{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Message) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Message) ProtoMessage() {}
 
 func (x *Message) ProtoReflect() protoreflect.Message {
-	mi := &file_api_conduit_v1_conduit_proto_msgTypes[26]
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Message.ProtoReflect.Descriptor instead.
+func (*Message) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *Message) GetAvatar() string {
+	if x != nil {
+		return x.Avatar
+	}
+	return ""
+}
+
+func (x *Message) GetFromUserName() string {
+	if x != nil {
+		return x.FromUserName
+	}
+	return ""
+}
+
+func (x *Message) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *Message) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *Message) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Message) GetMessageType() uint32 {
+	if x != nil {
+		return x.MessageType
+	}
+	return 0
+}
+
+func (x *Message) GetContentType() uint32 {
+	if x != nil {
+		return x.ContentType
+	}
+	return 0
+}
+
+func (x *Message) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Message) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Message) GetFileSuffix() string {
+	if x != nil {
+		return x.FileSuffix
+	}
+	return ""
+}
+
+func (x *Message) GetFile() []byte {
+	if x != nil {
+		return x.File
+	}
+	return nil
+}
+
+func (x *Message) GetMentions() []string {
+	if x != nil {
+		return x.Mentions
+	}
+	return nil
+}
+
+func (x *Message) GetMessageId() uint32 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+func (x *Message) GetSilent() bool {
+	if x != nil {
+		return x.Silent
+	}
+	return false
+}
+
+type GetMessagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MessageType   int32                  `protobuf:"varint,1,opt,name=messageType,proto3" json:"messageType,omitempty"` // 消息类型，1.单聊 2.群聊
+	Uuid          string                 `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`                // 当前用户uuid
+	FriendUuid    string                 `protobuf:"bytes,3,opt,name=friendUuid,proto3" json:"friendUuid,omitempty"`    // 好友用户uuid(单聊) 或 群聊uuid(群聊)
+	Page          int32                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`               // 分页页码
+	PageSize      int32                  `protobuf:"varint,5,opt,name=pageSize,proto3" json:"pageSize,omitempty"`       // 分页每页数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMessagesRequest) Reset() {
+	*x = GetMessagesRequest{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMessagesRequest) ProtoMessage() {}
+
+func (x *GetMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMessagesRequest.ProtoReflect.Descriptor instead.
+func (*GetMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *GetMessagesRequest) GetMessageType() int32 {
+	if x != nil {
+		return x.MessageType
+	}
+	return 0
+}
+
+func (x *GetMessagesRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *GetMessagesRequest) GetFriendUuid() string {
+	if x != nil {
+		return x.FriendUuid
+	}
+	return ""
+}
+
+func (x *GetMessagesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetMessagesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type GetMessagesReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Res           *Res                   `protobuf:"bytes,2,opt,name=res,proto3" json:"res,omitempty"`
+	Data          []*Message             `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`          // 消息列表
+	Total         int32                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`       // 总消息数
+	Page          int32                  `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`         // 当前页码
+	PageSize      int32                  `protobuf:"varint,6,opt,name=pageSize,proto3" json:"pageSize,omitempty"` // 每页数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMessagesReply) Reset() {
+	*x = GetMessagesReply{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMessagesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMessagesReply) ProtoMessage() {}
+
+func (x *GetMessagesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMessagesReply.ProtoReflect.Descriptor instead.
+func (*GetMessagesReply) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *GetMessagesReply) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetMessagesReply) GetRes() *Res {
+	if x != nil {
+		return x.Res
+	}
+	return nil
+}
+
+func (x *GetMessagesReply) GetData() []*Message {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *GetMessagesReply) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetMessagesReply) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetMessagesReply) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// NID_CONVERSATION_REQ
+type ConversationData struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	TargetId          string                 `protobuf:"bytes,1,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`                                 // 单聊为对端用户id，群聊为群uuid
+	ConversationType  uint32                 `protobuf:"varint,2,opt,name=conversation_type,json=conversationType,proto3" json:"conversation_type,omitempty"`        // 会话类型，1.单聊 2.群聊
+	LastMessageId     uint32                 `protobuf:"varint,3,opt,name=last_message_id,json=lastMessageId,proto3" json:"last_message_id,omitempty"`               // 最后一条消息id
+	LastReadMessageId uint32                 `protobuf:"varint,4,opt,name=last_read_message_id,json=lastReadMessageId,proto3" json:"last_read_message_id,omitempty"` // 已读到的消息id
+	UnreadCount       uint32                 `protobuf:"varint,5,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`                       // 未读消息数
+	Mentioned         bool                   `protobuf:"varint,6,opt,name=mentioned,proto3" json:"mentioned,omitempty"`                                              // 是否有人@我且未读
+	MentionMessageId  uint32                 `protobuf:"varint,7,opt,name=mention_message_id,json=mentionMessageId,proto3" json:"mention_message_id,omitempty"`      // 最早一条未读的@我消息id
+	Muted             bool                   `protobuf:"varint,8,opt,name=muted,proto3" json:"muted,omitempty"`                                                      // 是否免打扰
+	MutedUntil        *timestamp.Timestamp   `protobuf:"bytes,9,opt,name=muted_until,json=mutedUntil,proto3" json:"muted_until,omitempty"`                           // 免打扰截止时间
+	PinnedOrder       uint32                 `protobuf:"varint,10,opt,name=pinned_order,json=pinnedOrder,proto3" json:"pinned_order,omitempty"`                      // 置顶顺序，越大越靠前，0表示未置顶
+	Archived          bool                   `protobuf:"varint,11,opt,name=archived,proto3" json:"archived,omitempty"`                                               // 是否归档
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ConversationData) Reset() {
+	*x = ConversationData{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConversationData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConversationData) ProtoMessage() {}
+
+func (x *ConversationData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConversationData.ProtoReflect.Descriptor instead.
+func (*ConversationData) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ConversationData) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *ConversationData) GetConversationType() uint32 {
+	if x != nil {
+		return x.ConversationType
+	}
+	return 0
+}
+
+func (x *ConversationData) GetLastMessageId() uint32 {
+	if x != nil {
+		return x.LastMessageId
+	}
+	return 0
+}
+
+func (x *ConversationData) GetLastReadMessageId() uint32 {
+	if x != nil {
+		return x.LastReadMessageId
+	}
+	return 0
+}
+
+func (x *ConversationData) GetUnreadCount() uint32 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+func (x *ConversationData) GetMentioned() bool {
+	if x != nil {
+		return x.Mentioned
+	}
+	return false
+}
+
+func (x *ConversationData) GetMentionMessageId() uint32 {
+	if x != nil {
+		return x.MentionMessageId
+	}
+	return 0
+}
+
+func (x *ConversationData) GetMuted() bool {
+	if x != nil {
+		return x.Muted
+	}
+	return false
+}
+
+func (x *ConversationData) GetMutedUntil() *timestamp.Timestamp {
+	if x != nil {
+		return x.MutedUntil
+	}
+	return nil
+}
+
+func (x *ConversationData) GetPinnedOrder() uint32 {
+	if x != nil {
+		return x.PinnedOrder
+	}
+	return 0
+}
+
+func (x *ConversationData) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+type ListConversationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Archived      bool                   `protobuf:"varint,1,opt,name=archived,proto3" json:"archived,omitempty"` // true查看归档的会话
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListConversationsRequest) Reset() {
+	*x = ListConversationsRequest{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConversationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConversationsRequest) ProtoMessage() {}
+
+func (x *ListConversationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConversationsRequest.ProtoReflect.Descriptor instead.
+func (*ListConversationsRequest) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ListConversationsRequest) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+type ListConversationsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Res           *Res                   `protobuf:"bytes,2,opt,name=res,proto3" json:"res,omitempty"`
+	Data          []*ConversationData    `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListConversationsReply) Reset() {
+	*x = ListConversationsReply{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConversationsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConversationsReply) ProtoMessage() {}
+
+func (x *ListConversationsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConversationsReply.ProtoReflect.Descriptor instead.
+func (*ListConversationsReply) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ListConversationsReply) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListConversationsReply) GetRes() *Res {
+	if x != nil {
+		return x.Res
+	}
+	return nil
+}
+
+func (x *ListConversationsReply) GetData() []*ConversationData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ReadConversationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TargetId      string                 `protobuf:"bytes,1,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	MessageId     uint32                 `protobuf:"varint,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"` // 已读到的消息id，0表示全部已读
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadConversationRequest) Reset() {
+	*x = ReadConversationRequest{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadConversationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadConversationRequest) ProtoMessage() {}
+
+func (x *ReadConversationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadConversationRequest.ProtoReflect.Descriptor instead.
+func (*ReadConversationRequest) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ReadConversationRequest) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *ReadConversationRequest) GetMessageId() uint32 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+type ReadConversationReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Res           *Res                   `protobuf:"bytes,2,opt,name=res,proto3" json:"res,omitempty"`
+	Data          *ConversationData      `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadConversationReply) Reset() {
+	*x = ReadConversationReply{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadConversationReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadConversationReply) ProtoMessage() {}
+
+func (x *ReadConversationReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadConversationReply.ProtoReflect.Descriptor instead.
+func (*ReadConversationReply) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ReadConversationReply) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ReadConversationReply) GetRes() *Res {
+	if x != nil {
+		return x.Res
+	}
+	return nil
+}
+
+func (x *ReadConversationReply) GetData() *ConversationData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// 只修改传了的字段
+type UpdateConversationSettingsRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	TargetId         string                 `protobuf:"bytes,1,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	ConversationType uint32                 `protobuf:"varint,2,opt,name=conversation_type,json=conversationType,proto3" json:"conversation_type,omitempty"` // 会话类型，1.单聊 2.群聊，会话还不存在时需要
+	MutedUntil       *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=muted_until,json=mutedUntil,proto3" json:"muted_until,omitempty"`                    // 免打扰到指定时间
+	MuteForever      *bool                  `protobuf:"varint,4,opt,name=mute_forever,json=muteForever,proto3,oneof" json:"mute_forever,omitempty"`          // 永久免打扰，传 false 并且不传muted_until表示取消免打扰
+	Pinned           *bool                  `protobuf:"varint,5,opt,name=pinned,proto3,oneof" json:"pinned,omitempty"`                                       // 是否置顶
+	Archived         *bool                  `protobuf:"varint,6,opt,name=archived,proto3,oneof" json:"archived,omitempty"`                                   // 是否归档
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UpdateConversationSettingsRequest) Reset() {
+	*x = UpdateConversationSettingsRequest{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateConversationSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateConversationSettingsRequest) ProtoMessage() {}
+
+func (x *UpdateConversationSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateConversationSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateConversationSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *UpdateConversationSettingsRequest) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *UpdateConversationSettingsRequest) GetConversationType() uint32 {
+	if x != nil {
+		return x.ConversationType
+	}
+	return 0
+}
+
+func (x *UpdateConversationSettingsRequest) GetMutedUntil() *timestamp.Timestamp {
+	if x != nil {
+		return x.MutedUntil
+	}
+	return nil
+}
+
+func (x *UpdateConversationSettingsRequest) GetMuteForever() bool {
+	if x != nil && x.MuteForever != nil {
+		return *x.MuteForever
+	}
+	return false
+}
+
+func (x *UpdateConversationSettingsRequest) GetPinned() bool {
+	if x != nil && x.Pinned != nil {
+		return *x.Pinned
+	}
+	return false
+}
+
+func (x *UpdateConversationSettingsRequest) GetArchived() bool {
+	if x != nil && x.Archived != nil {
+		return *x.Archived
+	}
+	return false
+}
+
+type UpdateConversationSettingsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Res           *Res                   `protobuf:"bytes,2,opt,name=res,proto3" json:"res,omitempty"`
+	Data          *ConversationData      `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateConversationSettingsReply) Reset() {
+	*x = UpdateConversationSettingsReply{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateConversationSettingsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateConversationSettingsReply) ProtoMessage() {}
+
+func (x *UpdateConversationSettingsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateConversationSettingsReply.ProtoReflect.Descriptor instead.
+func (*UpdateConversationSettingsReply) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *UpdateConversationSettingsReply) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *UpdateConversationSettingsReply) GetRes() *Res {
+	if x != nil {
+		return x.Res
+	}
+	return nil
+}
+
+func (x *UpdateConversationSettingsReply) GetData() *ConversationData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// 阅后即焚，可选 0(关闭)、86400(1天)、604800(7天)、2592000(30天)
+type ConversationTimerData struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	TargetId         string                 `protobuf:"bytes,1,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	ConversationType uint32                 `protobuf:"varint,2,opt,name=conversation_type,json=conversationType,proto3" json:"conversation_type,omitempty"`
+	TimerSeconds     uint32                 `protobuf:"varint,3,opt,name=timer_seconds,json=timerSeconds,proto3" json:"timer_seconds,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ConversationTimerData) Reset() {
+	*x = ConversationTimerData{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConversationTimerData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConversationTimerData) ProtoMessage() {}
+
+func (x *ConversationTimerData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConversationTimerData.ProtoReflect.Descriptor instead.
+func (*ConversationTimerData) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *ConversationTimerData) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *ConversationTimerData) GetConversationType() uint32 {
+	if x != nil {
+		return x.ConversationType
+	}
+	return 0
+}
+
+func (x *ConversationTimerData) GetTimerSeconds() uint32 {
+	if x != nil {
+		return x.TimerSeconds
+	}
+	return 0
+}
+
+type GetConversationTimerRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	TargetId         string                 `protobuf:"bytes,1,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	ConversationType uint32                 `protobuf:"varint,2,opt,name=conversation_type,json=conversationType,proto3" json:"conversation_type,omitempty"` // 会话类型，1.单聊 2.群聊
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetConversationTimerRequest) Reset() {
+	*x = GetConversationTimerRequest{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConversationTimerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConversationTimerRequest) ProtoMessage() {}
+
+func (x *GetConversationTimerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConversationTimerRequest.ProtoReflect.Descriptor instead.
+func (*GetConversationTimerRequest) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *GetConversationTimerRequest) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *GetConversationTimerRequest) GetConversationType() uint32 {
+	if x != nil {
+		return x.ConversationType
+	}
+	return 0
+}
+
+type SetConversationTimerRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	TargetId         string                 `protobuf:"bytes,1,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	ConversationType uint32                 `protobuf:"varint,2,opt,name=conversation_type,json=conversationType,proto3" json:"conversation_type,omitempty"`
+	TimerSeconds     uint32                 `protobuf:"varint,3,opt,name=timer_seconds,json=timerSeconds,proto3" json:"timer_seconds,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SetConversationTimerRequest) Reset() {
+	*x = SetConversationTimerRequest{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetConversationTimerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetConversationTimerRequest) ProtoMessage() {}
+
+func (x *SetConversationTimerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetConversationTimerRequest.ProtoReflect.Descriptor instead.
+func (*SetConversationTimerRequest) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *SetConversationTimerRequest) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *SetConversationTimerRequest) GetConversationType() uint32 {
+	if x != nil {
+		return x.ConversationType
+	}
+	return 0
+}
+
+func (x *SetConversationTimerRequest) GetTimerSeconds() uint32 {
+	if x != nil {
+		return x.TimerSeconds
+	}
+	return 0
+}
+
+type ConversationTimerReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Res           *Res                   `protobuf:"bytes,2,opt,name=res,proto3" json:"res,omitempty"`
+	Data          *ConversationTimerData `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConversationTimerReply) Reset() {
+	*x = ConversationTimerReply{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConversationTimerReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConversationTimerReply) ProtoMessage() {}
+
+func (x *ConversationTimerReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConversationTimerReply.ProtoReflect.Descriptor instead.
+func (*ConversationTimerReply) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *ConversationTimerReply) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ConversationTimerReply) GetRes() *Res {
+	if x != nil {
+		return x.Res
+	}
+	return nil
+}
+
+func (x *ConversationTimerReply) GetData() *ConversationTimerData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// 聊天记录导出，zip 里包含 messages.json、messages.csv、transcript.html 和附件
+type CreateChatExportRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	TargetId         string                 `protobuf:"bytes,1,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	ConversationType uint32                 `protobuf:"varint,2,opt,name=conversation_type,json=conversationType,proto3" json:"conversation_type,omitempty"` // 会话类型，1.单聊 2.群聊
+	StartTime        *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime          *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateChatExportRequest) Reset() {
+	*x = CreateChatExportRequest{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateChatExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateChatExportRequest) ProtoMessage() {}
+
+func (x *CreateChatExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateChatExportRequest.ProtoReflect.Descriptor instead.
+func (*CreateChatExportRequest) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *CreateChatExportRequest) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *CreateChatExportRequest) GetConversationType() uint32 {
+	if x != nil {
+		return x.ConversationType
+	}
+	return 0
+}
+
+func (x *CreateChatExportRequest) GetStartTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *CreateChatExportRequest) GetEndTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+type GetChatExportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExportId      uint32                 `protobuf:"varint,1,opt,name=export_id,json=exportId,proto3" json:"export_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChatExportRequest) Reset() {
+	*x = GetChatExportRequest{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChatExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChatExportRequest) ProtoMessage() {}
+
+func (x *GetChatExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChatExportRequest.ProtoReflect.Descriptor instead.
+func (*GetChatExportRequest) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *GetChatExportRequest) GetExportId() uint32 {
+	if x != nil {
+		return x.ExportId
+	}
+	return 0
+}
+
+type ChatExportData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExportId      uint32                 `protobuf:"varint,1,opt,name=export_id,json=exportId,proto3" json:"export_id,omitempty"`
+	Status        uint32                 `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`                             // 0.排队中 1.导出中 2.已完成 3.失败
+	DownloadUrl   string                 `protobuf:"bytes,3,opt,name=download_url,json=downloadUrl,proto3" json:"download_url,omitempty"` // 已完成且未过期时返回
+	ExpireAt      *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=expire_at,json=expireAt,proto3" json:"expire_at,omitempty"`          // 下载链接过期时间
+	ErrorMsg      string                 `protobuf:"bytes,5,opt,name=error_msg,json=errorMsg,proto3" json:"error_msg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatExportData) Reset() {
+	*x = ChatExportData{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatExportData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatExportData) ProtoMessage() {}
+
+func (x *ChatExportData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatExportData.ProtoReflect.Descriptor instead.
+func (*ChatExportData) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *ChatExportData) GetExportId() uint32 {
+	if x != nil {
+		return x.ExportId
+	}
+	return 0
+}
+
+func (x *ChatExportData) GetStatus() uint32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ChatExportData) GetDownloadUrl() string {
+	if x != nil {
+		return x.DownloadUrl
+	}
+	return ""
+}
+
+func (x *ChatExportData) GetExpireAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ExpireAt
+	}
+	return nil
+}
+
+func (x *ChatExportData) GetErrorMsg() string {
+	if x != nil {
+		return x.ErrorMsg
+	}
+	return ""
+}
+
+type ChatExportReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Res           *Res                   `protobuf:"bytes,2,opt,name=res,proto3" json:"res,omitempty"`
+	Data          *ChatExportData        `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatExportReply) Reset() {
+	*x = ChatExportReply{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatExportReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatExportReply) ProtoMessage() {}
+
+func (x *ChatExportReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatExportReply.ProtoReflect.Descriptor instead.
+func (*ChatExportReply) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *ChatExportReply) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ChatExportReply) GetRes() *Res {
+	if x != nil {
+		return x.Res
+	}
+	return nil
+}
+
+func (x *ChatExportReply) GetData() *ChatExportData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// NID_GROUP_REQ
+type GroupData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`  // 群uuid
+	OwnerId       uint32                 `protobuf:"varint,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"` // 群主id
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Notice        string                 `protobuf:"bytes,4,opt,name=notice,proto3" json:"notice,omitempty"`
+	MuteAll       bool                   `protobuf:"varint,5,opt,name=mute_all,json=muteAll,proto3" json:"mute_all,omitempty"`                     // 全员禁言
+	OnlyAdminEdit bool                   `protobuf:"varint,6,opt,name=only_admin_edit,json=onlyAdminEdit,proto3" json:"only_admin_edit,omitempty"` // 仅群主和管理员可修改群名称和公告
+	JoinApproval  bool                   `protobuf:"varint,7,opt,name=join_approval,json=joinApproval,proto3" json:"join_approval,omitempty"`      // 入群需要审批
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupData) Reset() {
+	*x = GroupData{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupData) ProtoMessage() {}
+
+func (x *GroupData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupData.ProtoReflect.Descriptor instead.
+func (*GroupData) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *GroupData) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *GroupData) GetOwnerId() uint32 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+func (x *GroupData) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GroupData) GetNotice() string {
+	if x != nil {
+		return x.Notice
+	}
+	return ""
+}
+
+func (x *GroupData) GetMuteAll() bool {
+	if x != nil {
+		return x.MuteAll
+	}
+	return false
+}
+
+func (x *GroupData) GetOnlyAdminEdit() bool {
+	if x != nil {
+		return x.OnlyAdminEdit
+	}
+	return false
+}
+
+func (x *GroupData) GetJoinApproval() bool {
+	if x != nil {
+		return x.JoinApproval
+	}
+	return false
+}
+
+type GroupMemberData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          uint32                 `protobuf:"varint,3,opt,name=role,proto3" json:"role,omitempty"`                           // 1.群主 2.管理员 3.普通成员
+	Mute          bool                   `protobuf:"varint,4,opt,name=mute,proto3" json:"mute,omitempty"`                           // 是否永久禁言
+	MuteUntil     *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=mute_until,json=muteUntil,proto3" json:"mute_until,omitempty"` // 禁言截止时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupMemberData) Reset() {
+	*x = GroupMemberData{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupMemberData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupMemberData) ProtoMessage() {}
+
+func (x *GroupMemberData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupMemberData.ProtoReflect.Descriptor instead.
+func (*GroupMemberData) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *GroupMemberData) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *GroupMemberData) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GroupMemberData) GetRole() uint32 {
+	if x != nil {
+		return x.Role
+	}
+	return 0
+}
+
+func (x *GroupMemberData) GetMute() bool {
+	if x != nil {
+		return x.Mute
+	}
+	return false
+}
+
+func (x *GroupMemberData) GetMuteUntil() *timestamp.Timestamp {
+	if x != nil {
+		return x.MuteUntil
+	}
+	return nil
+}
+
+type GroupReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Res           *Res                   `protobuf:"bytes,2,opt,name=res,proto3" json:"res,omitempty"`
+	Data          *GroupData             `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupReply) Reset() {
+	*x = GroupReply{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupReply) ProtoMessage() {}
+
+func (x *GroupReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupReply.ProtoReflect.Descriptor instead.
+func (*GroupReply) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *GroupReply) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GroupReply) GetRes() *Res {
+	if x != nil {
+		return x.Res
+	}
+	return nil
+}
+
+func (x *GroupReply) GetData() *GroupData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GroupMemberReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Res           *Res                   `protobuf:"bytes,2,opt,name=res,proto3" json:"res,omitempty"`
+	Data          *GroupMemberData       `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupMemberReply) Reset() {
+	*x = GroupMemberReply{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupMemberReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupMemberReply) ProtoMessage() {}
+
+func (x *GroupMemberReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupMemberReply.ProtoReflect.Descriptor instead.
+func (*GroupMemberReply) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *GroupMemberReply) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GroupMemberReply) GetRes() *Res {
+	if x != nil {
+		return x.Res
+	}
+	return nil
+}
+
+func (x *GroupMemberReply) GetData() *GroupMemberData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type UpdateGroupInfoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`           // 为空表示不修改
+	Notice        *string                `protobuf:"bytes,3,opt,name=notice,proto3,oneof" json:"notice,omitempty"` // 不传表示不修改，传空字符串清空公告
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGroupInfoRequest) Reset() {
+	*x = UpdateGroupInfoRequest{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGroupInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGroupInfoRequest) ProtoMessage() {}
+
+func (x *UpdateGroupInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGroupInfoRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGroupInfoRequest) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *UpdateGroupInfoRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *UpdateGroupInfoRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateGroupInfoRequest) GetNotice() string {
+	if x != nil && x.Notice != nil {
+		return *x.Notice
+	}
+	return ""
+}
+
+// 只修改传了的字段
+type UpdateGroupSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	MuteAll       *bool                  `protobuf:"varint,2,opt,name=mute_all,json=muteAll,proto3,oneof" json:"mute_all,omitempty"`                     // 全员禁言
+	OnlyAdminEdit *bool                  `protobuf:"varint,3,opt,name=only_admin_edit,json=onlyAdminEdit,proto3,oneof" json:"only_admin_edit,omitempty"` // 只有群主和管理员可以修改群资料
+	JoinApproval  *bool                  `protobuf:"varint,4,opt,name=join_approval,json=joinApproval,proto3,oneof" json:"join_approval,omitempty"`      // 入群需要审批
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGroupSettingsRequest) Reset() {
+	*x = UpdateGroupSettingsRequest{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGroupSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGroupSettingsRequest) ProtoMessage() {}
+
+func (x *UpdateGroupSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGroupSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGroupSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *UpdateGroupSettingsRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *UpdateGroupSettingsRequest) GetMuteAll() bool {
+	if x != nil && x.MuteAll != nil {
+		return *x.MuteAll
+	}
+	return false
+}
+
+func (x *UpdateGroupSettingsRequest) GetOnlyAdminEdit() bool {
+	if x != nil && x.OnlyAdminEdit != nil {
+		return *x.OnlyAdminEdit
+	}
+	return false
+}
+
+func (x *UpdateGroupSettingsRequest) GetJoinApproval() bool {
+	if x != nil && x.JoinApproval != nil {
+		return *x.JoinApproval
+	}
+	return false
+}
+
+type SetGroupAdminRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IsAdmin       bool                   `protobuf:"varint,3,opt,name=is_admin,json=isAdmin,proto3" json:"is_admin,omitempty"` // true设为管理员，false取消管理员
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetGroupAdminRequest) Reset() {
+	*x = SetGroupAdminRequest{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetGroupAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGroupAdminRequest) ProtoMessage() {}
+
+func (x *SetGroupAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGroupAdminRequest.ProtoReflect.Descriptor instead.
+func (*SetGroupAdminRequest) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *SetGroupAdminRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *SetGroupAdminRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SetGroupAdminRequest) GetIsAdmin() bool {
+	if x != nil {
+		return x.IsAdmin
+	}
+	return false
+}
+
+type MuteGroupMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MuteUntil     *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=mute_until,json=muteUntil,proto3" json:"mute_until,omitempty"` // 禁言到指定时间
+	Forever       bool                   `protobuf:"varint,4,opt,name=forever,proto3" json:"forever,omitempty"`                     // 永久禁言，和mute_until都不传表示解除禁言
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MuteGroupMemberRequest) Reset() {
+	*x = MuteGroupMemberRequest{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MuteGroupMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuteGroupMemberRequest) ProtoMessage() {}
+
+func (x *MuteGroupMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuteGroupMemberRequest.ProtoReflect.Descriptor instead.
+func (*MuteGroupMemberRequest) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *MuteGroupMemberRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *MuteGroupMemberRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *MuteGroupMemberRequest) GetMuteUntil() *timestamp.Timestamp {
+	if x != nil {
+		return x.MuteUntil
+	}
+	return nil
+}
+
+func (x *MuteGroupMemberRequest) GetForever() bool {
+	if x != nil {
+		return x.Forever
+	}
+	return false
+}
+
+type GroupInviteData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	Link          string                 `protobuf:"bytes,3,opt,name=link,proto3" json:"link,omitempty"`                            // 邀请链接
+	QrPayload     string                 `protobuf:"bytes,4,opt,name=qr_payload,json=qrPayload,proto3" json:"qr_payload,omitempty"` // 二维码内容
+	ExpireAt      *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=expire_at,json=expireAt,proto3" json:"expire_at,omitempty"`    // 为空表示永不过期
+	MaxUses       uint32                 `protobuf:"varint,6,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`      // 0表示不限次数
+	UsedCount     uint32                 `protobuf:"varint,7,opt,name=used_count,json=usedCount,proto3" json:"used_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupInviteData) Reset() {
+	*x = GroupInviteData{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupInviteData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupInviteData) ProtoMessage() {}
+
+func (x *GroupInviteData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupInviteData.ProtoReflect.Descriptor instead.
+func (*GroupInviteData) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *GroupInviteData) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *GroupInviteData) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GroupInviteData) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+func (x *GroupInviteData) GetQrPayload() string {
+	if x != nil {
+		return x.QrPayload
+	}
+	return ""
+}
+
+func (x *GroupInviteData) GetExpireAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ExpireAt
+	}
+	return nil
+}
+
+func (x *GroupInviteData) GetMaxUses() uint32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *GroupInviteData) GetUsedCount() uint32 {
+	if x != nil {
+		return x.UsedCount
+	}
+	return 0
+}
+
+type CreateGroupInviteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	ExpireSeconds int64                  `protobuf:"varint,2,opt,name=expire_seconds,json=expireSeconds,proto3" json:"expire_seconds,omitempty"` // 有效期，0表示永不过期
+	MaxUses       uint32                 `protobuf:"varint,3,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`                   // 最大使用次数，0表示不限
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGroupInviteRequest) Reset() {
+	*x = CreateGroupInviteRequest{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGroupInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupInviteRequest) ProtoMessage() {}
+
+func (x *CreateGroupInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupInviteRequest.ProtoReflect.Descriptor instead.
+func (*CreateGroupInviteRequest) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *CreateGroupInviteRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *CreateGroupInviteRequest) GetExpireSeconds() int64 {
+	if x != nil {
+		return x.ExpireSeconds
+	}
+	return 0
+}
+
+func (x *CreateGroupInviteRequest) GetMaxUses() uint32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+type GroupInviteReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Res           *Res                   `protobuf:"bytes,2,opt,name=res,proto3" json:"res,omitempty"`
+	Data          *GroupInviteData       `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupInviteReply) Reset() {
+	*x = GroupInviteReply{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupInviteReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupInviteReply) ProtoMessage() {}
+
+func (x *GroupInviteReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupInviteReply.ProtoReflect.Descriptor instead.
+func (*GroupInviteReply) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *GroupInviteReply) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GroupInviteReply) GetRes() *Res {
+	if x != nil {
+		return x.Res
+	}
+	return nil
+}
+
+func (x *GroupInviteReply) GetData() *GroupInviteData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type RevokeGroupInviteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeGroupInviteRequest) Reset() {
+	*x = RevokeGroupInviteRequest{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeGroupInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeGroupInviteRequest) ProtoMessage() {}
+
+func (x *RevokeGroupInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeGroupInviteRequest.ProtoReflect.Descriptor instead.
+func (*RevokeGroupInviteRequest) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *RevokeGroupInviteRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *RevokeGroupInviteRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type RevokeGroupInviteReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Res           *Res                   `protobuf:"bytes,2,opt,name=res,proto3" json:"res,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeGroupInviteReply) Reset() {
+	*x = RevokeGroupInviteReply{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeGroupInviteReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeGroupInviteReply) ProtoMessage() {}
+
+func (x *RevokeGroupInviteReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeGroupInviteReply.ProtoReflect.Descriptor instead.
+func (*RevokeGroupInviteReply) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *RevokeGroupInviteReply) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *RevokeGroupInviteReply) GetRes() *Res {
+	if x != nil {
+		return x.Res
+	}
+	return nil
+}
+
+type GroupJoinRequestData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     uint32                 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	GroupId       string                 `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`          // 申请人
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`                       // 申请留言
+	Status        uint32                 `protobuf:"varint,5,opt,name=status,proto3" json:"status,omitempty"`                        // 0.待审批 1.已通过 2.已拒绝
+	HandlerId     uint32                 `protobuf:"varint,6,opt,name=handler_id,json=handlerId,proto3" json:"handler_id,omitempty"` // 审批人
+	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupJoinRequestData) Reset() {
+	*x = GroupJoinRequestData{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupJoinRequestData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupJoinRequestData) ProtoMessage() {}
+
+func (x *GroupJoinRequestData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupJoinRequestData.ProtoReflect.Descriptor instead.
+func (*GroupJoinRequestData) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *GroupJoinRequestData) GetRequestId() uint32 {
+	if x != nil {
+		return x.RequestId
+	}
+	return 0
+}
+
+func (x *GroupJoinRequestData) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *GroupJoinRequestData) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GroupJoinRequestData) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GroupJoinRequestData) GetStatus() uint32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *GroupJoinRequestData) GetHandlerId() uint32 {
+	if x != nil {
+		return x.HandlerId
+	}
+	return 0
+}
+
+func (x *GroupJoinRequestData) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type JoinGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // 需要审批时的申请留言
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinGroupRequest) Reset() {
+	*x = JoinGroupRequest{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinGroupRequest) ProtoMessage() {}
+
+func (x *JoinGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinGroupRequest.ProtoReflect.Descriptor instead.
+func (*JoinGroupRequest) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *JoinGroupRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *JoinGroupRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type JoinGroupReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Res           *Res                   `protobuf:"bytes,2,opt,name=res,proto3" json:"res,omitempty"`
+	Data          *JoinGroupData         `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinGroupReply) Reset() {
+	*x = JoinGroupReply{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinGroupReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinGroupReply) ProtoMessage() {}
+
+func (x *JoinGroupReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinGroupReply.ProtoReflect.Descriptor instead.
+func (*JoinGroupReply) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *JoinGroupReply) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *JoinGroupReply) GetRes() *Res {
+	if x != nil {
+		return x.Res
+	}
+	return nil
+}
+
+func (x *JoinGroupReply) GetData() *JoinGroupData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type JoinGroupData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         *GroupData             `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Joined        bool                   `protobuf:"varint,2,opt,name=joined,proto3" json:"joined,omitempty"` // true已入群，false已提交申请等待审批
+	Request       *GroupJoinRequestData  `protobuf:"bytes,3,opt,name=request,proto3" json:"request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinGroupData) Reset() {
+	*x = JoinGroupData{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinGroupData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinGroupData) ProtoMessage() {}
+
+func (x *JoinGroupData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinGroupData.ProtoReflect.Descriptor instead.
+func (*JoinGroupData) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *JoinGroupData) GetGroup() *GroupData {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+func (x *JoinGroupData) GetJoined() bool {
+	if x != nil {
+		return x.Joined
+	}
+	return false
+}
+
+func (x *JoinGroupData) GetRequest() *GroupJoinRequestData {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type ListGroupJoinRequestsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGroupJoinRequestsRequest) Reset() {
+	*x = ListGroupJoinRequestsRequest{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGroupJoinRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupJoinRequestsRequest) ProtoMessage() {}
+
+func (x *ListGroupJoinRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupJoinRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListGroupJoinRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *ListGroupJoinRequestsRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type ListGroupJoinRequestsReply struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Code          int32                   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Res           *Res                    `protobuf:"bytes,2,opt,name=res,proto3" json:"res,omitempty"`
+	Data          []*GroupJoinRequestData `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGroupJoinRequestsReply) Reset() {
+	*x = ListGroupJoinRequestsReply{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGroupJoinRequestsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupJoinRequestsReply) ProtoMessage() {}
+
+func (x *ListGroupJoinRequestsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupJoinRequestsReply.ProtoReflect.Descriptor instead.
+func (*ListGroupJoinRequestsReply) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *ListGroupJoinRequestsReply) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListGroupJoinRequestsReply) GetRes() *Res {
+	if x != nil {
+		return x.Res
+	}
+	return nil
+}
+
+func (x *ListGroupJoinRequestsReply) GetData() []*GroupJoinRequestData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type HandleGroupJoinRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	RequestId     uint32                 `protobuf:"varint,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Approve       bool                   `protobuf:"varint,3,opt,name=approve,proto3" json:"approve,omitempty"` // true通过，false拒绝
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HandleGroupJoinRequestRequest) Reset() {
+	*x = HandleGroupJoinRequestRequest{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HandleGroupJoinRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandleGroupJoinRequestRequest) ProtoMessage() {}
+
+func (x *HandleGroupJoinRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandleGroupJoinRequestRequest.ProtoReflect.Descriptor instead.
+func (*HandleGroupJoinRequestRequest) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *HandleGroupJoinRequestRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *HandleGroupJoinRequestRequest) GetRequestId() uint32 {
+	if x != nil {
+		return x.RequestId
+	}
+	return 0
+}
+
+func (x *HandleGroupJoinRequestRequest) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
+}
+
+type GroupJoinRequestReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Res           *Res                   `protobuf:"bytes,2,opt,name=res,proto3" json:"res,omitempty"`
+	Data          *GroupJoinRequestData  `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupJoinRequestReply) Reset() {
+	*x = GroupJoinRequestReply{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupJoinRequestReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupJoinRequestReply) ProtoMessage() {}
+
+func (x *GroupJoinRequestReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupJoinRequestReply.ProtoReflect.Descriptor instead.
+func (*GroupJoinRequestReply) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *GroupJoinRequestReply) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GroupJoinRequestReply) GetRes() *Res {
+	if x != nil {
+		return x.Res
+	}
+	return nil
+}
+
+func (x *GroupJoinRequestReply) GetData() *GroupJoinRequestData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type MomentData struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId             uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Message            string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	MediaUrl           string                 `protobuf:"bytes,4,opt,name=media_url,json=mediaUrl,proto3" json:"media_url,omitempty"`
+	LikeCount          int32                  `protobuf:"varint,5,opt,name=like_count,json=likeCount,proto3" json:"like_count,omitempty"`
+	BlackListIds       []uint32               `protobuf:"varint,6,rep,packed,name=black_list_ids,json=blackListIds,proto3" json:"black_list_ids,omitempty"` // 只有作者本人能看到
+	Comments           []*CommentData         `protobuf:"bytes,7,rep,name=comments,proto3" json:"comments,omitempty"`                                       // 第一页一级评论，更多评论通过 ListComments 获取
+	CreatedAt          *timestamp.Timestamp   `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Tags               []string               `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
+	Liked              bool                   `protobuf:"varint,10,opt,name=liked,proto3" json:"liked,omitempty"` // 当前用户是否点过赞
+	CommentsNextCursor uint32                 `protobuf:"varint,11,opt,name=comments_next_cursor,json=commentsNextCursor,proto3" json:"comments_next_cursor,omitempty"`
+	CommentsHasMore    bool                   `protobuf:"varint,12,opt,name=comments_has_more,json=commentsHasMore,proto3" json:"comments_has_more,omitempty"`
+	Visibility         string                 `protobuf:"bytes,13,opt,name=visibility,proto3" json:"visibility,omitempty"`                     // 可见范围，只有作者本人能看到
+	ScopeIds           []uint32               `protobuf:"varint,14,rep,packed,name=scope_ids,json=scopeIds,proto3" json:"scope_ids,omitempty"` // allow/hide 的用户列表，只有作者本人能看到
+	Media              []*MomentMediaData     `protobuf:"bytes,15,rep,name=media,proto3" json:"media,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *MomentData) Reset() {
+	*x = MomentData{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MomentData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MomentData) ProtoMessage() {}
+
+func (x *MomentData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MomentData.ProtoReflect.Descriptor instead.
+func (*MomentData) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *MomentData) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MomentData) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *MomentData) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *MomentData) GetMediaUrl() string {
+	if x != nil {
+		return x.MediaUrl
+	}
+	return ""
+}
+
+func (x *MomentData) GetLikeCount() int32 {
+	if x != nil {
+		return x.LikeCount
+	}
+	return 0
+}
+
+func (x *MomentData) GetBlackListIds() []uint32 {
+	if x != nil {
+		return x.BlackListIds
+	}
+	return nil
+}
+
+func (x *MomentData) GetComments() []*CommentData {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *MomentData) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *MomentData) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *MomentData) GetLiked() bool {
+	if x != nil {
+		return x.Liked
+	}
+	return false
+}
+
+func (x *MomentData) GetCommentsNextCursor() uint32 {
+	if x != nil {
+		return x.CommentsNextCursor
+	}
+	return 0
+}
+
+func (x *MomentData) GetCommentsHasMore() bool {
+	if x != nil {
+		return x.CommentsHasMore
+	}
+	return false
+}
+
+func (x *MomentData) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+func (x *MomentData) GetScopeIds() []uint32 {
+	if x != nil {
+		return x.ScopeIds
+	}
+	return nil
+}
+
+func (x *MomentData) GetMedia() []*MomentMediaData {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
+// 动态附件，视频的宽高、缩略图和 blurhash 取自封面
+type MomentMediaData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"` // image/video
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	CoverUrl      string                 `protobuf:"bytes,3,opt,name=cover_url,json=coverUrl,proto3" json:"cover_url,omitempty"` // 视频封面
+	ThumbnailUrl  string                 `protobuf:"bytes,4,opt,name=thumbnail_url,json=thumbnailUrl,proto3" json:"thumbnail_url,omitempty"`
+	Width         uint32                 `protobuf:"varint,5,opt,name=width,proto3" json:"width,omitempty"`
+	Height        uint32                 `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	BlurHash      string                 `protobuf:"bytes,7,opt,name=blur_hash,json=blurHash,proto3" json:"blur_hash,omitempty"`
+	Sort          int32                  `protobuf:"varint,8,opt,name=sort,proto3" json:"sort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MomentMediaData) Reset() {
+	*x = MomentMediaData{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MomentMediaData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MomentMediaData) ProtoMessage() {}
+
+func (x *MomentMediaData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MomentMediaData.ProtoReflect.Descriptor instead.
+func (*MomentMediaData) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *MomentMediaData) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *MomentMediaData) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *MomentMediaData) GetCoverUrl() string {
+	if x != nil {
+		return x.CoverUrl
+	}
+	return ""
+}
+
+func (x *MomentMediaData) GetThumbnailUrl() string {
+	if x != nil {
+		return x.ThumbnailUrl
+	}
+	return ""
+}
+
+func (x *MomentMediaData) GetWidth() uint32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *MomentMediaData) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *MomentMediaData) GetBlurHash() string {
+	if x != nil {
+		return x.BlurHash
+	}
+	return ""
+}
+
+func (x *MomentMediaData) GetSort() int32 {
+	if x != nil {
+		return x.Sort
+	}
+	return 0
+}
+
+type MomentMediaInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UploadId      uint32                 `protobuf:"varint,1,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`                  // UploadMomentMedia 返回的 id
+	CoverUploadId uint32                 `protobuf:"varint,2,opt,name=cover_upload_id,json=coverUploadId,proto3" json:"cover_upload_id,omitempty"` // 视频必须带一张图片封面
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MomentMediaInput) Reset() {
+	*x = MomentMediaInput{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MomentMediaInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MomentMediaInput) ProtoMessage() {}
+
+func (x *MomentMediaInput) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MomentMediaInput.ProtoReflect.Descriptor instead.
+func (*MomentMediaInput) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *MomentMediaInput) GetUploadId() uint32 {
+	if x != nil {
+		return x.UploadId
+	}
+	return 0
+}
+
+func (x *MomentMediaInput) GetCoverUploadId() uint32 {
+	if x != nil {
+		return x.CoverUploadId
+	}
+	return 0
+}
+
+type MediaUploadData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	ThumbnailUrl  string                 `protobuf:"bytes,4,opt,name=thumbnail_url,json=thumbnailUrl,proto3" json:"thumbnail_url,omitempty"` // 视频没有
+	Width         uint32                 `protobuf:"varint,5,opt,name=width,proto3" json:"width,omitempty"`
+	Height        uint32                 `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	BlurHash      string                 `protobuf:"bytes,7,opt,name=blur_hash,json=blurHash,proto3" json:"blur_hash,omitempty"`
+	Size          int64                  `protobuf:"varint,8,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MediaUploadData) Reset() {
+	*x = MediaUploadData{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MediaUploadData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MediaUploadData) ProtoMessage() {}
+
+func (x *MediaUploadData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MediaUploadData.ProtoReflect.Descriptor instead.
+func (*MediaUploadData) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *MediaUploadData) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MediaUploadData) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *MediaUploadData) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *MediaUploadData) GetThumbnailUrl() string {
+	if x != nil {
+		return x.ThumbnailUrl
+	}
+	return ""
+}
+
+func (x *MediaUploadData) GetWidth() uint32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *MediaUploadData) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *MediaUploadData) GetBlurHash() string {
+	if x != nil {
+		return x.BlurHash
+	}
+	return ""
+}
+
+func (x *MediaUploadData) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+// UploadMomentMediaReply POST /api/moments/media 的返回，上传走 multipart 流式写入，不经过 proto 请求体
+type UploadMomentMediaReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Res           *Res                   `protobuf:"bytes,2,opt,name=res,proto3" json:"res,omitempty"`
+	Data          *MediaUploadData       `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadMomentMediaReply) Reset() {
+	*x = UploadMomentMediaReply{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadMomentMediaReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadMomentMediaReply) ProtoMessage() {}
+
+func (x *UploadMomentMediaReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadMomentMediaReply.ProtoReflect.Descriptor instead.
+func (*UploadMomentMediaReply) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *UploadMomentMediaReply) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *UploadMomentMediaReply) GetRes() *Res {
+	if x != nil {
+		return x.Res
+	}
+	return nil
+}
+
+func (x *UploadMomentMediaReply) GetData() *MediaUploadData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type CommentData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	MomentId      uint32                 `protobuf:"varint,2,opt,name=moment_id,json=momentId,proto3" json:"moment_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Comment       string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ParentId      uint32                 `protobuf:"varint,6,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`                    // 所属一级评论ID，0表示一级评论
+	ReplyToUserId uint32                 `protobuf:"varint,7,opt,name=reply_to_user_id,json=replyToUserId,proto3" json:"reply_to_user_id,omitempty"` // 被回复的用户ID，0表示直接评论动态
+	LikeCount     uint32                 `protobuf:"varint,8,opt,name=like_count,json=likeCount,proto3" json:"like_count,omitempty"`
+	Liked         bool                   `protobuf:"varint,9,opt,name=liked,proto3" json:"liked,omitempty"` // 当前用户是否点过赞
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommentData) Reset() {
+	*x = CommentData{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommentData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentData) ProtoMessage() {}
+
+func (x *CommentData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentData.ProtoReflect.Descriptor instead.
+func (*CommentData) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *CommentData) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CommentData) GetMomentId() uint32 {
+	if x != nil {
+		return x.MomentId
+	}
+	return 0
+}
+
+func (x *CommentData) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CommentData) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *CommentData) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CommentData) GetParentId() uint32 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *CommentData) GetReplyToUserId() uint32 {
+	if x != nil {
+		return x.ReplyToUserId
+	}
+	return 0
+}
+
+func (x *CommentData) GetLikeCount() uint32 {
+	if x != nil {
+		return x.LikeCount
+	}
+	return 0
+}
+
+func (x *CommentData) GetLiked() bool {
+	if x != nil {
+		return x.Liked
+	}
+	return false
+}
+
+type CreateMomentRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Message  string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	MediaUrl string                 `protobuf:"bytes,2,opt,name=media_url,json=mediaUrl,proto3" json:"media_url,omitempty"`
+	Tags     []string               `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"` // 话题标签，最多5个，可以带#前缀
+	// 可见范围 public/followers/friends/private/allow/hide，不传时按隐私设置里的动态默认可见范围
+	Visibility    string              `protobuf:"bytes,4,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	ScopeIds      []uint32            `protobuf:"varint,5,rep,packed,name=scope_ids,json=scopeIds,proto3" json:"scope_ids,omitempty"` // allow 时只有这些人可见，hide 时这些人不可见
+	Media         []*MomentMediaInput `protobuf:"bytes,6,rep,name=media,proto3" json:"media,omitempty"`                               // 最多9张图片，或者1个视频
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMomentRequest) Reset() {
+	*x = CreateMomentRequest{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMomentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMomentRequest) ProtoMessage() {}
+
+func (x *CreateMomentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMomentRequest.ProtoReflect.Descriptor instead.
+func (*CreateMomentRequest) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *CreateMomentRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreateMomentRequest) GetMediaUrl() string {
+	if x != nil {
+		return x.MediaUrl
+	}
+	return ""
+}
+
+func (x *CreateMomentRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreateMomentRequest) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+func (x *CreateMomentRequest) GetScopeIds() []uint32 {
+	if x != nil {
+		return x.ScopeIds
+	}
+	return nil
+}
+
+func (x *CreateMomentRequest) GetMedia() []*MomentMediaInput {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
+type GetMomentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MomentId      uint32                 `protobuf:"varint,1,opt,name=moment_id,json=momentId,proto3" json:"moment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMomentRequest) Reset() {
+	*x = GetMomentRequest{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMomentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMomentRequest) ProtoMessage() {}
+
+func (x *GetMomentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMomentRequest.ProtoReflect.Descriptor instead.
+func (*GetMomentRequest) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *GetMomentRequest) GetMomentId() uint32 {
+	if x != nil {
+		return x.MomentId
+	}
+	return 0
+}
+
+type MomentReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Res           *Res                   `protobuf:"bytes,2,opt,name=res,proto3" json:"res,omitempty"`
+	Data          *MomentData            `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MomentReply) Reset() {
+	*x = MomentReply{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MomentReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MomentReply) ProtoMessage() {}
+
+func (x *MomentReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MomentReply.ProtoReflect.Descriptor instead.
+func (*MomentReply) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *MomentReply) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *MomentReply) GetRes() *Res {
+	if x != nil {
+		return x.Res
+	}
+	return nil
+}
+
+func (x *MomentReply) GetData() *MomentData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type DeleteMomentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MomentId      uint32                 `protobuf:"varint,1,opt,name=moment_id,json=momentId,proto3" json:"moment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMomentRequest) Reset() {
+	*x = DeleteMomentRequest{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMomentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMomentRequest) ProtoMessage() {}
+
+func (x *DeleteMomentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMomentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMomentRequest) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *DeleteMomentRequest) GetMomentId() uint32 {
+	if x != nil {
+		return x.MomentId
+	}
+	return 0
+}
+
+type DeleteMomentReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Res           *Res                   `protobuf:"bytes,2,opt,name=res,proto3" json:"res,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMomentReply) Reset() {
+	*x = DeleteMomentReply{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMomentReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMomentReply) ProtoMessage() {}
+
+func (x *DeleteMomentReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMomentReply.ProtoReflect.Descriptor instead.
+func (*DeleteMomentReply) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *DeleteMomentReply) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *DeleteMomentReply) GetRes() *Res {
+	if x != nil {
+		return x.Res
+	}
+	return nil
+}
+
+type CreateCommentRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	MomentId         uint32                 `protobuf:"varint,1,opt,name=moment_id,json=momentId,proto3" json:"moment_id,omitempty"`
+	Comment          string                 `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment,omitempty"`
+	ReplyToCommentId uint32                 `protobuf:"varint,3,opt,name=reply_to_comment_id,json=replyToCommentId,proto3" json:"reply_to_comment_id,omitempty"` // 回复某条评论时传它的ID，直接评论动态传 0
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateCommentRequest) Reset() {
+	*x = CreateCommentRequest{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCommentRequest) ProtoMessage() {}
+
+func (x *CreateCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCommentRequest.ProtoReflect.Descriptor instead.
+func (*CreateCommentRequest) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *CreateCommentRequest) GetMomentId() uint32 {
+	if x != nil {
+		return x.MomentId
+	}
+	return 0
+}
+
+func (x *CreateCommentRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *CreateCommentRequest) GetReplyToCommentId() uint32 {
+	if x != nil {
+		return x.ReplyToCommentId
+	}
+	return 0
+}
+
+type CommentReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Res           *Res                   `protobuf:"bytes,2,opt,name=res,proto3" json:"res,omitempty"`
+	Data          *CommentData           `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommentReply) Reset() {
+	*x = CommentReply{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommentReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentReply) ProtoMessage() {}
+
+func (x *CommentReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentReply.ProtoReflect.Descriptor instead.
+func (*CommentReply) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *CommentReply) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *CommentReply) GetRes() *Res {
+	if x != nil {
+		return x.Res
+	}
+	return nil
+}
+
+func (x *CommentReply) GetData() *CommentData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type DeleteCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MomentId      uint32                 `protobuf:"varint,1,opt,name=moment_id,json=momentId,proto3" json:"moment_id,omitempty"`
+	CommentId     uint32                 `protobuf:"varint,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCommentRequest) Reset() {
+	*x = DeleteCommentRequest{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCommentRequest) ProtoMessage() {}
+
+func (x *DeleteCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCommentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCommentRequest) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *DeleteCommentRequest) GetMomentId() uint32 {
+	if x != nil {
+		return x.MomentId
+	}
+	return 0
+}
+
+func (x *DeleteCommentRequest) GetCommentId() uint32 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+type DeleteCommentReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Res           *Res                   `protobuf:"bytes,2,opt,name=res,proto3" json:"res,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCommentReply) Reset() {
+	*x = DeleteCommentReply{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCommentReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCommentReply) ProtoMessage() {}
+
+func (x *DeleteCommentReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCommentReply.ProtoReflect.Descriptor instead.
+func (*DeleteCommentReply) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *DeleteCommentReply) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *DeleteCommentReply) GetRes() *Res {
+	if x != nil {
+		return x.Res
+	}
+	return nil
+}
+
+type UpdateMomentBlackListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MomentId      uint32                 `protobuf:"varint,1,opt,name=moment_id,json=momentId,proto3" json:"moment_id,omitempty"`
+	BlackListIds  []uint32               `protobuf:"varint,2,rep,packed,name=black_list_ids,json=blackListIds,proto3" json:"black_list_ids,omitempty"` // 修改后完整的黑名单
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMomentBlackListRequest) Reset() {
+	*x = UpdateMomentBlackListRequest{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMomentBlackListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMomentBlackListRequest) ProtoMessage() {}
+
+func (x *UpdateMomentBlackListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMomentBlackListRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMomentBlackListRequest) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *UpdateMomentBlackListRequest) GetMomentId() uint32 {
+	if x != nil {
+		return x.MomentId
+	}
+	return 0
+}
+
+func (x *UpdateMomentBlackListRequest) GetBlackListIds() []uint32 {
+	if x != nil {
+		return x.BlackListIds
+	}
+	return nil
+}
+
+type UpdateMomentBlackListReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Res           *Res                   `protobuf:"bytes,2,opt,name=res,proto3" json:"res,omitempty"`
+	AddedIds      []uint32               `protobuf:"varint,3,rep,packed,name=added_ids,json=addedIds,proto3" json:"added_ids,omitempty"`
+	RemovedIds    []uint32               `protobuf:"varint,4,rep,packed,name=removed_ids,json=removedIds,proto3" json:"removed_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMomentBlackListReply) Reset() {
+	*x = UpdateMomentBlackListReply{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMomentBlackListReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMomentBlackListReply) ProtoMessage() {}
+
+func (x *UpdateMomentBlackListReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMomentBlackListReply.ProtoReflect.Descriptor instead.
+func (*UpdateMomentBlackListReply) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *UpdateMomentBlackListReply) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *UpdateMomentBlackListReply) GetRes() *Res {
+	if x != nil {
+		return x.Res
+	}
+	return nil
+}
+
+func (x *UpdateMomentBlackListReply) GetAddedIds() []uint32 {
+	if x != nil {
+		return x.AddedIds
+	}
+	return nil
+}
+
+func (x *UpdateMomentBlackListReply) GetRemovedIds() []uint32 {
+	if x != nil {
+		return x.RemovedIds
+	}
+	return nil
+}
+
+type UpdateMomentVisibilityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MomentId      uint32                 `protobuf:"varint,1,opt,name=moment_id,json=momentId,proto3" json:"moment_id,omitempty"`
+	Visibility    string                 `protobuf:"bytes,2,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	ScopeIds      []uint32               `protobuf:"varint,3,rep,packed,name=scope_ids,json=scopeIds,proto3" json:"scope_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMomentVisibilityRequest) Reset() {
+	*x = UpdateMomentVisibilityRequest{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMomentVisibilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMomentVisibilityRequest) ProtoMessage() {}
+
+func (x *UpdateMomentVisibilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMomentVisibilityRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMomentVisibilityRequest) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *UpdateMomentVisibilityRequest) GetMomentId() uint32 {
+	if x != nil {
+		return x.MomentId
+	}
+	return 0
+}
+
+func (x *UpdateMomentVisibilityRequest) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+func (x *UpdateMomentVisibilityRequest) GetScopeIds() []uint32 {
+	if x != nil {
+		return x.ScopeIds
+	}
+	return nil
+}
+
+type UpdateMomentVisibilityReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Res           *Res                   `protobuf:"bytes,2,opt,name=res,proto3" json:"res,omitempty"`
+	AddedIds      []uint32               `protobuf:"varint,3,rep,packed,name=added_ids,json=addedIds,proto3" json:"added_ids,omitempty"`       // 新获得可见权限的粉丝
+	RemovedIds    []uint32               `protobuf:"varint,4,rep,packed,name=removed_ids,json=removedIds,proto3" json:"removed_ids,omitempty"` // 失去可见权限的粉丝
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMomentVisibilityReply) Reset() {
+	*x = UpdateMomentVisibilityReply{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMomentVisibilityReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMomentVisibilityReply) ProtoMessage() {}
+
+func (x *UpdateMomentVisibilityReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMomentVisibilityReply.ProtoReflect.Descriptor instead.
+func (*UpdateMomentVisibilityReply) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *UpdateMomentVisibilityReply) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *UpdateMomentVisibilityReply) GetRes() *Res {
+	if x != nil {
+		return x.Res
+	}
+	return nil
+}
+
+func (x *UpdateMomentVisibilityReply) GetAddedIds() []uint32 {
+	if x != nil {
+		return x.AddedIds
+	}
+	return nil
+}
+
+func (x *UpdateMomentVisibilityReply) GetRemovedIds() []uint32 {
+	if x != nil {
+		return x.RemovedIds
+	}
+	return nil
+}
+
+type GetTimelineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cursor        uint32                 `protobuf:"varint,1,opt,name=cursor,proto3" json:"cursor,omitempty"` // 上一页返回的 next_cursor，第一页传 0
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`   // 每页数量，默认20，最大100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTimelineRequest) Reset() {
+	*x = GetTimelineRequest{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTimelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTimelineRequest) ProtoMessage() {}
+
+func (x *GetTimelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTimelineRequest.ProtoReflect.Descriptor instead.
+func (*GetTimelineRequest) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *GetTimelineRequest) GetCursor() uint32 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+func (x *GetTimelineRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// 时间线里的缩略动态，详情通过 GetMoment 获取
+type MomentSummaryData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MomentId      uint32                 `protobuf:"varint,1,opt,name=moment_id,json=momentId,proto3" json:"moment_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	MediaUrl      string                 `protobuf:"bytes,4,opt,name=media_url,json=mediaUrl,proto3" json:"media_url,omitempty"`
+	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Tags          []string               `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	Thumbnail     string                 `protobuf:"bytes,7,opt,name=thumbnail,proto3" json:"thumbnail,omitempty"` // 第一个附件的缩略图
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MomentSummaryData) Reset() {
+	*x = MomentSummaryData{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MomentSummaryData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MomentSummaryData) ProtoMessage() {}
+
+func (x *MomentSummaryData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MomentSummaryData.ProtoReflect.Descriptor instead.
+func (*MomentSummaryData) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *MomentSummaryData) GetMomentId() uint32 {
+	if x != nil {
+		return x.MomentId
+	}
+	return 0
+}
+
+func (x *MomentSummaryData) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *MomentSummaryData) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *MomentSummaryData) GetMediaUrl() string {
+	if x != nil {
+		return x.MediaUrl
+	}
+	return ""
+}
+
+func (x *MomentSummaryData) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *MomentSummaryData) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *MomentSummaryData) GetThumbnail() string {
+	if x != nil {
+		return x.Thumbnail
+	}
+	return ""
+}
+
+type GetTimelineReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Res           *Res                   `protobuf:"bytes,2,opt,name=res,proto3" json:"res,omitempty"`
+	Data          []*MomentSummaryData   `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	NextCursor    uint32                 `protobuf:"varint,4,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	HasMore       bool                   `protobuf:"varint,5,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTimelineReply) Reset() {
+	*x = GetTimelineReply{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTimelineReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTimelineReply) ProtoMessage() {}
+
+func (x *GetTimelineReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTimelineReply.ProtoReflect.Descriptor instead.
+func (*GetTimelineReply) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *GetTimelineReply) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetTimelineReply) GetRes() *Res {
+	if x != nil {
+		return x.Res
+	}
+	return nil
+}
+
+func (x *GetTimelineReply) GetData() []*MomentSummaryData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *GetTimelineReply) GetNextCursor() uint32 {
+	if x != nil {
+		return x.NextCursor
+	}
+	return 0
+}
+
+func (x *GetTimelineReply) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+type GetSquareRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mode          string                 `protobuf:"bytes,1,opt,name=mode,proto3" json:"mode,omitempty"`                            // random（默认）随机抽取，同一个会话内不重复；latest 按发布时间倒序
+	Tag           string                 `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`                              // 按话题过滤，为空不过滤
+	SessionId     string                 `protobuf:"bytes,3,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"` // random 模式，第一次不传，之后传上一次返回的 session_id
+	Cursor        uint32                 `protobuf:"varint,4,opt,name=cursor,proto3" json:"cursor,omitempty"`                       // latest 模式，上一页返回的 next_cursor，第一页传 0
+	Limit         int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`                         // 每页数量，默认20，最大100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSquareRequest) Reset() {
+	*x = GetSquareRequest{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSquareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSquareRequest) ProtoMessage() {}
+
+func (x *GetSquareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSquareRequest.ProtoReflect.Descriptor instead.
+func (*GetSquareRequest) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *GetSquareRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *GetSquareRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *GetSquareRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *GetSquareRequest) GetCursor() uint32 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+func (x *GetSquareRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetSquareReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Res           *Res                   `protobuf:"bytes,2,opt,name=res,proto3" json:"res,omitempty"`
+	Data          []*MomentSummaryData   `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	SessionId     string                 `protobuf:"bytes,4,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	NextCursor    uint32                 `protobuf:"varint,5,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	HasMore       bool                   `protobuf:"varint,6,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSquareReply) Reset() {
+	*x = GetSquareReply{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSquareReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSquareReply) ProtoMessage() {}
+
+func (x *GetSquareReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSquareReply.ProtoReflect.Descriptor instead.
+func (*GetSquareReply) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *GetSquareReply) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetSquareReply) GetRes() *Res {
+	if x != nil {
+		return x.Res
+	}
+	return nil
+}
+
+func (x *GetSquareReply) GetData() []*MomentSummaryData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *GetSquareReply) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *GetSquareReply) GetNextCursor() uint32 {
+	if x != nil {
+		return x.NextCursor
+	}
+	return 0
+}
+
+func (x *GetSquareReply) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+type LikeMomentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MomentId      uint32                 `protobuf:"varint,1,opt,name=moment_id,json=momentId,proto3" json:"moment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LikeMomentRequest) Reset() {
+	*x = LikeMomentRequest{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LikeMomentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LikeMomentRequest) ProtoMessage() {}
+
+func (x *LikeMomentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LikeMomentRequest.ProtoReflect.Descriptor instead.
+func (*LikeMomentRequest) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *LikeMomentRequest) GetMomentId() uint32 {
+	if x != nil {
+		return x.MomentId
+	}
+	return 0
+}
+
+type UnlikeMomentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MomentId      uint32                 `protobuf:"varint,1,opt,name=moment_id,json=momentId,proto3" json:"moment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlikeMomentRequest) Reset() {
+	*x = UnlikeMomentRequest{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlikeMomentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlikeMomentRequest) ProtoMessage() {}
+
+func (x *UnlikeMomentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlikeMomentRequest.ProtoReflect.Descriptor instead.
+func (*UnlikeMomentRequest) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *UnlikeMomentRequest) GetMomentId() uint32 {
+	if x != nil {
+		return x.MomentId
+	}
+	return 0
+}
+
+type MomentLikeReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Res           *Res                   `protobuf:"bytes,2,opt,name=res,proto3" json:"res,omitempty"`
+	LikeCount     int64                  `protobuf:"varint,3,opt,name=like_count,json=likeCount,proto3" json:"like_count,omitempty"`
+	Liked         bool                   `protobuf:"varint,4,opt,name=liked,proto3" json:"liked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MomentLikeReply) Reset() {
+	*x = MomentLikeReply{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MomentLikeReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MomentLikeReply) ProtoMessage() {}
+
+func (x *MomentLikeReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[140]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MomentLikeReply.ProtoReflect.Descriptor instead.
+func (*MomentLikeReply) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *MomentLikeReply) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *MomentLikeReply) GetRes() *Res {
+	if x != nil {
+		return x.Res
+	}
+	return nil
+}
+
+func (x *MomentLikeReply) GetLikeCount() int64 {
+	if x != nil {
+		return x.LikeCount
+	}
+	return 0
+}
+
+func (x *MomentLikeReply) GetLiked() bool {
+	if x != nil {
+		return x.Liked
+	}
+	return false
+}
+
+type ListCommentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MomentId      uint32                 `protobuf:"varint,1,opt,name=moment_id,json=momentId,proto3" json:"moment_id,omitempty"`
+	ParentId      uint32                 `protobuf:"varint,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"` // 0 查一级评论，否则查这条一级评论下的回复
+	Cursor        uint32                 `protobuf:"varint,3,opt,name=cursor,proto3" json:"cursor,omitempty"`                     // 上一页返回的 next_cursor，第一页传 0
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`                       // 每页数量，默认20，最大100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommentsRequest) Reset() {
+	*x = ListCommentsRequest{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[141]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommentsRequest) ProtoMessage() {}
+
+func (x *ListCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[141]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1664,114 +9194,138 @@ func (x *Message) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Message.ProtoReflect.Descriptor instead.
-func (*Message) Descriptor() ([]byte, []int) {
-	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use ListCommentsRequest.ProtoReflect.Descriptor instead.
+func (*ListCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{141}
 }
 
-func (x *Message) GetAvatar() string {
+func (x *ListCommentsRequest) GetMomentId() uint32 {
 	if x != nil {
-		return x.Avatar
+		return x.MomentId
 	}
-	return ""
+	return 0
 }
 
-func (x *Message) GetFromUserName() string {
+func (x *ListCommentsRequest) GetParentId() uint32 {
 	if x != nil {
-		return x.FromUserName
+		return x.ParentId
 	}
-	return ""
+	return 0
 }
 
-func (x *Message) GetFrom() string {
+func (x *ListCommentsRequest) GetCursor() uint32 {
 	if x != nil {
-		return x.From
+		return x.Cursor
 	}
-	return ""
+	return 0
 }
 
-func (x *Message) GetTo() string {
+func (x *ListCommentsRequest) GetLimit() int32 {
 	if x != nil {
-		return x.To
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-func (x *Message) GetContent() string {
-	if x != nil {
-		return x.Content
-	}
-	return ""
+type ListCommentsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Res           *Res                   `protobuf:"bytes,2,opt,name=res,proto3" json:"res,omitempty"`
+	Data          []*CommentData         `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	NextCursor    uint32                 `protobuf:"varint,4,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	HasMore       bool                   `protobuf:"varint,5,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Message) GetMessageType() uint32 {
+func (x *ListCommentsReply) Reset() {
+	*x = ListCommentsReply{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommentsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommentsReply) ProtoMessage() {}
+
+func (x *ListCommentsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[142]
 	if x != nil {
-		return x.MessageType
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Message) GetContentType() uint32 {
+// Deprecated: Use ListCommentsReply.ProtoReflect.Descriptor instead.
+func (*ListCommentsReply) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *ListCommentsReply) GetCode() int32 {
 	if x != nil {
-		return x.ContentType
+		return x.Code
 	}
 	return 0
 }
 
-func (x *Message) GetType() string {
+func (x *ListCommentsReply) GetRes() *Res {
 	if x != nil {
-		return x.Type
+		return x.Res
 	}
-	return ""
+	return nil
 }
 
-func (x *Message) GetUrl() string {
+func (x *ListCommentsReply) GetData() []*CommentData {
 	if x != nil {
-		return x.Url
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-func (x *Message) GetFileSuffix() string {
+func (x *ListCommentsReply) GetNextCursor() uint32 {
 	if x != nil {
-		return x.FileSuffix
+		return x.NextCursor
 	}
-	return ""
+	return 0
 }
 
-func (x *Message) GetFile() []byte {
+func (x *ListCommentsReply) GetHasMore() bool {
 	if x != nil {
-		return x.File
+		return x.HasMore
 	}
-	return nil
+	return false
 }
 
-type GetMessagesRequest struct {
+type LikeCommentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	MessageType   int32                  `protobuf:"varint,1,opt,name=messageType,proto3" json:"messageType,omitempty"` // 消息类型，1.单聊 2.群聊
-	Uuid          string                 `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`                // 当前用户uuid
-	FriendUuid    string                 `protobuf:"bytes,3,opt,name=friendUuid,proto3" json:"friendUuid,omitempty"`    // 好友用户uuid(单聊) 或 群聊uuid(群聊)
-	Page          int32                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`               // 分页页码
-	PageSize      int32                  `protobuf:"varint,5,opt,name=pageSize,proto3" json:"pageSize,omitempty"`       // 分页每页数量
+	MomentId      uint32                 `protobuf:"varint,1,opt,name=moment_id,json=momentId,proto3" json:"moment_id,omitempty"`
+	CommentId     uint32                 `protobuf:"varint,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMessagesRequest) Reset() {
-	*x = GetMessagesRequest{}
-	mi := &file_api_conduit_v1_conduit_proto_msgTypes[27]
+func (x *LikeCommentRequest) Reset() {
+	*x = LikeCommentRequest{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[143]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMessagesRequest) String() string {
+func (x *LikeCommentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMessagesRequest) ProtoMessage() {}
+func (*LikeCommentRequest) ProtoMessage() {}
 
-func (x *GetMessagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_conduit_v1_conduit_proto_msgTypes[27]
+func (x *LikeCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[143]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1782,73 +9336,102 @@ func (x *GetMessagesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMessagesRequest.ProtoReflect.Descriptor instead.
-func (*GetMessagesRequest) Descriptor() ([]byte, []int) {
-	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use LikeCommentRequest.ProtoReflect.Descriptor instead.
+func (*LikeCommentRequest) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{143}
 }
 
-func (x *GetMessagesRequest) GetMessageType() int32 {
+func (x *LikeCommentRequest) GetMomentId() uint32 {
 	if x != nil {
-		return x.MessageType
+		return x.MomentId
 	}
 	return 0
 }
 
-func (x *GetMessagesRequest) GetUuid() string {
+func (x *LikeCommentRequest) GetCommentId() uint32 {
 	if x != nil {
-		return x.Uuid
+		return x.CommentId
 	}
-	return ""
+	return 0
 }
 
-func (x *GetMessagesRequest) GetFriendUuid() string {
+type UnlikeCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MomentId      uint32                 `protobuf:"varint,1,opt,name=moment_id,json=momentId,proto3" json:"moment_id,omitempty"`
+	CommentId     uint32                 `protobuf:"varint,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlikeCommentRequest) Reset() {
+	*x = UnlikeCommentRequest{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[144]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlikeCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlikeCommentRequest) ProtoMessage() {}
+
+func (x *UnlikeCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[144]
 	if x != nil {
-		return x.FriendUuid
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GetMessagesRequest) GetPage() int32 {
+// Deprecated: Use UnlikeCommentRequest.ProtoReflect.Descriptor instead.
+func (*UnlikeCommentRequest) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *UnlikeCommentRequest) GetMomentId() uint32 {
 	if x != nil {
-		return x.Page
+		return x.MomentId
 	}
 	return 0
 }
 
-func (x *GetMessagesRequest) GetPageSize() int32 {
+func (x *UnlikeCommentRequest) GetCommentId() uint32 {
 	if x != nil {
-		return x.PageSize
+		return x.CommentId
 	}
 	return 0
 }
 
-type GetMessagesReply struct {
+type CommentLikeReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
 	Res           *Res                   `protobuf:"bytes,2,opt,name=res,proto3" json:"res,omitempty"`
-	Data          []*Message             `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`          // 消息列表
-	Total         int32                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`       // 总消息数
-	Page          int32                  `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`         // 当前页码
-	PageSize      int32                  `protobuf:"varint,6,opt,name=pageSize,proto3" json:"pageSize,omitempty"` // 每页数量
+	LikeCount     uint32                 `protobuf:"varint,3,opt,name=like_count,json=likeCount,proto3" json:"like_count,omitempty"`
+	Liked         bool                   `protobuf:"varint,4,opt,name=liked,proto3" json:"liked,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMessagesReply) Reset() {
-	*x = GetMessagesReply{}
-	mi := &file_api_conduit_v1_conduit_proto_msgTypes[28]
+func (x *CommentLikeReply) Reset() {
+	*x = CommentLikeReply{}
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[145]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMessagesReply) String() string {
+func (x *CommentLikeReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMessagesReply) ProtoMessage() {}
+func (*CommentLikeReply) ProtoMessage() {}
 
-func (x *GetMessagesReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_conduit_v1_conduit_proto_msgTypes[28]
+func (x *CommentLikeReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[145]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1859,51 +9442,37 @@ func (x *GetMessagesReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMessagesReply.ProtoReflect.Descriptor instead.
-func (*GetMessagesReply) Descriptor() ([]byte, []int) {
-	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use CommentLikeReply.ProtoReflect.Descriptor instead.
+func (*CommentLikeReply) Descriptor() ([]byte, []int) {
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{145}
 }
 
-func (x *GetMessagesReply) GetCode() int32 {
+func (x *CommentLikeReply) GetCode() int32 {
 	if x != nil {
 		return x.Code
 	}
 	return 0
 }
 
-func (x *GetMessagesReply) GetRes() *Res {
+func (x *CommentLikeReply) GetRes() *Res {
 	if x != nil {
 		return x.Res
 	}
 	return nil
 }
 
-func (x *GetMessagesReply) GetData() []*Message {
-	if x != nil {
-		return x.Data
-	}
-	return nil
-}
-
-func (x *GetMessagesReply) GetTotal() int32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-func (x *GetMessagesReply) GetPage() int32 {
+func (x *CommentLikeReply) GetLikeCount() uint32 {
 	if x != nil {
-		return x.Page
+		return x.LikeCount
 	}
 	return 0
 }
 
-func (x *GetMessagesReply) GetPageSize() int32 {
+func (x *CommentLikeReply) GetLiked() bool {
 	if x != nil {
-		return x.PageSize
+		return x.Liked
 	}
-	return 0
+	return false
 }
 
 // 前端错误信息查看
@@ -1919,7 +9488,7 @@ type Res struct {
 
 func (x *Res) Reset() {
 	*x = Res{}
-	mi := &file_api_conduit_v1_conduit_proto_msgTypes[29]
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[146]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1931,7 +9500,7 @@ func (x *Res) String() string {
 func (*Res) ProtoMessage() {}
 
 func (x *Res) ProtoReflect() protoreflect.Message {
-	mi := &file_api_conduit_v1_conduit_proto_msgTypes[29]
+	mi := &file_api_conduit_v1_conduit_proto_msgTypes[146]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1944,7 +9513,7 @@ func (x *Res) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Res.ProtoReflect.Descriptor instead.
 func (*Res) Descriptor() ([]byte, []int) {
-	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{29}
+	return file_api_conduit_v1_conduit_proto_rawDescGZIP(), []int{146}
 }
 
 func (x *Res) GetCode() int32 {
@@ -2060,7 +9629,164 @@ const file_api_conduit_v1_conduit_proto_rawDesc = "" +
 	"\aself_id\x18\x01 \x01(\rR\x06selfId\x12!\n" +
 	"\ffollow_count\x18\x02 \x01(\rR\vfollowCount\x12\x1b\n" +
 	"\ttarget_id\x18\x03 \x01(\rR\btargetId\x12\x1b\n" +
-	"\tfan_count\x18\x04 \x01(\rR\bfanCount\"2\n" +
+	"\tfan_count\x18\x04 \x01(\rR\bfanCount\"Z\n" +
+	"\x11ListFollowRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\rR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"\x88\x02\n" +
+	"\x0eFollowUserData\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x14\n" +
+	"\x05image\x18\x03 \x01(\tR\x05image\x12\x10\n" +
+	"\x03bio\x18\x04 \x01(\tR\x03bio\x12!\n" +
+	"\fis_following\x18\x05 \x01(\bR\visFollowing\x12$\n" +
+	"\x0eis_followed_by\x18\x06 \x01(\bR\fisFollowedBy\x12\x1b\n" +
+	"\tis_mutual\x18\a \x01(\bR\bisMutual\x12\x1b\n" +
+	"\tis_friend\x18\b \x01(\bR\bisFriend\x12\x16\n" +
+	"\x06handle\x18\t \x01(\tR\x06handle\"\xb8\x01\n" +
+	"\x0fListFollowReply\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12#\n" +
+	"\x03res\x18\x02 \x01(\v2\x11.realworld.v1.ResR\x03res\x120\n" +
+	"\x04data\x18\x03 \x03(\v2\x1c.realworld.v1.FollowUserDataR\x04data\x12\x1f\n" +
+	"\vnext_cursor\x18\x04 \x01(\rR\n" +
+	"nextCursor\x12\x19\n" +
+	"\bhas_more\x18\x05 \x01(\bR\ahasMore\"+\n" +
+	"\x13SuggestUsersRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\"\xb6\x01\n" +
+	"\x0fSuggestUserData\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x14\n" +
+	"\x05image\x18\x03 \x01(\tR\x05image\x12!\n" +
+	"\fmutual_count\x18\x04 \x01(\x05R\vmutualCount\x12\x1f\n" +
+	"\vshared_tags\x18\x05 \x01(\x05R\n" +
+	"sharedTags\x12\x14\n" +
+	"\x05score\x18\x06 \x01(\x01R\x05score\"\x7f\n" +
+	"\x11SuggestUsersReply\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12#\n" +
+	"\x03res\x18\x02 \x01(\v2\x11.realworld.v1.ResR\x03res\x121\n" +
+	"\x04data\x18\x03 \x03(\v2\x1d.realworld.v1.SuggestUserDataR\x04data\"7\n" +
+	"\x18DismissSuggestionRequest\x12\x1b\n" +
+	"\ttarget_id\x18\x01 \x01(\tR\btargetId\"Q\n" +
+	"\x16DismissSuggestionReply\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12#\n" +
+	"\x03res\x18\x02 \x01(\v2\x11.realworld.v1.ResR\x03res\"G\n" +
+	"\x17ListLoginHistoryRequest\x12\x16\n" +
+	"\x06cursor\x18\x01 \x01(\rR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\xc0\x01\n" +
+	"\x10LoginHistoryData\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x16\n" +
+	"\x06source\x18\x02 \x01(\tR\x06source\x12\x0e\n" +
+	"\x02ip\x18\x03 \x01(\tR\x02ip\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x04 \x01(\tR\tuserAgent\x12\x1a\n" +
+	"\bplatform\x18\x05 \x01(\tR\bplatform\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xc0\x01\n" +
+	"\x15ListLoginHistoryReply\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12#\n" +
+	"\x03res\x18\x02 \x01(\v2\x11.realworld.v1.ResR\x03res\x122\n" +
+	"\x04data\x18\x03 \x03(\v2\x1e.realworld.v1.LoginHistoryDataR\x04data\x12\x1f\n" +
+	"\vnext_cursor\x18\x04 \x01(\rR\n" +
+	"nextCursor\x12\x19\n" +
+	"\bhas_more\x18\x05 \x01(\bR\ahasMore\"-\n" +
+	"\x13ChangeHandleRequest\x12\x16\n" +
+	"\x06handle\x18\x01 \x01(\tR\x06handle\"d\n" +
+	"\x11ChangeHandleReply\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12#\n" +
+	"\x03res\x18\x02 \x01(\v2\x11.realworld.v1.ResR\x03res\x12\x16\n" +
+	"\x06handle\x18\x03 \x01(\tR\x06handle\"^\n" +
+	"\x12SearchUsersRequest\x12\x18\n" +
+	"\akeyword\x18\x01 \x01(\tR\akeyword\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1a\n" +
+	"\bpageSize\x18\x03 \x01(\x05R\bpageSize\"\x98\x01\n" +
+	"\x10SearchUsersReply\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12#\n" +
+	"\x03res\x18\x02 \x01(\v2\x11.realworld.v1.ResR\x03res\x120\n" +
+	"\x04data\x18\x03 \x03(\v2\x1c.realworld.v1.FollowUserDataR\x04data\x12\x19\n" +
+	"\bhas_more\x18\x04 \x01(\bR\ahasMore\"\x1b\n" +
+	"\x19GetPrivacySettingsRequest\"\xbc\x02\n" +
+	"\x13PrivacySettingsData\x12&\n" +
+	"\x0fwho_can_message\x18\x01 \x01(\tR\rwhoCanMessage\x12+\n" +
+	"\x11moment_visibility\x18\x02 \x01(\tR\x10momentVisibility\x124\n" +
+	"\x16follow_list_visibility\x18\x03 \x01(\tR\x14followListVisibility\x120\n" +
+	"\x14last_seen_visibility\x18\x04 \x01(\tR\x12lastSeenVisibility\x122\n" +
+	"\x15discoverable_by_phone\x18\x05 \x01(\bR\x13discoverableByPhone\x124\n" +
+	"\x16discoverable_by_search\x18\x06 \x01(\bR\x14discoverableBySearch\"\xf6\x03\n" +
+	"\x1cUpdatePrivacySettingsRequest\x12+\n" +
+	"\x0fwho_can_message\x18\x01 \x01(\tH\x00R\rwhoCanMessage\x88\x01\x01\x120\n" +
+	"\x11moment_visibility\x18\x02 \x01(\tH\x01R\x10momentVisibility\x88\x01\x01\x129\n" +
+	"\x16follow_list_visibility\x18\x03 \x01(\tH\x02R\x14followListVisibility\x88\x01\x01\x125\n" +
+	"\x14last_seen_visibility\x18\x04 \x01(\tH\x03R\x12lastSeenVisibility\x88\x01\x01\x127\n" +
+	"\x15discoverable_by_phone\x18\x05 \x01(\bH\x04R\x13discoverableByPhone\x88\x01\x01\x129\n" +
+	"\x16discoverable_by_search\x18\x06 \x01(\bH\x05R\x14discoverableBySearch\x88\x01\x01B\x12\n" +
+	"\x10_who_can_messageB\x14\n" +
+	"\x12_moment_visibilityB\x19\n" +
+	"\x17_follow_list_visibilityB\x17\n" +
+	"\x15_last_seen_visibilityB\x18\n" +
+	"\x16_discoverable_by_phoneB\x19\n" +
+	"\x17_discoverable_by_search\"\x86\x01\n" +
+	"\x14PrivacySettingsReply\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12#\n" +
+	"\x03res\x18\x02 \x01(\v2\x11.realworld.v1.ResR\x03res\x125\n" +
+	"\x04data\x18\x03 \x01(\v2!.realworld.v1.PrivacySettingsDataR\x04data\"P\n" +
+	"\x1aUpdateSearchPrivacyRequest\x122\n" +
+	"\x15discoverable_by_phone\x18\x01 \x01(\bR\x13discoverableByPhone\"S\n" +
+	"\x18UpdateSearchPrivacyReply\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12#\n" +
+	"\x03res\x18\x02 \x01(\v2\x11.realworld.v1.ResR\x03res\"J\n" +
+	"\x1aListProfileVisitorsRequest\x12\x16\n" +
+	"\x06cursor\x18\x01 \x01(\x03R\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\x93\x01\n" +
+	"\vVisitorData\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x14\n" +
+	"\x05image\x18\x03 \x01(\tR\x05image\x129\n" +
+	"\n" +
+	"visited_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tvisitedAt\"\xbe\x01\n" +
+	"\x18ListProfileVisitorsReply\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12#\n" +
+	"\x03res\x18\x02 \x01(\v2\x11.realworld.v1.ResR\x03res\x12-\n" +
+	"\x04data\x18\x03 \x03(\v2\x19.realworld.v1.VisitorDataR\x04data\x12\x1f\n" +
+	"\vnext_cursor\x18\x04 \x01(\x03R\n" +
+	"nextCursor\x12\x19\n" +
+	"\bhas_more\x18\x05 \x01(\bR\ahasMore\"<\n" +
+	"\x19UpdateVisitPrivacyRequest\x12\x1f\n" +
+	"\vhide_visits\x18\x01 \x01(\bR\n" +
+	"hideVisits\"R\n" +
+	"\x17UpdateVisitPrivacyReply\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12#\n" +
+	"\x03res\x18\x02 \x01(\v2\x11.realworld.v1.ResR\x03res\"s\n" +
+	"\x0eBanUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x120\n" +
+	"\x05until\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05until\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"C\n" +
+	"\x10UnbanUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"G\n" +
+	"\fBanUserReply\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12#\n" +
+	"\x03res\x18\x02 \x01(\v2\x11.realworld.v1.ResR\x03res\"_\n" +
+	"\x14ListBanAuditsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1a\n" +
+	"\bpageSize\x18\x03 \x01(\x05R\bpageSize\"\x82\x02\n" +
+	"\fBanAuditData\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\x12\x1f\n" +
+	"\voperator_id\x18\x03 \x01(\rR\n" +
+	"operatorId\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12=\n" +
+	"\fbanned_until\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vbannedUntil\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xc3\x01\n" +
+	"\x12ListBanAuditsReply\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12#\n" +
+	"\x03res\x18\x02 \x01(\v2\x11.realworld.v1.ResR\x03res\x12.\n" +
+	"\x04data\x18\x03 \x03(\v2\x1a.realworld.v1.BanAuditDataR\x04data\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04page\x18\x05 \x01(\x05R\x04page\x12\x1a\n" +
+	"\bpageSize\x18\x06 \x01(\x05R\bpageSize\"2\n" +
 	"\x13RelationshipRequest\x12\x1b\n" +
 	"\ttarget_id\x18\x01 \x01(\tR\btargetId\"\x80\x01\n" +
 	"\x11RelationshipReply\x12\x12\n" +
@@ -2080,8 +9806,81 @@ const file_api_conduit_v1_conduit_proto_rawDesc = "" +
 	"\x0fCanAddFriendRes\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\x05R\x04code\x12#\n" +
 	"\x03res\x18\x02 \x01(\v2\x11.realworld.v1.ResR\x03res\x12.\n" +
-	"\x04data\x18\x03 \x01(\v2\x1a.realworld.v1.AddFriendResR\x04data\"\x0e\n" +
-	"\fAddFriendRes\"\xa1\x02\n" +
+	"\x04data\x18\x03 \x01(\v2\x1a.realworld.v1.AddFriendResR\x04data\"\xba\x01\n" +
+	"\fAddFriendRes\x12\x17\n" +
+	"\acan_add\x18\x01 \x01(\bR\x06canAdd\x12\x1b\n" +
+	"\tis_friend\x18\x02 \x01(\bR\bisFriend\x12,\n" +
+	"\x12pending_request_id\x18\x03 \x01(\rR\x10pendingRequestId\x12.\n" +
+	"\x13incoming_request_id\x18\x04 \x01(\rR\x11incomingRequestId\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\"\xfb\x01\n" +
+	"\x11FriendRequestData\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x17\n" +
+	"\afrom_id\x18\x02 \x01(\rR\x06fromId\x12\x13\n" +
+	"\x05to_id\x18\x03 \x01(\rR\x04toId\x12\x1a\n" +
+	"\bgreeting\x18\x04 \x01(\tR\bgreeting\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\rR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"handled_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\thandledAt\"O\n" +
+	"\x14SendFriendRequestReq\x12\x1b\n" +
+	"\ttarget_id\x18\x01 \x01(\tR\btargetId\x12\x1a\n" +
+	"\bgreeting\x18\x02 \x01(\tR\bgreeting\"\x82\x01\n" +
+	"\x12FriendRequestReply\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12#\n" +
+	"\x03res\x18\x02 \x01(\v2\x11.realworld.v1.ResR\x03res\x123\n" +
+	"\x04data\x18\x03 \x01(\v2\x1f.realworld.v1.FriendRequestDataR\x04data\"O\n" +
+	"\x16HandleFriendRequestReq\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\rR\trequestId\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\"5\n" +
+	"\x15ListFriendRequestsReq\x12\x1c\n" +
+	"\tdirection\x18\x01 \x01(\tR\tdirection\"\x87\x01\n" +
+	"\x17ListFriendRequestsReply\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12#\n" +
+	"\x03res\x18\x02 \x01(\v2\x11.realworld.v1.ResR\x03res\x123\n" +
+	"\x04data\x18\x03 \x03(\v2\x1f.realworld.v1.FriendRequestDataR\x04data\"W\n" +
+	"\n" +
+	"FriendData\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x120\n" +
+	"\x05since\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\"@\n" +
+	"\x0eListFriendsReq\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1a\n" +
+	"\bpageSize\x18\x02 \x01(\x05R\bpageSize\"\xbf\x01\n" +
+	"\x10ListFriendsReply\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12#\n" +
+	"\x03res\x18\x02 \x01(\v2\x11.realworld.v1.ResR\x03res\x12,\n" +
+	"\x04data\x18\x03 \x03(\v2\x18.realworld.v1.FriendDataR\x04data\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04page\x18\x05 \x01(\x05R\x04page\x12\x1a\n" +
+	"\bpageSize\x18\x06 \x01(\x05R\bpageSize\".\n" +
+	"\x0fRemoveFriendReq\x12\x1b\n" +
+	"\ttarget_id\x18\x01 \x01(\tR\btargetId\"L\n" +
+	"\x11RemoveFriendReply\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12#\n" +
+	"\x03res\x18\x02 \x01(\v2\x11.realworld.v1.ResR\x03res\"+\n" +
+	"\fBlockUserReq\x12\x1b\n" +
+	"\ttarget_id\x18\x01 \x01(\tR\btargetId\"-\n" +
+	"\x0eUnblockUserReq\x12\x1b\n" +
+	"\ttarget_id\x18\x01 \x01(\tR\btargetId\"E\n" +
+	"\n" +
+	"BlockReply\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12#\n" +
+	"\x03res\x18\x02 \x01(\v2\x11.realworld.v1.ResR\x03res\"_\n" +
+	"\tBlockData\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x129\n" +
+	"\n" +
+	"blocked_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tblockedAt\"?\n" +
+	"\rListBlocksReq\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1a\n" +
+	"\bpageSize\x18\x02 \x01(\x05R\bpageSize\"\xbd\x01\n" +
+	"\x0fListBlocksReply\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12#\n" +
+	"\x03res\x18\x02 \x01(\v2\x11.realworld.v1.ResR\x03res\x12+\n" +
+	"\x04data\x18\x03 \x03(\v2\x17.realworld.v1.BlockDataR\x04data\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04page\x18\x05 \x01(\x05R\x04page\x12\x1a\n" +
+	"\bpageSize\x18\x06 \x01(\x05R\bpageSize\"\xf3\x02\n" +
 	"\aMessage\x12\x16\n" +
 	"\x06avatar\x18\x01 \x01(\tR\x06avatar\x12\"\n" +
 	"\ffromUserName\x18\x02 \x01(\tR\ffromUserName\x12\x12\n" +
@@ -2096,7 +9895,10 @@ const file_api_conduit_v1_conduit_proto_rawDesc = "" +
 	"fileSuffix\x18\n" +
 	" \x01(\tR\n" +
 	"fileSuffix\x12\x12\n" +
-	"\x04file\x18\v \x01(\fR\x04file\"\x9a\x01\n" +
+	"\x04file\x18\v \x01(\fR\x04file\x12\x1a\n" +
+	"\bmentions\x18\f \x03(\tR\bmentions\x12\x1c\n" +
+	"\tmessageId\x18\r \x01(\rR\tmessageId\x12\x16\n" +
+	"\x06silent\x18\x0e \x01(\bR\x06silent\"\x9a\x01\n" +
 	"\x12GetMessagesRequest\x12 \n" +
 	"\vmessageType\x18\x01 \x01(\x05R\vmessageType\x12\x12\n" +
 	"\x04uuid\x18\x02 \x01(\tR\x04uuid\x12\x1e\n" +
@@ -2111,7 +9913,377 @@ const file_api_conduit_v1_conduit_proto_rawDesc = "" +
 	"\x04data\x18\x03 \x03(\v2\x15.realworld.v1.MessageR\x04data\x12\x14\n" +
 	"\x05total\x18\x04 \x01(\x05R\x05total\x12\x12\n" +
 	"\x04page\x18\x05 \x01(\x05R\x04page\x12\x1a\n" +
-	"\bpageSize\x18\x06 \x01(\x05R\bpageSize\"C\n" +
+	"\bpageSize\x18\x06 \x01(\x05R\bpageSize\"\xb6\x03\n" +
+	"\x10ConversationData\x12\x1b\n" +
+	"\ttarget_id\x18\x01 \x01(\tR\btargetId\x12+\n" +
+	"\x11conversation_type\x18\x02 \x01(\rR\x10conversationType\x12&\n" +
+	"\x0flast_message_id\x18\x03 \x01(\rR\rlastMessageId\x12/\n" +
+	"\x14last_read_message_id\x18\x04 \x01(\rR\x11lastReadMessageId\x12!\n" +
+	"\funread_count\x18\x05 \x01(\rR\vunreadCount\x12\x1c\n" +
+	"\tmentioned\x18\x06 \x01(\bR\tmentioned\x12,\n" +
+	"\x12mention_message_id\x18\a \x01(\rR\x10mentionMessageId\x12\x14\n" +
+	"\x05muted\x18\b \x01(\bR\x05muted\x12;\n" +
+	"\vmuted_until\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"mutedUntil\x12!\n" +
+	"\fpinned_order\x18\n" +
+	" \x01(\rR\vpinnedOrder\x12\x1a\n" +
+	"\barchived\x18\v \x01(\bR\barchived\"6\n" +
+	"\x18ListConversationsRequest\x12\x1a\n" +
+	"\barchived\x18\x01 \x01(\bR\barchived\"\x85\x01\n" +
+	"\x16ListConversationsReply\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12#\n" +
+	"\x03res\x18\x02 \x01(\v2\x11.realworld.v1.ResR\x03res\x122\n" +
+	"\x04data\x18\x03 \x03(\v2\x1e.realworld.v1.ConversationDataR\x04data\"U\n" +
+	"\x17ReadConversationRequest\x12\x1b\n" +
+	"\ttarget_id\x18\x01 \x01(\tR\btargetId\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x02 \x01(\rR\tmessageId\"\x84\x01\n" +
+	"\x15ReadConversationReply\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12#\n" +
+	"\x03res\x18\x02 \x01(\v2\x11.realworld.v1.ResR\x03res\x122\n" +
+	"\x04data\x18\x03 \x01(\v2\x1e.realworld.v1.ConversationDataR\x04data\"\xb9\x02\n" +
+	"!UpdateConversationSettingsRequest\x12\x1b\n" +
+	"\ttarget_id\x18\x01 \x01(\tR\btargetId\x12+\n" +
+	"\x11conversation_type\x18\x02 \x01(\rR\x10conversationType\x12;\n" +
+	"\vmuted_until\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"mutedUntil\x12&\n" +
+	"\fmute_forever\x18\x04 \x01(\bH\x00R\vmuteForever\x88\x01\x01\x12\x1b\n" +
+	"\x06pinned\x18\x05 \x01(\bH\x01R\x06pinned\x88\x01\x01\x12\x1f\n" +
+	"\barchived\x18\x06 \x01(\bH\x02R\barchived\x88\x01\x01B\x0f\n" +
+	"\r_mute_foreverB\t\n" +
+	"\a_pinnedB\v\n" +
+	"\t_archived\"\x8e\x01\n" +
+	"\x1fUpdateConversationSettingsReply\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12#\n" +
+	"\x03res\x18\x02 \x01(\v2\x11.realworld.v1.ResR\x03res\x122\n" +
+	"\x04data\x18\x03 \x01(\v2\x1e.realworld.v1.ConversationDataR\x04data\"\x86\x01\n" +
+	"\x15ConversationTimerData\x12\x1b\n" +
+	"\ttarget_id\x18\x01 \x01(\tR\btargetId\x12+\n" +
+	"\x11conversation_type\x18\x02 \x01(\rR\x10conversationType\x12#\n" +
+	"\rtimer_seconds\x18\x03 \x01(\rR\ftimerSeconds\"g\n" +
+	"\x1bGetConversationTimerRequest\x12\x1b\n" +
+	"\ttarget_id\x18\x01 \x01(\tR\btargetId\x12+\n" +
+	"\x11conversation_type\x18\x02 \x01(\rR\x10conversationType\"\x8c\x01\n" +
+	"\x1bSetConversationTimerRequest\x12\x1b\n" +
+	"\ttarget_id\x18\x01 \x01(\tR\btargetId\x12+\n" +
+	"\x11conversation_type\x18\x02 \x01(\rR\x10conversationType\x12#\n" +
+	"\rtimer_seconds\x18\x03 \x01(\rR\ftimerSeconds\"\x8a\x01\n" +
+	"\x16ConversationTimerReply\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12#\n" +
+	"\x03res\x18\x02 \x01(\v2\x11.realworld.v1.ResR\x03res\x127\n" +
+	"\x04data\x18\x03 \x01(\v2#.realworld.v1.ConversationTimerDataR\x04data\"\xd5\x01\n" +
+	"\x17CreateChatExportRequest\x12\x1b\n" +
+	"\ttarget_id\x18\x01 \x01(\tR\btargetId\x12+\n" +
+	"\x11conversation_type\x18\x02 \x01(\rR\x10conversationType\x129\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\"3\n" +
+	"\x14GetChatExportRequest\x12\x1b\n" +
+	"\texport_id\x18\x01 \x01(\rR\bexportId\"\xbe\x01\n" +
+	"\x0eChatExportData\x12\x1b\n" +
+	"\texport_id\x18\x01 \x01(\rR\bexportId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\rR\x06status\x12!\n" +
+	"\fdownload_url\x18\x03 \x01(\tR\vdownloadUrl\x127\n" +
+	"\texpire_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bexpireAt\x12\x1b\n" +
+	"\terror_msg\x18\x05 \x01(\tR\berrorMsg\"|\n" +
+	"\x0fChatExportReply\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12#\n" +
+	"\x03res\x18\x02 \x01(\v2\x11.realworld.v1.ResR\x03res\x120\n" +
+	"\x04data\x18\x03 \x01(\v2\x1c.realworld.v1.ChatExportDataR\x04data\"\xd5\x01\n" +
+	"\tGroupData\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\rR\aownerId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x16\n" +
+	"\x06notice\x18\x04 \x01(\tR\x06notice\x12\x19\n" +
+	"\bmute_all\x18\x05 \x01(\bR\amuteAll\x12&\n" +
+	"\x0fonly_admin_edit\x18\x06 \x01(\bR\ronlyAdminEdit\x12#\n" +
+	"\rjoin_approval\x18\a \x01(\bR\fjoinApproval\"\xa8\x01\n" +
+	"\x0fGroupMemberData\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\rR\x04role\x12\x12\n" +
+	"\x04mute\x18\x04 \x01(\bR\x04mute\x129\n" +
+	"\n" +
+	"mute_until\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tmuteUntil\"r\n" +
+	"\n" +
+	"GroupReply\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12#\n" +
+	"\x03res\x18\x02 \x01(\v2\x11.realworld.v1.ResR\x03res\x12+\n" +
+	"\x04data\x18\x03 \x01(\v2\x17.realworld.v1.GroupDataR\x04data\"~\n" +
+	"\x10GroupMemberReply\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12#\n" +
+	"\x03res\x18\x02 \x01(\v2\x11.realworld.v1.ResR\x03res\x121\n" +
+	"\x04data\x18\x03 \x01(\v2\x1d.realworld.v1.GroupMemberDataR\x04data\"o\n" +
+	"\x16UpdateGroupInfoRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
+	"\x06notice\x18\x03 \x01(\tH\x00R\x06notice\x88\x01\x01B\t\n" +
+	"\a_notice\"\xe1\x01\n" +
+	"\x1aUpdateGroupSettingsRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x1e\n" +
+	"\bmute_all\x18\x02 \x01(\bH\x00R\amuteAll\x88\x01\x01\x12+\n" +
+	"\x0fonly_admin_edit\x18\x03 \x01(\bH\x01R\ronlyAdminEdit\x88\x01\x01\x12(\n" +
+	"\rjoin_approval\x18\x04 \x01(\bH\x02R\fjoinApproval\x88\x01\x01B\v\n" +
+	"\t_mute_allB\x12\n" +
+	"\x10_only_admin_editB\x10\n" +
+	"\x0e_join_approval\"e\n" +
+	"\x14SetGroupAdminRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\x12\x19\n" +
+	"\bis_admin\x18\x03 \x01(\bR\aisAdmin\"\xa1\x01\n" +
+	"\x16MuteGroupMemberRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\x129\n" +
+	"\n" +
+	"mute_until\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tmuteUntil\x12\x18\n" +
+	"\aforever\x18\x04 \x01(\bR\aforever\"\xe8\x01\n" +
+	"\x0fGroupInviteData\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\x12\x12\n" +
+	"\x04link\x18\x03 \x01(\tR\x04link\x12\x1d\n" +
+	"\n" +
+	"qr_payload\x18\x04 \x01(\tR\tqrPayload\x127\n" +
+	"\texpire_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bexpireAt\x12\x19\n" +
+	"\bmax_uses\x18\x06 \x01(\rR\amaxUses\x12\x1d\n" +
+	"\n" +
+	"used_count\x18\a \x01(\rR\tusedCount\"w\n" +
+	"\x18CreateGroupInviteRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12%\n" +
+	"\x0eexpire_seconds\x18\x02 \x01(\x03R\rexpireSeconds\x12\x19\n" +
+	"\bmax_uses\x18\x03 \x01(\rR\amaxUses\"~\n" +
+	"\x10GroupInviteReply\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12#\n" +
+	"\x03res\x18\x02 \x01(\v2\x11.realworld.v1.ResR\x03res\x121\n" +
+	"\x04data\x18\x03 \x01(\v2\x1d.realworld.v1.GroupInviteDataR\x04data\"K\n" +
+	"\x18RevokeGroupInviteRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\"Q\n" +
+	"\x16RevokeGroupInviteReply\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12#\n" +
+	"\x03res\x18\x02 \x01(\v2\x11.realworld.v1.ResR\x03res\"\xf5\x01\n" +
+	"\x14GroupJoinRequestData\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\rR\trequestId\x12\x19\n" +
+	"\bgroup_id\x18\x02 \x01(\tR\agroupId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\rR\x06userId\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\rR\x06status\x12\x1d\n" +
+	"\n" +
+	"handler_id\x18\x06 \x01(\rR\thandlerId\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"B\n" +
+	"\x10JoinGroupRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"z\n" +
+	"\x0eJoinGroupReply\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12#\n" +
+	"\x03res\x18\x02 \x01(\v2\x11.realworld.v1.ResR\x03res\x12/\n" +
+	"\x04data\x18\x03 \x01(\v2\x1b.realworld.v1.JoinGroupDataR\x04data\"\x94\x01\n" +
+	"\rJoinGroupData\x12-\n" +
+	"\x05group\x18\x01 \x01(\v2\x17.realworld.v1.GroupDataR\x05group\x12\x16\n" +
+	"\x06joined\x18\x02 \x01(\bR\x06joined\x12<\n" +
+	"\arequest\x18\x03 \x01(\v2\".realworld.v1.GroupJoinRequestDataR\arequest\"9\n" +
+	"\x1cListGroupJoinRequestsRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\"\x8d\x01\n" +
+	"\x1aListGroupJoinRequestsReply\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12#\n" +
+	"\x03res\x18\x02 \x01(\v2\x11.realworld.v1.ResR\x03res\x126\n" +
+	"\x04data\x18\x03 \x03(\v2\".realworld.v1.GroupJoinRequestDataR\x04data\"s\n" +
+	"\x1dHandleGroupJoinRequestRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x02 \x01(\rR\trequestId\x12\x18\n" +
+	"\aapprove\x18\x03 \x01(\bR\aapprove\"\x88\x01\n" +
+	"\x15GroupJoinRequestReply\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12#\n" +
+	"\x03res\x18\x02 \x01(\v2\x11.realworld.v1.ResR\x03res\x126\n" +
+	"\x04data\x18\x03 \x01(\v2\".realworld.v1.GroupJoinRequestDataR\x04data\"\x9d\x04\n" +
+	"\n" +
+	"MomentData\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12\x1b\n" +
+	"\tmedia_url\x18\x04 \x01(\tR\bmediaUrl\x12\x1d\n" +
+	"\n" +
+	"like_count\x18\x05 \x01(\x05R\tlikeCount\x12$\n" +
+	"\x0eblack_list_ids\x18\x06 \x03(\rR\fblackListIds\x125\n" +
+	"\bcomments\x18\a \x03(\v2\x19.realworld.v1.CommentDataR\bcomments\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x12\n" +
+	"\x04tags\x18\t \x03(\tR\x04tags\x12\x14\n" +
+	"\x05liked\x18\n" +
+	" \x01(\bR\x05liked\x120\n" +
+	"\x14comments_next_cursor\x18\v \x01(\rR\x12commentsNextCursor\x12*\n" +
+	"\x11comments_has_more\x18\f \x01(\bR\x0fcommentsHasMore\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\r \x01(\tR\n" +
+	"visibility\x12\x1b\n" +
+	"\tscope_ids\x18\x0e \x03(\rR\bscopeIds\x123\n" +
+	"\x05media\x18\x0f \x03(\v2\x1d.realworld.v1.MomentMediaDataR\x05media\"\xd8\x01\n" +
+	"\x0fMomentMediaData\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x1b\n" +
+	"\tcover_url\x18\x03 \x01(\tR\bcoverUrl\x12#\n" +
+	"\rthumbnail_url\x18\x04 \x01(\tR\fthumbnailUrl\x12\x14\n" +
+	"\x05width\x18\x05 \x01(\rR\x05width\x12\x16\n" +
+	"\x06height\x18\x06 \x01(\rR\x06height\x12\x1b\n" +
+	"\tblur_hash\x18\a \x01(\tR\bblurHash\x12\x12\n" +
+	"\x04sort\x18\b \x01(\x05R\x04sort\"W\n" +
+	"\x10MomentMediaInput\x12\x1b\n" +
+	"\tupload_id\x18\x01 \x01(\rR\buploadId\x12&\n" +
+	"\x0fcover_upload_id\x18\x02 \x01(\rR\rcoverUploadId\"\xcb\x01\n" +
+	"\x0fMediaUploadData\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12#\n" +
+	"\rthumbnail_url\x18\x04 \x01(\tR\fthumbnailUrl\x12\x14\n" +
+	"\x05width\x18\x05 \x01(\rR\x05width\x12\x16\n" +
+	"\x06height\x18\x06 \x01(\rR\x06height\x12\x1b\n" +
+	"\tblur_hash\x18\a \x01(\tR\bblurHash\x12\x12\n" +
+	"\x04size\x18\b \x01(\x03R\x04size\"\x84\x01\n" +
+	"\x16UploadMomentMediaReply\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12#\n" +
+	"\x03res\x18\x02 \x01(\v2\x11.realworld.v1.ResR\x03res\x121\n" +
+	"\x04data\x18\x03 \x01(\v2\x1d.realworld.v1.MediaUploadDataR\x04data\"\xa3\x02\n" +
+	"\vCommentData\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1b\n" +
+	"\tmoment_id\x18\x02 \x01(\rR\bmomentId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\rR\x06userId\x12\x18\n" +
+	"\acomment\x18\x04 \x01(\tR\acomment\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1b\n" +
+	"\tparent_id\x18\x06 \x01(\rR\bparentId\x12'\n" +
+	"\x10reply_to_user_id\x18\a \x01(\rR\rreplyToUserId\x12\x1d\n" +
+	"\n" +
+	"like_count\x18\b \x01(\rR\tlikeCount\x12\x14\n" +
+	"\x05liked\x18\t \x01(\bR\x05liked\"\xd3\x01\n" +
+	"\x13CreateMomentRequest\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x1b\n" +
+	"\tmedia_url\x18\x02 \x01(\tR\bmediaUrl\x12\x12\n" +
+	"\x04tags\x18\x03 \x03(\tR\x04tags\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\x04 \x01(\tR\n" +
+	"visibility\x12\x1b\n" +
+	"\tscope_ids\x18\x05 \x03(\rR\bscopeIds\x124\n" +
+	"\x05media\x18\x06 \x03(\v2\x1e.realworld.v1.MomentMediaInputR\x05media\"/\n" +
+	"\x10GetMomentRequest\x12\x1b\n" +
+	"\tmoment_id\x18\x01 \x01(\rR\bmomentId\"t\n" +
+	"\vMomentReply\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12#\n" +
+	"\x03res\x18\x02 \x01(\v2\x11.realworld.v1.ResR\x03res\x12,\n" +
+	"\x04data\x18\x03 \x01(\v2\x18.realworld.v1.MomentDataR\x04data\"2\n" +
+	"\x13DeleteMomentRequest\x12\x1b\n" +
+	"\tmoment_id\x18\x01 \x01(\rR\bmomentId\"L\n" +
+	"\x11DeleteMomentReply\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12#\n" +
+	"\x03res\x18\x02 \x01(\v2\x11.realworld.v1.ResR\x03res\"|\n" +
+	"\x14CreateCommentRequest\x12\x1b\n" +
+	"\tmoment_id\x18\x01 \x01(\rR\bmomentId\x12\x18\n" +
+	"\acomment\x18\x02 \x01(\tR\acomment\x12-\n" +
+	"\x13reply_to_comment_id\x18\x03 \x01(\rR\x10replyToCommentId\"v\n" +
+	"\fCommentReply\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12#\n" +
+	"\x03res\x18\x02 \x01(\v2\x11.realworld.v1.ResR\x03res\x12-\n" +
+	"\x04data\x18\x03 \x01(\v2\x19.realworld.v1.CommentDataR\x04data\"R\n" +
+	"\x14DeleteCommentRequest\x12\x1b\n" +
+	"\tmoment_id\x18\x01 \x01(\rR\bmomentId\x12\x1d\n" +
+	"\n" +
+	"comment_id\x18\x02 \x01(\rR\tcommentId\"M\n" +
+	"\x12DeleteCommentReply\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12#\n" +
+	"\x03res\x18\x02 \x01(\v2\x11.realworld.v1.ResR\x03res\"a\n" +
+	"\x1cUpdateMomentBlackListRequest\x12\x1b\n" +
+	"\tmoment_id\x18\x01 \x01(\rR\bmomentId\x12$\n" +
+	"\x0eblack_list_ids\x18\x02 \x03(\rR\fblackListIds\"\x93\x01\n" +
+	"\x1aUpdateMomentBlackListReply\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12#\n" +
+	"\x03res\x18\x02 \x01(\v2\x11.realworld.v1.ResR\x03res\x12\x1b\n" +
+	"\tadded_ids\x18\x03 \x03(\rR\baddedIds\x12\x1f\n" +
+	"\vremoved_ids\x18\x04 \x03(\rR\n" +
+	"removedIds\"y\n" +
+	"\x1dUpdateMomentVisibilityRequest\x12\x1b\n" +
+	"\tmoment_id\x18\x01 \x01(\rR\bmomentId\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\x02 \x01(\tR\n" +
+	"visibility\x12\x1b\n" +
+	"\tscope_ids\x18\x03 \x03(\rR\bscopeIds\"\x94\x01\n" +
+	"\x1bUpdateMomentVisibilityReply\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12#\n" +
+	"\x03res\x18\x02 \x01(\v2\x11.realworld.v1.ResR\x03res\x12\x1b\n" +
+	"\tadded_ids\x18\x03 \x03(\rR\baddedIds\x12\x1f\n" +
+	"\vremoved_ids\x18\x04 \x03(\rR\n" +
+	"removedIds\"B\n" +
+	"\x12GetTimelineRequest\x12\x16\n" +
+	"\x06cursor\x18\x01 \x01(\rR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\xed\x01\n" +
+	"\x11MomentSummaryData\x12\x1b\n" +
+	"\tmoment_id\x18\x01 \x01(\rR\bmomentId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12\x1b\n" +
+	"\tmedia_url\x18\x04 \x01(\tR\bmediaUrl\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x12\n" +
+	"\x04tags\x18\x06 \x03(\tR\x04tags\x12\x1c\n" +
+	"\tthumbnail\x18\a \x01(\tR\tthumbnail\"\xbc\x01\n" +
+	"\x10GetTimelineReply\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12#\n" +
+	"\x03res\x18\x02 \x01(\v2\x11.realworld.v1.ResR\x03res\x123\n" +
+	"\x04data\x18\x03 \x03(\v2\x1f.realworld.v1.MomentSummaryDataR\x04data\x12\x1f\n" +
+	"\vnext_cursor\x18\x04 \x01(\rR\n" +
+	"nextCursor\x12\x19\n" +
+	"\bhas_more\x18\x05 \x01(\bR\ahasMore\"\x85\x01\n" +
+	"\x10GetSquareRequest\x12\x12\n" +
+	"\x04mode\x18\x01 \x01(\tR\x04mode\x12\x10\n" +
+	"\x03tag\x18\x02 \x01(\tR\x03tag\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x03 \x01(\tR\tsessionId\x12\x16\n" +
+	"\x06cursor\x18\x04 \x01(\rR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\"\xd9\x01\n" +
+	"\x0eGetSquareReply\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12#\n" +
+	"\x03res\x18\x02 \x01(\v2\x11.realworld.v1.ResR\x03res\x123\n" +
+	"\x04data\x18\x03 \x03(\v2\x1f.realworld.v1.MomentSummaryDataR\x04data\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x04 \x01(\tR\tsessionId\x12\x1f\n" +
+	"\vnext_cursor\x18\x05 \x01(\rR\n" +
+	"nextCursor\x12\x19\n" +
+	"\bhas_more\x18\x06 \x01(\bR\ahasMore\"0\n" +
+	"\x11LikeMomentRequest\x12\x1b\n" +
+	"\tmoment_id\x18\x01 \x01(\rR\bmomentId\"2\n" +
+	"\x13UnlikeMomentRequest\x12\x1b\n" +
+	"\tmoment_id\x18\x01 \x01(\rR\bmomentId\"\x7f\n" +
+	"\x0fMomentLikeReply\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12#\n" +
+	"\x03res\x18\x02 \x01(\v2\x11.realworld.v1.ResR\x03res\x12\x1d\n" +
+	"\n" +
+	"like_count\x18\x03 \x01(\x03R\tlikeCount\x12\x14\n" +
+	"\x05liked\x18\x04 \x01(\bR\x05liked\"}\n" +
+	"\x13ListCommentsRequest\x12\x1b\n" +
+	"\tmoment_id\x18\x01 \x01(\rR\bmomentId\x12\x1b\n" +
+	"\tparent_id\x18\x02 \x01(\rR\bparentId\x12\x16\n" +
+	"\x06cursor\x18\x03 \x01(\rR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"\xb7\x01\n" +
+	"\x11ListCommentsReply\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12#\n" +
+	"\x03res\x18\x02 \x01(\v2\x11.realworld.v1.ResR\x03res\x12-\n" +
+	"\x04data\x18\x03 \x03(\v2\x19.realworld.v1.CommentDataR\x04data\x12\x1f\n" +
+	"\vnext_cursor\x18\x04 \x01(\rR\n" +
+	"nextCursor\x12\x19\n" +
+	"\bhas_more\x18\x05 \x01(\bR\ahasMore\"P\n" +
+	"\x12LikeCommentRequest\x12\x1b\n" +
+	"\tmoment_id\x18\x01 \x01(\rR\bmomentId\x12\x1d\n" +
+	"\n" +
+	"comment_id\x18\x02 \x01(\rR\tcommentId\"R\n" +
+	"\x14UnlikeCommentRequest\x12\x1b\n" +
+	"\tmoment_id\x18\x01 \x01(\rR\bmomentId\x12\x1d\n" +
+	"\n" +
+	"comment_id\x18\x02 \x01(\rR\tcommentId\"\x80\x01\n" +
+	"\x10CommentLikeReply\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12#\n" +
+	"\x03res\x18\x02 \x01(\v2\x11.realworld.v1.ResR\x03res\x12\x1d\n" +
+	"\n" +
+	"like_count\x18\x03 \x01(\rR\tlikeCount\x12\x14\n" +
+	"\x05liked\x18\x04 \x01(\bR\x05liked\"C\n" +
 	"\x03Res\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x16\n" +
 	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x10\n" +
@@ -2121,7 +10293,7 @@ const file_api_conduit_v1_conduit_proto_rawDesc = "" +
 	"\x04MALE\x10\x01\x12\n" +
 	"\n" +
 	"\x06FEMALE\x10\x02\x12\t\n" +
-	"\x05OTHER\x10\x032\xe9\v\n" +
+	"\x05OTHER\x10\x032\xe4A\n" +
 	"\aConduit\x12]\n" +
 	"\bRegister\x12\x1d.realworld.v1.RegisterRequest\x1a\x1b.realworld.v1.RegisterReply\"\x15\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
 	"/api/users\x12Z\n" +
@@ -2131,15 +10303,70 @@ const file_api_conduit_v1_conduit_proto_rawDesc = "" +
 	"\aSendSms\x12\x1c.realworld.v1.SendSmsRequest\x1a\x1a.realworld.v1.SendSmsReply\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/api/users/sendSms\x12\x80\x01\n" +
 	"\x12UpdateUserPassword\x12\".realworld.v1.UpdateUserPwdRequest\x1a .realworld.v1.UpdateUserPwdReply\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/api/users/updatePassword\x12|\n" +
 	"\x11ResetUserPassword\x12!.realworld.v1.ResetUserPwdRequest\x1a\x1f.realworld.v1.ResetUserPwdReply\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/api/users/resetPassword\x12~\n" +
-	"\x0eUpdateUserInfo\x12#.realworld.v1.UpdateUserInfoRequest\x1a!.realworld.v1.UpdateUserInfoReply\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\x1a\x19/api/users/updateUserInfo\x12m\n" +
+	"\x0eUpdateUserInfo\x12#.realworld.v1.UpdateUserInfoRequest\x1a!.realworld.v1.UpdateUserInfoReply\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\x1a\x19/api/users/updateUserInfo\x12\x7f\n" +
+	"\x10ListLoginHistory\x12%.realworld.v1.ListLoginHistoryRequest\x1a#.realworld.v1.ListLoginHistoryReply\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/api/users/loginHistory\x12p\n" +
+	"\fChangeHandle\x12!.realworld.v1.ChangeHandleRequest\x1a\x1f.realworld.v1.ChangeHandleReply\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/api/users/handle\x12j\n" +
+	"\vSearchUsers\x12 .realworld.v1.SearchUsersRequest\x1a\x1e.realworld.v1.SearchUsersReply\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/api/search/users\x12\x80\x01\n" +
+	"\x12GetPrivacySettings\x12'.realworld.v1.GetPrivacySettingsRequest\x1a\".realworld.v1.PrivacySettingsReply\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/api/settings/privacy\x12\x89\x01\n" +
+	"\x15UpdatePrivacySettings\x12*.realworld.v1.UpdatePrivacySettingsRequest\x1a\".realworld.v1.PrivacySettingsReply\" \x82\xd3\xe4\x93\x02\x1a:\x01*\x1a\x15/api/settings/privacy\x12\x8a\x01\n" +
+	"\x13UpdateSearchPrivacy\x12(.realworld.v1.UpdateSearchPrivacyRequest\x1a&.realworld.v1.UpdateSearchPrivacyReply\"!\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/api/search/privacy\x88\x02\x01\x12m\n" +
 	"\n" +
 	"GetProfile\x12\x1f.realworld.v1.GetProfileRequest\x1a\x1d.realworld.v1.GetProfileReply\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/api/profiles/{user_id}\x12x\n" +
 	"\n" +
 	"FollowUser\x12\x1f.realworld.v1.FollowUserRequest\x1a\x1c.realworld.v1.FollowFanReply\"+\x82\xd3\xe4\x93\x02%:\x01*\" /api/profiles/{target_id}/follow\x12~\n" +
-	"\fUnfollowUser\x12!.realworld.v1.UnfollowUserRequest\x1a\x1c.realworld.v1.FollowFanReply\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/api/profiles/{target_id}/unfollow\x12\x85\x01\n" +
+	"\fUnfollowUser\x12!.realworld.v1.UnfollowUserRequest\x1a\x1c.realworld.v1.FollowFanReply\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/api/profiles/{target_id}/unfollow\x12z\n" +
+	"\rListFollowing\x12\x1f.realworld.v1.ListFollowRequest\x1a\x1d.realworld.v1.ListFollowReply\")\x82\xd3\xe4\x93\x02#\x12!/api/profiles/{user_id}/following\x12z\n" +
+	"\rListFollowers\x12\x1f.realworld.v1.ListFollowRequest\x1a\x1d.realworld.v1.ListFollowReply\")\x82\xd3\xe4\x93\x02#\x12!/api/profiles/{user_id}/followers\x12r\n" +
+	"\fSuggestUsers\x12!.realworld.v1.SuggestUsersRequest\x1a\x1f.realworld.v1.SuggestUsersReply\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/api/suggestions/users\x12\x98\x01\n" +
+	"\x11DismissSuggestion\x12&.realworld.v1.DismissSuggestionRequest\x1a$.realworld.v1.DismissSuggestionReply\"5\x82\xd3\xe4\x93\x02/:\x01*\"*/api/suggestions/users/{target_id}/dismiss\x12~\n" +
+	"\x13ListProfileVisitors\x12(.realworld.v1.ListProfileVisitorsRequest\x1a&.realworld.v1.ListProfileVisitorsReply\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/api/visitors\x12\x86\x01\n" +
+	"\x12UpdateVisitPrivacy\x12'.realworld.v1.UpdateVisitPrivacyRequest\x1a%.realworld.v1.UpdateVisitPrivacyReply\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/api/visitors/privacy\x12n\n" +
+	"\aBanUser\x12\x1c.realworld.v1.BanUserRequest\x1a\x1a.realworld.v1.BanUserReply\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/api/admin/users/{user_id}/ban\x12t\n" +
+	"\tUnbanUser\x12\x1e.realworld.v1.UnbanUserRequest\x1a\x1a.realworld.v1.BanUserReply\"+\x82\xd3\xe4\x93\x02%:\x01*\" /api/admin/users/{user_id}/unban\x12n\n" +
+	"\rListBanAudits\x12\".realworld.v1.ListBanAuditsRequest\x1a .realworld.v1.ListBanAuditsReply\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/api/admin/bans\x12\x85\x01\n" +
 	"\x0fGetRelationship\x12!.realworld.v1.RelationshipRequest\x1a\x1f.realworld.v1.RelationshipReply\".\x82\xd3\xe4\x93\x02(\x12&/api/profiles/{target_id}/relationship\x12\x7f\n" +
-	"\fCanAddFriend\x12\x1d.realworld.v1.CanAddFriendReq\x1a\x1d.realworld.v1.CanAddFriendRes\"1\x82\xd3\xe4\x93\x02+:\x01*\"&/api/profiles/{target_id}/canAddFriend\x12b\n" +
-	"\vGetMessages\x12 .realworld.v1.GetMessagesRequest\x1a\x1e.realworld.v1.GetMessagesReply\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/api/chatB$Z\"kratos-realworld/api/conduit/v1;v1b\x06proto3"
+	"\fCanAddFriend\x12\x1d.realworld.v1.CanAddFriendReq\x1a\x1d.realworld.v1.CanAddFriendRes\"1\x82\xd3\xe4\x93\x02+:\x01*\"&/api/profiles/{target_id}/canAddFriend\x12\x8d\x01\n" +
+	"\x11SendFriendRequest\x12\".realworld.v1.SendFriendRequestReq\x1a .realworld.v1.FriendRequestReply\"2\x82\xd3\xe4\x93\x02,:\x01*\"'/api/profiles/{target_id}/friendRequest\x12}\n" +
+	"\fRemoveFriend\x12\x1d.realworld.v1.RemoveFriendReq\x1a\x1f.realworld.v1.RemoveFriendReply\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/api/profiles/{target_id}/unfriend\x12\x7f\n" +
+	"\x12ListFriendRequests\x12#.realworld.v1.ListFriendRequestsReq\x1a%.realworld.v1.ListFriendRequestsReply\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/api/friends/requests\x12\x8c\x01\n" +
+	"\x13HandleFriendRequest\x12$.realworld.v1.HandleFriendRequestReq\x1a .realworld.v1.FriendRequestReply\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/api/friends/requests/{request_id}\x12a\n" +
+	"\vListFriends\x12\x1c.realworld.v1.ListFriendsReq\x1a\x1e.realworld.v1.ListFriendsReply\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/api/friends\x12m\n" +
+	"\tBlockUser\x12\x1a.realworld.v1.BlockUserReq\x1a\x18.realworld.v1.BlockReply\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/api/profiles/{target_id}/block\x12s\n" +
+	"\vUnblockUser\x12\x1c.realworld.v1.UnblockUserReq\x1a\x18.realworld.v1.BlockReply\",\x82\xd3\xe4\x93\x02&:\x01*\"!/api/profiles/{target_id}/unblock\x12]\n" +
+	"\n" +
+	"ListBlocks\x12\x1b.realworld.v1.ListBlocksReq\x1a\x1d.realworld.v1.ListBlocksReply\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/api/blocks\x12b\n" +
+	"\vGetMessages\x12 .realworld.v1.GetMessagesRequest\x1a\x1e.realworld.v1.GetMessagesReply\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/api/chat\x12}\n" +
+	"\x11ListConversations\x12&.realworld.v1.ListConversationsRequest\x1a$.realworld.v1.ListConversationsReply\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/api/conversations\x12\x8e\x01\n" +
+	"\x10ReadConversation\x12%.realworld.v1.ReadConversationRequest\x1a#.realworld.v1.ReadConversationReply\".\x82\xd3\xe4\x93\x02(:\x01*\"#/api/conversations/{target_id}/read\x12\xb0\x01\n" +
+	"\x1aUpdateConversationSettings\x12/.realworld.v1.UpdateConversationSettingsRequest\x1a-.realworld.v1.UpdateConversationSettingsReply\"2\x82\xd3\xe4\x93\x02,:\x01*\"'/api/conversations/{target_id}/settings\x12\x95\x01\n" +
+	"\x14GetConversationTimer\x12).realworld.v1.GetConversationTimerRequest\x1a$.realworld.v1.ConversationTimerReply\",\x82\xd3\xe4\x93\x02&\x12$/api/conversations/{target_id}/timer\x12\x98\x01\n" +
+	"\x14SetConversationTimer\x12).realworld.v1.SetConversationTimerRequest\x1a$.realworld.v1.ConversationTimerReply\"/\x82\xd3\xe4\x93\x02):\x01*\"$/api/conversations/{target_id}/timer\x12\x8a\x01\n" +
+	"\x10CreateChatExport\x12%.realworld.v1.CreateChatExportRequest\x1a\x1d.realworld.v1.ChatExportReply\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/api/conversations/{target_id}/export\x12t\n" +
+	"\rGetChatExport\x12\".realworld.v1.GetChatExportRequest\x1a\x1d.realworld.v1.ChatExportReply\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/exports/{export_id}\x12t\n" +
+	"\x0fUpdateGroupInfo\x12$.realworld.v1.UpdateGroupInfoRequest\x1a\x18.realworld.v1.GroupReply\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\x1a\x16/api/groups/{group_id}\x12\x85\x01\n" +
+	"\x13UpdateGroupSettings\x12(.realworld.v1.UpdateGroupSettingsRequest\x1a\x18.realworld.v1.GroupReply\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/api/groups/{group_id}/settings\x12}\n" +
+	"\rSetGroupAdmin\x12\".realworld.v1.SetGroupAdminRequest\x1a\x1e.realworld.v1.GroupMemberReply\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/api/groups/{group_id}/admins\x12\x91\x01\n" +
+	"\x0fMuteGroupMember\x12$.realworld.v1.MuteGroupMemberRequest\x1a\x1e.realworld.v1.GroupMemberReply\"8\x82\xd3\xe4\x93\x022:\x01*\"-/api/groups/{group_id}/members/{user_id}/mute\x12\x86\x01\n" +
+	"\x11CreateGroupInvite\x12&.realworld.v1.CreateGroupInviteRequest\x1a\x1e.realworld.v1.GroupInviteReply\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/api/groups/{group_id}/invites\x12\x9b\x01\n" +
+	"\x11RevokeGroupInvite\x12&.realworld.v1.RevokeGroupInviteRequest\x1a$.realworld.v1.RevokeGroupInviteReply\"8\x82\xd3\xe4\x93\x022:\x01*\"-/api/groups/{group_id}/invites/{token}/revoke\x12f\n" +
+	"\tJoinGroup\x12\x1e.realworld.v1.JoinGroupRequest\x1a\x1c.realworld.v1.JoinGroupReply\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/api/groups/join\x12\x9a\x01\n" +
+	"\x15ListGroupJoinRequests\x12*.realworld.v1.ListGroupJoinRequestsRequest\x1a(.realworld.v1.ListGroupJoinRequestsReply\"+\x82\xd3\xe4\x93\x02%\x12#/api/groups/{group_id}/joinRequests\x12\xa7\x01\n" +
+	"\x16HandleGroupJoinRequest\x12+.realworld.v1.HandleGroupJoinRequestRequest\x1a#.realworld.v1.GroupJoinRequestReply\";\x82\xd3\xe4\x93\x025:\x01*\"0/api/groups/{group_id}/joinRequests/{request_id}\x12e\n" +
+	"\fCreateMoment\x12!.realworld.v1.CreateMomentRequest\x1a\x19.realworld.v1.MomentReply\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/api/moments\x12h\n" +
+	"\tGetMoment\x12\x1e.realworld.v1.GetMomentRequest\x1a\x19.realworld.v1.MomentReply\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/moments/{moment_id}\x12t\n" +
+	"\fDeleteMoment\x12!.realworld.v1.DeleteMomentRequest\x1a\x1f.realworld.v1.DeleteMomentReply\" \x82\xd3\xe4\x93\x02\x1a*\x18/api/moments/{moment_id}\x12}\n" +
+	"\rCreateComment\x12\".realworld.v1.CreateCommentRequest\x1a\x1a.realworld.v1.CommentReply\",\x82\xd3\xe4\x93\x02&:\x01*\"!/api/moments/{moment_id}/comments\x12\x8d\x01\n" +
+	"\rDeleteComment\x12\".realworld.v1.DeleteCommentRequest\x1a .realworld.v1.DeleteCommentReply\"6\x82\xd3\xe4\x93\x020*./api/moments/{moment_id}/comments/{comment_id}\x12\x9c\x01\n" +
+	"\x15UpdateMomentBlackList\x12*.realworld.v1.UpdateMomentBlackListRequest\x1a(.realworld.v1.UpdateMomentBlackListReply\"-\x82\xd3\xe4\x93\x02':\x01*\x1a\"/api/moments/{moment_id}/blacklist\x12\xa0\x01\n" +
+	"\x16UpdateMomentVisibility\x12+.realworld.v1.UpdateMomentVisibilityRequest\x1a).realworld.v1.UpdateMomentVisibilityReply\".\x82\xd3\xe4\x93\x02(:\x01*\x1a#/api/moments/{moment_id}/visibility\x12f\n" +
+	"\vGetTimeline\x12 .realworld.v1.GetTimelineRequest\x1a\x1e.realworld.v1.GetTimelineReply\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/api/timeline\x12^\n" +
+	"\tGetSquare\x12\x1e.realworld.v1.GetSquareRequest\x1a\x1c.realworld.v1.GetSquareReply\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/api/square\x12v\n" +
+	"\n" +
+	"LikeMoment\x12\x1f.realworld.v1.LikeMomentRequest\x1a\x1d.realworld.v1.MomentLikeReply\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/api/moments/{moment_id}/like\x12w\n" +
+	"\fUnlikeMoment\x12!.realworld.v1.UnlikeMomentRequest\x1a\x1d.realworld.v1.MomentLikeReply\"%\x82\xd3\xe4\x93\x02\x1f*\x1d/api/moments/{moment_id}/like\x12}\n" +
+	"\fListComments\x12!.realworld.v1.ListCommentsRequest\x1a\x1f.realworld.v1.ListCommentsReply\")\x82\xd3\xe4\x93\x02#\x12!/api/moments/{moment_id}/comments\x12\x8f\x01\n" +
+	"\vLikeComment\x12 .realworld.v1.LikeCommentRequest\x1a\x1e.realworld.v1.CommentLikeReply\">\x82\xd3\xe4\x93\x028:\x01*\"3/api/moments/{moment_id}/comments/{comment_id}/like\x12\x90\x01\n" +
+	"\rUnlikeComment\x12\".realworld.v1.UnlikeCommentRequest\x1a\x1e.realworld.v1.CommentLikeReply\";\x82\xd3\xe4\x93\x025*3/api/moments/{moment_id}/comments/{comment_id}/likeB$Z\"kratos-realworld/api/conduit/v1;v1b\x06proto3"
 
 var (
 	file_api_conduit_v1_conduit_proto_rawDescOnce sync.Once
@@ -2154,92 +10381,411 @@ func file_api_conduit_v1_conduit_proto_rawDescGZIP() []byte {
 }
 
 var file_api_conduit_v1_conduit_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_api_conduit_v1_conduit_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
+var file_api_conduit_v1_conduit_proto_msgTypes = make([]protoimpl.MessageInfo, 147)
 var file_api_conduit_v1_conduit_proto_goTypes = []any{
-	(Gender)(0),                   // 0: realworld.v1.Gender
-	(*RegisterRequest)(nil),       // 1: realworld.v1.RegisterRequest
-	(*RegisterReply)(nil),         // 2: realworld.v1.RegisterReply
-	(*LoginRequest)(nil),          // 3: realworld.v1.LoginRequest
-	(*LoginBySmsRequest)(nil),     // 4: realworld.v1.LoginBySmsRequest
-	(*LoginReply)(nil),            // 5: realworld.v1.LoginReply
-	(*SendSmsRequest)(nil),        // 6: realworld.v1.SendSmsRequest
-	(*SendSmsReply)(nil),          // 7: realworld.v1.SendSmsReply
-	(*UpdateUserPwdRequest)(nil),  // 8: realworld.v1.UpdateUserPwdRequest
-	(*UpdateUserPwdReply)(nil),    // 9: realworld.v1.UpdateUserPwdReply
-	(*ResetUserPwdRequest)(nil),   // 10: realworld.v1.ResetUserPwdRequest
-	(*ResetUserPwdReply)(nil),     // 11: realworld.v1.ResetUserPwdReply
-	(*UpdateUserInfoRequest)(nil), // 12: realworld.v1.UpdateUserInfoRequest
-	(*UpdateUserInfoReply)(nil),   // 13: realworld.v1.UpdateUserInfoReply
-	(*ProfileData)(nil),           // 14: realworld.v1.ProfileData
-	(*GetProfileRequest)(nil),     // 15: realworld.v1.GetProfileRequest
-	(*GetProfileReply)(nil),       // 16: realworld.v1.GetProfileReply
-	(*FollowUserRequest)(nil),     // 17: realworld.v1.FollowUserRequest
-	(*UnfollowUserRequest)(nil),   // 18: realworld.v1.UnfollowUserRequest
-	(*FollowFanReply)(nil),        // 19: realworld.v1.FollowFanReply
-	(*FollowFanData)(nil),         // 20: realworld.v1.FollowFanData
-	(*RelationshipRequest)(nil),   // 21: realworld.v1.RelationshipRequest
-	(*RelationshipReply)(nil),     // 22: realworld.v1.RelationshipReply
-	(*RelationshipData)(nil),      // 23: realworld.v1.RelationshipData
-	(*CanAddFriendReq)(nil),       // 24: realworld.v1.CanAddFriendReq
-	(*CanAddFriendRes)(nil),       // 25: realworld.v1.CanAddFriendRes
-	(*AddFriendRes)(nil),          // 26: realworld.v1.AddFriendRes
-	(*Message)(nil),               // 27: realworld.v1.Message
-	(*GetMessagesRequest)(nil),    // 28: realworld.v1.GetMessagesRequest
-	(*GetMessagesReply)(nil),      // 29: realworld.v1.GetMessagesReply
-	(*Res)(nil),                   // 30: realworld.v1.Res
-	(*timestamp.Timestamp)(nil),   // 31: google.protobuf.Timestamp
+	(Gender)(0),                               // 0: realworld.v1.Gender
+	(*RegisterRequest)(nil),                   // 1: realworld.v1.RegisterRequest
+	(*RegisterReply)(nil),                     // 2: realworld.v1.RegisterReply
+	(*LoginRequest)(nil),                      // 3: realworld.v1.LoginRequest
+	(*LoginBySmsRequest)(nil),                 // 4: realworld.v1.LoginBySmsRequest
+	(*LoginReply)(nil),                        // 5: realworld.v1.LoginReply
+	(*SendSmsRequest)(nil),                    // 6: realworld.v1.SendSmsRequest
+	(*SendSmsReply)(nil),                      // 7: realworld.v1.SendSmsReply
+	(*UpdateUserPwdRequest)(nil),              // 8: realworld.v1.UpdateUserPwdRequest
+	(*UpdateUserPwdReply)(nil),                // 9: realworld.v1.UpdateUserPwdReply
+	(*ResetUserPwdRequest)(nil),               // 10: realworld.v1.ResetUserPwdRequest
+	(*ResetUserPwdReply)(nil),                 // 11: realworld.v1.ResetUserPwdReply
+	(*UpdateUserInfoRequest)(nil),             // 12: realworld.v1.UpdateUserInfoRequest
+	(*UpdateUserInfoReply)(nil),               // 13: realworld.v1.UpdateUserInfoReply
+	(*ProfileData)(nil),                       // 14: realworld.v1.ProfileData
+	(*GetProfileRequest)(nil),                 // 15: realworld.v1.GetProfileRequest
+	(*GetProfileReply)(nil),                   // 16: realworld.v1.GetProfileReply
+	(*FollowUserRequest)(nil),                 // 17: realworld.v1.FollowUserRequest
+	(*UnfollowUserRequest)(nil),               // 18: realworld.v1.UnfollowUserRequest
+	(*FollowFanReply)(nil),                    // 19: realworld.v1.FollowFanReply
+	(*FollowFanData)(nil),                     // 20: realworld.v1.FollowFanData
+	(*ListFollowRequest)(nil),                 // 21: realworld.v1.ListFollowRequest
+	(*FollowUserData)(nil),                    // 22: realworld.v1.FollowUserData
+	(*ListFollowReply)(nil),                   // 23: realworld.v1.ListFollowReply
+	(*SuggestUsersRequest)(nil),               // 24: realworld.v1.SuggestUsersRequest
+	(*SuggestUserData)(nil),                   // 25: realworld.v1.SuggestUserData
+	(*SuggestUsersReply)(nil),                 // 26: realworld.v1.SuggestUsersReply
+	(*DismissSuggestionRequest)(nil),          // 27: realworld.v1.DismissSuggestionRequest
+	(*DismissSuggestionReply)(nil),            // 28: realworld.v1.DismissSuggestionReply
+	(*ListLoginHistoryRequest)(nil),           // 29: realworld.v1.ListLoginHistoryRequest
+	(*LoginHistoryData)(nil),                  // 30: realworld.v1.LoginHistoryData
+	(*ListLoginHistoryReply)(nil),             // 31: realworld.v1.ListLoginHistoryReply
+	(*ChangeHandleRequest)(nil),               // 32: realworld.v1.ChangeHandleRequest
+	(*ChangeHandleReply)(nil),                 // 33: realworld.v1.ChangeHandleReply
+	(*SearchUsersRequest)(nil),                // 34: realworld.v1.SearchUsersRequest
+	(*SearchUsersReply)(nil),                  // 35: realworld.v1.SearchUsersReply
+	(*GetPrivacySettingsRequest)(nil),         // 36: realworld.v1.GetPrivacySettingsRequest
+	(*PrivacySettingsData)(nil),               // 37: realworld.v1.PrivacySettingsData
+	(*UpdatePrivacySettingsRequest)(nil),      // 38: realworld.v1.UpdatePrivacySettingsRequest
+	(*PrivacySettingsReply)(nil),              // 39: realworld.v1.PrivacySettingsReply
+	(*UpdateSearchPrivacyRequest)(nil),        // 40: realworld.v1.UpdateSearchPrivacyRequest
+	(*UpdateSearchPrivacyReply)(nil),          // 41: realworld.v1.UpdateSearchPrivacyReply
+	(*ListProfileVisitorsRequest)(nil),        // 42: realworld.v1.ListProfileVisitorsRequest
+	(*VisitorData)(nil),                       // 43: realworld.v1.VisitorData
+	(*ListProfileVisitorsReply)(nil),          // 44: realworld.v1.ListProfileVisitorsReply
+	(*UpdateVisitPrivacyRequest)(nil),         // 45: realworld.v1.UpdateVisitPrivacyRequest
+	(*UpdateVisitPrivacyReply)(nil),           // 46: realworld.v1.UpdateVisitPrivacyReply
+	(*BanUserRequest)(nil),                    // 47: realworld.v1.BanUserRequest
+	(*UnbanUserRequest)(nil),                  // 48: realworld.v1.UnbanUserRequest
+	(*BanUserReply)(nil),                      // 49: realworld.v1.BanUserReply
+	(*ListBanAuditsRequest)(nil),              // 50: realworld.v1.ListBanAuditsRequest
+	(*BanAuditData)(nil),                      // 51: realworld.v1.BanAuditData
+	(*ListBanAuditsReply)(nil),                // 52: realworld.v1.ListBanAuditsReply
+	(*RelationshipRequest)(nil),               // 53: realworld.v1.RelationshipRequest
+	(*RelationshipReply)(nil),                 // 54: realworld.v1.RelationshipReply
+	(*RelationshipData)(nil),                  // 55: realworld.v1.RelationshipData
+	(*CanAddFriendReq)(nil),                   // 56: realworld.v1.CanAddFriendReq
+	(*CanAddFriendRes)(nil),                   // 57: realworld.v1.CanAddFriendRes
+	(*AddFriendRes)(nil),                      // 58: realworld.v1.AddFriendRes
+	(*FriendRequestData)(nil),                 // 59: realworld.v1.FriendRequestData
+	(*SendFriendRequestReq)(nil),              // 60: realworld.v1.SendFriendRequestReq
+	(*FriendRequestReply)(nil),                // 61: realworld.v1.FriendRequestReply
+	(*HandleFriendRequestReq)(nil),            // 62: realworld.v1.HandleFriendRequestReq
+	(*ListFriendRequestsReq)(nil),             // 63: realworld.v1.ListFriendRequestsReq
+	(*ListFriendRequestsReply)(nil),           // 64: realworld.v1.ListFriendRequestsReply
+	(*FriendData)(nil),                        // 65: realworld.v1.FriendData
+	(*ListFriendsReq)(nil),                    // 66: realworld.v1.ListFriendsReq
+	(*ListFriendsReply)(nil),                  // 67: realworld.v1.ListFriendsReply
+	(*RemoveFriendReq)(nil),                   // 68: realworld.v1.RemoveFriendReq
+	(*RemoveFriendReply)(nil),                 // 69: realworld.v1.RemoveFriendReply
+	(*BlockUserReq)(nil),                      // 70: realworld.v1.BlockUserReq
+	(*UnblockUserReq)(nil),                    // 71: realworld.v1.UnblockUserReq
+	(*BlockReply)(nil),                        // 72: realworld.v1.BlockReply
+	(*BlockData)(nil),                         // 73: realworld.v1.BlockData
+	(*ListBlocksReq)(nil),                     // 74: realworld.v1.ListBlocksReq
+	(*ListBlocksReply)(nil),                   // 75: realworld.v1.ListBlocksReply
+	(*Message)(nil),                           // 76: realworld.v1.Message
+	(*GetMessagesRequest)(nil),                // 77: realworld.v1.GetMessagesRequest
+	(*GetMessagesReply)(nil),                  // 78: realworld.v1.GetMessagesReply
+	(*ConversationData)(nil),                  // 79: realworld.v1.ConversationData
+	(*ListConversationsRequest)(nil),          // 80: realworld.v1.ListConversationsRequest
+	(*ListConversationsReply)(nil),            // 81: realworld.v1.ListConversationsReply
+	(*ReadConversationRequest)(nil),           // 82: realworld.v1.ReadConversationRequest
+	(*ReadConversationReply)(nil),             // 83: realworld.v1.ReadConversationReply
+	(*UpdateConversationSettingsRequest)(nil), // 84: realworld.v1.UpdateConversationSettingsRequest
+	(*UpdateConversationSettingsReply)(nil),   // 85: realworld.v1.UpdateConversationSettingsReply
+	(*ConversationTimerData)(nil),             // 86: realworld.v1.ConversationTimerData
+	(*GetConversationTimerRequest)(nil),       // 87: realworld.v1.GetConversationTimerRequest
+	(*SetConversationTimerRequest)(nil),       // 88: realworld.v1.SetConversationTimerRequest
+	(*ConversationTimerReply)(nil),            // 89: realworld.v1.ConversationTimerReply
+	(*CreateChatExportRequest)(nil),           // 90: realworld.v1.CreateChatExportRequest
+	(*GetChatExportRequest)(nil),              // 91: realworld.v1.GetChatExportRequest
+	(*ChatExportData)(nil),                    // 92: realworld.v1.ChatExportData
+	(*ChatExportReply)(nil),                   // 93: realworld.v1.ChatExportReply
+	(*GroupData)(nil),                         // 94: realworld.v1.GroupData
+	(*GroupMemberData)(nil),                   // 95: realworld.v1.GroupMemberData
+	(*GroupReply)(nil),                        // 96: realworld.v1.GroupReply
+	(*GroupMemberReply)(nil),                  // 97: realworld.v1.GroupMemberReply
+	(*UpdateGroupInfoRequest)(nil),            // 98: realworld.v1.UpdateGroupInfoRequest
+	(*UpdateGroupSettingsRequest)(nil),        // 99: realworld.v1.UpdateGroupSettingsRequest
+	(*SetGroupAdminRequest)(nil),              // 100: realworld.v1.SetGroupAdminRequest
+	(*MuteGroupMemberRequest)(nil),            // 101: realworld.v1.MuteGroupMemberRequest
+	(*GroupInviteData)(nil),                   // 102: realworld.v1.GroupInviteData
+	(*CreateGroupInviteRequest)(nil),          // 103: realworld.v1.CreateGroupInviteRequest
+	(*GroupInviteReply)(nil),                  // 104: realworld.v1.GroupInviteReply
+	(*RevokeGroupInviteRequest)(nil),          // 105: realworld.v1.RevokeGroupInviteRequest
+	(*RevokeGroupInviteReply)(nil),            // 106: realworld.v1.RevokeGroupInviteReply
+	(*GroupJoinRequestData)(nil),              // 107: realworld.v1.GroupJoinRequestData
+	(*JoinGroupRequest)(nil),                  // 108: realworld.v1.JoinGroupRequest
+	(*JoinGroupReply)(nil),                    // 109: realworld.v1.JoinGroupReply
+	(*JoinGroupData)(nil),                     // 110: realworld.v1.JoinGroupData
+	(*ListGroupJoinRequestsRequest)(nil),      // 111: realworld.v1.ListGroupJoinRequestsRequest
+	(*ListGroupJoinRequestsReply)(nil),        // 112: realworld.v1.ListGroupJoinRequestsReply
+	(*HandleGroupJoinRequestRequest)(nil),     // 113: realworld.v1.HandleGroupJoinRequestRequest
+	(*GroupJoinRequestReply)(nil),             // 114: realworld.v1.GroupJoinRequestReply
+	(*MomentData)(nil),                        // 115: realworld.v1.MomentData
+	(*MomentMediaData)(nil),                   // 116: realworld.v1.MomentMediaData
+	(*MomentMediaInput)(nil),                  // 117: realworld.v1.MomentMediaInput
+	(*MediaUploadData)(nil),                   // 118: realworld.v1.MediaUploadData
+	(*UploadMomentMediaReply)(nil),            // 119: realworld.v1.UploadMomentMediaReply
+	(*CommentData)(nil),                       // 120: realworld.v1.CommentData
+	(*CreateMomentRequest)(nil),               // 121: realworld.v1.CreateMomentRequest
+	(*GetMomentRequest)(nil),                  // 122: realworld.v1.GetMomentRequest
+	(*MomentReply)(nil),                       // 123: realworld.v1.MomentReply
+	(*DeleteMomentRequest)(nil),               // 124: realworld.v1.DeleteMomentRequest
+	(*DeleteMomentReply)(nil),                 // 125: realworld.v1.DeleteMomentReply
+	(*CreateCommentRequest)(nil),              // 126: realworld.v1.CreateCommentRequest
+	(*CommentReply)(nil),                      // 127: realworld.v1.CommentReply
+	(*DeleteCommentRequest)(nil),              // 128: realworld.v1.DeleteCommentRequest
+	(*DeleteCommentReply)(nil),                // 129: realworld.v1.DeleteCommentReply
+	(*UpdateMomentBlackListRequest)(nil),      // 130: realworld.v1.UpdateMomentBlackListRequest
+	(*UpdateMomentBlackListReply)(nil),        // 131: realworld.v1.UpdateMomentBlackListReply
+	(*UpdateMomentVisibilityRequest)(nil),     // 132: realworld.v1.UpdateMomentVisibilityRequest
+	(*UpdateMomentVisibilityReply)(nil),       // 133: realworld.v1.UpdateMomentVisibilityReply
+	(*GetTimelineRequest)(nil),                // 134: realworld.v1.GetTimelineRequest
+	(*MomentSummaryData)(nil),                 // 135: realworld.v1.MomentSummaryData
+	(*GetTimelineReply)(nil),                  // 136: realworld.v1.GetTimelineReply
+	(*GetSquareRequest)(nil),                  // 137: realworld.v1.GetSquareRequest
+	(*GetSquareReply)(nil),                    // 138: realworld.v1.GetSquareReply
+	(*LikeMomentRequest)(nil),                 // 139: realworld.v1.LikeMomentRequest
+	(*UnlikeMomentRequest)(nil),               // 140: realworld.v1.UnlikeMomentRequest
+	(*MomentLikeReply)(nil),                   // 141: realworld.v1.MomentLikeReply
+	(*ListCommentsRequest)(nil),               // 142: realworld.v1.ListCommentsRequest
+	(*ListCommentsReply)(nil),                 // 143: realworld.v1.ListCommentsReply
+	(*LikeCommentRequest)(nil),                // 144: realworld.v1.LikeCommentRequest
+	(*UnlikeCommentRequest)(nil),              // 145: realworld.v1.UnlikeCommentRequest
+	(*CommentLikeReply)(nil),                  // 146: realworld.v1.CommentLikeReply
+	(*Res)(nil),                               // 147: realworld.v1.Res
+	(*timestamp.Timestamp)(nil),               // 148: google.protobuf.Timestamp
 }
 var file_api_conduit_v1_conduit_proto_depIdxs = []int32{
-	30, // 0: realworld.v1.RegisterReply.res:type_name -> realworld.v1.Res
-	30, // 1: realworld.v1.LoginReply.res:type_name -> realworld.v1.Res
-	30, // 2: realworld.v1.SendSmsReply.res:type_name -> realworld.v1.Res
-	30, // 3: realworld.v1.UpdateUserPwdReply.res:type_name -> realworld.v1.Res
-	30, // 4: realworld.v1.ResetUserPwdReply.res:type_name -> realworld.v1.Res
-	0,  // 5: realworld.v1.UpdateUserInfoRequest.gender:type_name -> realworld.v1.Gender
-	31, // 6: realworld.v1.UpdateUserInfoRequest.birthday:type_name -> google.protobuf.Timestamp
-	30, // 7: realworld.v1.UpdateUserInfoReply.res:type_name -> realworld.v1.Res
-	31, // 8: realworld.v1.ProfileData.last_active:type_name -> google.protobuf.Timestamp
-	30, // 9: realworld.v1.GetProfileReply.res:type_name -> realworld.v1.Res
-	14, // 10: realworld.v1.GetProfileReply.data:type_name -> realworld.v1.ProfileData
-	30, // 11: realworld.v1.FollowFanReply.res:type_name -> realworld.v1.Res
-	20, // 12: realworld.v1.FollowFanReply.data:type_name -> realworld.v1.FollowFanData
-	30, // 13: realworld.v1.RelationshipReply.res:type_name -> realworld.v1.Res
-	23, // 14: realworld.v1.RelationshipReply.data:type_name -> realworld.v1.RelationshipData
-	30, // 15: realworld.v1.CanAddFriendRes.res:type_name -> realworld.v1.Res
-	26, // 16: realworld.v1.CanAddFriendRes.data:type_name -> realworld.v1.AddFriendRes
-	30, // 17: realworld.v1.GetMessagesReply.res:type_name -> realworld.v1.Res
-	27, // 18: realworld.v1.GetMessagesReply.data:type_name -> realworld.v1.Message
-	1,  // 19: realworld.v1.Conduit.Register:input_type -> realworld.v1.RegisterRequest
-	3,  // 20: realworld.v1.Conduit.Login:input_type -> realworld.v1.LoginRequest
-	4,  // 21: realworld.v1.Conduit.LoginBySms:input_type -> realworld.v1.LoginBySmsRequest
-	6,  // 22: realworld.v1.Conduit.SendSms:input_type -> realworld.v1.SendSmsRequest
-	8,  // 23: realworld.v1.Conduit.UpdateUserPassword:input_type -> realworld.v1.UpdateUserPwdRequest
-	10, // 24: realworld.v1.Conduit.ResetUserPassword:input_type -> realworld.v1.ResetUserPwdRequest
-	12, // 25: realworld.v1.Conduit.UpdateUserInfo:input_type -> realworld.v1.UpdateUserInfoRequest
-	15, // 26: realworld.v1.Conduit.GetProfile:input_type -> realworld.v1.GetProfileRequest
-	17, // 27: realworld.v1.Conduit.FollowUser:input_type -> realworld.v1.FollowUserRequest
-	18, // 28: realworld.v1.Conduit.UnfollowUser:input_type -> realworld.v1.UnfollowUserRequest
-	21, // 29: realworld.v1.Conduit.GetRelationship:input_type -> realworld.v1.RelationshipRequest
-	24, // 30: realworld.v1.Conduit.CanAddFriend:input_type -> realworld.v1.CanAddFriendReq
-	28, // 31: realworld.v1.Conduit.GetMessages:input_type -> realworld.v1.GetMessagesRequest
-	2,  // 32: realworld.v1.Conduit.Register:output_type -> realworld.v1.RegisterReply
-	5,  // 33: realworld.v1.Conduit.Login:output_type -> realworld.v1.LoginReply
-	5,  // 34: realworld.v1.Conduit.LoginBySms:output_type -> realworld.v1.LoginReply
-	7,  // 35: realworld.v1.Conduit.SendSms:output_type -> realworld.v1.SendSmsReply
-	9,  // 36: realworld.v1.Conduit.UpdateUserPassword:output_type -> realworld.v1.UpdateUserPwdReply
-	11, // 37: realworld.v1.Conduit.ResetUserPassword:output_type -> realworld.v1.ResetUserPwdReply
-	13, // 38: realworld.v1.Conduit.UpdateUserInfo:output_type -> realworld.v1.UpdateUserInfoReply
-	16, // 39: realworld.v1.Conduit.GetProfile:output_type -> realworld.v1.GetProfileReply
-	19, // 40: realworld.v1.Conduit.FollowUser:output_type -> realworld.v1.FollowFanReply
-	19, // 41: realworld.v1.Conduit.UnfollowUser:output_type -> realworld.v1.FollowFanReply
-	22, // 42: realworld.v1.Conduit.GetRelationship:output_type -> realworld.v1.RelationshipReply
-	25, // 43: realworld.v1.Conduit.CanAddFriend:output_type -> realworld.v1.CanAddFriendRes
-	29, // 44: realworld.v1.Conduit.GetMessages:output_type -> realworld.v1.GetMessagesReply
-	32, // [32:45] is the sub-list for method output_type
-	19, // [19:32] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	147, // 0: realworld.v1.RegisterReply.res:type_name -> realworld.v1.Res
+	147, // 1: realworld.v1.LoginReply.res:type_name -> realworld.v1.Res
+	147, // 2: realworld.v1.SendSmsReply.res:type_name -> realworld.v1.Res
+	147, // 3: realworld.v1.UpdateUserPwdReply.res:type_name -> realworld.v1.Res
+	147, // 4: realworld.v1.ResetUserPwdReply.res:type_name -> realworld.v1.Res
+	0,   // 5: realworld.v1.UpdateUserInfoRequest.gender:type_name -> realworld.v1.Gender
+	148, // 6: realworld.v1.UpdateUserInfoRequest.birthday:type_name -> google.protobuf.Timestamp
+	147, // 7: realworld.v1.UpdateUserInfoReply.res:type_name -> realworld.v1.Res
+	148, // 8: realworld.v1.ProfileData.last_active:type_name -> google.protobuf.Timestamp
+	147, // 9: realworld.v1.GetProfileReply.res:type_name -> realworld.v1.Res
+	14,  // 10: realworld.v1.GetProfileReply.data:type_name -> realworld.v1.ProfileData
+	147, // 11: realworld.v1.FollowFanReply.res:type_name -> realworld.v1.Res
+	20,  // 12: realworld.v1.FollowFanReply.data:type_name -> realworld.v1.FollowFanData
+	147, // 13: realworld.v1.ListFollowReply.res:type_name -> realworld.v1.Res
+	22,  // 14: realworld.v1.ListFollowReply.data:type_name -> realworld.v1.FollowUserData
+	147, // 15: realworld.v1.SuggestUsersReply.res:type_name -> realworld.v1.Res
+	25,  // 16: realworld.v1.SuggestUsersReply.data:type_name -> realworld.v1.SuggestUserData
+	147, // 17: realworld.v1.DismissSuggestionReply.res:type_name -> realworld.v1.Res
+	148, // 18: realworld.v1.LoginHistoryData.created_at:type_name -> google.protobuf.Timestamp
+	147, // 19: realworld.v1.ListLoginHistoryReply.res:type_name -> realworld.v1.Res
+	30,  // 20: realworld.v1.ListLoginHistoryReply.data:type_name -> realworld.v1.LoginHistoryData
+	147, // 21: realworld.v1.ChangeHandleReply.res:type_name -> realworld.v1.Res
+	147, // 22: realworld.v1.SearchUsersReply.res:type_name -> realworld.v1.Res
+	22,  // 23: realworld.v1.SearchUsersReply.data:type_name -> realworld.v1.FollowUserData
+	147, // 24: realworld.v1.PrivacySettingsReply.res:type_name -> realworld.v1.Res
+	37,  // 25: realworld.v1.PrivacySettingsReply.data:type_name -> realworld.v1.PrivacySettingsData
+	147, // 26: realworld.v1.UpdateSearchPrivacyReply.res:type_name -> realworld.v1.Res
+	148, // 27: realworld.v1.VisitorData.visited_at:type_name -> google.protobuf.Timestamp
+	147, // 28: realworld.v1.ListProfileVisitorsReply.res:type_name -> realworld.v1.Res
+	43,  // 29: realworld.v1.ListProfileVisitorsReply.data:type_name -> realworld.v1.VisitorData
+	147, // 30: realworld.v1.UpdateVisitPrivacyReply.res:type_name -> realworld.v1.Res
+	148, // 31: realworld.v1.BanUserRequest.until:type_name -> google.protobuf.Timestamp
+	147, // 32: realworld.v1.BanUserReply.res:type_name -> realworld.v1.Res
+	148, // 33: realworld.v1.BanAuditData.banned_until:type_name -> google.protobuf.Timestamp
+	148, // 34: realworld.v1.BanAuditData.created_at:type_name -> google.protobuf.Timestamp
+	147, // 35: realworld.v1.ListBanAuditsReply.res:type_name -> realworld.v1.Res
+	51,  // 36: realworld.v1.ListBanAuditsReply.data:type_name -> realworld.v1.BanAuditData
+	147, // 37: realworld.v1.RelationshipReply.res:type_name -> realworld.v1.Res
+	55,  // 38: realworld.v1.RelationshipReply.data:type_name -> realworld.v1.RelationshipData
+	147, // 39: realworld.v1.CanAddFriendRes.res:type_name -> realworld.v1.Res
+	58,  // 40: realworld.v1.CanAddFriendRes.data:type_name -> realworld.v1.AddFriendRes
+	148, // 41: realworld.v1.FriendRequestData.created_at:type_name -> google.protobuf.Timestamp
+	148, // 42: realworld.v1.FriendRequestData.handled_at:type_name -> google.protobuf.Timestamp
+	147, // 43: realworld.v1.FriendRequestReply.res:type_name -> realworld.v1.Res
+	59,  // 44: realworld.v1.FriendRequestReply.data:type_name -> realworld.v1.FriendRequestData
+	147, // 45: realworld.v1.ListFriendRequestsReply.res:type_name -> realworld.v1.Res
+	59,  // 46: realworld.v1.ListFriendRequestsReply.data:type_name -> realworld.v1.FriendRequestData
+	148, // 47: realworld.v1.FriendData.since:type_name -> google.protobuf.Timestamp
+	147, // 48: realworld.v1.ListFriendsReply.res:type_name -> realworld.v1.Res
+	65,  // 49: realworld.v1.ListFriendsReply.data:type_name -> realworld.v1.FriendData
+	147, // 50: realworld.v1.RemoveFriendReply.res:type_name -> realworld.v1.Res
+	147, // 51: realworld.v1.BlockReply.res:type_name -> realworld.v1.Res
+	148, // 52: realworld.v1.BlockData.blocked_at:type_name -> google.protobuf.Timestamp
+	147, // 53: realworld.v1.ListBlocksReply.res:type_name -> realworld.v1.Res
+	73,  // 54: realworld.v1.ListBlocksReply.data:type_name -> realworld.v1.BlockData
+	147, // 55: realworld.v1.GetMessagesReply.res:type_name -> realworld.v1.Res
+	76,  // 56: realworld.v1.GetMessagesReply.data:type_name -> realworld.v1.Message
+	148, // 57: realworld.v1.ConversationData.muted_until:type_name -> google.protobuf.Timestamp
+	147, // 58: realworld.v1.ListConversationsReply.res:type_name -> realworld.v1.Res
+	79,  // 59: realworld.v1.ListConversationsReply.data:type_name -> realworld.v1.ConversationData
+	147, // 60: realworld.v1.ReadConversationReply.res:type_name -> realworld.v1.Res
+	79,  // 61: realworld.v1.ReadConversationReply.data:type_name -> realworld.v1.ConversationData
+	148, // 62: realworld.v1.UpdateConversationSettingsRequest.muted_until:type_name -> google.protobuf.Timestamp
+	147, // 63: realworld.v1.UpdateConversationSettingsReply.res:type_name -> realworld.v1.Res
+	79,  // 64: realworld.v1.UpdateConversationSettingsReply.data:type_name -> realworld.v1.ConversationData
+	147, // 65: realworld.v1.ConversationTimerReply.res:type_name -> realworld.v1.Res
+	86,  // 66: realworld.v1.ConversationTimerReply.data:type_name -> realworld.v1.ConversationTimerData
+	148, // 67: realworld.v1.CreateChatExportRequest.start_time:type_name -> google.protobuf.Timestamp
+	148, // 68: realworld.v1.CreateChatExportRequest.end_time:type_name -> google.protobuf.Timestamp
+	148, // 69: realworld.v1.ChatExportData.expire_at:type_name -> google.protobuf.Timestamp
+	147, // 70: realworld.v1.ChatExportReply.res:type_name -> realworld.v1.Res
+	92,  // 71: realworld.v1.ChatExportReply.data:type_name -> realworld.v1.ChatExportData
+	148, // 72: realworld.v1.GroupMemberData.mute_until:type_name -> google.protobuf.Timestamp
+	147, // 73: realworld.v1.GroupReply.res:type_name -> realworld.v1.Res
+	94,  // 74: realworld.v1.GroupReply.data:type_name -> realworld.v1.GroupData
+	147, // 75: realworld.v1.GroupMemberReply.res:type_name -> realworld.v1.Res
+	95,  // 76: realworld.v1.GroupMemberReply.data:type_name -> realworld.v1.GroupMemberData
+	148, // 77: realworld.v1.MuteGroupMemberRequest.mute_until:type_name -> google.protobuf.Timestamp
+	148, // 78: realworld.v1.GroupInviteData.expire_at:type_name -> google.protobuf.Timestamp
+	147, // 79: realworld.v1.GroupInviteReply.res:type_name -> realworld.v1.Res
+	102, // 80: realworld.v1.GroupInviteReply.data:type_name -> realworld.v1.GroupInviteData
+	147, // 81: realworld.v1.RevokeGroupInviteReply.res:type_name -> realworld.v1.Res
+	148, // 82: realworld.v1.GroupJoinRequestData.created_at:type_name -> google.protobuf.Timestamp
+	147, // 83: realworld.v1.JoinGroupReply.res:type_name -> realworld.v1.Res
+	110, // 84: realworld.v1.JoinGroupReply.data:type_name -> realworld.v1.JoinGroupData
+	94,  // 85: realworld.v1.JoinGroupData.group:type_name -> realworld.v1.GroupData
+	107, // 86: realworld.v1.JoinGroupData.request:type_name -> realworld.v1.GroupJoinRequestData
+	147, // 87: realworld.v1.ListGroupJoinRequestsReply.res:type_name -> realworld.v1.Res
+	107, // 88: realworld.v1.ListGroupJoinRequestsReply.data:type_name -> realworld.v1.GroupJoinRequestData
+	147, // 89: realworld.v1.GroupJoinRequestReply.res:type_name -> realworld.v1.Res
+	107, // 90: realworld.v1.GroupJoinRequestReply.data:type_name -> realworld.v1.GroupJoinRequestData
+	120, // 91: realworld.v1.MomentData.comments:type_name -> realworld.v1.CommentData
+	148, // 92: realworld.v1.MomentData.created_at:type_name -> google.protobuf.Timestamp
+	116, // 93: realworld.v1.MomentData.media:type_name -> realworld.v1.MomentMediaData
+	147, // 94: realworld.v1.UploadMomentMediaReply.res:type_name -> realworld.v1.Res
+	118, // 95: realworld.v1.UploadMomentMediaReply.data:type_name -> realworld.v1.MediaUploadData
+	148, // 96: realworld.v1.CommentData.created_at:type_name -> google.protobuf.Timestamp
+	117, // 97: realworld.v1.CreateMomentRequest.media:type_name -> realworld.v1.MomentMediaInput
+	147, // 98: realworld.v1.MomentReply.res:type_name -> realworld.v1.Res
+	115, // 99: realworld.v1.MomentReply.data:type_name -> realworld.v1.MomentData
+	147, // 100: realworld.v1.DeleteMomentReply.res:type_name -> realworld.v1.Res
+	147, // 101: realworld.v1.CommentReply.res:type_name -> realworld.v1.Res
+	120, // 102: realworld.v1.CommentReply.data:type_name -> realworld.v1.CommentData
+	147, // 103: realworld.v1.DeleteCommentReply.res:type_name -> realworld.v1.Res
+	147, // 104: realworld.v1.UpdateMomentBlackListReply.res:type_name -> realworld.v1.Res
+	147, // 105: realworld.v1.UpdateMomentVisibilityReply.res:type_name -> realworld.v1.Res
+	148, // 106: realworld.v1.MomentSummaryData.created_at:type_name -> google.protobuf.Timestamp
+	147, // 107: realworld.v1.GetTimelineReply.res:type_name -> realworld.v1.Res
+	135, // 108: realworld.v1.GetTimelineReply.data:type_name -> realworld.v1.MomentSummaryData
+	147, // 109: realworld.v1.GetSquareReply.res:type_name -> realworld.v1.Res
+	135, // 110: realworld.v1.GetSquareReply.data:type_name -> realworld.v1.MomentSummaryData
+	147, // 111: realworld.v1.MomentLikeReply.res:type_name -> realworld.v1.Res
+	147, // 112: realworld.v1.ListCommentsReply.res:type_name -> realworld.v1.Res
+	120, // 113: realworld.v1.ListCommentsReply.data:type_name -> realworld.v1.CommentData
+	147, // 114: realworld.v1.CommentLikeReply.res:type_name -> realworld.v1.Res
+	1,   // 115: realworld.v1.Conduit.Register:input_type -> realworld.v1.RegisterRequest
+	3,   // 116: realworld.v1.Conduit.Login:input_type -> realworld.v1.LoginRequest
+	4,   // 117: realworld.v1.Conduit.LoginBySms:input_type -> realworld.v1.LoginBySmsRequest
+	6,   // 118: realworld.v1.Conduit.SendSms:input_type -> realworld.v1.SendSmsRequest
+	8,   // 119: realworld.v1.Conduit.UpdateUserPassword:input_type -> realworld.v1.UpdateUserPwdRequest
+	10,  // 120: realworld.v1.Conduit.ResetUserPassword:input_type -> realworld.v1.ResetUserPwdRequest
+	12,  // 121: realworld.v1.Conduit.UpdateUserInfo:input_type -> realworld.v1.UpdateUserInfoRequest
+	29,  // 122: realworld.v1.Conduit.ListLoginHistory:input_type -> realworld.v1.ListLoginHistoryRequest
+	32,  // 123: realworld.v1.Conduit.ChangeHandle:input_type -> realworld.v1.ChangeHandleRequest
+	34,  // 124: realworld.v1.Conduit.SearchUsers:input_type -> realworld.v1.SearchUsersRequest
+	36,  // 125: realworld.v1.Conduit.GetPrivacySettings:input_type -> realworld.v1.GetPrivacySettingsRequest
+	38,  // 126: realworld.v1.Conduit.UpdatePrivacySettings:input_type -> realworld.v1.UpdatePrivacySettingsRequest
+	40,  // 127: realworld.v1.Conduit.UpdateSearchPrivacy:input_type -> realworld.v1.UpdateSearchPrivacyRequest
+	15,  // 128: realworld.v1.Conduit.GetProfile:input_type -> realworld.v1.GetProfileRequest
+	17,  // 129: realworld.v1.Conduit.FollowUser:input_type -> realworld.v1.FollowUserRequest
+	18,  // 130: realworld.v1.Conduit.UnfollowUser:input_type -> realworld.v1.UnfollowUserRequest
+	21,  // 131: realworld.v1.Conduit.ListFollowing:input_type -> realworld.v1.ListFollowRequest
+	21,  // 132: realworld.v1.Conduit.ListFollowers:input_type -> realworld.v1.ListFollowRequest
+	24,  // 133: realworld.v1.Conduit.SuggestUsers:input_type -> realworld.v1.SuggestUsersRequest
+	27,  // 134: realworld.v1.Conduit.DismissSuggestion:input_type -> realworld.v1.DismissSuggestionRequest
+	42,  // 135: realworld.v1.Conduit.ListProfileVisitors:input_type -> realworld.v1.ListProfileVisitorsRequest
+	45,  // 136: realworld.v1.Conduit.UpdateVisitPrivacy:input_type -> realworld.v1.UpdateVisitPrivacyRequest
+	47,  // 137: realworld.v1.Conduit.BanUser:input_type -> realworld.v1.BanUserRequest
+	48,  // 138: realworld.v1.Conduit.UnbanUser:input_type -> realworld.v1.UnbanUserRequest
+	50,  // 139: realworld.v1.Conduit.ListBanAudits:input_type -> realworld.v1.ListBanAuditsRequest
+	53,  // 140: realworld.v1.Conduit.GetRelationship:input_type -> realworld.v1.RelationshipRequest
+	56,  // 141: realworld.v1.Conduit.CanAddFriend:input_type -> realworld.v1.CanAddFriendReq
+	60,  // 142: realworld.v1.Conduit.SendFriendRequest:input_type -> realworld.v1.SendFriendRequestReq
+	68,  // 143: realworld.v1.Conduit.RemoveFriend:input_type -> realworld.v1.RemoveFriendReq
+	63,  // 144: realworld.v1.Conduit.ListFriendRequests:input_type -> realworld.v1.ListFriendRequestsReq
+	62,  // 145: realworld.v1.Conduit.HandleFriendRequest:input_type -> realworld.v1.HandleFriendRequestReq
+	66,  // 146: realworld.v1.Conduit.ListFriends:input_type -> realworld.v1.ListFriendsReq
+	70,  // 147: realworld.v1.Conduit.BlockUser:input_type -> realworld.v1.BlockUserReq
+	71,  // 148: realworld.v1.Conduit.UnblockUser:input_type -> realworld.v1.UnblockUserReq
+	74,  // 149: realworld.v1.Conduit.ListBlocks:input_type -> realworld.v1.ListBlocksReq
+	77,  // 150: realworld.v1.Conduit.GetMessages:input_type -> realworld.v1.GetMessagesRequest
+	80,  // 151: realworld.v1.Conduit.ListConversations:input_type -> realworld.v1.ListConversationsRequest
+	82,  // 152: realworld.v1.Conduit.ReadConversation:input_type -> realworld.v1.ReadConversationRequest
+	84,  // 153: realworld.v1.Conduit.UpdateConversationSettings:input_type -> realworld.v1.UpdateConversationSettingsRequest
+	87,  // 154: realworld.v1.Conduit.GetConversationTimer:input_type -> realworld.v1.GetConversationTimerRequest
+	88,  // 155: realworld.v1.Conduit.SetConversationTimer:input_type -> realworld.v1.SetConversationTimerRequest
+	90,  // 156: realworld.v1.Conduit.CreateChatExport:input_type -> realworld.v1.CreateChatExportRequest
+	91,  // 157: realworld.v1.Conduit.GetChatExport:input_type -> realworld.v1.GetChatExportRequest
+	98,  // 158: realworld.v1.Conduit.UpdateGroupInfo:input_type -> realworld.v1.UpdateGroupInfoRequest
+	99,  // 159: realworld.v1.Conduit.UpdateGroupSettings:input_type -> realworld.v1.UpdateGroupSettingsRequest
+	100, // 160: realworld.v1.Conduit.SetGroupAdmin:input_type -> realworld.v1.SetGroupAdminRequest
+	101, // 161: realworld.v1.Conduit.MuteGroupMember:input_type -> realworld.v1.MuteGroupMemberRequest
+	103, // 162: realworld.v1.Conduit.CreateGroupInvite:input_type -> realworld.v1.CreateGroupInviteRequest
+	105, // 163: realworld.v1.Conduit.RevokeGroupInvite:input_type -> realworld.v1.RevokeGroupInviteRequest
+	108, // 164: realworld.v1.Conduit.JoinGroup:input_type -> realworld.v1.JoinGroupRequest
+	111, // 165: realworld.v1.Conduit.ListGroupJoinRequests:input_type -> realworld.v1.ListGroupJoinRequestsRequest
+	113, // 166: realworld.v1.Conduit.HandleGroupJoinRequest:input_type -> realworld.v1.HandleGroupJoinRequestRequest
+	121, // 167: realworld.v1.Conduit.CreateMoment:input_type -> realworld.v1.CreateMomentRequest
+	122, // 168: realworld.v1.Conduit.GetMoment:input_type -> realworld.v1.GetMomentRequest
+	124, // 169: realworld.v1.Conduit.DeleteMoment:input_type -> realworld.v1.DeleteMomentRequest
+	126, // 170: realworld.v1.Conduit.CreateComment:input_type -> realworld.v1.CreateCommentRequest
+	128, // 171: realworld.v1.Conduit.DeleteComment:input_type -> realworld.v1.DeleteCommentRequest
+	130, // 172: realworld.v1.Conduit.UpdateMomentBlackList:input_type -> realworld.v1.UpdateMomentBlackListRequest
+	132, // 173: realworld.v1.Conduit.UpdateMomentVisibility:input_type -> realworld.v1.UpdateMomentVisibilityRequest
+	134, // 174: realworld.v1.Conduit.GetTimeline:input_type -> realworld.v1.GetTimelineRequest
+	137, // 175: realworld.v1.Conduit.GetSquare:input_type -> realworld.v1.GetSquareRequest
+	139, // 176: realworld.v1.Conduit.LikeMoment:input_type -> realworld.v1.LikeMomentRequest
+	140, // 177: realworld.v1.Conduit.UnlikeMoment:input_type -> realworld.v1.UnlikeMomentRequest
+	142, // 178: realworld.v1.Conduit.ListComments:input_type -> realworld.v1.ListCommentsRequest
+	144, // 179: realworld.v1.Conduit.LikeComment:input_type -> realworld.v1.LikeCommentRequest
+	145, // 180: realworld.v1.Conduit.UnlikeComment:input_type -> realworld.v1.UnlikeCommentRequest
+	2,   // 181: realworld.v1.Conduit.Register:output_type -> realworld.v1.RegisterReply
+	5,   // 182: realworld.v1.Conduit.Login:output_type -> realworld.v1.LoginReply
+	5,   // 183: realworld.v1.Conduit.LoginBySms:output_type -> realworld.v1.LoginReply
+	7,   // 184: realworld.v1.Conduit.SendSms:output_type -> realworld.v1.SendSmsReply
+	9,   // 185: realworld.v1.Conduit.UpdateUserPassword:output_type -> realworld.v1.UpdateUserPwdReply
+	11,  // 186: realworld.v1.Conduit.ResetUserPassword:output_type -> realworld.v1.ResetUserPwdReply
+	13,  // 187: realworld.v1.Conduit.UpdateUserInfo:output_type -> realworld.v1.UpdateUserInfoReply
+	31,  // 188: realworld.v1.Conduit.ListLoginHistory:output_type -> realworld.v1.ListLoginHistoryReply
+	33,  // 189: realworld.v1.Conduit.ChangeHandle:output_type -> realworld.v1.ChangeHandleReply
+	35,  // 190: realworld.v1.Conduit.SearchUsers:output_type -> realworld.v1.SearchUsersReply
+	39,  // 191: realworld.v1.Conduit.GetPrivacySettings:output_type -> realworld.v1.PrivacySettingsReply
+	39,  // 192: realworld.v1.Conduit.UpdatePrivacySettings:output_type -> realworld.v1.PrivacySettingsReply
+	41,  // 193: realworld.v1.Conduit.UpdateSearchPrivacy:output_type -> realworld.v1.UpdateSearchPrivacyReply
+	16,  // 194: realworld.v1.Conduit.GetProfile:output_type -> realworld.v1.GetProfileReply
+	19,  // 195: realworld.v1.Conduit.FollowUser:output_type -> realworld.v1.FollowFanReply
+	19,  // 196: realworld.v1.Conduit.UnfollowUser:output_type -> realworld.v1.FollowFanReply
+	23,  // 197: realworld.v1.Conduit.ListFollowing:output_type -> realworld.v1.ListFollowReply
+	23,  // 198: realworld.v1.Conduit.ListFollowers:output_type -> realworld.v1.ListFollowReply
+	26,  // 199: realworld.v1.Conduit.SuggestUsers:output_type -> realworld.v1.SuggestUsersReply
+	28,  // 200: realworld.v1.Conduit.DismissSuggestion:output_type -> realworld.v1.DismissSuggestionReply
+	44,  // 201: realworld.v1.Conduit.ListProfileVisitors:output_type -> realworld.v1.ListProfileVisitorsReply
+	46,  // 202: realworld.v1.Conduit.UpdateVisitPrivacy:output_type -> realworld.v1.UpdateVisitPrivacyReply
+	49,  // 203: realworld.v1.Conduit.BanUser:output_type -> realworld.v1.BanUserReply
+	49,  // 204: realworld.v1.Conduit.UnbanUser:output_type -> realworld.v1.BanUserReply
+	52,  // 205: realworld.v1.Conduit.ListBanAudits:output_type -> realworld.v1.ListBanAuditsReply
+	54,  // 206: realworld.v1.Conduit.GetRelationship:output_type -> realworld.v1.RelationshipReply
+	57,  // 207: realworld.v1.Conduit.CanAddFriend:output_type -> realworld.v1.CanAddFriendRes
+	61,  // 208: realworld.v1.Conduit.SendFriendRequest:output_type -> realworld.v1.FriendRequestReply
+	69,  // 209: realworld.v1.Conduit.RemoveFriend:output_type -> realworld.v1.RemoveFriendReply
+	64,  // 210: realworld.v1.Conduit.ListFriendRequests:output_type -> realworld.v1.ListFriendRequestsReply
+	61,  // 211: realworld.v1.Conduit.HandleFriendRequest:output_type -> realworld.v1.FriendRequestReply
+	67,  // 212: realworld.v1.Conduit.ListFriends:output_type -> realworld.v1.ListFriendsReply
+	72,  // 213: realworld.v1.Conduit.BlockUser:output_type -> realworld.v1.BlockReply
+	72,  // 214: realworld.v1.Conduit.UnblockUser:output_type -> realworld.v1.BlockReply
+	75,  // 215: realworld.v1.Conduit.ListBlocks:output_type -> realworld.v1.ListBlocksReply
+	78,  // 216: realworld.v1.Conduit.GetMessages:output_type -> realworld.v1.GetMessagesReply
+	81,  // 217: realworld.v1.Conduit.ListConversations:output_type -> realworld.v1.ListConversationsReply
+	83,  // 218: realworld.v1.Conduit.ReadConversation:output_type -> realworld.v1.ReadConversationReply
+	85,  // 219: realworld.v1.Conduit.UpdateConversationSettings:output_type -> realworld.v1.UpdateConversationSettingsReply
+	89,  // 220: realworld.v1.Conduit.GetConversationTimer:output_type -> realworld.v1.ConversationTimerReply
+	89,  // 221: realworld.v1.Conduit.SetConversationTimer:output_type -> realworld.v1.ConversationTimerReply
+	93,  // 222: realworld.v1.Conduit.CreateChatExport:output_type -> realworld.v1.ChatExportReply
+	93,  // 223: realworld.v1.Conduit.GetChatExport:output_type -> realworld.v1.ChatExportReply
+	96,  // 224: realworld.v1.Conduit.UpdateGroupInfo:output_type -> realworld.v1.GroupReply
+	96,  // 225: realworld.v1.Conduit.UpdateGroupSettings:output_type -> realworld.v1.GroupReply
+	97,  // 226: realworld.v1.Conduit.SetGroupAdmin:output_type -> realworld.v1.GroupMemberReply
+	97,  // 227: realworld.v1.Conduit.MuteGroupMember:output_type -> realworld.v1.GroupMemberReply
+	104, // 228: realworld.v1.Conduit.CreateGroupInvite:output_type -> realworld.v1.GroupInviteReply
+	106, // 229: realworld.v1.Conduit.RevokeGroupInvite:output_type -> realworld.v1.RevokeGroupInviteReply
+	109, // 230: realworld.v1.Conduit.JoinGroup:output_type -> realworld.v1.JoinGroupReply
+	112, // 231: realworld.v1.Conduit.ListGroupJoinRequests:output_type -> realworld.v1.ListGroupJoinRequestsReply
+	114, // 232: realworld.v1.Conduit.HandleGroupJoinRequest:output_type -> realworld.v1.GroupJoinRequestReply
+	123, // 233: realworld.v1.Conduit.CreateMoment:output_type -> realworld.v1.MomentReply
+	123, // 234: realworld.v1.Conduit.GetMoment:output_type -> realworld.v1.MomentReply
+	125, // 235: realworld.v1.Conduit.DeleteMoment:output_type -> realworld.v1.DeleteMomentReply
+	127, // 236: realworld.v1.Conduit.CreateComment:output_type -> realworld.v1.CommentReply
+	129, // 237: realworld.v1.Conduit.DeleteComment:output_type -> realworld.v1.DeleteCommentReply
+	131, // 238: realworld.v1.Conduit.UpdateMomentBlackList:output_type -> realworld.v1.UpdateMomentBlackListReply
+	133, // 239: realworld.v1.Conduit.UpdateMomentVisibility:output_type -> realworld.v1.UpdateMomentVisibilityReply
+	136, // 240: realworld.v1.Conduit.GetTimeline:output_type -> realworld.v1.GetTimelineReply
+	138, // 241: realworld.v1.Conduit.GetSquare:output_type -> realworld.v1.GetSquareReply
+	141, // 242: realworld.v1.Conduit.LikeMoment:output_type -> realworld.v1.MomentLikeReply
+	141, // 243: realworld.v1.Conduit.UnlikeMoment:output_type -> realworld.v1.MomentLikeReply
+	143, // 244: realworld.v1.Conduit.ListComments:output_type -> realworld.v1.ListCommentsReply
+	146, // 245: realworld.v1.Conduit.LikeComment:output_type -> realworld.v1.CommentLikeReply
+	146, // 246: realworld.v1.Conduit.UnlikeComment:output_type -> realworld.v1.CommentLikeReply
+	181, // [181:247] is the sub-list for method output_type
+	115, // [115:181] is the sub-list for method input_type
+	115, // [115:115] is the sub-list for extension type_name
+	115, // [115:115] is the sub-list for extension extendee
+	0,   // [0:115] is the sub-list for field type_name
 }
 
 func init() { file_api_conduit_v1_conduit_proto_init() }
@@ -2247,13 +10793,17 @@ func file_api_conduit_v1_conduit_proto_init() {
 	if File_api_conduit_v1_conduit_proto != nil {
 		return
 	}
+	file_api_conduit_v1_conduit_proto_msgTypes[37].OneofWrappers = []any{}
+	file_api_conduit_v1_conduit_proto_msgTypes[83].OneofWrappers = []any{}
+	file_api_conduit_v1_conduit_proto_msgTypes[97].OneofWrappers = []any{}
+	file_api_conduit_v1_conduit_proto_msgTypes[98].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_conduit_v1_conduit_proto_rawDesc), len(file_api_conduit_v1_conduit_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   30,
+			NumMessages:   147,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,19 +19,72 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Conduit_Register_FullMethodName           = "/realworld.v1.Conduit/Register"
-	Conduit_Login_FullMethodName              = "/realworld.v1.Conduit/Login"
-	Conduit_LoginBySms_FullMethodName         = "/realworld.v1.Conduit/LoginBySms"
-	Conduit_SendSms_FullMethodName            = "/realworld.v1.Conduit/SendSms"
-	Conduit_UpdateUserPassword_FullMethodName = "/realworld.v1.Conduit/UpdateUserPassword"
-	Conduit_ResetUserPassword_FullMethodName  = "/realworld.v1.Conduit/ResetUserPassword"
-	Conduit_UpdateUserInfo_FullMethodName     = "/realworld.v1.Conduit/UpdateUserInfo"
-	Conduit_GetProfile_FullMethodName         = "/realworld.v1.Conduit/GetProfile"
-	Conduit_FollowUser_FullMethodName         = "/realworld.v1.Conduit/FollowUser"
-	Conduit_UnfollowUser_FullMethodName       = "/realworld.v1.Conduit/UnfollowUser"
-	Conduit_GetRelationship_FullMethodName    = "/realworld.v1.Conduit/GetRelationship"
-	Conduit_CanAddFriend_FullMethodName       = "/realworld.v1.Conduit/CanAddFriend"
-	Conduit_GetMessages_FullMethodName        = "/realworld.v1.Conduit/GetMessages"
+	Conduit_Register_FullMethodName                   = "/realworld.v1.Conduit/Register"
+	Conduit_Login_FullMethodName                      = "/realworld.v1.Conduit/Login"
+	Conduit_LoginBySms_FullMethodName                 = "/realworld.v1.Conduit/LoginBySms"
+	Conduit_SendSms_FullMethodName                    = "/realworld.v1.Conduit/SendSms"
+	Conduit_UpdateUserPassword_FullMethodName         = "/realworld.v1.Conduit/UpdateUserPassword"
+	Conduit_ResetUserPassword_FullMethodName          = "/realworld.v1.Conduit/ResetUserPassword"
+	Conduit_UpdateUserInfo_FullMethodName             = "/realworld.v1.Conduit/UpdateUserInfo"
+	Conduit_ListLoginHistory_FullMethodName           = "/realworld.v1.Conduit/ListLoginHistory"
+	Conduit_ChangeHandle_FullMethodName               = "/realworld.v1.Conduit/ChangeHandle"
+	Conduit_SearchUsers_FullMethodName                = "/realworld.v1.Conduit/SearchUsers"
+	Conduit_GetPrivacySettings_FullMethodName         = "/realworld.v1.Conduit/GetPrivacySettings"
+	Conduit_UpdatePrivacySettings_FullMethodName      = "/realworld.v1.Conduit/UpdatePrivacySettings"
+	Conduit_UpdateSearchPrivacy_FullMethodName        = "/realworld.v1.Conduit/UpdateSearchPrivacy"
+	Conduit_GetProfile_FullMethodName                 = "/realworld.v1.Conduit/GetProfile"
+	Conduit_FollowUser_FullMethodName                 = "/realworld.v1.Conduit/FollowUser"
+	Conduit_UnfollowUser_FullMethodName               = "/realworld.v1.Conduit/UnfollowUser"
+	Conduit_ListFollowing_FullMethodName              = "/realworld.v1.Conduit/ListFollowing"
+	Conduit_ListFollowers_FullMethodName              = "/realworld.v1.Conduit/ListFollowers"
+	Conduit_SuggestUsers_FullMethodName               = "/realworld.v1.Conduit/SuggestUsers"
+	Conduit_DismissSuggestion_FullMethodName          = "/realworld.v1.Conduit/DismissSuggestion"
+	Conduit_ListProfileVisitors_FullMethodName        = "/realworld.v1.Conduit/ListProfileVisitors"
+	Conduit_UpdateVisitPrivacy_FullMethodName         = "/realworld.v1.Conduit/UpdateVisitPrivacy"
+	Conduit_BanUser_FullMethodName                    = "/realworld.v1.Conduit/BanUser"
+	Conduit_UnbanUser_FullMethodName                  = "/realworld.v1.Conduit/UnbanUser"
+	Conduit_ListBanAudits_FullMethodName              = "/realworld.v1.Conduit/ListBanAudits"
+	Conduit_GetRelationship_FullMethodName            = "/realworld.v1.Conduit/GetRelationship"
+	Conduit_CanAddFriend_FullMethodName               = "/realworld.v1.Conduit/CanAddFriend"
+	Conduit_SendFriendRequest_FullMethodName          = "/realworld.v1.Conduit/SendFriendRequest"
+	Conduit_RemoveFriend_FullMethodName               = "/realworld.v1.Conduit/RemoveFriend"
+	Conduit_ListFriendRequests_FullMethodName         = "/realworld.v1.Conduit/ListFriendRequests"
+	Conduit_HandleFriendRequest_FullMethodName        = "/realworld.v1.Conduit/HandleFriendRequest"
+	Conduit_ListFriends_FullMethodName                = "/realworld.v1.Conduit/ListFriends"
+	Conduit_BlockUser_FullMethodName                  = "/realworld.v1.Conduit/BlockUser"
+	Conduit_UnblockUser_FullMethodName                = "/realworld.v1.Conduit/UnblockUser"
+	Conduit_ListBlocks_FullMethodName                 = "/realworld.v1.Conduit/ListBlocks"
+	Conduit_GetMessages_FullMethodName                = "/realworld.v1.Conduit/GetMessages"
+	Conduit_ListConversations_FullMethodName          = "/realworld.v1.Conduit/ListConversations"
+	Conduit_ReadConversation_FullMethodName           = "/realworld.v1.Conduit/ReadConversation"
+	Conduit_UpdateConversationSettings_FullMethodName = "/realworld.v1.Conduit/UpdateConversationSettings"
+	Conduit_GetConversationTimer_FullMethodName       = "/realworld.v1.Conduit/GetConversationTimer"
+	Conduit_SetConversationTimer_FullMethodName       = "/realworld.v1.Conduit/SetConversationTimer"
+	Conduit_CreateChatExport_FullMethodName           = "/realworld.v1.Conduit/CreateChatExport"
+	Conduit_GetChatExport_FullMethodName              = "/realworld.v1.Conduit/GetChatExport"
+	Conduit_UpdateGroupInfo_FullMethodName            = "/realworld.v1.Conduit/UpdateGroupInfo"
+	Conduit_UpdateGroupSettings_FullMethodName        = "/realworld.v1.Conduit/UpdateGroupSettings"
+	Conduit_SetGroupAdmin_FullMethodName              = "/realworld.v1.Conduit/SetGroupAdmin"
+	Conduit_MuteGroupMember_FullMethodName            = "/realworld.v1.Conduit/MuteGroupMember"
+	Conduit_CreateGroupInvite_FullMethodName          = "/realworld.v1.Conduit/CreateGroupInvite"
+	Conduit_RevokeGroupInvite_FullMethodName          = "/realworld.v1.Conduit/RevokeGroupInvite"
+	Conduit_JoinGroup_FullMethodName                  = "/realworld.v1.Conduit/JoinGroup"
+	Conduit_ListGroupJoinRequests_FullMethodName      = "/realworld.v1.Conduit/ListGroupJoinRequests"
+	Conduit_HandleGroupJoinRequest_FullMethodName     = "/realworld.v1.Conduit/HandleGroupJoinRequest"
+	Conduit_CreateMoment_FullMethodName               = "/realworld.v1.Conduit/CreateMoment"
+	Conduit_GetMoment_FullMethodName                  = "/realworld.v1.Conduit/GetMoment"
+	Conduit_DeleteMoment_FullMethodName               = "/realworld.v1.Conduit/DeleteMoment"
+	Conduit_CreateComment_FullMethodName              = "/realworld.v1.Conduit/CreateComment"
+	Conduit_DeleteComment_FullMethodName              = "/realworld.v1.Conduit/DeleteComment"
+	Conduit_UpdateMomentBlackList_FullMethodName      = "/realworld.v1.Conduit/UpdateMomentBlackList"
+	Conduit_UpdateMomentVisibility_FullMethodName     = "/realworld.v1.Conduit/UpdateMomentVisibility"
+	Conduit_GetTimeline_FullMethodName                = "/realworld.v1.Conduit/GetTimeline"
+	Conduit_GetSquare_FullMethodName                  = "/realworld.v1.Conduit/GetSquare"
+	Conduit_LikeMoment_FullMethodName                 = "/realworld.v1.Conduit/LikeMoment"
+	Conduit_UnlikeMoment_FullMethodName               = "/realworld.v1.Conduit/UnlikeMoment"
+	Conduit_ListComments_FullMethodName               = "/realworld.v1.Conduit/ListComments"
+	Conduit_LikeComment_FullMethodName                = "/realworld.v1.Conduit/LikeComment"
+	Conduit_UnlikeComment_FullMethodName              = "/realworld.v1.Conduit/UnlikeComment"
 )
 
 // ConduitClient is the client API for Conduit service.
@@ -47,12 +100,67 @@ type ConduitClient interface {
 	UpdateUserPassword(ctx context.Context, in *UpdateUserPwdRequest, opts ...grpc.CallOption) (*UpdateUserPwdReply, error)
 	ResetUserPassword(ctx context.Context, in *ResetUserPwdRequest, opts ...grpc.CallOption) (*ResetUserPwdReply, error)
 	UpdateUserInfo(ctx context.Context, in *UpdateUserInfoRequest, opts ...grpc.CallOption) (*UpdateUserInfoReply, error)
+	ListLoginHistory(ctx context.Context, in *ListLoginHistoryRequest, opts ...grpc.CallOption) (*ListLoginHistoryReply, error)
+	ChangeHandle(ctx context.Context, in *ChangeHandleRequest, opts ...grpc.CallOption) (*ChangeHandleReply, error)
+	SearchUsers(ctx context.Context, in *SearchUsersRequest, opts ...grpc.CallOption) (*SearchUsersReply, error)
+	GetPrivacySettings(ctx context.Context, in *GetPrivacySettingsRequest, opts ...grpc.CallOption) (*PrivacySettingsReply, error)
+	UpdatePrivacySettings(ctx context.Context, in *UpdatePrivacySettingsRequest, opts ...grpc.CallOption) (*PrivacySettingsReply, error)
+	// Deprecated: Do not use.
+	// 已废弃，等同于 UpdatePrivacySettings 只传 discoverable_by_phone，留给老客户端
+	UpdateSearchPrivacy(ctx context.Context, in *UpdateSearchPrivacyRequest, opts ...grpc.CallOption) (*UpdateSearchPrivacyReply, error)
 	GetProfile(ctx context.Context, in *GetProfileRequest, opts ...grpc.CallOption) (*GetProfileReply, error)
 	FollowUser(ctx context.Context, in *FollowUserRequest, opts ...grpc.CallOption) (*FollowFanReply, error)
 	UnfollowUser(ctx context.Context, in *UnfollowUserRequest, opts ...grpc.CallOption) (*FollowFanReply, error)
+	ListFollowing(ctx context.Context, in *ListFollowRequest, opts ...grpc.CallOption) (*ListFollowReply, error)
+	ListFollowers(ctx context.Context, in *ListFollowRequest, opts ...grpc.CallOption) (*ListFollowReply, error)
+	SuggestUsers(ctx context.Context, in *SuggestUsersRequest, opts ...grpc.CallOption) (*SuggestUsersReply, error)
+	DismissSuggestion(ctx context.Context, in *DismissSuggestionRequest, opts ...grpc.CallOption) (*DismissSuggestionReply, error)
+	ListProfileVisitors(ctx context.Context, in *ListProfileVisitorsRequest, opts ...grpc.CallOption) (*ListProfileVisitorsReply, error)
+	UpdateVisitPrivacy(ctx context.Context, in *UpdateVisitPrivacyRequest, opts ...grpc.CallOption) (*UpdateVisitPrivacyReply, error)
+	BanUser(ctx context.Context, in *BanUserRequest, opts ...grpc.CallOption) (*BanUserReply, error)
+	UnbanUser(ctx context.Context, in *UnbanUserRequest, opts ...grpc.CallOption) (*BanUserReply, error)
+	ListBanAudits(ctx context.Context, in *ListBanAuditsRequest, opts ...grpc.CallOption) (*ListBanAuditsReply, error)
 	GetRelationship(ctx context.Context, in *RelationshipRequest, opts ...grpc.CallOption) (*RelationshipReply, error)
 	CanAddFriend(ctx context.Context, in *CanAddFriendReq, opts ...grpc.CallOption) (*CanAddFriendRes, error)
+	SendFriendRequest(ctx context.Context, in *SendFriendRequestReq, opts ...grpc.CallOption) (*FriendRequestReply, error)
+	RemoveFriend(ctx context.Context, in *RemoveFriendReq, opts ...grpc.CallOption) (*RemoveFriendReply, error)
+	ListFriendRequests(ctx context.Context, in *ListFriendRequestsReq, opts ...grpc.CallOption) (*ListFriendRequestsReply, error)
+	HandleFriendRequest(ctx context.Context, in *HandleFriendRequestReq, opts ...grpc.CallOption) (*FriendRequestReply, error)
+	ListFriends(ctx context.Context, in *ListFriendsReq, opts ...grpc.CallOption) (*ListFriendsReply, error)
+	BlockUser(ctx context.Context, in *BlockUserReq, opts ...grpc.CallOption) (*BlockReply, error)
+	UnblockUser(ctx context.Context, in *UnblockUserReq, opts ...grpc.CallOption) (*BlockReply, error)
+	ListBlocks(ctx context.Context, in *ListBlocksReq, opts ...grpc.CallOption) (*ListBlocksReply, error)
 	GetMessages(ctx context.Context, in *GetMessagesRequest, opts ...grpc.CallOption) (*GetMessagesReply, error)
+	ListConversations(ctx context.Context, in *ListConversationsRequest, opts ...grpc.CallOption) (*ListConversationsReply, error)
+	ReadConversation(ctx context.Context, in *ReadConversationRequest, opts ...grpc.CallOption) (*ReadConversationReply, error)
+	UpdateConversationSettings(ctx context.Context, in *UpdateConversationSettingsRequest, opts ...grpc.CallOption) (*UpdateConversationSettingsReply, error)
+	GetConversationTimer(ctx context.Context, in *GetConversationTimerRequest, opts ...grpc.CallOption) (*ConversationTimerReply, error)
+	SetConversationTimer(ctx context.Context, in *SetConversationTimerRequest, opts ...grpc.CallOption) (*ConversationTimerReply, error)
+	CreateChatExport(ctx context.Context, in *CreateChatExportRequest, opts ...grpc.CallOption) (*ChatExportReply, error)
+	GetChatExport(ctx context.Context, in *GetChatExportRequest, opts ...grpc.CallOption) (*ChatExportReply, error)
+	UpdateGroupInfo(ctx context.Context, in *UpdateGroupInfoRequest, opts ...grpc.CallOption) (*GroupReply, error)
+	UpdateGroupSettings(ctx context.Context, in *UpdateGroupSettingsRequest, opts ...grpc.CallOption) (*GroupReply, error)
+	SetGroupAdmin(ctx context.Context, in *SetGroupAdminRequest, opts ...grpc.CallOption) (*GroupMemberReply, error)
+	MuteGroupMember(ctx context.Context, in *MuteGroupMemberRequest, opts ...grpc.CallOption) (*GroupMemberReply, error)
+	CreateGroupInvite(ctx context.Context, in *CreateGroupInviteRequest, opts ...grpc.CallOption) (*GroupInviteReply, error)
+	RevokeGroupInvite(ctx context.Context, in *RevokeGroupInviteRequest, opts ...grpc.CallOption) (*RevokeGroupInviteReply, error)
+	JoinGroup(ctx context.Context, in *JoinGroupRequest, opts ...grpc.CallOption) (*JoinGroupReply, error)
+	ListGroupJoinRequests(ctx context.Context, in *ListGroupJoinRequestsRequest, opts ...grpc.CallOption) (*ListGroupJoinRequestsReply, error)
+	HandleGroupJoinRequest(ctx context.Context, in *HandleGroupJoinRequestRequest, opts ...grpc.CallOption) (*GroupJoinRequestReply, error)
+	CreateMoment(ctx context.Context, in *CreateMomentRequest, opts ...grpc.CallOption) (*MomentReply, error)
+	GetMoment(ctx context.Context, in *GetMomentRequest, opts ...grpc.CallOption) (*MomentReply, error)
+	DeleteMoment(ctx context.Context, in *DeleteMomentRequest, opts ...grpc.CallOption) (*DeleteMomentReply, error)
+	CreateComment(ctx context.Context, in *CreateCommentRequest, opts ...grpc.CallOption) (*CommentReply, error)
+	DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*DeleteCommentReply, error)
+	UpdateMomentBlackList(ctx context.Context, in *UpdateMomentBlackListRequest, opts ...grpc.CallOption) (*UpdateMomentBlackListReply, error)
+	UpdateMomentVisibility(ctx context.Context, in *UpdateMomentVisibilityRequest, opts ...grpc.CallOption) (*UpdateMomentVisibilityReply, error)
+	GetTimeline(ctx context.Context, in *GetTimelineRequest, opts ...grpc.CallOption) (*GetTimelineReply, error)
+	GetSquare(ctx context.Context, in *GetSquareRequest, opts ...grpc.CallOption) (*GetSquareReply, error)
+	LikeMoment(ctx context.Context, in *LikeMomentRequest, opts ...grpc.CallOption) (*MomentLikeReply, error)
+	UnlikeMoment(ctx context.Context, in *UnlikeMomentRequest, opts ...grpc.CallOption) (*MomentLikeReply, error)
+	ListComments(ctx context.Context, in *ListCommentsRequest, opts ...grpc.CallOption) (*ListCommentsReply, error)
+	LikeComment(ctx context.Context, in *LikeCommentRequest, opts ...grpc.CallOption) (*CommentLikeReply, error)
+	UnlikeComment(ctx context.Context, in *UnlikeCommentRequest, opts ...grpc.CallOption) (*CommentLikeReply, error)
 }
 
 type conduitClient struct {
@@ -133,6 +241,67 @@ func (c *conduitClient) UpdateUserInfo(ctx context.Context, in *UpdateUserInfoRe
 	return out, nil
 }
 
+func (c *conduitClient) ListLoginHistory(ctx context.Context, in *ListLoginHistoryRequest, opts ...grpc.CallOption) (*ListLoginHistoryReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLoginHistoryReply)
+	err := c.cc.Invoke(ctx, Conduit_ListLoginHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conduitClient) ChangeHandle(ctx context.Context, in *ChangeHandleRequest, opts ...grpc.CallOption) (*ChangeHandleReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangeHandleReply)
+	err := c.cc.Invoke(ctx, Conduit_ChangeHandle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conduitClient) SearchUsers(ctx context.Context, in *SearchUsersRequest, opts ...grpc.CallOption) (*SearchUsersReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchUsersReply)
+	err := c.cc.Invoke(ctx, Conduit_SearchUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conduitClient) GetPrivacySettings(ctx context.Context, in *GetPrivacySettingsRequest, opts ...grpc.CallOption) (*PrivacySettingsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PrivacySettingsReply)
+	err := c.cc.Invoke(ctx, Conduit_GetPrivacySettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conduitClient) UpdatePrivacySettings(ctx context.Context, in *UpdatePrivacySettingsRequest, opts ...grpc.CallOption) (*PrivacySettingsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PrivacySettingsReply)
+	err := c.cc.Invoke(ctx, Conduit_UpdatePrivacySettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Deprecated: Do not use.
+func (c *conduitClient) UpdateSearchPrivacy(ctx context.Context, in *UpdateSearchPrivacyRequest, opts ...grpc.CallOption) (*UpdateSearchPrivacyReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateSearchPrivacyReply)
+	err := c.cc.Invoke(ctx, Conduit_UpdateSearchPrivacy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *conduitClient) GetProfile(ctx context.Context, in *GetProfileRequest, opts ...grpc.CallOption) (*GetProfileReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetProfileReply)
@@ -163,6 +332,96 @@ func (c *conduitClient) UnfollowUser(ctx context.Context, in *UnfollowUserReques
 	return out, nil
 }
 
+func (c *conduitClient) ListFollowing(ctx context.Context, in *ListFollowRequest, opts ...grpc.CallOption) (*ListFollowReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFollowReply)
+	err := c.cc.Invoke(ctx, Conduit_ListFollowing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conduitClient) ListFollowers(ctx context.Context, in *ListFollowRequest, opts ...grpc.CallOption) (*ListFollowReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFollowReply)
+	err := c.cc.Invoke(ctx, Conduit_ListFollowers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conduitClient) SuggestUsers(ctx context.Context, in *SuggestUsersRequest, opts ...grpc.CallOption) (*SuggestUsersReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuggestUsersReply)
+	err := c.cc.Invoke(ctx, Conduit_SuggestUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conduitClient) DismissSuggestion(ctx context.Context, in *DismissSuggestionRequest, opts ...grpc.CallOption) (*DismissSuggestionReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DismissSuggestionReply)
+	err := c.cc.Invoke(ctx, Conduit_DismissSuggestion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conduitClient) ListProfileVisitors(ctx context.Context, in *ListProfileVisitorsRequest, opts ...grpc.CallOption) (*ListProfileVisitorsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProfileVisitorsReply)
+	err := c.cc.Invoke(ctx, Conduit_ListProfileVisitors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conduitClient) UpdateVisitPrivacy(ctx context.Context, in *UpdateVisitPrivacyRequest, opts ...grpc.CallOption) (*UpdateVisitPrivacyReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateVisitPrivacyReply)
+	err := c.cc.Invoke(ctx, Conduit_UpdateVisitPrivacy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conduitClient) BanUser(ctx context.Context, in *BanUserRequest, opts ...grpc.CallOption) (*BanUserReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BanUserReply)
+	err := c.cc.Invoke(ctx, Conduit_BanUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conduitClient) UnbanUser(ctx context.Context, in *UnbanUserRequest, opts ...grpc.CallOption) (*BanUserReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BanUserReply)
+	err := c.cc.Invoke(ctx, Conduit_UnbanUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conduitClient) ListBanAudits(ctx context.Context, in *ListBanAuditsRequest, opts ...grpc.CallOption) (*ListBanAuditsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBanAuditsReply)
+	err := c.cc.Invoke(ctx, Conduit_ListBanAudits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *conduitClient) GetRelationship(ctx context.Context, in *RelationshipRequest, opts ...grpc.CallOption) (*RelationshipReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(RelationshipReply)
@@ -183,6 +442,86 @@ func (c *conduitClient) CanAddFriend(ctx context.Context, in *CanAddFriendReq, o
 	return out, nil
 }
 
+func (c *conduitClient) SendFriendRequest(ctx context.Context, in *SendFriendRequestReq, opts ...grpc.CallOption) (*FriendRequestReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FriendRequestReply)
+	err := c.cc.Invoke(ctx, Conduit_SendFriendRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conduitClient) RemoveFriend(ctx context.Context, in *RemoveFriendReq, opts ...grpc.CallOption) (*RemoveFriendReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveFriendReply)
+	err := c.cc.Invoke(ctx, Conduit_RemoveFriend_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conduitClient) ListFriendRequests(ctx context.Context, in *ListFriendRequestsReq, opts ...grpc.CallOption) (*ListFriendRequestsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFriendRequestsReply)
+	err := c.cc.Invoke(ctx, Conduit_ListFriendRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conduitClient) HandleFriendRequest(ctx context.Context, in *HandleFriendRequestReq, opts ...grpc.CallOption) (*FriendRequestReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FriendRequestReply)
+	err := c.cc.Invoke(ctx, Conduit_HandleFriendRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conduitClient) ListFriends(ctx context.Context, in *ListFriendsReq, opts ...grpc.CallOption) (*ListFriendsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFriendsReply)
+	err := c.cc.Invoke(ctx, Conduit_ListFriends_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conduitClient) BlockUser(ctx context.Context, in *BlockUserReq, opts ...grpc.CallOption) (*BlockReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlockReply)
+	err := c.cc.Invoke(ctx, Conduit_BlockUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conduitClient) UnblockUser(ctx context.Context, in *UnblockUserReq, opts ...grpc.CallOption) (*BlockReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlockReply)
+	err := c.cc.Invoke(ctx, Conduit_UnblockUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conduitClient) ListBlocks(ctx context.Context, in *ListBlocksReq, opts ...grpc.CallOption) (*ListBlocksReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBlocksReply)
+	err := c.cc.Invoke(ctx, Conduit_ListBlocks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *conduitClient) GetMessages(ctx context.Context, in *GetMessagesRequest, opts ...grpc.CallOption) (*GetMessagesReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetMessagesReply)
@@ -193,58 +532,431 @@ func (c *conduitClient) GetMessages(ctx context.Context, in *GetMessagesRequest,
 	return out, nil
 }
 
-// ConduitServer is the server API for Conduit service.
-// All implementations must embed UnimplementedConduitServer
-// for forward compatibility.
-//
-// The greeting service definition.
-type ConduitServer interface {
-	Register(context.Context, *RegisterRequest) (*RegisterReply, error)
-	Login(context.Context, *LoginRequest) (*LoginReply, error)
-	LoginBySms(context.Context, *LoginBySmsRequest) (*LoginReply, error)
-	SendSms(context.Context, *SendSmsRequest) (*SendSmsReply, error)
-	UpdateUserPassword(context.Context, *UpdateUserPwdRequest) (*UpdateUserPwdReply, error)
-	ResetUserPassword(context.Context, *ResetUserPwdRequest) (*ResetUserPwdReply, error)
-	UpdateUserInfo(context.Context, *UpdateUserInfoRequest) (*UpdateUserInfoReply, error)
-	GetProfile(context.Context, *GetProfileRequest) (*GetProfileReply, error)
-	FollowUser(context.Context, *FollowUserRequest) (*FollowFanReply, error)
-	UnfollowUser(context.Context, *UnfollowUserRequest) (*FollowFanReply, error)
-	GetRelationship(context.Context, *RelationshipRequest) (*RelationshipReply, error)
-	CanAddFriend(context.Context, *CanAddFriendReq) (*CanAddFriendRes, error)
-	GetMessages(context.Context, *GetMessagesRequest) (*GetMessagesReply, error)
-	mustEmbedUnimplementedConduitServer()
+func (c *conduitClient) ListConversations(ctx context.Context, in *ListConversationsRequest, opts ...grpc.CallOption) (*ListConversationsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListConversationsReply)
+	err := c.cc.Invoke(ctx, Conduit_ListConversations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedConduitServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedConduitServer struct{}
-
-func (UnimplementedConduitServer) Register(context.Context, *RegisterRequest) (*RegisterReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
+func (c *conduitClient) ReadConversation(ctx context.Context, in *ReadConversationRequest, opts ...grpc.CallOption) (*ReadConversationReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReadConversationReply)
+	err := c.cc.Invoke(ctx, Conduit_ReadConversation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedConduitServer) Login(context.Context, *LoginRequest) (*LoginReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+
+func (c *conduitClient) UpdateConversationSettings(ctx context.Context, in *UpdateConversationSettingsRequest, opts ...grpc.CallOption) (*UpdateConversationSettingsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateConversationSettingsReply)
+	err := c.cc.Invoke(ctx, Conduit_UpdateConversationSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedConduitServer) LoginBySms(context.Context, *LoginBySmsRequest) (*LoginReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LoginBySms not implemented")
+
+func (c *conduitClient) GetConversationTimer(ctx context.Context, in *GetConversationTimerRequest, opts ...grpc.CallOption) (*ConversationTimerReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConversationTimerReply)
+	err := c.cc.Invoke(ctx, Conduit_GetConversationTimer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedConduitServer) SendSms(context.Context, *SendSmsRequest) (*SendSmsReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SendSms not implemented")
+
+func (c *conduitClient) SetConversationTimer(ctx context.Context, in *SetConversationTimerRequest, opts ...grpc.CallOption) (*ConversationTimerReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConversationTimerReply)
+	err := c.cc.Invoke(ctx, Conduit_SetConversationTimer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedConduitServer) UpdateUserPassword(context.Context, *UpdateUserPwdRequest) (*UpdateUserPwdReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserPassword not implemented")
+
+func (c *conduitClient) CreateChatExport(ctx context.Context, in *CreateChatExportRequest, opts ...grpc.CallOption) (*ChatExportReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChatExportReply)
+	err := c.cc.Invoke(ctx, Conduit_CreateChatExport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedConduitServer) ResetUserPassword(context.Context, *ResetUserPwdRequest) (*ResetUserPwdReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ResetUserPassword not implemented")
+
+func (c *conduitClient) GetChatExport(ctx context.Context, in *GetChatExportRequest, opts ...grpc.CallOption) (*ChatExportReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChatExportReply)
+	err := c.cc.Invoke(ctx, Conduit_GetChatExport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedConduitServer) UpdateUserInfo(context.Context, *UpdateUserInfoRequest) (*UpdateUserInfoReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserInfo not implemented")
+
+func (c *conduitClient) UpdateGroupInfo(ctx context.Context, in *UpdateGroupInfoRequest, opts ...grpc.CallOption) (*GroupReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GroupReply)
+	err := c.cc.Invoke(ctx, Conduit_UpdateGroupInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedConduitServer) GetProfile(context.Context, *GetProfileRequest) (*GetProfileReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProfile not implemented")
+
+func (c *conduitClient) UpdateGroupSettings(ctx context.Context, in *UpdateGroupSettingsRequest, opts ...grpc.CallOption) (*GroupReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GroupReply)
+	err := c.cc.Invoke(ctx, Conduit_UpdateGroupSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conduitClient) SetGroupAdmin(ctx context.Context, in *SetGroupAdminRequest, opts ...grpc.CallOption) (*GroupMemberReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GroupMemberReply)
+	err := c.cc.Invoke(ctx, Conduit_SetGroupAdmin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conduitClient) MuteGroupMember(ctx context.Context, in *MuteGroupMemberRequest, opts ...grpc.CallOption) (*GroupMemberReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GroupMemberReply)
+	err := c.cc.Invoke(ctx, Conduit_MuteGroupMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conduitClient) CreateGroupInvite(ctx context.Context, in *CreateGroupInviteRequest, opts ...grpc.CallOption) (*GroupInviteReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GroupInviteReply)
+	err := c.cc.Invoke(ctx, Conduit_CreateGroupInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conduitClient) RevokeGroupInvite(ctx context.Context, in *RevokeGroupInviteRequest, opts ...grpc.CallOption) (*RevokeGroupInviteReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeGroupInviteReply)
+	err := c.cc.Invoke(ctx, Conduit_RevokeGroupInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conduitClient) JoinGroup(ctx context.Context, in *JoinGroupRequest, opts ...grpc.CallOption) (*JoinGroupReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JoinGroupReply)
+	err := c.cc.Invoke(ctx, Conduit_JoinGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conduitClient) ListGroupJoinRequests(ctx context.Context, in *ListGroupJoinRequestsRequest, opts ...grpc.CallOption) (*ListGroupJoinRequestsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListGroupJoinRequestsReply)
+	err := c.cc.Invoke(ctx, Conduit_ListGroupJoinRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conduitClient) HandleGroupJoinRequest(ctx context.Context, in *HandleGroupJoinRequestRequest, opts ...grpc.CallOption) (*GroupJoinRequestReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GroupJoinRequestReply)
+	err := c.cc.Invoke(ctx, Conduit_HandleGroupJoinRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conduitClient) CreateMoment(ctx context.Context, in *CreateMomentRequest, opts ...grpc.CallOption) (*MomentReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MomentReply)
+	err := c.cc.Invoke(ctx, Conduit_CreateMoment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conduitClient) GetMoment(ctx context.Context, in *GetMomentRequest, opts ...grpc.CallOption) (*MomentReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MomentReply)
+	err := c.cc.Invoke(ctx, Conduit_GetMoment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conduitClient) DeleteMoment(ctx context.Context, in *DeleteMomentRequest, opts ...grpc.CallOption) (*DeleteMomentReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteMomentReply)
+	err := c.cc.Invoke(ctx, Conduit_DeleteMoment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conduitClient) CreateComment(ctx context.Context, in *CreateCommentRequest, opts ...grpc.CallOption) (*CommentReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommentReply)
+	err := c.cc.Invoke(ctx, Conduit_CreateComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conduitClient) DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*DeleteCommentReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCommentReply)
+	err := c.cc.Invoke(ctx, Conduit_DeleteComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conduitClient) UpdateMomentBlackList(ctx context.Context, in *UpdateMomentBlackListRequest, opts ...grpc.CallOption) (*UpdateMomentBlackListReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateMomentBlackListReply)
+	err := c.cc.Invoke(ctx, Conduit_UpdateMomentBlackList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conduitClient) UpdateMomentVisibility(ctx context.Context, in *UpdateMomentVisibilityRequest, opts ...grpc.CallOption) (*UpdateMomentVisibilityReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateMomentVisibilityReply)
+	err := c.cc.Invoke(ctx, Conduit_UpdateMomentVisibility_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conduitClient) GetTimeline(ctx context.Context, in *GetTimelineRequest, opts ...grpc.CallOption) (*GetTimelineReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTimelineReply)
+	err := c.cc.Invoke(ctx, Conduit_GetTimeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conduitClient) GetSquare(ctx context.Context, in *GetSquareRequest, opts ...grpc.CallOption) (*GetSquareReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSquareReply)
+	err := c.cc.Invoke(ctx, Conduit_GetSquare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conduitClient) LikeMoment(ctx context.Context, in *LikeMomentRequest, opts ...grpc.CallOption) (*MomentLikeReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MomentLikeReply)
+	err := c.cc.Invoke(ctx, Conduit_LikeMoment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conduitClient) UnlikeMoment(ctx context.Context, in *UnlikeMomentRequest, opts ...grpc.CallOption) (*MomentLikeReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MomentLikeReply)
+	err := c.cc.Invoke(ctx, Conduit_UnlikeMoment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conduitClient) ListComments(ctx context.Context, in *ListCommentsRequest, opts ...grpc.CallOption) (*ListCommentsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCommentsReply)
+	err := c.cc.Invoke(ctx, Conduit_ListComments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conduitClient) LikeComment(ctx context.Context, in *LikeCommentRequest, opts ...grpc.CallOption) (*CommentLikeReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommentLikeReply)
+	err := c.cc.Invoke(ctx, Conduit_LikeComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conduitClient) UnlikeComment(ctx context.Context, in *UnlikeCommentRequest, opts ...grpc.CallOption) (*CommentLikeReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommentLikeReply)
+	err := c.cc.Invoke(ctx, Conduit_UnlikeComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ConduitServer is the server API for Conduit service.
+// All implementations must embed UnimplementedConduitServer
+// for forward compatibility.
+//
+// The greeting service definition.
+type ConduitServer interface {
+	Register(context.Context, *RegisterRequest) (*RegisterReply, error)
+	Login(context.Context, *LoginRequest) (*LoginReply, error)
+	LoginBySms(context.Context, *LoginBySmsRequest) (*LoginReply, error)
+	SendSms(context.Context, *SendSmsRequest) (*SendSmsReply, error)
+	UpdateUserPassword(context.Context, *UpdateUserPwdRequest) (*UpdateUserPwdReply, error)
+	ResetUserPassword(context.Context, *ResetUserPwdRequest) (*ResetUserPwdReply, error)
+	UpdateUserInfo(context.Context, *UpdateUserInfoRequest) (*UpdateUserInfoReply, error)
+	ListLoginHistory(context.Context, *ListLoginHistoryRequest) (*ListLoginHistoryReply, error)
+	ChangeHandle(context.Context, *ChangeHandleRequest) (*ChangeHandleReply, error)
+	SearchUsers(context.Context, *SearchUsersRequest) (*SearchUsersReply, error)
+	GetPrivacySettings(context.Context, *GetPrivacySettingsRequest) (*PrivacySettingsReply, error)
+	UpdatePrivacySettings(context.Context, *UpdatePrivacySettingsRequest) (*PrivacySettingsReply, error)
+	// Deprecated: Do not use.
+	// 已废弃，等同于 UpdatePrivacySettings 只传 discoverable_by_phone，留给老客户端
+	UpdateSearchPrivacy(context.Context, *UpdateSearchPrivacyRequest) (*UpdateSearchPrivacyReply, error)
+	GetProfile(context.Context, *GetProfileRequest) (*GetProfileReply, error)
+	FollowUser(context.Context, *FollowUserRequest) (*FollowFanReply, error)
+	UnfollowUser(context.Context, *UnfollowUserRequest) (*FollowFanReply, error)
+	ListFollowing(context.Context, *ListFollowRequest) (*ListFollowReply, error)
+	ListFollowers(context.Context, *ListFollowRequest) (*ListFollowReply, error)
+	SuggestUsers(context.Context, *SuggestUsersRequest) (*SuggestUsersReply, error)
+	DismissSuggestion(context.Context, *DismissSuggestionRequest) (*DismissSuggestionReply, error)
+	ListProfileVisitors(context.Context, *ListProfileVisitorsRequest) (*ListProfileVisitorsReply, error)
+	UpdateVisitPrivacy(context.Context, *UpdateVisitPrivacyRequest) (*UpdateVisitPrivacyReply, error)
+	BanUser(context.Context, *BanUserRequest) (*BanUserReply, error)
+	UnbanUser(context.Context, *UnbanUserRequest) (*BanUserReply, error)
+	ListBanAudits(context.Context, *ListBanAuditsRequest) (*ListBanAuditsReply, error)
+	GetRelationship(context.Context, *RelationshipRequest) (*RelationshipReply, error)
+	CanAddFriend(context.Context, *CanAddFriendReq) (*CanAddFriendRes, error)
+	SendFriendRequest(context.Context, *SendFriendRequestReq) (*FriendRequestReply, error)
+	RemoveFriend(context.Context, *RemoveFriendReq) (*RemoveFriendReply, error)
+	ListFriendRequests(context.Context, *ListFriendRequestsReq) (*ListFriendRequestsReply, error)
+	HandleFriendRequest(context.Context, *HandleFriendRequestReq) (*FriendRequestReply, error)
+	ListFriends(context.Context, *ListFriendsReq) (*ListFriendsReply, error)
+	BlockUser(context.Context, *BlockUserReq) (*BlockReply, error)
+	UnblockUser(context.Context, *UnblockUserReq) (*BlockReply, error)
+	ListBlocks(context.Context, *ListBlocksReq) (*ListBlocksReply, error)
+	GetMessages(context.Context, *GetMessagesRequest) (*GetMessagesReply, error)
+	ListConversations(context.Context, *ListConversationsRequest) (*ListConversationsReply, error)
+	ReadConversation(context.Context, *ReadConversationRequest) (*ReadConversationReply, error)
+	UpdateConversationSettings(context.Context, *UpdateConversationSettingsRequest) (*UpdateConversationSettingsReply, error)
+	GetConversationTimer(context.Context, *GetConversationTimerRequest) (*ConversationTimerReply, error)
+	SetConversationTimer(context.Context, *SetConversationTimerRequest) (*ConversationTimerReply, error)
+	CreateChatExport(context.Context, *CreateChatExportRequest) (*ChatExportReply, error)
+	GetChatExport(context.Context, *GetChatExportRequest) (*ChatExportReply, error)
+	UpdateGroupInfo(context.Context, *UpdateGroupInfoRequest) (*GroupReply, error)
+	UpdateGroupSettings(context.Context, *UpdateGroupSettingsRequest) (*GroupReply, error)
+	SetGroupAdmin(context.Context, *SetGroupAdminRequest) (*GroupMemberReply, error)
+	MuteGroupMember(context.Context, *MuteGroupMemberRequest) (*GroupMemberReply, error)
+	CreateGroupInvite(context.Context, *CreateGroupInviteRequest) (*GroupInviteReply, error)
+	RevokeGroupInvite(context.Context, *RevokeGroupInviteRequest) (*RevokeGroupInviteReply, error)
+	JoinGroup(context.Context, *JoinGroupRequest) (*JoinGroupReply, error)
+	ListGroupJoinRequests(context.Context, *ListGroupJoinRequestsRequest) (*ListGroupJoinRequestsReply, error)
+	HandleGroupJoinRequest(context.Context, *HandleGroupJoinRequestRequest) (*GroupJoinRequestReply, error)
+	CreateMoment(context.Context, *CreateMomentRequest) (*MomentReply, error)
+	GetMoment(context.Context, *GetMomentRequest) (*MomentReply, error)
+	DeleteMoment(context.Context, *DeleteMomentRequest) (*DeleteMomentReply, error)
+	CreateComment(context.Context, *CreateCommentRequest) (*CommentReply, error)
+	DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentReply, error)
+	UpdateMomentBlackList(context.Context, *UpdateMomentBlackListRequest) (*UpdateMomentBlackListReply, error)
+	UpdateMomentVisibility(context.Context, *UpdateMomentVisibilityRequest) (*UpdateMomentVisibilityReply, error)
+	GetTimeline(context.Context, *GetTimelineRequest) (*GetTimelineReply, error)
+	GetSquare(context.Context, *GetSquareRequest) (*GetSquareReply, error)
+	LikeMoment(context.Context, *LikeMomentRequest) (*MomentLikeReply, error)
+	UnlikeMoment(context.Context, *UnlikeMomentRequest) (*MomentLikeReply, error)
+	ListComments(context.Context, *ListCommentsRequest) (*ListCommentsReply, error)
+	LikeComment(context.Context, *LikeCommentRequest) (*CommentLikeReply, error)
+	UnlikeComment(context.Context, *UnlikeCommentRequest) (*CommentLikeReply, error)
+	mustEmbedUnimplementedConduitServer()
+}
+
+// UnimplementedConduitServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedConduitServer struct{}
+
+func (UnimplementedConduitServer) Register(context.Context, *RegisterRequest) (*RegisterReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
+}
+func (UnimplementedConduitServer) Login(context.Context, *LoginRequest) (*LoginReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+}
+func (UnimplementedConduitServer) LoginBySms(context.Context, *LoginBySmsRequest) (*LoginReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginBySms not implemented")
+}
+func (UnimplementedConduitServer) SendSms(context.Context, *SendSmsRequest) (*SendSmsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendSms not implemented")
+}
+func (UnimplementedConduitServer) UpdateUserPassword(context.Context, *UpdateUserPwdRequest) (*UpdateUserPwdReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserPassword not implemented")
+}
+func (UnimplementedConduitServer) ResetUserPassword(context.Context, *ResetUserPwdRequest) (*ResetUserPwdReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetUserPassword not implemented")
+}
+func (UnimplementedConduitServer) UpdateUserInfo(context.Context, *UpdateUserInfoRequest) (*UpdateUserInfoReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserInfo not implemented")
+}
+func (UnimplementedConduitServer) ListLoginHistory(context.Context, *ListLoginHistoryRequest) (*ListLoginHistoryReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLoginHistory not implemented")
+}
+func (UnimplementedConduitServer) ChangeHandle(context.Context, *ChangeHandleRequest) (*ChangeHandleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeHandle not implemented")
+}
+func (UnimplementedConduitServer) SearchUsers(context.Context, *SearchUsersRequest) (*SearchUsersReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchUsers not implemented")
+}
+func (UnimplementedConduitServer) GetPrivacySettings(context.Context, *GetPrivacySettingsRequest) (*PrivacySettingsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPrivacySettings not implemented")
+}
+func (UnimplementedConduitServer) UpdatePrivacySettings(context.Context, *UpdatePrivacySettingsRequest) (*PrivacySettingsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePrivacySettings not implemented")
+}
+func (UnimplementedConduitServer) UpdateSearchPrivacy(context.Context, *UpdateSearchPrivacyRequest) (*UpdateSearchPrivacyReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSearchPrivacy not implemented")
+}
+func (UnimplementedConduitServer) GetProfile(context.Context, *GetProfileRequest) (*GetProfileReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProfile not implemented")
 }
 func (UnimplementedConduitServer) FollowUser(context.Context, *FollowUserRequest) (*FollowFanReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FollowUser not implemented")
@@ -252,266 +964,1361 @@ func (UnimplementedConduitServer) FollowUser(context.Context, *FollowUserRequest
 func (UnimplementedConduitServer) UnfollowUser(context.Context, *UnfollowUserRequest) (*FollowFanReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnfollowUser not implemented")
 }
-func (UnimplementedConduitServer) GetRelationship(context.Context, *RelationshipRequest) (*RelationshipReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRelationship not implemented")
+func (UnimplementedConduitServer) ListFollowing(context.Context, *ListFollowRequest) (*ListFollowReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFollowing not implemented")
+}
+func (UnimplementedConduitServer) ListFollowers(context.Context, *ListFollowRequest) (*ListFollowReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFollowers not implemented")
+}
+func (UnimplementedConduitServer) SuggestUsers(context.Context, *SuggestUsersRequest) (*SuggestUsersReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestUsers not implemented")
+}
+func (UnimplementedConduitServer) DismissSuggestion(context.Context, *DismissSuggestionRequest) (*DismissSuggestionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DismissSuggestion not implemented")
+}
+func (UnimplementedConduitServer) ListProfileVisitors(context.Context, *ListProfileVisitorsRequest) (*ListProfileVisitorsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProfileVisitors not implemented")
+}
+func (UnimplementedConduitServer) UpdateVisitPrivacy(context.Context, *UpdateVisitPrivacyRequest) (*UpdateVisitPrivacyReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateVisitPrivacy not implemented")
+}
+func (UnimplementedConduitServer) BanUser(context.Context, *BanUserRequest) (*BanUserReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BanUser not implemented")
+}
+func (UnimplementedConduitServer) UnbanUser(context.Context, *UnbanUserRequest) (*BanUserReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbanUser not implemented")
+}
+func (UnimplementedConduitServer) ListBanAudits(context.Context, *ListBanAuditsRequest) (*ListBanAuditsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBanAudits not implemented")
+}
+func (UnimplementedConduitServer) GetRelationship(context.Context, *RelationshipRequest) (*RelationshipReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRelationship not implemented")
+}
+func (UnimplementedConduitServer) CanAddFriend(context.Context, *CanAddFriendReq) (*CanAddFriendRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CanAddFriend not implemented")
+}
+func (UnimplementedConduitServer) SendFriendRequest(context.Context, *SendFriendRequestReq) (*FriendRequestReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendFriendRequest not implemented")
+}
+func (UnimplementedConduitServer) RemoveFriend(context.Context, *RemoveFriendReq) (*RemoveFriendReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFriend not implemented")
+}
+func (UnimplementedConduitServer) ListFriendRequests(context.Context, *ListFriendRequestsReq) (*ListFriendRequestsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFriendRequests not implemented")
+}
+func (UnimplementedConduitServer) HandleFriendRequest(context.Context, *HandleFriendRequestReq) (*FriendRequestReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleFriendRequest not implemented")
+}
+func (UnimplementedConduitServer) ListFriends(context.Context, *ListFriendsReq) (*ListFriendsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFriends not implemented")
+}
+func (UnimplementedConduitServer) BlockUser(context.Context, *BlockUserReq) (*BlockReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockUser not implemented")
+}
+func (UnimplementedConduitServer) UnblockUser(context.Context, *UnblockUserReq) (*BlockReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnblockUser not implemented")
+}
+func (UnimplementedConduitServer) ListBlocks(context.Context, *ListBlocksReq) (*ListBlocksReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBlocks not implemented")
+}
+func (UnimplementedConduitServer) GetMessages(context.Context, *GetMessagesRequest) (*GetMessagesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMessages not implemented")
+}
+func (UnimplementedConduitServer) ListConversations(context.Context, *ListConversationsRequest) (*ListConversationsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConversations not implemented")
+}
+func (UnimplementedConduitServer) ReadConversation(context.Context, *ReadConversationRequest) (*ReadConversationReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadConversation not implemented")
+}
+func (UnimplementedConduitServer) UpdateConversationSettings(context.Context, *UpdateConversationSettingsRequest) (*UpdateConversationSettingsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateConversationSettings not implemented")
+}
+func (UnimplementedConduitServer) GetConversationTimer(context.Context, *GetConversationTimerRequest) (*ConversationTimerReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConversationTimer not implemented")
+}
+func (UnimplementedConduitServer) SetConversationTimer(context.Context, *SetConversationTimerRequest) (*ConversationTimerReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetConversationTimer not implemented")
+}
+func (UnimplementedConduitServer) CreateChatExport(context.Context, *CreateChatExportRequest) (*ChatExportReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateChatExport not implemented")
+}
+func (UnimplementedConduitServer) GetChatExport(context.Context, *GetChatExportRequest) (*ChatExportReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChatExport not implemented")
+}
+func (UnimplementedConduitServer) UpdateGroupInfo(context.Context, *UpdateGroupInfoRequest) (*GroupReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroupInfo not implemented")
+}
+func (UnimplementedConduitServer) UpdateGroupSettings(context.Context, *UpdateGroupSettingsRequest) (*GroupReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroupSettings not implemented")
+}
+func (UnimplementedConduitServer) SetGroupAdmin(context.Context, *SetGroupAdminRequest) (*GroupMemberReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGroupAdmin not implemented")
+}
+func (UnimplementedConduitServer) MuteGroupMember(context.Context, *MuteGroupMemberRequest) (*GroupMemberReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MuteGroupMember not implemented")
+}
+func (UnimplementedConduitServer) CreateGroupInvite(context.Context, *CreateGroupInviteRequest) (*GroupInviteReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGroupInvite not implemented")
+}
+func (UnimplementedConduitServer) RevokeGroupInvite(context.Context, *RevokeGroupInviteRequest) (*RevokeGroupInviteReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeGroupInvite not implemented")
+}
+func (UnimplementedConduitServer) JoinGroup(context.Context, *JoinGroupRequest) (*JoinGroupReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinGroup not implemented")
+}
+func (UnimplementedConduitServer) ListGroupJoinRequests(context.Context, *ListGroupJoinRequestsRequest) (*ListGroupJoinRequestsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGroupJoinRequests not implemented")
+}
+func (UnimplementedConduitServer) HandleGroupJoinRequest(context.Context, *HandleGroupJoinRequestRequest) (*GroupJoinRequestReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleGroupJoinRequest not implemented")
+}
+func (UnimplementedConduitServer) CreateMoment(context.Context, *CreateMomentRequest) (*MomentReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMoment not implemented")
+}
+func (UnimplementedConduitServer) GetMoment(context.Context, *GetMomentRequest) (*MomentReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMoment not implemented")
+}
+func (UnimplementedConduitServer) DeleteMoment(context.Context, *DeleteMomentRequest) (*DeleteMomentReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMoment not implemented")
+}
+func (UnimplementedConduitServer) CreateComment(context.Context, *CreateCommentRequest) (*CommentReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateComment not implemented")
+}
+func (UnimplementedConduitServer) DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteComment not implemented")
+}
+func (UnimplementedConduitServer) UpdateMomentBlackList(context.Context, *UpdateMomentBlackListRequest) (*UpdateMomentBlackListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMomentBlackList not implemented")
+}
+func (UnimplementedConduitServer) UpdateMomentVisibility(context.Context, *UpdateMomentVisibilityRequest) (*UpdateMomentVisibilityReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMomentVisibility not implemented")
+}
+func (UnimplementedConduitServer) GetTimeline(context.Context, *GetTimelineRequest) (*GetTimelineReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTimeline not implemented")
+}
+func (UnimplementedConduitServer) GetSquare(context.Context, *GetSquareRequest) (*GetSquareReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSquare not implemented")
+}
+func (UnimplementedConduitServer) LikeMoment(context.Context, *LikeMomentRequest) (*MomentLikeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LikeMoment not implemented")
+}
+func (UnimplementedConduitServer) UnlikeMoment(context.Context, *UnlikeMomentRequest) (*MomentLikeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlikeMoment not implemented")
+}
+func (UnimplementedConduitServer) ListComments(context.Context, *ListCommentsRequest) (*ListCommentsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListComments not implemented")
+}
+func (UnimplementedConduitServer) LikeComment(context.Context, *LikeCommentRequest) (*CommentLikeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LikeComment not implemented")
+}
+func (UnimplementedConduitServer) UnlikeComment(context.Context, *UnlikeCommentRequest) (*CommentLikeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlikeComment not implemented")
+}
+func (UnimplementedConduitServer) mustEmbedUnimplementedConduitServer() {}
+func (UnimplementedConduitServer) testEmbeddedByValue()                 {}
+
+// UnsafeConduitServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ConduitServer will
+// result in compilation errors.
+type UnsafeConduitServer interface {
+	mustEmbedUnimplementedConduitServer()
+}
+
+func RegisterConduitServer(s grpc.ServiceRegistrar, srv ConduitServer) {
+	// If the following call pancis, it indicates UnimplementedConduitServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Conduit_ServiceDesc, srv)
+}
+
+func _Conduit_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConduitServer).Register(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conduit_Register_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConduitServer).Register(ctx, req.(*RegisterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conduit_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConduitServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conduit_Login_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConduitServer).Login(ctx, req.(*LoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conduit_LoginBySms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginBySmsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConduitServer).LoginBySms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conduit_LoginBySms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConduitServer).LoginBySms(ctx, req.(*LoginBySmsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conduit_SendSms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendSmsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConduitServer).SendSms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conduit_SendSms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConduitServer).SendSms(ctx, req.(*SendSmsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conduit_UpdateUserPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserPwdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConduitServer).UpdateUserPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conduit_UpdateUserPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConduitServer).UpdateUserPassword(ctx, req.(*UpdateUserPwdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conduit_ResetUserPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetUserPwdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConduitServer).ResetUserPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conduit_ResetUserPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConduitServer).ResetUserPassword(ctx, req.(*ResetUserPwdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conduit_UpdateUserInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConduitServer).UpdateUserInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conduit_UpdateUserInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConduitServer).UpdateUserInfo(ctx, req.(*UpdateUserInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conduit_ListLoginHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLoginHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConduitServer).ListLoginHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conduit_ListLoginHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConduitServer).ListLoginHistory(ctx, req.(*ListLoginHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conduit_ChangeHandle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeHandleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConduitServer).ChangeHandle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conduit_ChangeHandle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConduitServer).ChangeHandle(ctx, req.(*ChangeHandleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conduit_SearchUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConduitServer).SearchUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conduit_SearchUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConduitServer).SearchUsers(ctx, req.(*SearchUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conduit_GetPrivacySettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPrivacySettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConduitServer).GetPrivacySettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conduit_GetPrivacySettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConduitServer).GetPrivacySettings(ctx, req.(*GetPrivacySettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conduit_UpdatePrivacySettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePrivacySettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConduitServer).UpdatePrivacySettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conduit_UpdatePrivacySettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConduitServer).UpdatePrivacySettings(ctx, req.(*UpdatePrivacySettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conduit_UpdateSearchPrivacy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSearchPrivacyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConduitServer).UpdateSearchPrivacy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conduit_UpdateSearchPrivacy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConduitServer).UpdateSearchPrivacy(ctx, req.(*UpdateSearchPrivacyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conduit_GetProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConduitServer).GetProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conduit_GetProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConduitServer).GetProfile(ctx, req.(*GetProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conduit_FollowUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConduitServer).FollowUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conduit_FollowUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConduitServer).FollowUser(ctx, req.(*FollowUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conduit_UnfollowUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnfollowUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConduitServer).UnfollowUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conduit_UnfollowUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConduitServer).UnfollowUser(ctx, req.(*UnfollowUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conduit_ListFollowing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFollowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConduitServer).ListFollowing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conduit_ListFollowing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConduitServer).ListFollowing(ctx, req.(*ListFollowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conduit_ListFollowers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFollowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConduitServer).ListFollowers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conduit_ListFollowers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConduitServer).ListFollowers(ctx, req.(*ListFollowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conduit_SuggestUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConduitServer).SuggestUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conduit_SuggestUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConduitServer).SuggestUsers(ctx, req.(*SuggestUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conduit_DismissSuggestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DismissSuggestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConduitServer).DismissSuggestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conduit_DismissSuggestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConduitServer).DismissSuggestion(ctx, req.(*DismissSuggestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conduit_ListProfileVisitors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProfileVisitorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConduitServer).ListProfileVisitors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conduit_ListProfileVisitors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConduitServer).ListProfileVisitors(ctx, req.(*ListProfileVisitorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conduit_UpdateVisitPrivacy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateVisitPrivacyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConduitServer).UpdateVisitPrivacy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conduit_UpdateVisitPrivacy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConduitServer).UpdateVisitPrivacy(ctx, req.(*UpdateVisitPrivacyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conduit_BanUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BanUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConduitServer).BanUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conduit_BanUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConduitServer).BanUser(ctx, req.(*BanUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conduit_UnbanUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnbanUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConduitServer).UnbanUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conduit_UnbanUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConduitServer).UnbanUser(ctx, req.(*UnbanUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conduit_ListBanAudits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBanAuditsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConduitServer).ListBanAudits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conduit_ListBanAudits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConduitServer).ListBanAudits(ctx, req.(*ListBanAuditsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conduit_GetRelationship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RelationshipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConduitServer).GetRelationship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conduit_GetRelationship_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConduitServer).GetRelationship(ctx, req.(*RelationshipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conduit_CanAddFriend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CanAddFriendReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConduitServer).CanAddFriend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conduit_CanAddFriend_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConduitServer).CanAddFriend(ctx, req.(*CanAddFriendReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conduit_SendFriendRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendFriendRequestReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConduitServer).SendFriendRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conduit_SendFriendRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConduitServer).SendFriendRequest(ctx, req.(*SendFriendRequestReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conduit_RemoveFriend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFriendReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConduitServer).RemoveFriend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conduit_RemoveFriend_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConduitServer).RemoveFriend(ctx, req.(*RemoveFriendReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conduit_ListFriendRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFriendRequestsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConduitServer).ListFriendRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conduit_ListFriendRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConduitServer).ListFriendRequests(ctx, req.(*ListFriendRequestsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conduit_HandleFriendRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HandleFriendRequestReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConduitServer).HandleFriendRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conduit_HandleFriendRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConduitServer).HandleFriendRequest(ctx, req.(*HandleFriendRequestReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conduit_ListFriends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFriendsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConduitServer).ListFriends(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conduit_ListFriends_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConduitServer).ListFriends(ctx, req.(*ListFriendsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conduit_BlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConduitServer).BlockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conduit_BlockUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConduitServer).BlockUser(ctx, req.(*BlockUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conduit_UnblockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnblockUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConduitServer).UnblockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conduit_UnblockUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConduitServer).UnblockUser(ctx, req.(*UnblockUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conduit_ListBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBlocksReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConduitServer).ListBlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conduit_ListBlocks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConduitServer).ListBlocks(ctx, req.(*ListBlocksReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conduit_GetMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConduitServer).GetMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conduit_GetMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConduitServer).GetMessages(ctx, req.(*GetMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conduit_ListConversations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConversationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConduitServer).ListConversations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conduit_ListConversations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConduitServer).ListConversations(ctx, req.(*ListConversationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conduit_ReadConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadConversationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConduitServer).ReadConversation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conduit_ReadConversation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConduitServer).ReadConversation(ctx, req.(*ReadConversationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conduit_UpdateConversationSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateConversationSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConduitServer).UpdateConversationSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conduit_UpdateConversationSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConduitServer).UpdateConversationSettings(ctx, req.(*UpdateConversationSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conduit_GetConversationTimer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConversationTimerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConduitServer).GetConversationTimer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conduit_GetConversationTimer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConduitServer).GetConversationTimer(ctx, req.(*GetConversationTimerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conduit_SetConversationTimer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetConversationTimerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConduitServer).SetConversationTimer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conduit_SetConversationTimer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConduitServer).SetConversationTimer(ctx, req.(*SetConversationTimerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conduit_CreateChatExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateChatExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConduitServer).CreateChatExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conduit_CreateChatExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConduitServer).CreateChatExport(ctx, req.(*CreateChatExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conduit_GetChatExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChatExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConduitServer).GetChatExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conduit_GetChatExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConduitServer).GetChatExport(ctx, req.(*GetChatExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conduit_UpdateGroupInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGroupInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConduitServer).UpdateGroupInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conduit_UpdateGroupInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConduitServer).UpdateGroupInfo(ctx, req.(*UpdateGroupInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conduit_UpdateGroupSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGroupSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConduitServer).UpdateGroupSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conduit_UpdateGroupSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConduitServer).UpdateGroupSettings(ctx, req.(*UpdateGroupSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conduit_SetGroupAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGroupAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConduitServer).SetGroupAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conduit_SetGroupAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConduitServer).SetGroupAdmin(ctx, req.(*SetGroupAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conduit_MuteGroupMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MuteGroupMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConduitServer).MuteGroupMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conduit_MuteGroupMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConduitServer).MuteGroupMember(ctx, req.(*MuteGroupMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conduit_CreateGroupInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGroupInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConduitServer).CreateGroupInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conduit_CreateGroupInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConduitServer).CreateGroupInvite(ctx, req.(*CreateGroupInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conduit_RevokeGroupInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeGroupInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConduitServer).RevokeGroupInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conduit_RevokeGroupInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConduitServer).RevokeGroupInvite(ctx, req.(*RevokeGroupInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConduitServer) CanAddFriend(context.Context, *CanAddFriendReq) (*CanAddFriendRes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CanAddFriend not implemented")
+
+func _Conduit_JoinGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConduitServer).JoinGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conduit_JoinGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConduitServer).JoinGroup(ctx, req.(*JoinGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConduitServer) GetMessages(context.Context, *GetMessagesRequest) (*GetMessagesReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMessages not implemented")
+
+func _Conduit_ListGroupJoinRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGroupJoinRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConduitServer).ListGroupJoinRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conduit_ListGroupJoinRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConduitServer).ListGroupJoinRequests(ctx, req.(*ListGroupJoinRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConduitServer) mustEmbedUnimplementedConduitServer() {}
-func (UnimplementedConduitServer) testEmbeddedByValue()                 {}
 
-// UnsafeConduitServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to ConduitServer will
-// result in compilation errors.
-type UnsafeConduitServer interface {
-	mustEmbedUnimplementedConduitServer()
+func _Conduit_HandleGroupJoinRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HandleGroupJoinRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConduitServer).HandleGroupJoinRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conduit_HandleGroupJoinRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConduitServer).HandleGroupJoinRequest(ctx, req.(*HandleGroupJoinRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterConduitServer(s grpc.ServiceRegistrar, srv ConduitServer) {
-	// If the following call pancis, it indicates UnimplementedConduitServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _Conduit_CreateMoment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMomentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&Conduit_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(ConduitServer).CreateMoment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conduit_CreateMoment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConduitServer).CreateMoment(ctx, req.(*CreateMomentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Conduit_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RegisterRequest)
+func _Conduit_GetMoment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMomentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConduitServer).Register(ctx, in)
+		return srv.(ConduitServer).GetMoment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Conduit_Register_FullMethodName,
+		FullMethod: Conduit_GetMoment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConduitServer).Register(ctx, req.(*RegisterRequest))
+		return srv.(ConduitServer).GetMoment(ctx, req.(*GetMomentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Conduit_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginRequest)
+func _Conduit_DeleteMoment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMomentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConduitServer).Login(ctx, in)
+		return srv.(ConduitServer).DeleteMoment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Conduit_Login_FullMethodName,
+		FullMethod: Conduit_DeleteMoment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConduitServer).Login(ctx, req.(*LoginRequest))
+		return srv.(ConduitServer).DeleteMoment(ctx, req.(*DeleteMomentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Conduit_LoginBySms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginBySmsRequest)
+func _Conduit_CreateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCommentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConduitServer).LoginBySms(ctx, in)
+		return srv.(ConduitServer).CreateComment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Conduit_LoginBySms_FullMethodName,
+		FullMethod: Conduit_CreateComment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConduitServer).LoginBySms(ctx, req.(*LoginBySmsRequest))
+		return srv.(ConduitServer).CreateComment(ctx, req.(*CreateCommentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Conduit_SendSms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SendSmsRequest)
+func _Conduit_DeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCommentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConduitServer).SendSms(ctx, in)
+		return srv.(ConduitServer).DeleteComment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Conduit_SendSms_FullMethodName,
+		FullMethod: Conduit_DeleteComment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConduitServer).SendSms(ctx, req.(*SendSmsRequest))
+		return srv.(ConduitServer).DeleteComment(ctx, req.(*DeleteCommentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Conduit_UpdateUserPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateUserPwdRequest)
+func _Conduit_UpdateMomentBlackList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMomentBlackListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConduitServer).UpdateUserPassword(ctx, in)
+		return srv.(ConduitServer).UpdateMomentBlackList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Conduit_UpdateUserPassword_FullMethodName,
+		FullMethod: Conduit_UpdateMomentBlackList_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConduitServer).UpdateUserPassword(ctx, req.(*UpdateUserPwdRequest))
+		return srv.(ConduitServer).UpdateMomentBlackList(ctx, req.(*UpdateMomentBlackListRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Conduit_ResetUserPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ResetUserPwdRequest)
+func _Conduit_UpdateMomentVisibility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMomentVisibilityRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConduitServer).ResetUserPassword(ctx, in)
+		return srv.(ConduitServer).UpdateMomentVisibility(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Conduit_ResetUserPassword_FullMethodName,
+		FullMethod: Conduit_UpdateMomentVisibility_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConduitServer).ResetUserPassword(ctx, req.(*ResetUserPwdRequest))
+		return srv.(ConduitServer).UpdateMomentVisibility(ctx, req.(*UpdateMomentVisibilityRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Conduit_UpdateUserInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateUserInfoRequest)
+func _Conduit_GetTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTimelineRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConduitServer).UpdateUserInfo(ctx, in)
+		return srv.(ConduitServer).GetTimeline(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Conduit_UpdateUserInfo_FullMethodName,
+		FullMethod: Conduit_GetTimeline_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConduitServer).UpdateUserInfo(ctx, req.(*UpdateUserInfoRequest))
+		return srv.(ConduitServer).GetTimeline(ctx, req.(*GetTimelineRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Conduit_GetProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetProfileRequest)
+func _Conduit_GetSquare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSquareRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConduitServer).GetProfile(ctx, in)
+		return srv.(ConduitServer).GetSquare(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Conduit_GetProfile_FullMethodName,
+		FullMethod: Conduit_GetSquare_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConduitServer).GetProfile(ctx, req.(*GetProfileRequest))
+		return srv.(ConduitServer).GetSquare(ctx, req.(*GetSquareRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Conduit_FollowUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FollowUserRequest)
+func _Conduit_LikeMoment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LikeMomentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConduitServer).FollowUser(ctx, in)
+		return srv.(ConduitServer).LikeMoment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Conduit_FollowUser_FullMethodName,
+		FullMethod: Conduit_LikeMoment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConduitServer).FollowUser(ctx, req.(*FollowUserRequest))
+		return srv.(ConduitServer).LikeMoment(ctx, req.(*LikeMomentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Conduit_UnfollowUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnfollowUserRequest)
+func _Conduit_UnlikeMoment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlikeMomentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConduitServer).UnfollowUser(ctx, in)
+		return srv.(ConduitServer).UnlikeMoment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Conduit_UnfollowUser_FullMethodName,
+		FullMethod: Conduit_UnlikeMoment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConduitServer).UnfollowUser(ctx, req.(*UnfollowUserRequest))
+		return srv.(ConduitServer).UnlikeMoment(ctx, req.(*UnlikeMomentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Conduit_GetRelationship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RelationshipRequest)
+func _Conduit_ListComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCommentsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConduitServer).GetRelationship(ctx, in)
+		return srv.(ConduitServer).ListComments(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Conduit_GetRelationship_FullMethodName,
+		FullMethod: Conduit_ListComments_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConduitServer).GetRelationship(ctx, req.(*RelationshipRequest))
+		return srv.(ConduitServer).ListComments(ctx, req.(*ListCommentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Conduit_CanAddFriend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CanAddFriendReq)
+func _Conduit_LikeComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LikeCommentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConduitServer).CanAddFriend(ctx, in)
+		return srv.(ConduitServer).LikeComment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Conduit_CanAddFriend_FullMethodName,
+		FullMethod: Conduit_LikeComment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConduitServer).CanAddFriend(ctx, req.(*CanAddFriendReq))
+		return srv.(ConduitServer).LikeComment(ctx, req.(*LikeCommentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Conduit_GetMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMessagesRequest)
+func _Conduit_UnlikeComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlikeCommentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConduitServer).GetMessages(ctx, in)
+		return srv.(ConduitServer).UnlikeComment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Conduit_GetMessages_FullMethodName,
+		FullMethod: Conduit_UnlikeComment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConduitServer).GetMessages(ctx, req.(*GetMessagesRequest))
+		return srv.(ConduitServer).UnlikeComment(ctx, req.(*UnlikeCommentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -551,6 +2358,30 @@ var Conduit_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateUserInfo",
 			Handler:    _Conduit_UpdateUserInfo_Handler,
 		},
+		{
+			MethodName: "ListLoginHistory",
+			Handler:    _Conduit_ListLoginHistory_Handler,
+		},
+		{
+			MethodName: "ChangeHandle",
+			Handler:    _Conduit_ChangeHandle_Handler,
+		},
+		{
+			MethodName: "SearchUsers",
+			Handler:    _Conduit_SearchUsers_Handler,
+		},
+		{
+			MethodName: "GetPrivacySettings",
+			Handler:    _Conduit_GetPrivacySettings_Handler,
+		},
+		{
+			MethodName: "UpdatePrivacySettings",
+			Handler:    _Conduit_UpdatePrivacySettings_Handler,
+		},
+		{
+			MethodName: "UpdateSearchPrivacy",
+			Handler:    _Conduit_UpdateSearchPrivacy_Handler,
+		},
 		{
 			MethodName: "GetProfile",
 			Handler:    _Conduit_GetProfile_Handler,
@@ -563,6 +2394,42 @@ var Conduit_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UnfollowUser",
 			Handler:    _Conduit_UnfollowUser_Handler,
 		},
+		{
+			MethodName: "ListFollowing",
+			Handler:    _Conduit_ListFollowing_Handler,
+		},
+		{
+			MethodName: "ListFollowers",
+			Handler:    _Conduit_ListFollowers_Handler,
+		},
+		{
+			MethodName: "SuggestUsers",
+			Handler:    _Conduit_SuggestUsers_Handler,
+		},
+		{
+			MethodName: "DismissSuggestion",
+			Handler:    _Conduit_DismissSuggestion_Handler,
+		},
+		{
+			MethodName: "ListProfileVisitors",
+			Handler:    _Conduit_ListProfileVisitors_Handler,
+		},
+		{
+			MethodName: "UpdateVisitPrivacy",
+			Handler:    _Conduit_UpdateVisitPrivacy_Handler,
+		},
+		{
+			MethodName: "BanUser",
+			Handler:    _Conduit_BanUser_Handler,
+		},
+		{
+			MethodName: "UnbanUser",
+			Handler:    _Conduit_UnbanUser_Handler,
+		},
+		{
+			MethodName: "ListBanAudits",
+			Handler:    _Conduit_ListBanAudits_Handler,
+		},
 		{
 			MethodName: "GetRelationship",
 			Handler:    _Conduit_GetRelationship_Handler,
@@ -571,10 +2438,162 @@ var Conduit_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CanAddFriend",
 			Handler:    _Conduit_CanAddFriend_Handler,
 		},
+		{
+			MethodName: "SendFriendRequest",
+			Handler:    _Conduit_SendFriendRequest_Handler,
+		},
+		{
+			MethodName: "RemoveFriend",
+			Handler:    _Conduit_RemoveFriend_Handler,
+		},
+		{
+			MethodName: "ListFriendRequests",
+			Handler:    _Conduit_ListFriendRequests_Handler,
+		},
+		{
+			MethodName: "HandleFriendRequest",
+			Handler:    _Conduit_HandleFriendRequest_Handler,
+		},
+		{
+			MethodName: "ListFriends",
+			Handler:    _Conduit_ListFriends_Handler,
+		},
+		{
+			MethodName: "BlockUser",
+			Handler:    _Conduit_BlockUser_Handler,
+		},
+		{
+			MethodName: "UnblockUser",
+			Handler:    _Conduit_UnblockUser_Handler,
+		},
+		{
+			MethodName: "ListBlocks",
+			Handler:    _Conduit_ListBlocks_Handler,
+		},
 		{
 			MethodName: "GetMessages",
 			Handler:    _Conduit_GetMessages_Handler,
 		},
+		{
+			MethodName: "ListConversations",
+			Handler:    _Conduit_ListConversations_Handler,
+		},
+		{
+			MethodName: "ReadConversation",
+			Handler:    _Conduit_ReadConversation_Handler,
+		},
+		{
+			MethodName: "UpdateConversationSettings",
+			Handler:    _Conduit_UpdateConversationSettings_Handler,
+		},
+		{
+			MethodName: "GetConversationTimer",
+			Handler:    _Conduit_GetConversationTimer_Handler,
+		},
+		{
+			MethodName: "SetConversationTimer",
+			Handler:    _Conduit_SetConversationTimer_Handler,
+		},
+		{
+			MethodName: "CreateChatExport",
+			Handler:    _Conduit_CreateChatExport_Handler,
+		},
+		{
+			MethodName: "GetChatExport",
+			Handler:    _Conduit_GetChatExport_Handler,
+		},
+		{
+			MethodName: "UpdateGroupInfo",
+			Handler:    _Conduit_UpdateGroupInfo_Handler,
+		},
+		{
+			MethodName: "UpdateGroupSettings",
+			Handler:    _Conduit_UpdateGroupSettings_Handler,
+		},
+		{
+			MethodName: "SetGroupAdmin",
+			Handler:    _Conduit_SetGroupAdmin_Handler,
+		},
+		{
+			MethodName: "MuteGroupMember",
+			Handler:    _Conduit_MuteGroupMember_Handler,
+		},
+		{
+			MethodName: "CreateGroupInvite",
+			Handler:    _Conduit_CreateGroupInvite_Handler,
+		},
+		{
+			MethodName: "RevokeGroupInvite",
+			Handler:    _Conduit_RevokeGroupInvite_Handler,
+		},
+		{
+			MethodName: "JoinGroup",
+			Handler:    _Conduit_JoinGroup_Handler,
+		},
+		{
+			MethodName: "ListGroupJoinRequests",
+			Handler:    _Conduit_ListGroupJoinRequests_Handler,
+		},
+		{
+			MethodName: "HandleGroupJoinRequest",
+			Handler:    _Conduit_HandleGroupJoinRequest_Handler,
+		},
+		{
+			MethodName: "CreateMoment",
+			Handler:    _Conduit_CreateMoment_Handler,
+		},
+		{
+			MethodName: "GetMoment",
+			Handler:    _Conduit_GetMoment_Handler,
+		},
+		{
+			MethodName: "DeleteMoment",
+			Handler:    _Conduit_DeleteMoment_Handler,
+		},
+		{
+			MethodName: "CreateComment",
+			Handler:    _Conduit_CreateComment_Handler,
+		},
+		{
+			MethodName: "DeleteComment",
+			Handler:    _Conduit_DeleteComment_Handler,
+		},
+		{
+			MethodName: "UpdateMomentBlackList",
+			Handler:    _Conduit_UpdateMomentBlackList_Handler,
+		},
+		{
+			MethodName: "UpdateMomentVisibility",
+			Handler:    _Conduit_UpdateMomentVisibility_Handler,
+		},
+		{
+			MethodName: "GetTimeline",
+			Handler:    _Conduit_GetTimeline_Handler,
+		},
+		{
+			MethodName: "GetSquare",
+			Handler:    _Conduit_GetSquare_Handler,
+		},
+		{
+			MethodName: "LikeMoment",
+			Handler:    _Conduit_LikeMoment_Handler,
+		},
+		{
+			MethodName: "UnlikeMoment",
+			Handler:    _Conduit_UnlikeMoment_Handler,
+		},
+		{
+			MethodName: "ListComments",
+			Handler:    _Conduit_ListComments_Handler,
+		},
+		{
+			MethodName: "LikeComment",
+			Handler:    _Conduit_LikeComment_Handler,
+		},
+		{
+			MethodName: "UnlikeComment",
+			Handler:    _Conduit_UnlikeComment_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/conduit/v1/conduit.proto",
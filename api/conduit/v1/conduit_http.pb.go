@@ -19,34 +19,140 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationConduitBanUser = "/realworld.v1.Conduit/BanUser"
+const OperationConduitBlockUser = "/realworld.v1.Conduit/BlockUser"
 const OperationConduitCanAddFriend = "/realworld.v1.Conduit/CanAddFriend"
+const OperationConduitChangeHandle = "/realworld.v1.Conduit/ChangeHandle"
+const OperationConduitCreateChatExport = "/realworld.v1.Conduit/CreateChatExport"
+const OperationConduitCreateComment = "/realworld.v1.Conduit/CreateComment"
+const OperationConduitCreateGroupInvite = "/realworld.v1.Conduit/CreateGroupInvite"
+const OperationConduitCreateMoment = "/realworld.v1.Conduit/CreateMoment"
+const OperationConduitDeleteComment = "/realworld.v1.Conduit/DeleteComment"
+const OperationConduitDeleteMoment = "/realworld.v1.Conduit/DeleteMoment"
+const OperationConduitDismissSuggestion = "/realworld.v1.Conduit/DismissSuggestion"
 const OperationConduitFollowUser = "/realworld.v1.Conduit/FollowUser"
+const OperationConduitGetChatExport = "/realworld.v1.Conduit/GetChatExport"
+const OperationConduitGetConversationTimer = "/realworld.v1.Conduit/GetConversationTimer"
 const OperationConduitGetMessages = "/realworld.v1.Conduit/GetMessages"
+const OperationConduitGetMoment = "/realworld.v1.Conduit/GetMoment"
+const OperationConduitGetPrivacySettings = "/realworld.v1.Conduit/GetPrivacySettings"
 const OperationConduitGetProfile = "/realworld.v1.Conduit/GetProfile"
 const OperationConduitGetRelationship = "/realworld.v1.Conduit/GetRelationship"
+const OperationConduitGetSquare = "/realworld.v1.Conduit/GetSquare"
+const OperationConduitGetTimeline = "/realworld.v1.Conduit/GetTimeline"
+const OperationConduitHandleFriendRequest = "/realworld.v1.Conduit/HandleFriendRequest"
+const OperationConduitHandleGroupJoinRequest = "/realworld.v1.Conduit/HandleGroupJoinRequest"
+const OperationConduitJoinGroup = "/realworld.v1.Conduit/JoinGroup"
+const OperationConduitLikeComment = "/realworld.v1.Conduit/LikeComment"
+const OperationConduitLikeMoment = "/realworld.v1.Conduit/LikeMoment"
+const OperationConduitListBanAudits = "/realworld.v1.Conduit/ListBanAudits"
+const OperationConduitListBlocks = "/realworld.v1.Conduit/ListBlocks"
+const OperationConduitListComments = "/realworld.v1.Conduit/ListComments"
+const OperationConduitListConversations = "/realworld.v1.Conduit/ListConversations"
+const OperationConduitListFollowers = "/realworld.v1.Conduit/ListFollowers"
+const OperationConduitListFollowing = "/realworld.v1.Conduit/ListFollowing"
+const OperationConduitListFriendRequests = "/realworld.v1.Conduit/ListFriendRequests"
+const OperationConduitListFriends = "/realworld.v1.Conduit/ListFriends"
+const OperationConduitListGroupJoinRequests = "/realworld.v1.Conduit/ListGroupJoinRequests"
+const OperationConduitListLoginHistory = "/realworld.v1.Conduit/ListLoginHistory"
+const OperationConduitListProfileVisitors = "/realworld.v1.Conduit/ListProfileVisitors"
 const OperationConduitLogin = "/realworld.v1.Conduit/Login"
 const OperationConduitLoginBySms = "/realworld.v1.Conduit/LoginBySms"
+const OperationConduitMuteGroupMember = "/realworld.v1.Conduit/MuteGroupMember"
+const OperationConduitReadConversation = "/realworld.v1.Conduit/ReadConversation"
 const OperationConduitRegister = "/realworld.v1.Conduit/Register"
+const OperationConduitRemoveFriend = "/realworld.v1.Conduit/RemoveFriend"
 const OperationConduitResetUserPassword = "/realworld.v1.Conduit/ResetUserPassword"
+const OperationConduitRevokeGroupInvite = "/realworld.v1.Conduit/RevokeGroupInvite"
+const OperationConduitSearchUsers = "/realworld.v1.Conduit/SearchUsers"
+const OperationConduitSendFriendRequest = "/realworld.v1.Conduit/SendFriendRequest"
 const OperationConduitSendSms = "/realworld.v1.Conduit/SendSms"
+const OperationConduitSetConversationTimer = "/realworld.v1.Conduit/SetConversationTimer"
+const OperationConduitSetGroupAdmin = "/realworld.v1.Conduit/SetGroupAdmin"
+const OperationConduitSuggestUsers = "/realworld.v1.Conduit/SuggestUsers"
+const OperationConduitUnbanUser = "/realworld.v1.Conduit/UnbanUser"
+const OperationConduitUnblockUser = "/realworld.v1.Conduit/UnblockUser"
 const OperationConduitUnfollowUser = "/realworld.v1.Conduit/UnfollowUser"
+const OperationConduitUnlikeComment = "/realworld.v1.Conduit/UnlikeComment"
+const OperationConduitUnlikeMoment = "/realworld.v1.Conduit/UnlikeMoment"
+const OperationConduitUpdateConversationSettings = "/realworld.v1.Conduit/UpdateConversationSettings"
+const OperationConduitUpdateGroupInfo = "/realworld.v1.Conduit/UpdateGroupInfo"
+const OperationConduitUpdateGroupSettings = "/realworld.v1.Conduit/UpdateGroupSettings"
+const OperationConduitUpdateMomentBlackList = "/realworld.v1.Conduit/UpdateMomentBlackList"
+const OperationConduitUpdateMomentVisibility = "/realworld.v1.Conduit/UpdateMomentVisibility"
+const OperationConduitUpdatePrivacySettings = "/realworld.v1.Conduit/UpdatePrivacySettings"
+const OperationConduitUpdateSearchPrivacy = "/realworld.v1.Conduit/UpdateSearchPrivacy"
 const OperationConduitUpdateUserInfo = "/realworld.v1.Conduit/UpdateUserInfo"
 const OperationConduitUpdateUserPassword = "/realworld.v1.Conduit/UpdateUserPassword"
+const OperationConduitUpdateVisitPrivacy = "/realworld.v1.Conduit/UpdateVisitPrivacy"
 
 type ConduitHTTPServer interface {
+	BanUser(context.Context, *BanUserRequest) (*BanUserReply, error)
+	BlockUser(context.Context, *BlockUserReq) (*BlockReply, error)
 	CanAddFriend(context.Context, *CanAddFriendReq) (*CanAddFriendRes, error)
+	ChangeHandle(context.Context, *ChangeHandleRequest) (*ChangeHandleReply, error)
+	CreateChatExport(context.Context, *CreateChatExportRequest) (*ChatExportReply, error)
+	CreateComment(context.Context, *CreateCommentRequest) (*CommentReply, error)
+	CreateGroupInvite(context.Context, *CreateGroupInviteRequest) (*GroupInviteReply, error)
+	CreateMoment(context.Context, *CreateMomentRequest) (*MomentReply, error)
+	DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentReply, error)
+	DeleteMoment(context.Context, *DeleteMomentRequest) (*DeleteMomentReply, error)
+	DismissSuggestion(context.Context, *DismissSuggestionRequest) (*DismissSuggestionReply, error)
 	FollowUser(context.Context, *FollowUserRequest) (*FollowFanReply, error)
+	GetChatExport(context.Context, *GetChatExportRequest) (*ChatExportReply, error)
+	GetConversationTimer(context.Context, *GetConversationTimerRequest) (*ConversationTimerReply, error)
 	GetMessages(context.Context, *GetMessagesRequest) (*GetMessagesReply, error)
+	GetMoment(context.Context, *GetMomentRequest) (*MomentReply, error)
+	GetPrivacySettings(context.Context, *GetPrivacySettingsRequest) (*PrivacySettingsReply, error)
 	GetProfile(context.Context, *GetProfileRequest) (*GetProfileReply, error)
 	GetRelationship(context.Context, *RelationshipRequest) (*RelationshipReply, error)
+	GetSquare(context.Context, *GetSquareRequest) (*GetSquareReply, error)
+	GetTimeline(context.Context, *GetTimelineRequest) (*GetTimelineReply, error)
+	HandleFriendRequest(context.Context, *HandleFriendRequestReq) (*FriendRequestReply, error)
+	HandleGroupJoinRequest(context.Context, *HandleGroupJoinRequestRequest) (*GroupJoinRequestReply, error)
+	JoinGroup(context.Context, *JoinGroupRequest) (*JoinGroupReply, error)
+	LikeComment(context.Context, *LikeCommentRequest) (*CommentLikeReply, error)
+	LikeMoment(context.Context, *LikeMomentRequest) (*MomentLikeReply, error)
+	ListBanAudits(context.Context, *ListBanAuditsRequest) (*ListBanAuditsReply, error)
+	ListBlocks(context.Context, *ListBlocksReq) (*ListBlocksReply, error)
+	ListComments(context.Context, *ListCommentsRequest) (*ListCommentsReply, error)
+	ListConversations(context.Context, *ListConversationsRequest) (*ListConversationsReply, error)
+	ListFollowers(context.Context, *ListFollowRequest) (*ListFollowReply, error)
+	ListFollowing(context.Context, *ListFollowRequest) (*ListFollowReply, error)
+	ListFriendRequests(context.Context, *ListFriendRequestsReq) (*ListFriendRequestsReply, error)
+	ListFriends(context.Context, *ListFriendsReq) (*ListFriendsReply, error)
+	ListGroupJoinRequests(context.Context, *ListGroupJoinRequestsRequest) (*ListGroupJoinRequestsReply, error)
+	ListLoginHistory(context.Context, *ListLoginHistoryRequest) (*ListLoginHistoryReply, error)
+	ListProfileVisitors(context.Context, *ListProfileVisitorsRequest) (*ListProfileVisitorsReply, error)
 	Login(context.Context, *LoginRequest) (*LoginReply, error)
 	LoginBySms(context.Context, *LoginBySmsRequest) (*LoginReply, error)
+	MuteGroupMember(context.Context, *MuteGroupMemberRequest) (*GroupMemberReply, error)
+	ReadConversation(context.Context, *ReadConversationRequest) (*ReadConversationReply, error)
 	Register(context.Context, *RegisterRequest) (*RegisterReply, error)
+	RemoveFriend(context.Context, *RemoveFriendReq) (*RemoveFriendReply, error)
 	ResetUserPassword(context.Context, *ResetUserPwdRequest) (*ResetUserPwdReply, error)
+	RevokeGroupInvite(context.Context, *RevokeGroupInviteRequest) (*RevokeGroupInviteReply, error)
+	SearchUsers(context.Context, *SearchUsersRequest) (*SearchUsersReply, error)
+	SendFriendRequest(context.Context, *SendFriendRequestReq) (*FriendRequestReply, error)
 	SendSms(context.Context, *SendSmsRequest) (*SendSmsReply, error)
+	SetConversationTimer(context.Context, *SetConversationTimerRequest) (*ConversationTimerReply, error)
+	SetGroupAdmin(context.Context, *SetGroupAdminRequest) (*GroupMemberReply, error)
+	SuggestUsers(context.Context, *SuggestUsersRequest) (*SuggestUsersReply, error)
+	UnbanUser(context.Context, *UnbanUserRequest) (*BanUserReply, error)
+	UnblockUser(context.Context, *UnblockUserReq) (*BlockReply, error)
 	UnfollowUser(context.Context, *UnfollowUserRequest) (*FollowFanReply, error)
+	UnlikeComment(context.Context, *UnlikeCommentRequest) (*CommentLikeReply, error)
+	UnlikeMoment(context.Context, *UnlikeMomentRequest) (*MomentLikeReply, error)
+	UpdateConversationSettings(context.Context, *UpdateConversationSettingsRequest) (*UpdateConversationSettingsReply, error)
+	UpdateGroupInfo(context.Context, *UpdateGroupInfoRequest) (*GroupReply, error)
+	UpdateGroupSettings(context.Context, *UpdateGroupSettingsRequest) (*GroupReply, error)
+	UpdateMomentBlackList(context.Context, *UpdateMomentBlackListRequest) (*UpdateMomentBlackListReply, error)
+	UpdateMomentVisibility(context.Context, *UpdateMomentVisibilityRequest) (*UpdateMomentVisibilityReply, error)
+	UpdatePrivacySettings(context.Context, *UpdatePrivacySettingsRequest) (*PrivacySettingsReply, error)
+	UpdateSearchPrivacy(context.Context, *UpdateSearchPrivacyRequest) (*UpdateSearchPrivacyReply, error)
 	UpdateUserInfo(context.Context, *UpdateUserInfoRequest) (*UpdateUserInfoReply, error)
 	UpdateUserPassword(context.Context, *UpdateUserPwdRequest) (*UpdateUserPwdReply, error)
+	UpdateVisitPrivacy(context.Context, *UpdateVisitPrivacyRequest) (*UpdateVisitPrivacyReply, error)
 }
 
 func RegisterConduitHTTPServer(s *http.Server, srv ConduitHTTPServer) {
@@ -58,12 +164,65 @@ func RegisterConduitHTTPServer(s *http.Server, srv ConduitHTTPServer) {
 	r.POST("/api/users/updatePassword", _Conduit_UpdateUserPassword0_HTTP_Handler(srv))
 	r.POST("/api/users/resetPassword", _Conduit_ResetUserPassword0_HTTP_Handler(srv))
 	r.PUT("/api/users/updateUserInfo", _Conduit_UpdateUserInfo0_HTTP_Handler(srv))
+	r.GET("/api/users/loginHistory", _Conduit_ListLoginHistory0_HTTP_Handler(srv))
+	r.POST("/api/users/handle", _Conduit_ChangeHandle0_HTTP_Handler(srv))
+	r.GET("/api/search/users", _Conduit_SearchUsers0_HTTP_Handler(srv))
+	r.GET("/api/settings/privacy", _Conduit_GetPrivacySettings0_HTTP_Handler(srv))
+	r.PUT("/api/settings/privacy", _Conduit_UpdatePrivacySettings0_HTTP_Handler(srv))
+	r.POST("/api/search/privacy", _Conduit_UpdateSearchPrivacy0_HTTP_Handler(srv))
 	r.GET("/api/profiles/{user_id}", _Conduit_GetProfile0_HTTP_Handler(srv))
 	r.POST("/api/profiles/{target_id}/follow", _Conduit_FollowUser0_HTTP_Handler(srv))
 	r.POST("/api/profiles/{target_id}/unfollow", _Conduit_UnfollowUser0_HTTP_Handler(srv))
+	r.GET("/api/profiles/{user_id}/following", _Conduit_ListFollowing0_HTTP_Handler(srv))
+	r.GET("/api/profiles/{user_id}/followers", _Conduit_ListFollowers0_HTTP_Handler(srv))
+	r.GET("/api/suggestions/users", _Conduit_SuggestUsers0_HTTP_Handler(srv))
+	r.POST("/api/suggestions/users/{target_id}/dismiss", _Conduit_DismissSuggestion0_HTTP_Handler(srv))
+	r.GET("/api/visitors", _Conduit_ListProfileVisitors0_HTTP_Handler(srv))
+	r.POST("/api/visitors/privacy", _Conduit_UpdateVisitPrivacy0_HTTP_Handler(srv))
+	r.POST("/api/admin/users/{user_id}/ban", _Conduit_BanUser0_HTTP_Handler(srv))
+	r.POST("/api/admin/users/{user_id}/unban", _Conduit_UnbanUser0_HTTP_Handler(srv))
+	r.GET("/api/admin/bans", _Conduit_ListBanAudits0_HTTP_Handler(srv))
 	r.GET("/api/profiles/{target_id}/relationship", _Conduit_GetRelationship0_HTTP_Handler(srv))
 	r.POST("/api/profiles/{target_id}/canAddFriend", _Conduit_CanAddFriend0_HTTP_Handler(srv))
+	r.POST("/api/profiles/{target_id}/friendRequest", _Conduit_SendFriendRequest0_HTTP_Handler(srv))
+	r.POST("/api/profiles/{target_id}/unfriend", _Conduit_RemoveFriend0_HTTP_Handler(srv))
+	r.GET("/api/friends/requests", _Conduit_ListFriendRequests0_HTTP_Handler(srv))
+	r.POST("/api/friends/requests/{request_id}", _Conduit_HandleFriendRequest0_HTTP_Handler(srv))
+	r.GET("/api/friends", _Conduit_ListFriends0_HTTP_Handler(srv))
+	r.POST("/api/profiles/{target_id}/block", _Conduit_BlockUser0_HTTP_Handler(srv))
+	r.POST("/api/profiles/{target_id}/unblock", _Conduit_UnblockUser0_HTTP_Handler(srv))
+	r.GET("/api/blocks", _Conduit_ListBlocks0_HTTP_Handler(srv))
 	r.GET("/api/chat", _Conduit_GetMessages0_HTTP_Handler(srv))
+	r.GET("/api/conversations", _Conduit_ListConversations0_HTTP_Handler(srv))
+	r.POST("/api/conversations/{target_id}/read", _Conduit_ReadConversation0_HTTP_Handler(srv))
+	r.POST("/api/conversations/{target_id}/settings", _Conduit_UpdateConversationSettings0_HTTP_Handler(srv))
+	r.GET("/api/conversations/{target_id}/timer", _Conduit_GetConversationTimer0_HTTP_Handler(srv))
+	r.POST("/api/conversations/{target_id}/timer", _Conduit_SetConversationTimer0_HTTP_Handler(srv))
+	r.POST("/api/conversations/{target_id}/export", _Conduit_CreateChatExport0_HTTP_Handler(srv))
+	r.GET("/api/exports/{export_id}", _Conduit_GetChatExport0_HTTP_Handler(srv))
+	r.PUT("/api/groups/{group_id}", _Conduit_UpdateGroupInfo0_HTTP_Handler(srv))
+	r.POST("/api/groups/{group_id}/settings", _Conduit_UpdateGroupSettings0_HTTP_Handler(srv))
+	r.POST("/api/groups/{group_id}/admins", _Conduit_SetGroupAdmin0_HTTP_Handler(srv))
+	r.POST("/api/groups/{group_id}/members/{user_id}/mute", _Conduit_MuteGroupMember0_HTTP_Handler(srv))
+	r.POST("/api/groups/{group_id}/invites", _Conduit_CreateGroupInvite0_HTTP_Handler(srv))
+	r.POST("/api/groups/{group_id}/invites/{token}/revoke", _Conduit_RevokeGroupInvite0_HTTP_Handler(srv))
+	r.POST("/api/groups/join", _Conduit_JoinGroup0_HTTP_Handler(srv))
+	r.GET("/api/groups/{group_id}/joinRequests", _Conduit_ListGroupJoinRequests0_HTTP_Handler(srv))
+	r.POST("/api/groups/{group_id}/joinRequests/{request_id}", _Conduit_HandleGroupJoinRequest0_HTTP_Handler(srv))
+	r.POST("/api/moments", _Conduit_CreateMoment0_HTTP_Handler(srv))
+	r.GET("/api/moments/{moment_id}", _Conduit_GetMoment0_HTTP_Handler(srv))
+	r.DELETE("/api/moments/{moment_id}", _Conduit_DeleteMoment0_HTTP_Handler(srv))
+	r.POST("/api/moments/{moment_id}/comments", _Conduit_CreateComment0_HTTP_Handler(srv))
+	r.DELETE("/api/moments/{moment_id}/comments/{comment_id}", _Conduit_DeleteComment0_HTTP_Handler(srv))
+	r.PUT("/api/moments/{moment_id}/blacklist", _Conduit_UpdateMomentBlackList0_HTTP_Handler(srv))
+	r.PUT("/api/moments/{moment_id}/visibility", _Conduit_UpdateMomentVisibility0_HTTP_Handler(srv))
+	r.GET("/api/timeline", _Conduit_GetTimeline0_HTTP_Handler(srv))
+	r.GET("/api/square", _Conduit_GetSquare0_HTTP_Handler(srv))
+	r.POST("/api/moments/{moment_id}/like", _Conduit_LikeMoment0_HTTP_Handler(srv))
+	r.DELETE("/api/moments/{moment_id}/like", _Conduit_UnlikeMoment0_HTTP_Handler(srv))
+	r.GET("/api/moments/{moment_id}/comments", _Conduit_ListComments0_HTTP_Handler(srv))
+	r.POST("/api/moments/{moment_id}/comments/{comment_id}/like", _Conduit_LikeComment0_HTTP_Handler(srv))
+	r.DELETE("/api/moments/{moment_id}/comments/{comment_id}/like", _Conduit_UnlikeComment0_HTTP_Handler(srv))
 }
 
 func _Conduit_Register0_HTTP_Handler(srv ConduitHTTPServer) func(ctx http.Context) error {
@@ -220,6 +379,129 @@ func _Conduit_UpdateUserInfo0_HTTP_Handler(srv ConduitHTTPServer) func(ctx http.
 	}
 }
 
+func _Conduit_ListLoginHistory0_HTTP_Handler(srv ConduitHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListLoginHistoryRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConduitListLoginHistory)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListLoginHistory(ctx, req.(*ListLoginHistoryRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListLoginHistoryReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Conduit_ChangeHandle0_HTTP_Handler(srv ConduitHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ChangeHandleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConduitChangeHandle)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ChangeHandle(ctx, req.(*ChangeHandleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ChangeHandleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Conduit_SearchUsers0_HTTP_Handler(srv ConduitHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SearchUsersRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConduitSearchUsers)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SearchUsers(ctx, req.(*SearchUsersRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SearchUsersReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Conduit_GetPrivacySettings0_HTTP_Handler(srv ConduitHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetPrivacySettingsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConduitGetPrivacySettings)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetPrivacySettings(ctx, req.(*GetPrivacySettingsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*PrivacySettingsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Conduit_UpdatePrivacySettings0_HTTP_Handler(srv ConduitHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdatePrivacySettingsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConduitUpdatePrivacySettings)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdatePrivacySettings(ctx, req.(*UpdatePrivacySettingsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*PrivacySettingsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Conduit_UpdateSearchPrivacy0_HTTP_Handler(srv ConduitHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateSearchPrivacyRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConduitUpdateSearchPrivacy)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateSearchPrivacy(ctx, req.(*UpdateSearchPrivacyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateSearchPrivacyReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _Conduit_GetProfile0_HTTP_Handler(srv ConduitHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in GetProfileRequest
@@ -292,6 +574,204 @@ func _Conduit_UnfollowUser0_HTTP_Handler(srv ConduitHTTPServer) func(ctx http.Co
 	}
 }
 
+func _Conduit_ListFollowing0_HTTP_Handler(srv ConduitHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListFollowRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConduitListFollowing)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListFollowing(ctx, req.(*ListFollowRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListFollowReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Conduit_ListFollowers0_HTTP_Handler(srv ConduitHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListFollowRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConduitListFollowers)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListFollowers(ctx, req.(*ListFollowRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListFollowReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Conduit_SuggestUsers0_HTTP_Handler(srv ConduitHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SuggestUsersRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConduitSuggestUsers)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SuggestUsers(ctx, req.(*SuggestUsersRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SuggestUsersReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Conduit_DismissSuggestion0_HTTP_Handler(srv ConduitHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DismissSuggestionRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConduitDismissSuggestion)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DismissSuggestion(ctx, req.(*DismissSuggestionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DismissSuggestionReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Conduit_ListProfileVisitors0_HTTP_Handler(srv ConduitHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListProfileVisitorsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConduitListProfileVisitors)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListProfileVisitors(ctx, req.(*ListProfileVisitorsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListProfileVisitorsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Conduit_UpdateVisitPrivacy0_HTTP_Handler(srv ConduitHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateVisitPrivacyRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConduitUpdateVisitPrivacy)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateVisitPrivacy(ctx, req.(*UpdateVisitPrivacyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateVisitPrivacyReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Conduit_BanUser0_HTTP_Handler(srv ConduitHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BanUserRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConduitBanUser)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.BanUser(ctx, req.(*BanUserRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BanUserReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Conduit_UnbanUser0_HTTP_Handler(srv ConduitHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UnbanUserRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConduitUnbanUser)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UnbanUser(ctx, req.(*UnbanUserRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BanUserReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Conduit_ListBanAudits0_HTTP_Handler(srv ConduitHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListBanAuditsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConduitListBanAudits)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListBanAudits(ctx, req.(*ListBanAuditsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListBanAuditsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _Conduit_GetRelationship0_HTTP_Handler(srv ConduitHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in RelationshipRequest
@@ -339,15 +819,197 @@ func _Conduit_CanAddFriend0_HTTP_Handler(srv ConduitHTTPServer) func(ctx http.Co
 	}
 }
 
-func _Conduit_GetMessages0_HTTP_Handler(srv ConduitHTTPServer) func(ctx http.Context) error {
+func _Conduit_SendFriendRequest0_HTTP_Handler(srv ConduitHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in GetMessagesRequest
+		var in SendFriendRequestReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationConduitGetMessages)
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConduitSendFriendRequest)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.GetMessages(ctx, req.(*GetMessagesRequest))
+			return srv.SendFriendRequest(ctx, req.(*SendFriendRequestReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*FriendRequestReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Conduit_RemoveFriend0_HTTP_Handler(srv ConduitHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RemoveFriendReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConduitRemoveFriend)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RemoveFriend(ctx, req.(*RemoveFriendReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RemoveFriendReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Conduit_ListFriendRequests0_HTTP_Handler(srv ConduitHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListFriendRequestsReq
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConduitListFriendRequests)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListFriendRequests(ctx, req.(*ListFriendRequestsReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListFriendRequestsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Conduit_HandleFriendRequest0_HTTP_Handler(srv ConduitHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in HandleFriendRequestReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConduitHandleFriendRequest)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.HandleFriendRequest(ctx, req.(*HandleFriendRequestReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*FriendRequestReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Conduit_ListFriends0_HTTP_Handler(srv ConduitHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListFriendsReq
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConduitListFriends)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListFriends(ctx, req.(*ListFriendsReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListFriendsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Conduit_BlockUser0_HTTP_Handler(srv ConduitHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BlockUserReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConduitBlockUser)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.BlockUser(ctx, req.(*BlockUserReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BlockReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Conduit_UnblockUser0_HTTP_Handler(srv ConduitHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UnblockUserReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConduitUnblockUser)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UnblockUser(ctx, req.(*UnblockUserReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BlockReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Conduit_ListBlocks0_HTTP_Handler(srv ConduitHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListBlocksReq
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConduitListBlocks)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListBlocks(ctx, req.(*ListBlocksReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListBlocksReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Conduit_GetMessages0_HTTP_Handler(srv ConduitHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetMessagesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConduitGetMessages)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetMessages(ctx, req.(*GetMessagesRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
@@ -358,61 +1020,1229 @@ func _Conduit_GetMessages0_HTTP_Handler(srv ConduitHTTPServer) func(ctx http.Con
 	}
 }
 
-type ConduitHTTPClient interface {
-	CanAddFriend(ctx context.Context, req *CanAddFriendReq, opts ...http.CallOption) (rsp *CanAddFriendRes, err error)
-	FollowUser(ctx context.Context, req *FollowUserRequest, opts ...http.CallOption) (rsp *FollowFanReply, err error)
-	GetMessages(ctx context.Context, req *GetMessagesRequest, opts ...http.CallOption) (rsp *GetMessagesReply, err error)
-	GetProfile(ctx context.Context, req *GetProfileRequest, opts ...http.CallOption) (rsp *GetProfileReply, err error)
-	GetRelationship(ctx context.Context, req *RelationshipRequest, opts ...http.CallOption) (rsp *RelationshipReply, err error)
-	Login(ctx context.Context, req *LoginRequest, opts ...http.CallOption) (rsp *LoginReply, err error)
-	LoginBySms(ctx context.Context, req *LoginBySmsRequest, opts ...http.CallOption) (rsp *LoginReply, err error)
-	Register(ctx context.Context, req *RegisterRequest, opts ...http.CallOption) (rsp *RegisterReply, err error)
-	ResetUserPassword(ctx context.Context, req *ResetUserPwdRequest, opts ...http.CallOption) (rsp *ResetUserPwdReply, err error)
-	SendSms(ctx context.Context, req *SendSmsRequest, opts ...http.CallOption) (rsp *SendSmsReply, err error)
-	UnfollowUser(ctx context.Context, req *UnfollowUserRequest, opts ...http.CallOption) (rsp *FollowFanReply, err error)
-	UpdateUserInfo(ctx context.Context, req *UpdateUserInfoRequest, opts ...http.CallOption) (rsp *UpdateUserInfoReply, err error)
-	UpdateUserPassword(ctx context.Context, req *UpdateUserPwdRequest, opts ...http.CallOption) (rsp *UpdateUserPwdReply, err error)
+func _Conduit_ListConversations0_HTTP_Handler(srv ConduitHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListConversationsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConduitListConversations)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListConversations(ctx, req.(*ListConversationsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListConversationsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Conduit_ReadConversation0_HTTP_Handler(srv ConduitHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ReadConversationRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConduitReadConversation)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ReadConversation(ctx, req.(*ReadConversationRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ReadConversationReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Conduit_UpdateConversationSettings0_HTTP_Handler(srv ConduitHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateConversationSettingsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConduitUpdateConversationSettings)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateConversationSettings(ctx, req.(*UpdateConversationSettingsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateConversationSettingsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Conduit_GetConversationTimer0_HTTP_Handler(srv ConduitHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetConversationTimerRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConduitGetConversationTimer)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetConversationTimer(ctx, req.(*GetConversationTimerRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ConversationTimerReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Conduit_SetConversationTimer0_HTTP_Handler(srv ConduitHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetConversationTimerRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConduitSetConversationTimer)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetConversationTimer(ctx, req.(*SetConversationTimerRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ConversationTimerReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Conduit_CreateChatExport0_HTTP_Handler(srv ConduitHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateChatExportRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConduitCreateChatExport)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateChatExport(ctx, req.(*CreateChatExportRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ChatExportReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Conduit_GetChatExport0_HTTP_Handler(srv ConduitHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetChatExportRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConduitGetChatExport)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetChatExport(ctx, req.(*GetChatExportRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ChatExportReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Conduit_UpdateGroupInfo0_HTTP_Handler(srv ConduitHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateGroupInfoRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConduitUpdateGroupInfo)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateGroupInfo(ctx, req.(*UpdateGroupInfoRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GroupReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Conduit_UpdateGroupSettings0_HTTP_Handler(srv ConduitHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateGroupSettingsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConduitUpdateGroupSettings)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateGroupSettings(ctx, req.(*UpdateGroupSettingsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GroupReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Conduit_SetGroupAdmin0_HTTP_Handler(srv ConduitHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetGroupAdminRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConduitSetGroupAdmin)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetGroupAdmin(ctx, req.(*SetGroupAdminRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GroupMemberReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Conduit_MuteGroupMember0_HTTP_Handler(srv ConduitHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in MuteGroupMemberRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConduitMuteGroupMember)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.MuteGroupMember(ctx, req.(*MuteGroupMemberRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GroupMemberReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Conduit_CreateGroupInvite0_HTTP_Handler(srv ConduitHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateGroupInviteRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConduitCreateGroupInvite)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateGroupInvite(ctx, req.(*CreateGroupInviteRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GroupInviteReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Conduit_RevokeGroupInvite0_HTTP_Handler(srv ConduitHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RevokeGroupInviteRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConduitRevokeGroupInvite)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RevokeGroupInvite(ctx, req.(*RevokeGroupInviteRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RevokeGroupInviteReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Conduit_JoinGroup0_HTTP_Handler(srv ConduitHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in JoinGroupRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConduitJoinGroup)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.JoinGroup(ctx, req.(*JoinGroupRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*JoinGroupReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Conduit_ListGroupJoinRequests0_HTTP_Handler(srv ConduitHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListGroupJoinRequestsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConduitListGroupJoinRequests)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListGroupJoinRequests(ctx, req.(*ListGroupJoinRequestsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListGroupJoinRequestsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Conduit_HandleGroupJoinRequest0_HTTP_Handler(srv ConduitHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in HandleGroupJoinRequestRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConduitHandleGroupJoinRequest)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.HandleGroupJoinRequest(ctx, req.(*HandleGroupJoinRequestRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GroupJoinRequestReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Conduit_CreateMoment0_HTTP_Handler(srv ConduitHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateMomentRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConduitCreateMoment)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateMoment(ctx, req.(*CreateMomentRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MomentReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Conduit_GetMoment0_HTTP_Handler(srv ConduitHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetMomentRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConduitGetMoment)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetMoment(ctx, req.(*GetMomentRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MomentReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Conduit_DeleteMoment0_HTTP_Handler(srv ConduitHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteMomentRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConduitDeleteMoment)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteMoment(ctx, req.(*DeleteMomentRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteMomentReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Conduit_CreateComment0_HTTP_Handler(srv ConduitHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateCommentRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConduitCreateComment)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateComment(ctx, req.(*CreateCommentRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CommentReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Conduit_DeleteComment0_HTTP_Handler(srv ConduitHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteCommentRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConduitDeleteComment)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteComment(ctx, req.(*DeleteCommentRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteCommentReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Conduit_UpdateMomentBlackList0_HTTP_Handler(srv ConduitHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateMomentBlackListRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConduitUpdateMomentBlackList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateMomentBlackList(ctx, req.(*UpdateMomentBlackListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateMomentBlackListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Conduit_UpdateMomentVisibility0_HTTP_Handler(srv ConduitHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateMomentVisibilityRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConduitUpdateMomentVisibility)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateMomentVisibility(ctx, req.(*UpdateMomentVisibilityRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateMomentVisibilityReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Conduit_GetTimeline0_HTTP_Handler(srv ConduitHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetTimelineRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConduitGetTimeline)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetTimeline(ctx, req.(*GetTimelineRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetTimelineReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Conduit_GetSquare0_HTTP_Handler(srv ConduitHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetSquareRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConduitGetSquare)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetSquare(ctx, req.(*GetSquareRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetSquareReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Conduit_LikeMoment0_HTTP_Handler(srv ConduitHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LikeMomentRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConduitLikeMoment)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.LikeMoment(ctx, req.(*LikeMomentRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MomentLikeReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Conduit_UnlikeMoment0_HTTP_Handler(srv ConduitHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UnlikeMomentRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConduitUnlikeMoment)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UnlikeMoment(ctx, req.(*UnlikeMomentRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MomentLikeReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Conduit_ListComments0_HTTP_Handler(srv ConduitHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListCommentsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConduitListComments)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListComments(ctx, req.(*ListCommentsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListCommentsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Conduit_LikeComment0_HTTP_Handler(srv ConduitHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LikeCommentRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConduitLikeComment)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.LikeComment(ctx, req.(*LikeCommentRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CommentLikeReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Conduit_UnlikeComment0_HTTP_Handler(srv ConduitHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UnlikeCommentRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConduitUnlikeComment)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UnlikeComment(ctx, req.(*UnlikeCommentRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CommentLikeReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+type ConduitHTTPClient interface {
+	BanUser(ctx context.Context, req *BanUserRequest, opts ...http.CallOption) (rsp *BanUserReply, err error)
+	BlockUser(ctx context.Context, req *BlockUserReq, opts ...http.CallOption) (rsp *BlockReply, err error)
+	CanAddFriend(ctx context.Context, req *CanAddFriendReq, opts ...http.CallOption) (rsp *CanAddFriendRes, err error)
+	ChangeHandle(ctx context.Context, req *ChangeHandleRequest, opts ...http.CallOption) (rsp *ChangeHandleReply, err error)
+	CreateChatExport(ctx context.Context, req *CreateChatExportRequest, opts ...http.CallOption) (rsp *ChatExportReply, err error)
+	CreateComment(ctx context.Context, req *CreateCommentRequest, opts ...http.CallOption) (rsp *CommentReply, err error)
+	CreateGroupInvite(ctx context.Context, req *CreateGroupInviteRequest, opts ...http.CallOption) (rsp *GroupInviteReply, err error)
+	CreateMoment(ctx context.Context, req *CreateMomentRequest, opts ...http.CallOption) (rsp *MomentReply, err error)
+	DeleteComment(ctx context.Context, req *DeleteCommentRequest, opts ...http.CallOption) (rsp *DeleteCommentReply, err error)
+	DeleteMoment(ctx context.Context, req *DeleteMomentRequest, opts ...http.CallOption) (rsp *DeleteMomentReply, err error)
+	DismissSuggestion(ctx context.Context, req *DismissSuggestionRequest, opts ...http.CallOption) (rsp *DismissSuggestionReply, err error)
+	FollowUser(ctx context.Context, req *FollowUserRequest, opts ...http.CallOption) (rsp *FollowFanReply, err error)
+	GetChatExport(ctx context.Context, req *GetChatExportRequest, opts ...http.CallOption) (rsp *ChatExportReply, err error)
+	GetConversationTimer(ctx context.Context, req *GetConversationTimerRequest, opts ...http.CallOption) (rsp *ConversationTimerReply, err error)
+	GetMessages(ctx context.Context, req *GetMessagesRequest, opts ...http.CallOption) (rsp *GetMessagesReply, err error)
+	GetMoment(ctx context.Context, req *GetMomentRequest, opts ...http.CallOption) (rsp *MomentReply, err error)
+	GetPrivacySettings(ctx context.Context, req *GetPrivacySettingsRequest, opts ...http.CallOption) (rsp *PrivacySettingsReply, err error)
+	GetProfile(ctx context.Context, req *GetProfileRequest, opts ...http.CallOption) (rsp *GetProfileReply, err error)
+	GetRelationship(ctx context.Context, req *RelationshipRequest, opts ...http.CallOption) (rsp *RelationshipReply, err error)
+	GetSquare(ctx context.Context, req *GetSquareRequest, opts ...http.CallOption) (rsp *GetSquareReply, err error)
+	GetTimeline(ctx context.Context, req *GetTimelineRequest, opts ...http.CallOption) (rsp *GetTimelineReply, err error)
+	HandleFriendRequest(ctx context.Context, req *HandleFriendRequestReq, opts ...http.CallOption) (rsp *FriendRequestReply, err error)
+	HandleGroupJoinRequest(ctx context.Context, req *HandleGroupJoinRequestRequest, opts ...http.CallOption) (rsp *GroupJoinRequestReply, err error)
+	JoinGroup(ctx context.Context, req *JoinGroupRequest, opts ...http.CallOption) (rsp *JoinGroupReply, err error)
+	LikeComment(ctx context.Context, req *LikeCommentRequest, opts ...http.CallOption) (rsp *CommentLikeReply, err error)
+	LikeMoment(ctx context.Context, req *LikeMomentRequest, opts ...http.CallOption) (rsp *MomentLikeReply, err error)
+	ListBanAudits(ctx context.Context, req *ListBanAuditsRequest, opts ...http.CallOption) (rsp *ListBanAuditsReply, err error)
+	ListBlocks(ctx context.Context, req *ListBlocksReq, opts ...http.CallOption) (rsp *ListBlocksReply, err error)
+	ListComments(ctx context.Context, req *ListCommentsRequest, opts ...http.CallOption) (rsp *ListCommentsReply, err error)
+	ListConversations(ctx context.Context, req *ListConversationsRequest, opts ...http.CallOption) (rsp *ListConversationsReply, err error)
+	ListFollowers(ctx context.Context, req *ListFollowRequest, opts ...http.CallOption) (rsp *ListFollowReply, err error)
+	ListFollowing(ctx context.Context, req *ListFollowRequest, opts ...http.CallOption) (rsp *ListFollowReply, err error)
+	ListFriendRequests(ctx context.Context, req *ListFriendRequestsReq, opts ...http.CallOption) (rsp *ListFriendRequestsReply, err error)
+	ListFriends(ctx context.Context, req *ListFriendsReq, opts ...http.CallOption) (rsp *ListFriendsReply, err error)
+	ListGroupJoinRequests(ctx context.Context, req *ListGroupJoinRequestsRequest, opts ...http.CallOption) (rsp *ListGroupJoinRequestsReply, err error)
+	ListLoginHistory(ctx context.Context, req *ListLoginHistoryRequest, opts ...http.CallOption) (rsp *ListLoginHistoryReply, err error)
+	ListProfileVisitors(ctx context.Context, req *ListProfileVisitorsRequest, opts ...http.CallOption) (rsp *ListProfileVisitorsReply, err error)
+	Login(ctx context.Context, req *LoginRequest, opts ...http.CallOption) (rsp *LoginReply, err error)
+	LoginBySms(ctx context.Context, req *LoginBySmsRequest, opts ...http.CallOption) (rsp *LoginReply, err error)
+	MuteGroupMember(ctx context.Context, req *MuteGroupMemberRequest, opts ...http.CallOption) (rsp *GroupMemberReply, err error)
+	ReadConversation(ctx context.Context, req *ReadConversationRequest, opts ...http.CallOption) (rsp *ReadConversationReply, err error)
+	Register(ctx context.Context, req *RegisterRequest, opts ...http.CallOption) (rsp *RegisterReply, err error)
+	RemoveFriend(ctx context.Context, req *RemoveFriendReq, opts ...http.CallOption) (rsp *RemoveFriendReply, err error)
+	ResetUserPassword(ctx context.Context, req *ResetUserPwdRequest, opts ...http.CallOption) (rsp *ResetUserPwdReply, err error)
+	RevokeGroupInvite(ctx context.Context, req *RevokeGroupInviteRequest, opts ...http.CallOption) (rsp *RevokeGroupInviteReply, err error)
+	SearchUsers(ctx context.Context, req *SearchUsersRequest, opts ...http.CallOption) (rsp *SearchUsersReply, err error)
+	SendFriendRequest(ctx context.Context, req *SendFriendRequestReq, opts ...http.CallOption) (rsp *FriendRequestReply, err error)
+	SendSms(ctx context.Context, req *SendSmsRequest, opts ...http.CallOption) (rsp *SendSmsReply, err error)
+	SetConversationTimer(ctx context.Context, req *SetConversationTimerRequest, opts ...http.CallOption) (rsp *ConversationTimerReply, err error)
+	SetGroupAdmin(ctx context.Context, req *SetGroupAdminRequest, opts ...http.CallOption) (rsp *GroupMemberReply, err error)
+	SuggestUsers(ctx context.Context, req *SuggestUsersRequest, opts ...http.CallOption) (rsp *SuggestUsersReply, err error)
+	UnbanUser(ctx context.Context, req *UnbanUserRequest, opts ...http.CallOption) (rsp *BanUserReply, err error)
+	UnblockUser(ctx context.Context, req *UnblockUserReq, opts ...http.CallOption) (rsp *BlockReply, err error)
+	UnfollowUser(ctx context.Context, req *UnfollowUserRequest, opts ...http.CallOption) (rsp *FollowFanReply, err error)
+	UnlikeComment(ctx context.Context, req *UnlikeCommentRequest, opts ...http.CallOption) (rsp *CommentLikeReply, err error)
+	UnlikeMoment(ctx context.Context, req *UnlikeMomentRequest, opts ...http.CallOption) (rsp *MomentLikeReply, err error)
+	UpdateConversationSettings(ctx context.Context, req *UpdateConversationSettingsRequest, opts ...http.CallOption) (rsp *UpdateConversationSettingsReply, err error)
+	UpdateGroupInfo(ctx context.Context, req *UpdateGroupInfoRequest, opts ...http.CallOption) (rsp *GroupReply, err error)
+	UpdateGroupSettings(ctx context.Context, req *UpdateGroupSettingsRequest, opts ...http.CallOption) (rsp *GroupReply, err error)
+	UpdateMomentBlackList(ctx context.Context, req *UpdateMomentBlackListRequest, opts ...http.CallOption) (rsp *UpdateMomentBlackListReply, err error)
+	UpdateMomentVisibility(ctx context.Context, req *UpdateMomentVisibilityRequest, opts ...http.CallOption) (rsp *UpdateMomentVisibilityReply, err error)
+	UpdatePrivacySettings(ctx context.Context, req *UpdatePrivacySettingsRequest, opts ...http.CallOption) (rsp *PrivacySettingsReply, err error)
+	UpdateSearchPrivacy(ctx context.Context, req *UpdateSearchPrivacyRequest, opts ...http.CallOption) (rsp *UpdateSearchPrivacyReply, err error)
+	UpdateUserInfo(ctx context.Context, req *UpdateUserInfoRequest, opts ...http.CallOption) (rsp *UpdateUserInfoReply, err error)
+	UpdateUserPassword(ctx context.Context, req *UpdateUserPwdRequest, opts ...http.CallOption) (rsp *UpdateUserPwdReply, err error)
+	UpdateVisitPrivacy(ctx context.Context, req *UpdateVisitPrivacyRequest, opts ...http.CallOption) (rsp *UpdateVisitPrivacyReply, err error)
+}
+
+type ConduitHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewConduitHTTPClient(client *http.Client) ConduitHTTPClient {
+	return &ConduitHTTPClientImpl{client}
+}
+
+func (c *ConduitHTTPClientImpl) BanUser(ctx context.Context, in *BanUserRequest, opts ...http.CallOption) (*BanUserReply, error) {
+	var out BanUserReply
+	pattern := "/api/admin/users/{user_id}/ban"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationConduitBanUser))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConduitHTTPClientImpl) BlockUser(ctx context.Context, in *BlockUserReq, opts ...http.CallOption) (*BlockReply, error) {
+	var out BlockReply
+	pattern := "/api/profiles/{target_id}/block"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationConduitBlockUser))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConduitHTTPClientImpl) CanAddFriend(ctx context.Context, in *CanAddFriendReq, opts ...http.CallOption) (*CanAddFriendRes, error) {
+	var out CanAddFriendRes
+	pattern := "/api/profiles/{target_id}/canAddFriend"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationConduitCanAddFriend))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConduitHTTPClientImpl) ChangeHandle(ctx context.Context, in *ChangeHandleRequest, opts ...http.CallOption) (*ChangeHandleReply, error) {
+	var out ChangeHandleReply
+	pattern := "/api/users/handle"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationConduitChangeHandle))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConduitHTTPClientImpl) CreateChatExport(ctx context.Context, in *CreateChatExportRequest, opts ...http.CallOption) (*ChatExportReply, error) {
+	var out ChatExportReply
+	pattern := "/api/conversations/{target_id}/export"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationConduitCreateChatExport))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConduitHTTPClientImpl) CreateComment(ctx context.Context, in *CreateCommentRequest, opts ...http.CallOption) (*CommentReply, error) {
+	var out CommentReply
+	pattern := "/api/moments/{moment_id}/comments"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationConduitCreateComment))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConduitHTTPClientImpl) CreateGroupInvite(ctx context.Context, in *CreateGroupInviteRequest, opts ...http.CallOption) (*GroupInviteReply, error) {
+	var out GroupInviteReply
+	pattern := "/api/groups/{group_id}/invites"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationConduitCreateGroupInvite))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConduitHTTPClientImpl) CreateMoment(ctx context.Context, in *CreateMomentRequest, opts ...http.CallOption) (*MomentReply, error) {
+	var out MomentReply
+	pattern := "/api/moments"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationConduitCreateMoment))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConduitHTTPClientImpl) DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...http.CallOption) (*DeleteCommentReply, error) {
+	var out DeleteCommentReply
+	pattern := "/api/moments/{moment_id}/comments/{comment_id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationConduitDeleteComment))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConduitHTTPClientImpl) DeleteMoment(ctx context.Context, in *DeleteMomentRequest, opts ...http.CallOption) (*DeleteMomentReply, error) {
+	var out DeleteMomentReply
+	pattern := "/api/moments/{moment_id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationConduitDeleteMoment))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConduitHTTPClientImpl) DismissSuggestion(ctx context.Context, in *DismissSuggestionRequest, opts ...http.CallOption) (*DismissSuggestionReply, error) {
+	var out DismissSuggestionReply
+	pattern := "/api/suggestions/users/{target_id}/dismiss"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationConduitDismissSuggestion))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConduitHTTPClientImpl) FollowUser(ctx context.Context, in *FollowUserRequest, opts ...http.CallOption) (*FollowFanReply, error) {
+	var out FollowFanReply
+	pattern := "/api/profiles/{target_id}/follow"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationConduitFollowUser))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConduitHTTPClientImpl) GetChatExport(ctx context.Context, in *GetChatExportRequest, opts ...http.CallOption) (*ChatExportReply, error) {
+	var out ChatExportReply
+	pattern := "/api/exports/{export_id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationConduitGetChatExport))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConduitHTTPClientImpl) GetConversationTimer(ctx context.Context, in *GetConversationTimerRequest, opts ...http.CallOption) (*ConversationTimerReply, error) {
+	var out ConversationTimerReply
+	pattern := "/api/conversations/{target_id}/timer"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationConduitGetConversationTimer))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConduitHTTPClientImpl) GetMessages(ctx context.Context, in *GetMessagesRequest, opts ...http.CallOption) (*GetMessagesReply, error) {
+	var out GetMessagesReply
+	pattern := "/api/chat"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationConduitGetMessages))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConduitHTTPClientImpl) GetMoment(ctx context.Context, in *GetMomentRequest, opts ...http.CallOption) (*MomentReply, error) {
+	var out MomentReply
+	pattern := "/api/moments/{moment_id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationConduitGetMoment))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConduitHTTPClientImpl) GetPrivacySettings(ctx context.Context, in *GetPrivacySettingsRequest, opts ...http.CallOption) (*PrivacySettingsReply, error) {
+	var out PrivacySettingsReply
+	pattern := "/api/settings/privacy"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationConduitGetPrivacySettings))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConduitHTTPClientImpl) GetProfile(ctx context.Context, in *GetProfileRequest, opts ...http.CallOption) (*GetProfileReply, error) {
+	var out GetProfileReply
+	pattern := "/api/profiles/{user_id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationConduitGetProfile))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConduitHTTPClientImpl) GetRelationship(ctx context.Context, in *RelationshipRequest, opts ...http.CallOption) (*RelationshipReply, error) {
+	var out RelationshipReply
+	pattern := "/api/profiles/{target_id}/relationship"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationConduitGetRelationship))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConduitHTTPClientImpl) GetSquare(ctx context.Context, in *GetSquareRequest, opts ...http.CallOption) (*GetSquareReply, error) {
+	var out GetSquareReply
+	pattern := "/api/square"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationConduitGetSquare))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConduitHTTPClientImpl) GetTimeline(ctx context.Context, in *GetTimelineRequest, opts ...http.CallOption) (*GetTimelineReply, error) {
+	var out GetTimelineReply
+	pattern := "/api/timeline"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationConduitGetTimeline))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConduitHTTPClientImpl) HandleFriendRequest(ctx context.Context, in *HandleFriendRequestReq, opts ...http.CallOption) (*FriendRequestReply, error) {
+	var out FriendRequestReply
+	pattern := "/api/friends/requests/{request_id}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationConduitHandleFriendRequest))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConduitHTTPClientImpl) HandleGroupJoinRequest(ctx context.Context, in *HandleGroupJoinRequestRequest, opts ...http.CallOption) (*GroupJoinRequestReply, error) {
+	var out GroupJoinRequestReply
+	pattern := "/api/groups/{group_id}/joinRequests/{request_id}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationConduitHandleGroupJoinRequest))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConduitHTTPClientImpl) JoinGroup(ctx context.Context, in *JoinGroupRequest, opts ...http.CallOption) (*JoinGroupReply, error) {
+	var out JoinGroupReply
+	pattern := "/api/groups/join"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationConduitJoinGroup))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConduitHTTPClientImpl) LikeComment(ctx context.Context, in *LikeCommentRequest, opts ...http.CallOption) (*CommentLikeReply, error) {
+	var out CommentLikeReply
+	pattern := "/api/moments/{moment_id}/comments/{comment_id}/like"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationConduitLikeComment))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConduitHTTPClientImpl) LikeMoment(ctx context.Context, in *LikeMomentRequest, opts ...http.CallOption) (*MomentLikeReply, error) {
+	var out MomentLikeReply
+	pattern := "/api/moments/{moment_id}/like"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationConduitLikeMoment))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConduitHTTPClientImpl) ListBanAudits(ctx context.Context, in *ListBanAuditsRequest, opts ...http.CallOption) (*ListBanAuditsReply, error) {
+	var out ListBanAuditsReply
+	pattern := "/api/admin/bans"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationConduitListBanAudits))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConduitHTTPClientImpl) ListBlocks(ctx context.Context, in *ListBlocksReq, opts ...http.CallOption) (*ListBlocksReply, error) {
+	var out ListBlocksReply
+	pattern := "/api/blocks"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationConduitListBlocks))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConduitHTTPClientImpl) ListComments(ctx context.Context, in *ListCommentsRequest, opts ...http.CallOption) (*ListCommentsReply, error) {
+	var out ListCommentsReply
+	pattern := "/api/moments/{moment_id}/comments"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationConduitListComments))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConduitHTTPClientImpl) ListConversations(ctx context.Context, in *ListConversationsRequest, opts ...http.CallOption) (*ListConversationsReply, error) {
+	var out ListConversationsReply
+	pattern := "/api/conversations"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationConduitListConversations))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
 }
 
-type ConduitHTTPClientImpl struct {
-	cc *http.Client
+func (c *ConduitHTTPClientImpl) ListFollowers(ctx context.Context, in *ListFollowRequest, opts ...http.CallOption) (*ListFollowReply, error) {
+	var out ListFollowReply
+	pattern := "/api/profiles/{user_id}/followers"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationConduitListFollowers))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
 }
 
-func NewConduitHTTPClient(client *http.Client) ConduitHTTPClient {
-	return &ConduitHTTPClientImpl{client}
+func (c *ConduitHTTPClientImpl) ListFollowing(ctx context.Context, in *ListFollowRequest, opts ...http.CallOption) (*ListFollowReply, error) {
+	var out ListFollowReply
+	pattern := "/api/profiles/{user_id}/following"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationConduitListFollowing))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
 }
 
-func (c *ConduitHTTPClientImpl) CanAddFriend(ctx context.Context, in *CanAddFriendReq, opts ...http.CallOption) (*CanAddFriendRes, error) {
-	var out CanAddFriendRes
-	pattern := "/api/profiles/{target_id}/canAddFriend"
-	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationConduitCanAddFriend))
+func (c *ConduitHTTPClientImpl) ListFriendRequests(ctx context.Context, in *ListFriendRequestsReq, opts ...http.CallOption) (*ListFriendRequestsReply, error) {
+	var out ListFriendRequestsReply
+	pattern := "/api/friends/requests"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationConduitListFriendRequests))
 	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &out, nil
 }
 
-func (c *ConduitHTTPClientImpl) FollowUser(ctx context.Context, in *FollowUserRequest, opts ...http.CallOption) (*FollowFanReply, error) {
-	var out FollowFanReply
-	pattern := "/api/profiles/{target_id}/follow"
-	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationConduitFollowUser))
+func (c *ConduitHTTPClientImpl) ListFriends(ctx context.Context, in *ListFriendsReq, opts ...http.CallOption) (*ListFriendsReply, error) {
+	var out ListFriendsReply
+	pattern := "/api/friends"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationConduitListFriends))
 	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &out, nil
 }
 
-func (c *ConduitHTTPClientImpl) GetMessages(ctx context.Context, in *GetMessagesRequest, opts ...http.CallOption) (*GetMessagesReply, error) {
-	var out GetMessagesReply
-	pattern := "/api/chat"
+func (c *ConduitHTTPClientImpl) ListGroupJoinRequests(ctx context.Context, in *ListGroupJoinRequestsRequest, opts ...http.CallOption) (*ListGroupJoinRequestsReply, error) {
+	var out ListGroupJoinRequestsReply
+	pattern := "/api/groups/{group_id}/joinRequests"
 	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationConduitGetMessages))
+	opts = append(opts, http.Operation(OperationConduitListGroupJoinRequests))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
 	if err != nil {
@@ -421,11 +2251,11 @@ func (c *ConduitHTTPClientImpl) GetMessages(ctx context.Context, in *GetMessages
 	return &out, nil
 }
 
-func (c *ConduitHTTPClientImpl) GetProfile(ctx context.Context, in *GetProfileRequest, opts ...http.CallOption) (*GetProfileReply, error) {
-	var out GetProfileReply
-	pattern := "/api/profiles/{user_id}"
+func (c *ConduitHTTPClientImpl) ListLoginHistory(ctx context.Context, in *ListLoginHistoryRequest, opts ...http.CallOption) (*ListLoginHistoryReply, error) {
+	var out ListLoginHistoryReply
+	pattern := "/api/users/loginHistory"
 	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationConduitGetProfile))
+	opts = append(opts, http.Operation(OperationConduitListLoginHistory))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
 	if err != nil {
@@ -434,11 +2264,11 @@ func (c *ConduitHTTPClientImpl) GetProfile(ctx context.Context, in *GetProfileRe
 	return &out, nil
 }
 
-func (c *ConduitHTTPClientImpl) GetRelationship(ctx context.Context, in *RelationshipRequest, opts ...http.CallOption) (*RelationshipReply, error) {
-	var out RelationshipReply
-	pattern := "/api/profiles/{target_id}/relationship"
+func (c *ConduitHTTPClientImpl) ListProfileVisitors(ctx context.Context, in *ListProfileVisitorsRequest, opts ...http.CallOption) (*ListProfileVisitorsReply, error) {
+	var out ListProfileVisitorsReply
+	pattern := "/api/visitors"
 	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationConduitGetRelationship))
+	opts = append(opts, http.Operation(OperationConduitListProfileVisitors))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
 	if err != nil {
@@ -473,6 +2303,32 @@ func (c *ConduitHTTPClientImpl) LoginBySms(ctx context.Context, in *LoginBySmsRe
 	return &out, nil
 }
 
+func (c *ConduitHTTPClientImpl) MuteGroupMember(ctx context.Context, in *MuteGroupMemberRequest, opts ...http.CallOption) (*GroupMemberReply, error) {
+	var out GroupMemberReply
+	pattern := "/api/groups/{group_id}/members/{user_id}/mute"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationConduitMuteGroupMember))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConduitHTTPClientImpl) ReadConversation(ctx context.Context, in *ReadConversationRequest, opts ...http.CallOption) (*ReadConversationReply, error) {
+	var out ReadConversationReply
+	pattern := "/api/conversations/{target_id}/read"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationConduitReadConversation))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *ConduitHTTPClientImpl) Register(ctx context.Context, in *RegisterRequest, opts ...http.CallOption) (*RegisterReply, error) {
 	var out RegisterReply
 	pattern := "/api/users"
@@ -486,6 +2342,19 @@ func (c *ConduitHTTPClientImpl) Register(ctx context.Context, in *RegisterReques
 	return &out, nil
 }
 
+func (c *ConduitHTTPClientImpl) RemoveFriend(ctx context.Context, in *RemoveFriendReq, opts ...http.CallOption) (*RemoveFriendReply, error) {
+	var out RemoveFriendReply
+	pattern := "/api/profiles/{target_id}/unfriend"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationConduitRemoveFriend))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *ConduitHTTPClientImpl) ResetUserPassword(ctx context.Context, in *ResetUserPwdRequest, opts ...http.CallOption) (*ResetUserPwdReply, error) {
 	var out ResetUserPwdReply
 	pattern := "/api/users/resetPassword"
@@ -499,6 +2368,45 @@ func (c *ConduitHTTPClientImpl) ResetUserPassword(ctx context.Context, in *Reset
 	return &out, nil
 }
 
+func (c *ConduitHTTPClientImpl) RevokeGroupInvite(ctx context.Context, in *RevokeGroupInviteRequest, opts ...http.CallOption) (*RevokeGroupInviteReply, error) {
+	var out RevokeGroupInviteReply
+	pattern := "/api/groups/{group_id}/invites/{token}/revoke"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationConduitRevokeGroupInvite))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConduitHTTPClientImpl) SearchUsers(ctx context.Context, in *SearchUsersRequest, opts ...http.CallOption) (*SearchUsersReply, error) {
+	var out SearchUsersReply
+	pattern := "/api/search/users"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationConduitSearchUsers))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConduitHTTPClientImpl) SendFriendRequest(ctx context.Context, in *SendFriendRequestReq, opts ...http.CallOption) (*FriendRequestReply, error) {
+	var out FriendRequestReply
+	pattern := "/api/profiles/{target_id}/friendRequest"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationConduitSendFriendRequest))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *ConduitHTTPClientImpl) SendSms(ctx context.Context, in *SendSmsRequest, opts ...http.CallOption) (*SendSmsReply, error) {
 	var out SendSmsReply
 	pattern := "/api/users/sendSms"
@@ -512,6 +2420,71 @@ func (c *ConduitHTTPClientImpl) SendSms(ctx context.Context, in *SendSmsRequest,
 	return &out, nil
 }
 
+func (c *ConduitHTTPClientImpl) SetConversationTimer(ctx context.Context, in *SetConversationTimerRequest, opts ...http.CallOption) (*ConversationTimerReply, error) {
+	var out ConversationTimerReply
+	pattern := "/api/conversations/{target_id}/timer"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationConduitSetConversationTimer))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConduitHTTPClientImpl) SetGroupAdmin(ctx context.Context, in *SetGroupAdminRequest, opts ...http.CallOption) (*GroupMemberReply, error) {
+	var out GroupMemberReply
+	pattern := "/api/groups/{group_id}/admins"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationConduitSetGroupAdmin))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConduitHTTPClientImpl) SuggestUsers(ctx context.Context, in *SuggestUsersRequest, opts ...http.CallOption) (*SuggestUsersReply, error) {
+	var out SuggestUsersReply
+	pattern := "/api/suggestions/users"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationConduitSuggestUsers))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConduitHTTPClientImpl) UnbanUser(ctx context.Context, in *UnbanUserRequest, opts ...http.CallOption) (*BanUserReply, error) {
+	var out BanUserReply
+	pattern := "/api/admin/users/{user_id}/unban"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationConduitUnbanUser))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConduitHTTPClientImpl) UnblockUser(ctx context.Context, in *UnblockUserReq, opts ...http.CallOption) (*BlockReply, error) {
+	var out BlockReply
+	pattern := "/api/profiles/{target_id}/unblock"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationConduitUnblockUser))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *ConduitHTTPClientImpl) UnfollowUser(ctx context.Context, in *UnfollowUserRequest, opts ...http.CallOption) (*FollowFanReply, error) {
 	var out FollowFanReply
 	pattern := "/api/profiles/{target_id}/unfollow"
@@ -525,6 +2498,123 @@ func (c *ConduitHTTPClientImpl) UnfollowUser(ctx context.Context, in *UnfollowUs
 	return &out, nil
 }
 
+func (c *ConduitHTTPClientImpl) UnlikeComment(ctx context.Context, in *UnlikeCommentRequest, opts ...http.CallOption) (*CommentLikeReply, error) {
+	var out CommentLikeReply
+	pattern := "/api/moments/{moment_id}/comments/{comment_id}/like"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationConduitUnlikeComment))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConduitHTTPClientImpl) UnlikeMoment(ctx context.Context, in *UnlikeMomentRequest, opts ...http.CallOption) (*MomentLikeReply, error) {
+	var out MomentLikeReply
+	pattern := "/api/moments/{moment_id}/like"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationConduitUnlikeMoment))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConduitHTTPClientImpl) UpdateConversationSettings(ctx context.Context, in *UpdateConversationSettingsRequest, opts ...http.CallOption) (*UpdateConversationSettingsReply, error) {
+	var out UpdateConversationSettingsReply
+	pattern := "/api/conversations/{target_id}/settings"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationConduitUpdateConversationSettings))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConduitHTTPClientImpl) UpdateGroupInfo(ctx context.Context, in *UpdateGroupInfoRequest, opts ...http.CallOption) (*GroupReply, error) {
+	var out GroupReply
+	pattern := "/api/groups/{group_id}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationConduitUpdateGroupInfo))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConduitHTTPClientImpl) UpdateGroupSettings(ctx context.Context, in *UpdateGroupSettingsRequest, opts ...http.CallOption) (*GroupReply, error) {
+	var out GroupReply
+	pattern := "/api/groups/{group_id}/settings"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationConduitUpdateGroupSettings))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConduitHTTPClientImpl) UpdateMomentBlackList(ctx context.Context, in *UpdateMomentBlackListRequest, opts ...http.CallOption) (*UpdateMomentBlackListReply, error) {
+	var out UpdateMomentBlackListReply
+	pattern := "/api/moments/{moment_id}/blacklist"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationConduitUpdateMomentBlackList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConduitHTTPClientImpl) UpdateMomentVisibility(ctx context.Context, in *UpdateMomentVisibilityRequest, opts ...http.CallOption) (*UpdateMomentVisibilityReply, error) {
+	var out UpdateMomentVisibilityReply
+	pattern := "/api/moments/{moment_id}/visibility"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationConduitUpdateMomentVisibility))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConduitHTTPClientImpl) UpdatePrivacySettings(ctx context.Context, in *UpdatePrivacySettingsRequest, opts ...http.CallOption) (*PrivacySettingsReply, error) {
+	var out PrivacySettingsReply
+	pattern := "/api/settings/privacy"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationConduitUpdatePrivacySettings))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConduitHTTPClientImpl) UpdateSearchPrivacy(ctx context.Context, in *UpdateSearchPrivacyRequest, opts ...http.CallOption) (*UpdateSearchPrivacyReply, error) {
+	var out UpdateSearchPrivacyReply
+	pattern := "/api/search/privacy"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationConduitUpdateSearchPrivacy))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *ConduitHTTPClientImpl) UpdateUserInfo(ctx context.Context, in *UpdateUserInfoRequest, opts ...http.CallOption) (*UpdateUserInfoReply, error) {
 	var out UpdateUserInfoReply
 	pattern := "/api/users/updateUserInfo"
@@ -550,3 +2640,16 @@ func (c *ConduitHTTPClientImpl) UpdateUserPassword(ctx context.Context, in *Upda
 	}
 	return &out, nil
 }
+
+func (c *ConduitHTTPClientImpl) UpdateVisitPrivacy(ctx context.Context, in *UpdateVisitPrivacyRequest, opts ...http.CallOption) (*UpdateVisitPrivacyReply, error) {
+	var out UpdateVisitPrivacyReply
+	pattern := "/api/visitors/privacy"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationConduitUpdateVisitPrivacy))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -4,6 +4,7 @@ import (
 	"flag"
 	"kratos-realworld/cmd/conduit/core"
 	"kratos-realworld/internal/conf"
+	"kratos-realworld/internal/job"
 	"kratos-realworld/internal/kafka"
 	wsrv "kratos-realworld/internal/websocket"
 	"os"
@@ -81,7 +82,7 @@ func main() {
 		"span.id", tracing.SpanID(),
 	)
 
-	app, cleanup, err := initApp(bc.Server, bc.Data, bc.Jwt, logger, bc.Sms)
+	app, cleanup, err := initApp(bc.Server, bc.Data, bc.Jwt, logger, bc.Sms, bc.Admin)
 	if err != nil {
 		panic(err)
 	}
@@ -109,7 +110,27 @@ func main() {
 				_ = logger.Log(log.LevelWarn, "msg", "kafka consumer disabled - messages from kafka will not be processed")
 			} else {
 				_ = logger.Log(log.LevelInfo, "msg", "kafka consumer initialized successfully")
-				go kafka.ConsumerMsg(wsrv.ConsumerKafkaMsg)
+				// 每个节点都要消费的 topic，只投递给连在本节点上的用户
+				registry := kafka.NewNotifyHandlerRegistry()
+				registry.RegisterAll([]kafka.NotifyHandler{
+					kafka.NewCallbackHandler(kafka.DefaultTopic(), wsrv.ConsumerKafkaMsg),
+					kafka.NewCallbackHandler(kafka.GROUP_DELIVERY_TOPIC, wsrv.ConsumeGroupDelivery),
+					kafka.NewMomentNotifyHandler(wsrv.MyServer.OnlineUsers, wsrv.ConsumerKafkaMsg),
+				})
+				kafka.ConsumeRegistry(registry)
+			}
+
+			if err := kafka.InitConsumerGroup(bc.Data.Kafka.Hosts, bc.Data.Kafka.ConsumerGroup); err != nil {
+				_ = logger.Log(log.LevelError, "msg", "init kafka consumer group failed", "err", err)
+				_ = logger.Log(log.LevelWarn, "msg", "kafka consumer group disabled - moment fanout and counter events will not be processed")
+			} else {
+				// 整个集群只处理一次的 topic，写接收盒、更新计数不能每个节点都做一遍
+				groupRegistry := kafka.NewNotifyHandlerRegistry()
+				groupRegistry.RegisterAll([]kafka.NotifyHandler{
+					kafka.NewCallbackHandler(kafka.PROFILE_COUNTER_TOPIC, app.Pc.ConsumeCounterEvent),
+					kafka.NewCallbackHandler(kafka.MOMENT_TOPIC, app.Moc.ConsumeMomentMessage),
+				})
+				kafka.ConsumeGroupRegistry(groupRegistry)
 			}
 			defer kafka.Close()
 			defer kafka.CloseConsumer()
@@ -126,6 +147,16 @@ func main() {
 	// 启动websocket服务
 	go wsrv.MyServer.Start()
 
+	// 定时清理过期消息
+	job.StartPurgeMessagesJob(app.Mc, bc.Data.Retention, logger)
+	job.StartSuggestUsersJob(app.Pc, bc.Data.Suggestion, logger)
+	job.StartRepairFollowCacheJob(app.Pc, bc.Data.FollowRepair, logger)
+	job.StartFlushProfileViewsJob(app.Pc, bc.Data.ProfileView, logger)
+	job.StartRebuildProfileCountersJob(app.Pc, bc.Data.CounterRepair, logger)
+	job.StartFlushMomentLikesJob(app.Moc, bc.Data.LikeFlush, logger)
+	job.StartMaintainExportsJob(app.Ec, logger)
+	job.StartCleanOrphanUploadsJob(app.Moc, bc.Data.Storage, logger)
+
 	// start and wait for stop signal
 	if err := app.App.Run(); err != nil {
 		panic(err)
@@ -25,14 +25,22 @@ import (
 //}
 
 type CustomApp struct {
-	App *kratos.App // newApp 返回 *kratos.App
-	DB  *gorm.DB    // data.ProviderSet 里面提供 *gorm.DB，用于在开发环境创建database和对应的table
+	App *kratos.App         // newApp 返回 *kratos.App
+	DB  *gorm.DB            // data.ProviderSet 里面提供 *gorm.DB，用于在开发环境创建database和对应的table
+	Mc  *biz.MessageUseCase // 后台任务使用，例如定时清理过期消息
+	Pc  *biz.ProfileUsecase // 后台任务使用，例如预计算关注推荐
+	Moc *biz.MomentUsecase  // kafka 消费动态消息，维护粉丝接收盒
+	Ec  *biz.ExportUsecase  // 后台任务使用，维护聊天记录导出任务
 }
 
-func newCustomApp(kapp *kratos.App, db *gorm.DB) *CustomApp {
+func newCustomApp(kapp *kratos.App, db *gorm.DB, mc *biz.MessageUseCase, pc *biz.ProfileUsecase, moc *biz.MomentUsecase, ec *biz.ExportUsecase) *CustomApp {
 	return &CustomApp{
 		App: kapp,
 		DB:  db,
+		Mc:  mc,
+		Pc:  pc,
+		Moc: moc,
+		Ec:  ec,
 	}
 }
 
@@ -46,6 +54,6 @@ var CustomProviderSet = wire.NewSet(
 	newCustomApp,        // 封装成 *CustomApp
 )
 
-func initApp(*conf.Server, *conf.Data, *conf.JWT, log.Logger, *conf.Sms) (*CustomApp, func(), error) {
+func initApp(*conf.Server, *conf.Data, *conf.JWT, log.Logger, *conf.Sms, *conf.Admin) (*CustomApp, func(), error) {
 	panic(wire.Build(CustomProviderSet))
 }
@@ -23,7 +23,7 @@ import (
 
 // Injectors from wire.go:
 
-func initApp(confServer *conf.Server, confData *conf.Data, jwt *conf.JWT, logger log.Logger, confSms *conf.Sms) (*CustomApp, func(), error) {
+func initApp(confServer *conf.Server, confData *conf.Data, jwt *conf.JWT, logger log.Logger, confSms *conf.Sms, admin *conf.Admin) (*CustomApp, func(), error) {
 	db := infra.NewDatabase(confData)
 	client := infra.NewCache(confData)
 	modelData := model.NewData(db, client)
@@ -33,14 +33,26 @@ func initApp(confServer *conf.Server, confData *conf.Data, jwt *conf.JWT, logger
 	smsRepo := data.NewSmsRepo(modelData, logger, smsService)
 	gateWayUsecase := biz.NewGateWayUsecase(userRepo, profileRepo, smsRepo, jwt, logger)
 	transaction := model.NewTransaction(modelData)
-	profileUsecase := biz.NewProfileUsecase(profileRepo, transaction, jwt, logger)
+	locker := model.NewLocker(modelData)
+	privacyPolicy := biz.NewPrivacyPolicy(profileRepo, logger)
+	squareRepo := data.NewSquareRepo(modelData, logger)
+	profileUsecase := biz.NewProfileUsecase(profileRepo, userRepo, transaction, locker, privacyPolicy, squareRepo, jwt, logger)
 	messageRepo := data.NewMessageRepo(modelData, logger)
-	messageUseCase := biz.NewMessageUseCase(messageRepo, logger)
-	conduitService := service.NewConduitService(gateWayUsecase, profileUsecase, messageUseCase, logger)
+	groupRepo := data.NewGroupRepo(modelData, logger)
+	conversationRepo := data.NewConversationRepo(modelData, logger)
+	messageUseCase := biz.NewMessageUseCase(messageRepo, groupRepo, conversationRepo, profileRepo, privacyPolicy, locker, confData, logger)
+	groupUsecase := biz.NewGroupUsecase(groupRepo, transaction, logger)
+	exportRepo := data.NewExportRepo(modelData, logger)
+	exportUsecase := biz.NewExportUsecase(messageRepo, groupRepo, exportRepo, confData, logger)
+	adminUsecase := biz.NewAdminUsecase(profileRepo, transaction, admin, logger)
+	momentRepo := data.NewMomentRepo(modelData, logger)
+	mediaStorage := data.NewMediaStorage(confData)
+	momentUsecase := biz.NewMomentUsecase(momentRepo, squareRepo, profileRepo, privacyPolicy, locker, mediaStorage, confData, logger)
+	conduitService := service.NewConduitService(gateWayUsecase, profileUsecase, messageUseCase, groupUsecase, exportUsecase, adminUsecase, momentUsecase, logger)
 	httpServer := server.NewHTTPServer(confServer, jwt, conduitService, logger)
 	grpcServer := server.NewGRPCServer(confServer, conduitService, logger)
 	app := newApp(logger, httpServer, grpcServer)
-	customApp := newCustomApp(app, db)
+	customApp := newCustomApp(app, db, messageUseCase, profileUsecase, momentUsecase, exportUsecase)
 	return customApp, func() {
 	}, nil
 }
@@ -48,14 +60,22 @@ func initApp(confServer *conf.Server, confData *conf.Data, jwt *conf.JWT, logger
 // wire.go:
 
 type CustomApp struct {
-	App *kratos.App // newApp 返回 *kratos.App
-	DB  *gorm.DB    // data.ProviderSet 里面提供 *gorm.DB，用于在开发环境创建database和对应的table
+	App *kratos.App         // newApp 返回 *kratos.App
+	DB  *gorm.DB            // data.ProviderSet 里面提供 *gorm.DB，用于在开发环境创建database和对应的table
+	Mc  *biz.MessageUseCase // 后台任务使用，例如定时清理过期消息
+	Pc  *biz.ProfileUsecase // 后台任务使用，例如预计算关注推荐
+	Moc *biz.MomentUsecase  // kafka 消费动态消息，维护粉丝接收盒
+	Ec  *biz.ExportUsecase  // 后台任务使用，维护聊天记录导出任务
 }
 
-func newCustomApp(kapp *kratos.App, db *gorm.DB) *CustomApp {
+func newCustomApp(kapp *kratos.App, db *gorm.DB, mc *biz.MessageUseCase, pc *biz.ProfileUsecase, moc *biz.MomentUsecase, ec *biz.ExportUsecase) *CustomApp {
 	return &CustomApp{
 		App: kapp,
 		DB:  db,
+		Mc:  mc,
+		Pc:  pc,
+		Moc: moc,
+		Ec:  ec,
 	}
 }
 
@@ -0,0 +1,186 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	bizProfile "kratos-realworld/internal/biz/profile"
+	"kratos-realworld/internal/common"
+	"kratos-realworld/internal/conf"
+	"kratos-realworld/internal/model"
+	"kratos-realworld/internal/pkg/middleware/auth"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// AdminUsecase 管理员操作，管理员名单来自配置
+type AdminUsecase struct {
+	pr bizProfile.ProfileRepo
+	tx model.Transaction
+
+	admins map[uint32]struct{}
+	log    *log.Helper
+}
+
+func NewAdminUsecase(pr bizProfile.ProfileRepo, tx model.Transaction, ac *conf.Admin, logger log.Logger) *AdminUsecase {
+	admins := make(map[uint32]struct{})
+	for _, id := range ac.GetUserIds() {
+		admins[id] = struct{}{}
+	}
+	return &AdminUsecase{
+		pr:     pr,
+		tx:     tx,
+		admins: admins,
+		log:    log.NewHelper(logger),
+	}
+}
+
+// checkAccountBanned 封禁中的账号不能登录、调用接口和建立 websocket 连接，过了截止时间自动失效
+func checkAccountBanned(ctx context.Context, pr bizProfile.ProfileRepo, userID uint32) error {
+	ban, err := pr.GetBan(ctx, userID)
+	if err != nil {
+		return NewErr(ErrCodeDBQueryFailed, DB_QUERY_FAILED, "failed to query account status")
+	}
+	if ban == nil || !ban.Until.After(time.Now()) {
+		return nil
+	}
+	return NewErr(ErrCodeAccountBanned, ACCOUNT_BANNED,
+		fmt.Sprintf("account is suspended until %s: %s", ban.Until.Format(time.RFC3339), ban.Reason))
+}
+
+// CheckBanned 给 JWT 中间件和 websocket 握手使用
+func (ac *AdminUsecase) CheckBanned(ctx context.Context, userID uint32) error {
+	return checkAccountBanned(ctx, ac.pr, userID)
+}
+
+func (ac *AdminUsecase) checkAdmin(ctx context.Context) (uint32, error) {
+	operatorID := uint32(auth.FromContext(ctx).UserID)
+	if _, ok := ac.admins[operatorID]; !ok {
+		return 0, NewErr(ErrCodePermissionDenied, PERMISSION_DENIED, "only administrators can do this")
+	}
+	return operatorID, nil
+}
+
+// BanUser 封禁用户到 until，已经在线的连接会收到原因后被断开
+func (ac *AdminUsecase) BanUser(ctx context.Context, userID string, until time.Time, reason string) error {
+	operatorID, err := ac.checkAdmin(ctx)
+	if err != nil {
+		return err
+	}
+	uID, err := parseTargetID(userID)
+	if err != nil {
+		return err
+	}
+	reason = strings.TrimSpace(reason)
+	if uID == operatorID {
+		return NewErr(ErrCodeBanFailed, BAN_FAILED, "administrators cannot ban themselves")
+	}
+	if !until.After(time.Now()) {
+		return NewErr(ErrCodeBanFailed, BAN_FAILED, "ban end time must be in the future")
+	}
+	if reason == "" {
+		return NewErr(ErrCodeBanFailed, BAN_FAILED, "ban reason is required")
+	}
+
+	err = ac.tx.InTx(ctx, func(ctx context.Context) error {
+		if err := ac.pr.SetBan(ctx, uID, until, reason); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return NewErr(ErrCodeBanFailed, BAN_FAILED, "target user not found")
+			}
+			return NewErr(ErrCodeBanFailed, BAN_FAILED, "failed to update account status")
+		}
+		audit := &bizProfile.BanAuditTB{
+			UserID:      uID,
+			OperatorID:  operatorID,
+			Action:      bizProfile.BanActionBan,
+			Reason:      reason,
+			BannedUntil: &until,
+		}
+		if err := ac.pr.CreateBanAudit(ctx, audit); err != nil {
+			return NewErr(ErrCodeBanFailed, BAN_FAILED, "failed to save ban audit")
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	ac.log.Infof("user %d banned by %d until %s: %s", uID, operatorID, until.Format(time.RFC3339), reason)
+
+	payload := &bizProfile.BanInfo{Until: until, Reason: reason}
+	if err := pushSystemNotify(strconv.Itoa(int(uID)), common.ACCOUNT_BANNED, payload); err != nil {
+		ac.log.Errorf("push ban notify to %d error: %v", uID, err)
+	}
+	return nil
+}
+
+// UnbanUser 提前解封
+func (ac *AdminUsecase) UnbanUser(ctx context.Context, userID string, reason string) error {
+	operatorID, err := ac.checkAdmin(ctx)
+	if err != nil {
+		return err
+	}
+	uID, err := parseTargetID(userID)
+	if err != nil {
+		return err
+	}
+
+	ban, err := ac.pr.GetBan(ctx, uID)
+	if err != nil {
+		return NewErr(ErrCodeDBQueryFailed, DB_QUERY_FAILED, "failed to query account status")
+	}
+	if ban == nil {
+		return NewErr(ErrCodeBanFailed, BAN_FAILED, "the user is not banned")
+	}
+
+	err = ac.tx.InTx(ctx, func(ctx context.Context) error {
+		if err := ac.pr.ClearBan(ctx, uID); err != nil {
+			return NewErr(ErrCodeBanFailed, BAN_FAILED, "failed to update account status")
+		}
+		audit := &bizProfile.BanAuditTB{
+			UserID:     uID,
+			OperatorID: operatorID,
+			Action:     bizProfile.BanActionUnban,
+			Reason:     strings.TrimSpace(reason),
+		}
+		if err := ac.pr.CreateBanAudit(ctx, audit); err != nil {
+			return NewErr(ErrCodeBanFailed, BAN_FAILED, "failed to save ban audit")
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	ac.log.Infof("user %d unbanned by %d", uID, operatorID)
+	return nil
+}
+
+// ListBanAudits 查询封禁记录，userID 为空时查询全部
+func (ac *AdminUsecase) ListBanAudits(ctx context.Context, userID string, page int, pageSize int) (*BanAuditListReply, error) {
+	if _, err := ac.checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+	var uID uint32
+	if userID != "" {
+		id, err := parseTargetID(userID)
+		if err != nil {
+			return nil, err
+		}
+		uID = id
+	}
+
+	page, pageSize = normalizePage(page, pageSize)
+	audits, total, err := ac.pr.ListBanAudits(ctx, uID, page, pageSize)
+	if err != nil {
+		return nil, NewErr(ErrCodeDBQueryFailed, DB_QUERY_FAILED, "failed to query ban audits")
+	}
+	return &BanAuditListReply{
+		Audits:   audits,
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	}, nil
+}
@@ -0,0 +1,109 @@
+package biz
+
+import (
+	"context"
+	bizProfile "kratos-realworld/internal/biz/profile"
+	"kratos-realworld/internal/conf"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+type fakeBanRepo struct {
+	bizProfile.ProfileRepo
+	bans   map[uint32]*bizProfile.BanInfo
+	audits []*bizProfile.BanAuditTB
+}
+
+func newFakeBanRepo() *fakeBanRepo {
+	return &fakeBanRepo{bans: make(map[uint32]*bizProfile.BanInfo)}
+}
+
+func (r *fakeBanRepo) SetBan(ctx context.Context, userID uint32, until time.Time, reason string) error {
+	if userID == 404 {
+		return gorm.ErrRecordNotFound
+	}
+	r.bans[userID] = &bizProfile.BanInfo{Until: until, Reason: reason}
+	return nil
+}
+
+func (r *fakeBanRepo) ClearBan(ctx context.Context, userID uint32) error {
+	delete(r.bans, userID)
+	return nil
+}
+
+func (r *fakeBanRepo) GetBan(ctx context.Context, userID uint32) (*bizProfile.BanInfo, error) {
+	return r.bans[userID], nil
+}
+
+func (r *fakeBanRepo) CreateBanAudit(ctx context.Context, audit *bizProfile.BanAuditTB) error {
+	r.audits = append(r.audits, audit)
+	return nil
+}
+
+func newTestAdminUsecase(pr bizProfile.ProfileRepo) *AdminUsecase {
+	return NewAdminUsecase(pr, fakeTx{}, &conf.Admin{UserIds: []uint32{1}}, log.DefaultLogger)
+}
+
+func TestBanUserValidation(t *testing.T) {
+	pr := newFakeBanRepo()
+	ac := newTestAdminUsecase(pr)
+	later := time.Now().Add(time.Hour)
+
+	err := ac.BanUser(userCtx(2), "3", later, "spam")
+	assert.Equal(t, PERMISSION_DENIED, errors.FromError(err).Reason)
+
+	cases := []struct {
+		name   string
+		target string
+		until  time.Time
+		reason string
+	}{
+		{"self", "1", later, "spam"},
+		{"past", "3", time.Now().Add(-time.Minute), "spam"},
+		{"no reason", "3", later, "  "},
+		{"not found", "404", later, "spam"},
+	}
+	for _, c := range cases {
+		err := ac.BanUser(userCtx(1), c.target, c.until, c.reason)
+		assert.Equal(t, BAN_FAILED, errors.FromError(err).Reason, c.name)
+	}
+	assert.Empty(t, pr.audits)
+}
+
+// 封禁期间 CheckBanned 拒绝，解封后恢复，每次操作都有审计记录
+func TestBanAndUnbanUser(t *testing.T) {
+	pr := newFakeBanRepo()
+	ac := newTestAdminUsecase(pr)
+	ctx := userCtx(1)
+
+	err := ac.UnbanUser(ctx, "3", "")
+	assert.Equal(t, BAN_FAILED, errors.FromError(err).Reason)
+
+	assert.NoError(t, ac.BanUser(ctx, "3", time.Now().Add(time.Hour), " spam "))
+	err = ac.CheckBanned(context.Background(), 3)
+	assert.Equal(t, ACCOUNT_BANNED, errors.FromError(err).Reason)
+	assert.Equal(t, "spam", pr.bans[3].Reason)
+
+	assert.NoError(t, ac.UnbanUser(ctx, "3", "appeal"))
+	assert.NoError(t, ac.CheckBanned(context.Background(), 3))
+
+	assert.Len(t, pr.audits, 2)
+	assert.Equal(t, bizProfile.BanActionBan, pr.audits[0].Action)
+	assert.Equal(t, uint32(1), pr.audits[0].OperatorID)
+	assert.Equal(t, bizProfile.BanActionUnban, pr.audits[1].Action)
+	assert.Equal(t, "appeal", pr.audits[1].Reason)
+}
+
+// 过了截止时间的封禁自动失效
+func TestCheckBannedExpired(t *testing.T) {
+	pr := newFakeBanRepo()
+	pr.bans[3] = &bizProfile.BanInfo{Until: time.Now().Add(-time.Minute), Reason: "spam"}
+	ac := newTestAdminUsecase(pr)
+
+	assert.NoError(t, ac.CheckBanned(context.Background(), 3))
+}
@@ -9,4 +9,9 @@ var ProviderSet = wire.NewSet(
 	NewGateWayUsecase,
 	NewProfileUsecase,
 	NewMessageUseCase,
+	NewGroupUsecase,
+	NewExportUsecase,
+	NewAdminUsecase,
+	NewPrivacyPolicy,
+	NewMomentUsecase,
 )
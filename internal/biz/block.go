@@ -0,0 +1,146 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	bizProfile "kratos-realworld/internal/biz/profile"
+	"kratos-realworld/internal/pkg/middleware/auth"
+
+	"gorm.io/gorm"
+)
+
+// checkBlocked 双方任意一方拉黑了另一方都不允许互动（关注、私聊、加好友）
+func checkBlocked(ctx context.Context, pr bizProfile.ProfileRepo, userID uint32, targetID uint32) error {
+	blockedBy, err := pr.CheckBlock(ctx, targetID, userID)
+	if err != nil {
+		return NewErr(ErrCodeDBQueryFailed, DB_QUERY_FAILED, "failed to query block relationship")
+	}
+	if blockedBy {
+		return NewErr(ErrCodeUserBlocked, USER_BLOCKED, "you have been blocked by this user")
+	}
+
+	blocked, err := pr.CheckBlock(ctx, userID, targetID)
+	if err != nil {
+		return NewErr(ErrCodeDBQueryFailed, DB_QUERY_FAILED, "failed to query block relationship")
+	}
+	if blocked {
+		return NewErr(ErrCodeUserBlocked, USER_BLOCKED, "you have blocked this user, unblock first")
+	}
+	return nil
+}
+
+// BlockUser 拉黑对方，同时解除双方的关注关系和好友关系
+func (pc *ProfileUsecase) BlockUser(ctx context.Context, targetID string) error {
+	userID := uint32(auth.FromContext(ctx).UserID)
+	tID, err := parseTargetID(targetID)
+	if err != nil {
+		return err
+	}
+	if userID == tID {
+		return NewErr(ErrCodeBlockFailed, BLOCK_FAILED, "user cannot block themselves")
+	}
+
+	if _, err := pc.pr.GetProfileByUserID(ctx, tID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return NewErr(ErrCodeBlockFailed, BLOCK_FAILED, "target user not found")
+		}
+		return NewErr(ErrCodeDBQueryFailed, DB_QUERY_FAILED, "failed to query profile by UserID")
+	}
+
+	isBlocked, err := pc.pr.CheckBlock(ctx, userID, tID)
+	if err != nil {
+		return NewErr(ErrCodeDBQueryFailed, DB_QUERY_FAILED, "failed to query block relationship")
+	}
+	if isBlocked {
+		return nil
+	}
+
+	// 先查出需要拆掉的关注边，事务里只做写操作
+	following, err := pc.pr.CheckFollow(ctx, userID, tID)
+	if err != nil {
+		return NewErr(ErrCodeDBQueryFailed, DB_QUERY_FAILED, "failed to query follow relationship")
+	}
+	followedBy, err := pc.pr.CheckFollow(ctx, tID, userID)
+	if err != nil {
+		return NewErr(ErrCodeDBQueryFailed, DB_QUERY_FAILED, "failed to query follow relationship")
+	}
+
+	err = pc.tx.InTx(ctx, func(ctx context.Context) error {
+		if err := pc.pr.BlockUser(ctx, userID, tID); err != nil {
+			return NewErr(ErrCodeBlockFailed, BLOCK_FAILED, "failed to insert block relationship")
+		}
+		if following {
+			if err := pc.removeFollowEdge(ctx, userID, tID); err != nil {
+				return err
+			}
+		}
+		if followedBy {
+			if err := pc.removeFollowEdge(ctx, tID, userID); err != nil {
+				return err
+			}
+		}
+		if _, err := pc.pr.DeleteFriend(ctx, userID, tID); err != nil {
+			return NewErr(ErrCodeBlockFailed, BLOCK_FAILED, "failed to delete friend relationship")
+		}
+		// 拉黑之后待处理的好友申请不能再被同意
+		if err := pc.pr.CancelPendingFriendRequests(ctx, userID, tID); err != nil {
+			return NewErr(ErrCodeBlockFailed, BLOCK_FAILED, "failed to cancel pending friend requests")
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	pc.removeSuggestion(ctx, userID, tID)
+	pc.removeSuggestion(ctx, tID, userID)
+	return nil
+}
+
+// removeFollowEdge 删除一条关注关系并修正双方的计数，需要在事务中调用
+func (pc *ProfileUsecase) removeFollowEdge(ctx context.Context, followerID uint32, followeeID uint32) error {
+	if err := pc.pr.UnfollowUser(ctx, followerID, followeeID); err != nil {
+		return NewErr(ErrCodeBlockFailed, BLOCK_FAILED, "failed to delete follow relationship")
+	}
+	if _, err := pc.pr.IncrementFollowCount(ctx, followerID, -1); err != nil {
+		return NewErr(ErrCodeBlockFailed, BLOCK_FAILED, "failed to decrease follower follow counts")
+	}
+	if _, err := pc.pr.IncrementFanCount(ctx, followeeID, -1); err != nil {
+		return NewErr(ErrCodeBlockFailed, BLOCK_FAILED, "failed to decrease followee fan counts")
+	}
+	return nil
+}
+
+// UnblockUser 取消拉黑，之前拆掉的关注关系不会恢复
+func (pc *ProfileUsecase) UnblockUser(ctx context.Context, targetID string) error {
+	userID := uint32(auth.FromContext(ctx).UserID)
+	tID, err := parseTargetID(targetID)
+	if err != nil {
+		return err
+	}
+
+	ok, err := pc.pr.UnblockUser(ctx, userID, tID)
+	if err != nil {
+		return NewErr(ErrCodeBlockFailed, BLOCK_FAILED, "failed to delete block relationship")
+	}
+	if !ok {
+		return NewErr(ErrCodeBlockFailed, BLOCK_FAILED, "the block relationship does not exist")
+	}
+	return nil
+}
+
+// ListBlocks 分页查询当前用户的黑名单
+func (pc *ProfileUsecase) ListBlocks(ctx context.Context, page int, pageSize int) (*BlockListReply, error) {
+	userID := uint32(auth.FromContext(ctx).UserID)
+
+	page, pageSize = normalizePage(page, pageSize)
+	blocks, total, err := pc.pr.ListBlocks(ctx, userID, page, pageSize)
+	if err != nil {
+		return nil, NewErr(ErrCodeDBQueryFailed, DB_QUERY_FAILED, "failed to query block list")
+	}
+	return &BlockListReply{
+		Blocks:   blocks,
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	}, nil
+}
@@ -0,0 +1,98 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	bizMoment "kratos-realworld/internal/biz/moments"
+	bizProfile "kratos-realworld/internal/biz/profile"
+	"testing"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+)
+
+var errFakeDB = errors.New("db unavailable")
+
+type fakeBlockRepo struct {
+	bizProfile.ProfileRepo
+	checkBlockErr  error
+	checkFollowErr error
+	blocked        bool
+	canceled       bool
+}
+
+func (r *fakeBlockRepo) GetProfileByUserID(ctx context.Context, userID uint32) (*bizProfile.ProfileTB, error) {
+	return &bizProfile.ProfileTB{UserID: userID}, nil
+}
+
+func (r *fakeBlockRepo) CheckBlock(ctx context.Context, userID uint32, targetID uint32) (bool, error) {
+	return false, r.checkBlockErr
+}
+
+func (r *fakeBlockRepo) CheckFollow(ctx context.Context, userID uint32, targetID uint32) (bool, error) {
+	return false, r.checkFollowErr
+}
+
+func (r *fakeBlockRepo) BlockUser(ctx context.Context, userID uint32, targetID uint32) error {
+	r.blocked = true
+	return nil
+}
+
+func (r *fakeBlockRepo) DeleteFriend(ctx context.Context, userID uint32, friendID uint32) (bool, error) {
+	return false, nil
+}
+
+func (r *fakeBlockRepo) CancelPendingFriendRequests(ctx context.Context, userID uint32, targetID uint32) error {
+	r.canceled = true
+	return nil
+}
+
+func (r *fakeBlockRepo) GetSuggestions(ctx context.Context, userID uint32) ([]*bizProfile.SuggestionItem, error) {
+	return nil, nil
+}
+
+// 查不到关注关系时不能拉黑，否则关注边和计数会留下脏数据
+func TestBlockUserFollowCheckError(t *testing.T) {
+	pr := &fakeBlockRepo{checkFollowErr: errFakeDB}
+	pc := newFriendUsecase(pr)
+
+	err := pc.BlockUser(userCtx(1), "2")
+	assert.Equal(t, DB_QUERY_FAILED, kerrors.FromError(err).Reason)
+	assert.False(t, pr.blocked)
+	assert.False(t, pr.canceled)
+}
+
+// 拉黑时作废两人之间待处理的好友申请
+func TestBlockUserCancelsFriendRequests(t *testing.T) {
+	pr := &fakeBlockRepo{}
+	pc := newFriendUsecase(pr)
+
+	assert.NoError(t, pc.BlockUser(userCtx(1), "2"))
+	assert.True(t, pr.blocked)
+	assert.True(t, pr.canceled)
+}
+
+type fakeMomentRepo struct {
+	bizMoment.MomentRepo
+	moments map[uint32]*bizMoment.MomentTB
+}
+
+func (r *fakeMomentRepo) GetMoment(ctx context.Context, momentID uint32) (*bizMoment.MomentTB, error) {
+	if m, ok := r.moments[momentID]; ok {
+		return m, nil
+	}
+	return nil, errFakeDB
+}
+
+// 查不到拉黑关系时不能当作没有拉黑
+func TestGetMomentBlockCheckError(t *testing.T) {
+	mr := &fakeMomentRepo{moments: map[uint32]*bizMoment.MomentTB{1: {ID: 1, UserID: 2, Visibility: bizMoment.VisibilityPublic}}}
+	uc := NewMomentUsecase(mr, nil, &fakeBlockRepo{checkBlockErr: errFakeDB}, nil, nil, nil, nil, log.DefaultLogger)
+
+	_, err := uc.GetMoment(context.Background(), 3, 1)
+	assert.Equal(t, MOMENT_FAILED, kerrors.FromError(err).Reason)
+
+	visible := uc.canViewMoments(context.Background(), 2, 3)
+	assert.False(t, visible)
+}
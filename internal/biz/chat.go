@@ -2,25 +2,46 @@ package biz
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
 	bizChat "kratos-realworld/internal/biz/messageGroup"
+	bizProfile "kratos-realworld/internal/biz/profile"
 	"kratos-realworld/internal/common"
+	"kratos-realworld/internal/conf"
+	"kratos-realworld/internal/model"
+	"kratos-realworld/internal/pkg/middleware/auth"
+	"strconv"
+	"time"
 )
 
 type MessageUseCase struct {
 	mr  bizChat.MessageRepo
+	gr  bizChat.GroupRepo
+	cr  bizChat.ConversationRepo
+	pr  bizProfile.ProfileRepo
+	pp  *PrivacyPolicy
+	lk  model.Locker
+	dc  *conf.Data
 	log *log.Helper
 }
 
-func NewMessageUseCase(mr bizChat.MessageRepo, logger log.Logger) *MessageUseCase {
+func NewMessageUseCase(mr bizChat.MessageRepo, gr bizChat.GroupRepo, cr bizChat.ConversationRepo, pr bizProfile.ProfileRepo, pp *PrivacyPolicy, lk model.Locker, dc *conf.Data, logger log.Logger) *MessageUseCase {
 	return &MessageUseCase{
 		mr:  mr,
+		gr:  gr,
+		cr:  cr,
+		pr:  pr,
+		pp:  pp,
+		lk:  lk,
+		dc:  dc,
 		log: log.NewHelper(logger),
 	}
 }
 
 func (mc *MessageUseCase) SaveMessage(message *bizChat.MessageTB) error {
+	mc.applyMessageExpire(context.Background(), message)
 	err := mc.mr.SaveMessage(message)
 	if err != nil {
 		return NewErr(ErrCodeMessageFailed, MESSAGE_FAILED, "Save message to database failed")
@@ -42,3 +63,335 @@ func (mc *MessageUseCase) GetMessages(ctx context.Context, messageReq common.Mes
 func (mc *MessageUseCase) fetchGroupMessage() {
 
 }
+
+// CheckPrivateMessage 单聊发送前校验，双方有拉黑关系，或者接收者的私信设置不允许时不能发送
+func (mc *MessageUseCase) CheckPrivateMessage(ctx context.Context, fromID string, toID string) error {
+	from, err := strconv.ParseUint(fromID, 10, 32)
+	if err != nil {
+		return NewErr(ErrCodeInvalidParams, INVALID_PARAMS, "invalid sender id")
+	}
+	to, err := strconv.ParseUint(toID, 10, 32)
+	if err != nil {
+		return NewErr(ErrCodeInvalidParams, INVALID_PARAMS, "invalid receiver id")
+	}
+	if err := checkBlocked(ctx, mc.pr, uint32(from), uint32(to)); err != nil {
+		return err
+	}
+	return mc.pp.CheckCanMessage(ctx, uint32(from), uint32(to))
+}
+
+// PrepareGroupMessage 校验群消息的发送者和@对象，返回需要投递的成员
+func (mc *MessageUseCase) PrepareGroupMessage(ctx context.Context, fromID string, groupUuid string, mentions []string) (*GroupDelivery, error) {
+	senderID, err := strconv.ParseUint(fromID, 10, 32)
+	if err != nil {
+		return nil, NewErr(ErrCodeInvalidParams, INVALID_PARAMS, "invalid sender id")
+	}
+
+	group, err := getGroup(ctx, mc.gr, groupUuid)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := mc.gr.ListGroupMembers(ctx, group.ID)
+	if err != nil {
+		return nil, NewErr(ErrCodeDBQueryFailed, DB_QUERY_FAILED, "failed to query group members")
+	}
+
+	var sender *bizChat.GroupMemberTB
+	memberSet := make(map[uint32]struct{}, len(members))
+	receiverIDs := make([]uint32, 0, len(members))
+	for _, m := range members {
+		memberSet[m.UserID] = struct{}{}
+		if m.UserID == uint32(senderID) {
+			sender = m
+			continue
+		}
+		receiverIDs = append(receiverIDs, m.UserID)
+	}
+	if sender == nil {
+		return nil, NewErr(ErrCodeNotGroupMember, NOT_GROUP_MEMBER, "you are not a member of this group")
+	}
+	if err := checkCanSpeak(group, sender, time.Now()); err != nil {
+		return nil, err
+	}
+
+	mentionedIDs, err := resolveMentions(group, sender, memberSet, receiverIDs, mentions)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GroupDelivery{
+		Group:        group,
+		ReceiverIDs:  receiverIDs,
+		MentionedIDs: mentionedIDs,
+	}, nil
+}
+
+// resolveMentions 把消息里的@列表解析成群成员ID，@自己会被忽略
+func resolveMentions(group *bizChat.GroupTB, sender *bizChat.GroupMemberTB, memberSet map[uint32]struct{}, receiverIDs []uint32, mentions []string) ([]uint32, error) {
+	if len(mentions) == 0 {
+		return nil, nil
+	}
+
+	if Contains(mentions, common.MENTION_ALL) {
+		if !canMentionAll(group, sender) {
+			return nil, NewErr(ErrCodeMentionAllDenied, MENTION_ALL_DENIED, "only group managers can mention all")
+		}
+		return receiverIDs, nil
+	}
+
+	seen := make(map[uint32]struct{}, len(mentions))
+	mentionedIDs := make([]uint32, 0, len(mentions))
+	for _, s := range mentions {
+		id, err := strconv.ParseUint(s, 10, 32)
+		if err != nil {
+			return nil, NewErr(ErrCodeInvalidMention, INVALID_MENTION, "invalid mention: "+s)
+		}
+		uid := uint32(id)
+		if _, ok := memberSet[uid]; !ok {
+			return nil, NewErr(ErrCodeInvalidMention, INVALID_MENTION, "mentioned user is not a group member: "+s)
+		}
+		if _, ok := seen[uid]; ok || uid == sender.UserID {
+			continue
+		}
+		seen[uid] = struct{}{}
+		mentionedIDs = append(mentionedIDs, uid)
+	}
+	return mentionedIDs, nil
+}
+
+// canMentionAll 只有群主和管理员可以@所有人
+func canMentionAll(group *bizChat.GroupTB, member *bizChat.GroupMemberTB) bool {
+	return isGroupManager(group, member)
+}
+
+// checkCanSpeak 群主和管理员不受禁言限制
+func checkCanSpeak(group *bizChat.GroupTB, member *bizChat.GroupMemberTB, now time.Time) error {
+	if isGroupManager(group, member) {
+		return nil
+	}
+	if member.IsMuted(now) {
+		return NewErr(ErrCodeMemberMuted, MEMBER_MUTED, "you have been muted in this group")
+	}
+	if group.MuteAll != 0 {
+		return NewErr(ErrCodeGroupMuted, GROUP_MUTED, "this group is muted")
+	}
+	return nil
+}
+
+// TouchConversations 消息落库后更新发送者和接收者的会话
+func (mc *MessageUseCase) TouchConversations(ctx context.Context, message *bizChat.MessageTB, receiverIDs []uint32, mentionedIDs []uint32) error {
+	senderID, err := strconv.ParseUint(message.FromUserID, 10, 32)
+	if err != nil {
+		return NewErr(ErrCodeInvalidParams, INVALID_PARAMS, "invalid sender id")
+	}
+
+	// 群聊双方的会话对象都是群，单聊接收者的会话对象是发送者
+	receiverTarget := message.ToUserID
+	if message.MessageType != common.MESSAGE_TYPE_GROUP {
+		receiverTarget = message.FromUserID
+	}
+
+	err = mc.cr.TouchSenderConversation(ctx, uint32(senderID), message.ToUserID, message.MessageType, message.ID)
+	if err != nil {
+		return NewErr(ErrCodeMessageFailed, MESSAGE_FAILED, "update sender conversation failed")
+	}
+	err = mc.cr.TouchReceiverConversations(ctx, receiverIDs, receiverTarget, message.MessageType, message.ID, mentionedIDs)
+	if err != nil {
+		return NewErr(ErrCodeMessageFailed, MESSAGE_FAILED, "update receiver conversations failed")
+	}
+	return nil
+}
+
+// ListConversations archived 为 true 时查看归档的会话
+func (mc *MessageUseCase) ListConversations(ctx context.Context, archived bool) ([]*ConversationReply, error) {
+	userID := auth.FromContext(ctx).UserID
+	conversations, err := mc.cr.ListConversations(ctx, uint32(userID), archived)
+	if err != nil {
+		return nil, NewErr(ErrCodeDBQueryFailed, DB_QUERY_FAILED, "failed to query conversations")
+	}
+
+	res := make([]*ConversationReply, 0, len(conversations))
+	for _, c := range conversations {
+		res = append(res, toConversationReply(c))
+	}
+	return res, nil
+}
+
+// ReadConversation 把会话标记为已读到 messageID，messageID 为 0 时全部已读
+func (mc *MessageUseCase) ReadConversation(ctx context.Context, targetID string, messageID uint32) (*ConversationReply, error) {
+	userID := auth.FromContext(ctx).UserID
+	conversation, err := mc.cr.GetConversation(ctx, uint32(userID), targetID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, NewErr(ErrCodeConversationNotFound, CONVERSATION_NOT_FOUND, "conversation not found")
+		}
+		return nil, NewErr(ErrCodeDBQueryFailed, DB_QUERY_FAILED, "failed to query conversation")
+	}
+
+	if messageID == 0 || messageID > conversation.LastMessageID {
+		messageID = conversation.LastMessageID
+	}
+	// 已读位置只能前进
+	if messageID <= conversation.LastReadMessageID {
+		return toConversationReply(conversation), nil
+	}
+
+	unread, err := mc.cr.CountUnread(ctx, conversation, messageID)
+	if err != nil {
+		return nil, NewErr(ErrCodeDBQueryFailed, DB_QUERY_FAILED, "failed to count unread messages")
+	}
+	if err := mc.cr.ReadConversation(ctx, conversation, messageID, unread); err != nil {
+		return nil, NewErr(ErrCodeMessageFailed, MESSAGE_FAILED, "failed to update conversation")
+	}
+
+	conversation.LastReadMessageID = messageID
+	conversation.UnreadCount = unread
+	conversation.ReadMentionsTo(messageID)
+	return toConversationReply(conversation), nil
+}
+
+// checkConversationTarget 单聊对象必须是存在的其他用户，群聊必须是自己所在的群，群聊时返回群和自己的成员信息
+func (mc *MessageUseCase) checkConversationTarget(ctx context.Context, userID uint32, targetID string, conversationType uint16) (*bizChat.GroupTB, *bizChat.GroupMemberTB, error) {
+	switch conversationType {
+	case common.MESSAGE_TYPE_USER:
+		id, err := strconv.ParseUint(targetID, 10, 32)
+		if err != nil || uint32(id) == userID {
+			return nil, nil, NewErr(ErrCodeInvalidParams, INVALID_PARAMS, "invalid target user")
+		}
+		if _, err := mc.pr.GetProfileByUserID(ctx, uint32(id)); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, nil, NewErr(ErrCodeInvalidParams, INVALID_PARAMS, "target user not found")
+			}
+			return nil, nil, NewErr(ErrCodeDBQueryFailed, DB_QUERY_FAILED, "failed to query profile by UserID")
+		}
+		return nil, nil, nil
+	case common.MESSAGE_TYPE_GROUP:
+		group, err := getGroup(ctx, mc.gr, targetID)
+		if err != nil {
+			return nil, nil, err
+		}
+		member, err := getGroupMember(ctx, mc.gr, group, userID)
+		if err != nil {
+			return nil, nil, err
+		}
+		return group, member, nil
+	}
+	return nil, nil, NewErr(ErrCodeInvalidParams, INVALID_PARAMS, "invalid conversation type")
+}
+
+// UpdateConversationSettings 设置免打扰、置顶和归档，只修改 patch 里传了的字段
+// muteForever 为 true 时优先于 mutedUntil，muteForever 为 false 并且没有 mutedUntil 表示取消免打扰
+func (mc *MessageUseCase) UpdateConversationSettings(ctx context.Context, targetID string, conversationType uint16, patch *ConversationSettingsPatch) (*ConversationReply, error) {
+	userID := uint32(auth.FromContext(ctx).UserID)
+	conversation, err := mc.cr.GetConversation(ctx, userID, targetID)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, NewErr(ErrCodeDBQueryFailed, DB_QUERY_FAILED, "failed to query conversation")
+		}
+		// 会话还不存在时才需要校验对象，已有的会话一定是收发过消息的
+		if _, _, err := mc.checkConversationTarget(ctx, userID, targetID, conversationType); err != nil {
+			return nil, err
+		}
+		conversation = &bizChat.ConversationTB{
+			UserID:           userID,
+			TargetID:         targetID,
+			ConversationType: conversationType,
+		}
+	}
+
+	var columns []string
+	now := time.Now()
+	switch {
+	case patch.MuteForever != nil && *patch.MuteForever:
+		forever := bizChat.ConversationMuteForever
+		conversation.MutedUntil = &forever
+		columns = append(columns, "muted_until")
+	case patch.MutedUntil != nil:
+		if !patch.MutedUntil.After(now) {
+			return nil, NewErr(ErrCodeInvalidParams, INVALID_PARAMS, "muted_until must be in the future")
+		}
+		conversation.MutedUntil = patch.MutedUntil
+		columns = append(columns, "muted_until")
+	case patch.MuteForever != nil:
+		conversation.MutedUntil = nil
+		columns = append(columns, "muted_until")
+	}
+
+	if patch.Pinned != nil {
+		if !*patch.Pinned {
+			conversation.PinnedOrder = 0
+		} else if conversation.PinnedOrder == 0 {
+			// 新置顶的会话排在最前面
+			maxOrder, err := mc.cr.MaxPinnedOrder(ctx, userID)
+			if err != nil {
+				return nil, NewErr(ErrCodeDBQueryFailed, DB_QUERY_FAILED, "failed to query pinned conversations")
+			}
+			conversation.PinnedOrder = maxOrder + 1
+		}
+		columns = append(columns, "pinned_order")
+	}
+	if patch.Archived != nil {
+		conversation.Archived = boolToUint16(*patch.Archived)
+		columns = append(columns, "archived")
+	}
+	if len(columns) == 0 {
+		return toConversationReply(conversation), nil
+	}
+
+	if err := mc.cr.SaveConversationSettings(ctx, conversation, columns); err != nil {
+		return nil, NewErr(ErrCodeMessageFailed, MESSAGE_FAILED, "failed to update conversation settings")
+	}
+
+	res := toConversationReply(conversation)
+	var mutedUntilUnix int64
+	if res.Muted {
+		mutedUntilUnix = conversation.MutedUntil.Unix()
+	}
+	err = pushSystemNotify(strconv.Itoa(int(userID)), common.CONVERSATION_SYNC, map[string]interface{}{
+		"targetId":         res.TargetID,
+		"conversationType": res.ConversationType,
+		"mutedUntil":       mutedUntilUnix,
+		"pinnedOrder":      res.PinnedOrder,
+		"archived":         res.Archived,
+	})
+	if err != nil {
+		mc.log.Errorf("push conversation settings to %d error: %v", userID, err)
+	}
+	return res, nil
+}
+
+// MutedReceivers 开启了免打扰的接收者，查询失败时按未免打扰处理，不影响投递
+func (mc *MessageUseCase) MutedReceivers(ctx context.Context, receiverIDs []uint32, targetID string) map[uint32]struct{} {
+	muted := make(map[uint32]struct{})
+	ids, err := mc.cr.ListMutedUserIDs(ctx, receiverIDs, targetID, time.Now())
+	if err != nil {
+		mc.log.Errorf("ListMutedUserIDs error: %v", err)
+		return muted
+	}
+	for _, id := range ids {
+		muted[id] = struct{}{}
+	}
+	return muted
+}
+
+func toConversationReply(c *bizChat.ConversationTB) *ConversationReply {
+	muted := c.IsMuted(time.Now())
+	var mutedUntil *time.Time
+	if muted {
+		mutedUntil = c.MutedUntil
+	}
+	return &ConversationReply{
+		TargetID:          c.TargetID,
+		ConversationType:  c.ConversationType,
+		LastMessageID:     c.LastMessageID,
+		LastReadMessageID: c.LastReadMessageID,
+		UnreadCount:       c.UnreadCount,
+		Mentioned:         c.MentionMessageID != 0,
+		MentionMessageID:  c.MentionMessageID,
+		MutedUntil:        mutedUntil,
+		Muted:             muted,
+		PinnedOrder:       c.PinnedOrder,
+		Archived:          c.Archived != 0,
+	}
+}
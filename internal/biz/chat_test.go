@@ -0,0 +1,225 @@
+package biz
+
+import (
+	"context"
+	bizChat "kratos-realworld/internal/biz/messageGroup"
+	bizProfile "kratos-realworld/internal/biz/profile"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestResolveMentions(t *testing.T) {
+	group := &bizChat.GroupTB{ID: 1, UserID: 1}
+	owner := &bizChat.GroupMemberTB{UserID: 1, GroupID: 1}
+	member := &bizChat.GroupMemberTB{UserID: 2, GroupID: 1}
+	memberSet := map[uint32]struct{}{1: {}, 2: {}, 3: {}}
+
+	ids, err := resolveMentions(group, member, memberSet, []uint32{1, 3}, []string{"3", "3", "2"})
+	assert.NoError(t, err)
+	assert.Equal(t, []uint32{3}, ids)
+
+	_, err = resolveMentions(group, member, memberSet, []uint32{1, 3}, []string{"4"})
+	assert.Equal(t, INVALID_MENTION, errors.FromError(err).Reason)
+
+	_, err = resolveMentions(group, member, memberSet, []uint32{1, 3}, []string{"all"})
+	assert.Equal(t, MENTION_ALL_DENIED, errors.FromError(err).Reason)
+
+	ids, err = resolveMentions(group, owner, memberSet, []uint32{2, 3}, []string{"all"})
+	assert.NoError(t, err)
+	assert.Equal(t, []uint32{2, 3}, ids)
+
+	admin := &bizChat.GroupMemberTB{UserID: 3, GroupID: 1, Role: bizChat.GroupRoleAdmin}
+	ids, err = resolveMentions(group, admin, memberSet, []uint32{1, 2}, []string{"all"})
+	assert.NoError(t, err)
+	assert.Equal(t, []uint32{1, 2}, ids)
+}
+
+func TestCheckCanSpeak(t *testing.T) {
+	now := time.Now()
+	later := now.Add(time.Hour)
+	earlier := now.Add(-time.Hour)
+	group := &bizChat.GroupTB{ID: 1, UserID: 1}
+
+	assert.NoError(t, checkCanSpeak(group, &bizChat.GroupMemberTB{UserID: 2}, now))
+	assert.NoError(t, checkCanSpeak(group, &bizChat.GroupMemberTB{UserID: 2, MuteUntil: &earlier}, now))
+
+	err := checkCanSpeak(group, &bizChat.GroupMemberTB{UserID: 2, MuteUntil: &later}, now)
+	assert.Equal(t, MEMBER_MUTED, errors.FromError(err).Reason)
+	err = checkCanSpeak(group, &bizChat.GroupMemberTB{UserID: 2, Mute: 1}, now)
+	assert.Equal(t, MEMBER_MUTED, errors.FromError(err).Reason)
+
+	group.MuteAll = 1
+	err = checkCanSpeak(group, &bizChat.GroupMemberTB{UserID: 2}, now)
+	assert.Equal(t, GROUP_MUTED, errors.FromError(err).Reason)
+	assert.NoError(t, checkCanSpeak(group, &bizChat.GroupMemberTB{UserID: 3, Role: bizChat.GroupRoleAdmin, Mute: 1}, now))
+	assert.NoError(t, checkCanSpeak(group, &bizChat.GroupMemberTB{UserID: 1}, now))
+}
+
+func TestConversationKey(t *testing.T) {
+	assert.Equal(t, "u:9:10", conversationKey(1, "10", "9"))
+	assert.Equal(t, "u:9:10", conversationKey(1, "9", "10"))
+	assert.Equal(t, "g:abc", conversationKey(2, "10", "abc"))
+}
+
+type fakeConversationRepo struct {
+	bizChat.ConversationRepo
+	conversations map[string]*bizChat.ConversationTB
+	savedColumns  []string
+}
+
+func (r *fakeConversationRepo) GetConversation(ctx context.Context, userID uint32, targetID string) (*bizChat.ConversationTB, error) {
+	if c, ok := r.conversations[targetID]; ok {
+		copied := *c
+		return &copied, nil
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (r *fakeConversationRepo) SaveConversationSettings(ctx context.Context, conversation *bizChat.ConversationTB, columns []string) error {
+	r.savedColumns = columns
+	r.conversations[conversation.TargetID] = conversation
+	return nil
+}
+
+func (r *fakeConversationRepo) MaxPinnedOrder(ctx context.Context, userID uint32) (uint32, error) {
+	return 3, nil
+}
+
+type fakeProfileRepo struct {
+	bizProfile.ProfileRepo
+	users map[uint32]bool
+}
+
+func (r *fakeProfileRepo) GetProfileByUserID(ctx context.Context, userID uint32) (*bizProfile.ProfileTB, error) {
+	if !r.users[userID] {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &bizProfile.ProfileTB{UserID: userID}, nil
+}
+
+func TestUpdateConversationSettingsPatch(t *testing.T) {
+	later := time.Now().Add(time.Hour)
+	cr := &fakeConversationRepo{conversations: map[string]*bizChat.ConversationTB{
+		"2": {UserID: 1, TargetID: "2", ConversationType: 1, MutedUntil: &later, PinnedOrder: 5},
+	}}
+	mc := NewMessageUseCase(nil, nil, cr, &fakeProfileRepo{users: map[uint32]bool{1: true, 2: true}}, nil, nil, nil, log.DefaultLogger)
+	ctx := userCtx(1)
+	yes, no := true, false
+
+	// 只归档，免打扰和置顶不变
+	res, err := mc.UpdateConversationSettings(ctx, "2", 1, &ConversationSettingsPatch{Archived: &yes})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"archived"}, cr.savedColumns)
+	assert.True(t, res.Archived)
+	assert.True(t, res.Muted)
+	assert.Equal(t, uint32(5), res.PinnedOrder)
+
+	// 取消免打扰
+	res, err = mc.UpdateConversationSettings(ctx, "2", 1, &ConversationSettingsPatch{MuteForever: &no})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"muted_until"}, cr.savedColumns)
+	assert.False(t, res.Muted)
+	assert.True(t, res.Archived)
+
+	// 没传任何字段不写库
+	cr.savedColumns = nil
+	_, err = mc.UpdateConversationSettings(ctx, "2", 1, &ConversationSettingsPatch{})
+	assert.NoError(t, err)
+	assert.Nil(t, cr.savedColumns)
+}
+
+func TestUpdateConversationSettingsTarget(t *testing.T) {
+	cr := &fakeConversationRepo{conversations: map[string]*bizChat.ConversationTB{}}
+	mc := NewMessageUseCase(nil, nil, cr, &fakeProfileRepo{users: map[uint32]bool{1: true, 2: true}}, nil, nil, nil, log.DefaultLogger)
+	ctx := userCtx(1)
+	yes := true
+
+	for _, target := range []string{"1", "3", "abc"} {
+		_, err := mc.UpdateConversationSettings(ctx, target, 1, &ConversationSettingsPatch{Pinned: &yes})
+		assert.Equal(t, INVALID_PARAMS, errors.FromError(err).Reason, target)
+	}
+	_, err := mc.UpdateConversationSettings(ctx, "2", 9, &ConversationSettingsPatch{Pinned: &yes})
+	assert.Equal(t, INVALID_PARAMS, errors.FromError(err).Reason)
+
+	// 新置顶的会话排在最前面
+	res, err := mc.UpdateConversationSettings(ctx, "2", 1, &ConversationSettingsPatch{Pinned: &yes})
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(4), res.PinnedOrder)
+	assert.Equal(t, []string{"pinned_order"}, cr.savedColumns)
+}
+
+func TestSetConversationTimerTarget(t *testing.T) {
+	mc := NewMessageUseCase(nil, nil, nil, &fakeProfileRepo{users: map[uint32]bool{1: true}}, nil, nil, nil, log.DefaultLogger)
+
+	_, err := mc.SetConversationTimer(userCtx(1), "3", 1, bizChat.ConversationTimerDay)
+	assert.Equal(t, INVALID_PARAMS, errors.FromError(err).Reason)
+	_, err = mc.SetConversationTimer(userCtx(1), "1", 1, bizChat.ConversationTimerDay)
+	assert.Equal(t, INVALID_PARAMS, errors.FromError(err).Reason)
+}
+
+func TestReadMentionsTo(t *testing.T) {
+	tests := []struct {
+		mention, last, readTo, want uint32
+	}{
+		{0, 0, 10, 0},
+		{5, 5, 4, 5}, // 还没读到最早那条
+		{5, 5, 5, 0}, // 唯一的@读过了
+		{5, 9, 6, 9}, // 后面还有没读的@
+		{5, 9, 9, 0}, // 全部读完
+		{5, 0, 6, 0}, // 老数据没有记录最近一条
+	}
+	for _, tt := range tests {
+		c := &bizChat.ConversationTB{MentionMessageID: tt.mention, LastMentionID: tt.last}
+		c.ReadMentionsTo(tt.readTo)
+		assert.Equal(t, tt.want, c.MentionMessageID, "%+v", tt)
+	}
+}
+
+// fakeExpiredRepo 每次按 limit 取出一批过期消息
+type fakeExpiredRepo struct {
+	bizChat.MessageRepo
+	expired []*bizChat.MessageTB
+	deleted []uint32
+}
+
+func (r *fakeExpiredRepo) ListExpiredMessages(ctx context.Context, now time.Time, createdBefore *time.Time, limit int) ([]*bizChat.MessageTB, error) {
+	n := min(limit, len(r.expired))
+	batch := r.expired[:n]
+	r.expired = r.expired[n:]
+	return batch, nil
+}
+
+func (r *fakeExpiredRepo) DeleteMessages(ctx context.Context, ids []uint32) error {
+	r.deleted = append(r.deleted, ids...)
+	return nil
+}
+
+// 多个实例同时执行清理时只有抢到锁的实例删除
+func TestPurgeExpiredMessagesLocked(t *testing.T) {
+	mr := &fakeExpiredRepo{}
+	for i := uint32(1); i <= 5; i++ {
+		mr.expired = append(mr.expired, &bizChat.MessageTB{ID: i, FromUserID: "1", ToUserID: "2", MessageType: 1})
+	}
+	lk := newFakeLocker()
+	mc := NewMessageUseCase(mr, nil, nil, nil, nil, lk, nil, log.DefaultLogger)
+
+	lk.held[purgeExpiredMessagesLockKey] = "other"
+	n, ok, err := mc.PurgeExpiredMessages(context.Background(), 2, 10, 0, time.Minute)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	assert.Zero(t, n)
+	assert.Empty(t, mr.deleted)
+
+	delete(lk.held, purgeExpiredMessagesLockKey)
+	n, ok, err = mc.PurgeExpiredMessages(context.Background(), 2, 10, 0, time.Minute)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, 5, n)
+	assert.Equal(t, []uint32{1, 2, 3, 4, 5}, mr.deleted)
+	assert.Empty(t, lk.held)
+}
@@ -0,0 +1,153 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	bizMoment "kratos-realworld/internal/biz/moments"
+	"kratos-realworld/internal/pkg/middleware/auth"
+
+	"gorm.io/gorm"
+)
+
+// getComment 评论不存在、已删除或者不属于这条动态时都当作不存在
+func (uc *MomentUsecase) getComment(ctx context.Context, momentID uint32, commentID uint32) (*bizMoment.CommentsTB, error) {
+	comment, err := uc.mr.GetComment(ctx, commentID)
+	if errors.Is(err, gorm.ErrRecordNotFound) || (err == nil && comment.MomentID != momentID) {
+		return nil, NewErr(ErrCodeMomentNotFound, MOMENT_NOT_FOUND, "comment not found")
+	}
+	if err != nil {
+		uc.log.Errorf("GetComment error: %v", err)
+		return nil, NewErr(ErrCodeMomentFailed, MOMENT_FAILED, "Get comment failed")
+	}
+	return comment, nil
+}
+
+// canSeeComment 动态作者能看到所有评论，其他人只能看到自己、作者和自己好友的评论
+func (uc *MomentUsecase) canSeeComment(ctx context.Context, moment *bizMoment.MomentTB, viewerID uint32, commenterID uint32) (bool, error) {
+	if viewerID == moment.UserID || commenterID == viewerID || commenterID == moment.UserID {
+		return true, nil
+	}
+	return uc.pr.CheckFriend(ctx, viewerID, commenterID)
+}
+
+// getVisibleComment 看不到的评论当作不存在
+func (uc *MomentUsecase) getVisibleComment(ctx context.Context, moment *bizMoment.MomentTB, viewerID uint32, commentID uint32) (*bizMoment.CommentsTB, error) {
+	comment, err := uc.getComment(ctx, moment.ID, commentID)
+	if err != nil {
+		return nil, err
+	}
+	visible, err := uc.canSeeComment(ctx, moment, viewerID, comment.UserID)
+	if err != nil {
+		uc.log.Errorf("CheckFriend error: %v", err)
+		return nil, NewErr(ErrCodeMomentFailed, MOMENT_FAILED, "Get comment failed")
+	}
+	if !visible {
+		return nil, NewErr(ErrCodeMomentNotFound, MOMENT_NOT_FOUND, "comment not found")
+	}
+	return comment, nil
+}
+
+// ListComments parentID 为 0 时分页查一级评论，否则查这条一级评论下的回复
+func (uc *MomentUsecase) ListComments(ctx context.Context, momentID uint32, parentID uint32, cursor uint32, limit int) (*CommentListReply, error) {
+	viewerID := uint32(auth.FromContext(ctx).UserID)
+	moment, err := uc.GetMoment(ctx, viewerID, momentID)
+	if err != nil {
+		return nil, err
+	}
+	if parentID != 0 {
+		parent, err := uc.getVisibleComment(ctx, moment, viewerID, parentID)
+		if err != nil {
+			return nil, err
+		}
+		if parent.ParentID != 0 {
+			return nil, NewErr(ErrCodeInvalidParams, INVALID_PARAMS, "parent comment must be a top-level comment")
+		}
+	}
+	return uc.listComments(ctx, moment, viewerID, parentID, cursor, limit)
+}
+
+func (uc *MomentUsecase) listComments(ctx context.Context, moment *bizMoment.MomentTB, viewerID uint32, parentID uint32, cursor uint32, limit int) (*CommentListReply, error) {
+	_, limit = normalizePage(1, limit)
+	query := &bizMoment.CommentQuery{
+		MomentID: moment.ID,
+		ParentID: parentID,
+		Cursor:   cursor,
+		Limit:    limit + 1,
+	}
+	if viewerID != moment.UserID {
+		query.FriendsOf = viewerID
+		query.VisibleIDs = []uint32{viewerID, moment.UserID}
+	}
+	comments, err := uc.mr.ListComments(ctx, query)
+	if err != nil {
+		uc.log.Errorf("ListComments error: %v", err)
+		return nil, NewErr(ErrCodeMomentFailed, MOMENT_FAILED, "List comments failed")
+	}
+
+	reply := &CommentListReply{Liked: make(map[uint32]bool)}
+	if len(comments) > limit {
+		comments = comments[:limit]
+		reply.HasMore = true
+	}
+	reply.Comments = comments
+	if len(comments) == 0 {
+		return reply, nil
+	}
+	reply.NextCursor = comments[len(comments)-1].ID
+
+	ids := make([]uint32, 0, len(comments))
+	for _, c := range comments {
+		ids = append(ids, c.ID)
+	}
+	likedIDs, err := uc.mr.FilterLikedComments(ctx, viewerID, ids)
+	if err != nil {
+		uc.log.Errorf("FilterLikedComments error: %v", err)
+		return nil, NewErr(ErrCodeMomentFailed, MOMENT_FAILED, "List comments failed")
+	}
+	for _, id := range likedIDs {
+		reply.Liked[id] = true
+	}
+	return reply, nil
+}
+
+// LikeComment 看不到的评论不能点赞，重复点赞不报错
+func (uc *MomentUsecase) LikeComment(ctx context.Context, momentID uint32, commentID uint32) (*CommentLikeReply, error) {
+	userID := uint32(auth.FromContext(ctx).UserID)
+	moment, err := uc.GetMoment(ctx, userID, momentID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := uc.getVisibleComment(ctx, moment, userID, commentID); err != nil {
+		return nil, err
+	}
+
+	_, err = uc.mr.CreateCommentLike(ctx, &bizMoment.CommentLikeTB{CommentID: commentID, UserID: userID})
+	if err != nil {
+		uc.log.Errorf("CreateCommentLike error: %v", err)
+		return nil, NewErr(ErrCodeMomentFailed, MOMENT_FAILED, "Like comment failed")
+	}
+	return uc.commentLikeReply(ctx, momentID, commentID, true)
+}
+
+// UnlikeComment 没点过赞的不报错
+func (uc *MomentUsecase) UnlikeComment(ctx context.Context, momentID uint32, commentID uint32) (*CommentLikeReply, error) {
+	userID := uint32(auth.FromContext(ctx).UserID)
+	if _, err := uc.getComment(ctx, momentID, commentID); err != nil {
+		return nil, err
+	}
+
+	_, err := uc.mr.DeleteCommentLike(ctx, commentID, userID)
+	if err != nil {
+		uc.log.Errorf("DeleteCommentLike error: %v", err)
+		return nil, NewErr(ErrCodeMomentFailed, MOMENT_FAILED, "Unlike comment failed")
+	}
+	return uc.commentLikeReply(ctx, momentID, commentID, false)
+}
+
+func (uc *MomentUsecase) commentLikeReply(ctx context.Context, momentID uint32, commentID uint32, liked bool) (*CommentLikeReply, error) {
+	comment, err := uc.getComment(ctx, momentID, commentID)
+	if err != nil {
+		return nil, err
+	}
+	return &CommentLikeReply{LikeCount: comment.LikeCount, Liked: liked}, nil
+}
@@ -0,0 +1,166 @@
+package biz
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/stretchr/testify/assert"
+
+	bizMoment "kratos-realworld/internal/biz/moments"
+)
+
+// fakeCommentRepo 按 CommentQuery 在内存里过滤评论，好友关系取自 pr
+type fakeCommentRepo struct {
+	*fakeMomentStore
+	pr    *fakeSocialRepo
+	liked map[[2]uint32]bool // (评论ID, 用户ID)
+}
+
+func newFakeCommentRepo(pr *fakeSocialRepo) *fakeCommentRepo {
+	return &fakeCommentRepo{fakeMomentStore: newFakeMomentStore(), pr: pr, liked: make(map[[2]uint32]bool)}
+}
+
+func (r *fakeCommentRepo) ListComments(ctx context.Context, query *bizMoment.CommentQuery) ([]*bizMoment.CommentsTB, error) {
+	var res []*bizMoment.CommentsTB
+	for _, c := range r.comments {
+		if c.MomentID != query.MomentID || c.ParentID != query.ParentID || c.ID <= query.Cursor {
+			continue
+		}
+		if query.FriendsOf > 0 && !inIDs(query.VisibleIDs, c.UserID) && !r.pr.friends[[2]uint32{query.FriendsOf, c.UserID}] {
+			continue
+		}
+		res = append(res, c)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].ID < res[j].ID })
+	if len(res) > query.Limit {
+		res = res[:query.Limit]
+	}
+	return res, nil
+}
+
+func (r *fakeCommentRepo) FilterLikedComments(ctx context.Context, userID uint32, commentIDs []uint32) ([]uint32, error) {
+	var ids []uint32
+	for _, id := range commentIDs {
+		if r.liked[[2]uint32{id, userID}] {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+func (r *fakeCommentRepo) CreateCommentLike(ctx context.Context, like *bizMoment.CommentLikeTB) (bool, error) {
+	key := [2]uint32{like.CommentID, like.UserID}
+	if r.liked[key] {
+		return false, nil
+	}
+	r.liked[key] = true
+	r.comments[like.CommentID].LikeCount++
+	return true, nil
+}
+
+func commentIDs(reply *CommentListReply) []uint32 {
+	ids := make([]uint32, 0, len(reply.Comments))
+	for _, c := range reply.Comments {
+		ids = append(ids, c.ID)
+	}
+	return ids
+}
+
+func TestCreateCommentRepliesStayTwoLevels(t *testing.T) {
+	pr := newFakeSocialRepo()
+	pr.befriend(3, 2)
+	mr := newFakeCommentRepo(pr)
+	uc := newTestMomentUsecase(mr, pr)
+	momentID := mr.add(&bizMoment.MomentTB{UserID: 1})
+
+	top := &bizMoment.CommentsTB{MomentID: momentID, Comment: "first"}
+	assert.NoError(t, uc.CreateComment(userCtx(2), top, 0))
+
+	reply := &bizMoment.CommentsTB{MomentID: momentID, Comment: "reply", ParentID: 99}
+	assert.NoError(t, uc.CreateComment(userCtx(3), reply, top.ID))
+	assert.Equal(t, top.ID, reply.ParentID)
+	assert.Equal(t, uint32(2), reply.ReplyToUserID)
+
+	// 回复一条回复时挂在同一条一级评论下，回复对象是那条回复的作者
+	nested := &bizMoment.CommentsTB{MomentID: momentID, Comment: "nested"}
+	assert.NoError(t, uc.CreateComment(userCtx(1), nested, reply.ID))
+	assert.Equal(t, top.ID, nested.ParentID)
+	assert.Equal(t, uint32(3), nested.ReplyToUserID)
+
+	// 看不到的评论不能回复
+	err := uc.CreateComment(userCtx(4), &bizMoment.CommentsTB{MomentID: momentID, Comment: "hi"}, top.ID)
+	assert.Equal(t, MOMENT_NOT_FOUND, errors.FromError(err).Reason)
+
+	err = uc.CreateComment(userCtx(2), &bizMoment.CommentsTB{MomentID: momentID, Comment: "  "}, 0)
+	assert.Equal(t, INVALID_PARAMS, errors.FromError(err).Reason)
+}
+
+func TestListCommentsPagingAndFriendsOnly(t *testing.T) {
+	pr := newFakeSocialRepo()
+	pr.befriend(5, 2)
+	mr := newFakeCommentRepo(pr)
+	uc := newTestMomentUsecase(mr, pr)
+	momentID := mr.add(&bizMoment.MomentTB{UserID: 1})
+	byFriend := mr.addComment(&bizMoment.CommentsTB{MomentID: momentID, UserID: 2})
+	byStranger := mr.addComment(&bizMoment.CommentsTB{MomentID: momentID, UserID: 3})
+	byAuthor := mr.addComment(&bizMoment.CommentsTB{MomentID: momentID, UserID: 1})
+	bySelf := mr.addComment(&bizMoment.CommentsTB{MomentID: momentID, UserID: 5})
+	mr.addComment(&bizMoment.CommentsTB{MomentID: momentID, UserID: 3, ParentID: byFriend})
+	mr.liked[[2]uint32{byAuthor, 5}] = true
+
+	// 非作者只能看到自己、作者和自己好友的评论
+	first, err := uc.ListComments(userCtx(5), momentID, 0, 0, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint32{byFriend, byAuthor}, commentIDs(first))
+	assert.True(t, first.HasMore)
+	assert.Equal(t, byAuthor, first.NextCursor)
+	assert.Equal(t, map[uint32]bool{byAuthor: true}, first.Liked)
+
+	second, err := uc.ListComments(userCtx(5), momentID, 0, first.NextCursor, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint32{bySelf}, commentIDs(second))
+	assert.False(t, second.HasMore)
+
+	// 作者看到全部一级评论
+	all, err := uc.ListComments(userCtx(1), momentID, 0, 0, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint32{byFriend, byStranger, byAuthor, bySelf}, commentIDs(all))
+}
+
+func TestListCommentsReplies(t *testing.T) {
+	pr := newFakeSocialRepo()
+	mr := newFakeCommentRepo(pr)
+	uc := newTestMomentUsecase(mr, pr)
+	momentID := mr.add(&bizMoment.MomentTB{UserID: 1})
+	top := mr.addComment(&bizMoment.CommentsTB{MomentID: momentID, UserID: 2})
+	reply := mr.addComment(&bizMoment.CommentsTB{MomentID: momentID, UserID: 1, ParentID: top})
+
+	replies, err := uc.ListComments(userCtx(1), momentID, top, 0, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint32{reply}, commentIDs(replies))
+
+	// 只能按一级评论查回复
+	_, err = uc.ListComments(userCtx(1), momentID, reply, 0, 10)
+	assert.Equal(t, INVALID_PARAMS, errors.FromError(err).Reason)
+
+	// 看不到的一级评论当作不存在
+	_, err = uc.ListComments(userCtx(4), momentID, top, 0, 10)
+	assert.Equal(t, MOMENT_NOT_FOUND, errors.FromError(err).Reason)
+}
+
+func TestLikeCommentTwiceDoesNotError(t *testing.T) {
+	pr := newFakeSocialRepo()
+	mr := newFakeCommentRepo(pr)
+	uc := newTestMomentUsecase(mr, pr)
+	momentID := mr.add(&bizMoment.MomentTB{UserID: 1})
+	commentID := mr.addComment(&bizMoment.CommentsTB{MomentID: momentID, UserID: 1})
+
+	for i := 0; i < 2; i++ {
+		reply, err := uc.LikeComment(userCtx(2), momentID, commentID)
+		assert.NoError(t, err)
+		assert.True(t, reply.Liked)
+		assert.Equal(t, uint32(1), reply.LikeCount)
+	}
+}
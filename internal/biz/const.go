@@ -2,9 +2,13 @@ package biz
 
 import (
 	"crypto/md5"
+	"crypto/rand"
 	"encoding/hex"
 	"fmt"
 	"golang.org/x/crypto/bcrypt"
+	bizChat "kratos-realworld/internal/biz/messageGroup"
+	bizMoment "kratos-realworld/internal/biz/moments"
+	bizProfile "kratos-realworld/internal/biz/profile"
 	"regexp"
 	"time"
 )
@@ -58,6 +62,175 @@ type UserRelationshipReply struct {
 	IsFriend     bool
 }
 
+type CanAddFriendReply struct {
+	CanAdd            bool
+	IsFriend          bool
+	PendingRequestID  uint32 // 我发出的、对方还没处理的申请
+	IncomingRequestID uint32 // 对方发给我的、我还没处理的申请
+	Reason            string
+}
+
+type FriendListReply struct {
+	Friends  []*bizProfile.FriendTB
+	Total    int64
+	Page     int
+	PageSize int
+}
+
+// FollowUserItem 关注、粉丝列表和搜索结果中的一项，关系字段都是相对当前登录用户
+type FollowUserItem struct {
+	UserID       uint32
+	UserName     string
+	Handle       string
+	HeadImage    string
+	Bio          string
+	IsFollowing  bool
+	IsFollowedBy bool
+	IsMutual     bool
+	IsFriend     bool
+}
+
+type FollowListReply struct {
+	Users      []*FollowUserItem
+	NextCursor uint32 // 下一页的游标，HasMore 为 false 时无意义
+	HasMore    bool
+}
+
+// VisitorItem 最近访客，同一个人多次访问只保留最后一次
+type VisitorItem struct {
+	UserID    uint32
+	UserName  string
+	HeadImage string
+	VisitedAt time.Time
+}
+
+type VisitorListReply struct {
+	Visitors   []*VisitorItem
+	NextCursor int64 // 下一页的游标，HasMore 为 false 时无意义
+	HasMore    bool
+}
+
+type SearchUsersReply struct {
+	Users   []*FollowUserItem
+	HasMore bool
+}
+
+type LoginHistoryListReply struct {
+	Histories  []*bizProfile.LoginHistoryTB
+	NextCursor uint32 // 下一页的游标，HasMore 为 false 时无意义
+	HasMore    bool
+}
+
+type TimelineReply struct {
+	Moments    []*bizMoment.MomentsMetaTB
+	NextCursor uint32 // 下一页的游标，即本页最后一条动态的ID
+	HasMore    bool
+}
+
+type MomentDetailReply struct {
+	Moment   *bizMoment.MomentTB
+	Liked    bool              // 当前用户是否点过赞
+	Comments *CommentListReply // 当前用户能看到的第一页一级评论
+}
+
+type MomentLikeReply struct {
+	LikeCount int64
+	Liked     bool
+}
+
+type CommentListReply struct {
+	Comments   []*bizMoment.CommentsTB
+	Liked      map[uint32]bool // 当前用户点过赞的评论
+	NextCursor uint32          // 下一页的游标，即本页最后一条评论的ID
+	HasMore    bool
+}
+
+type CommentLikeReply struct {
+	LikeCount uint32
+	Liked     bool
+}
+
+type SquareReply struct {
+	Moments    []*bizMoment.MomentsMetaTB
+	SessionID  string // random 模式下一次请求带上，同一个会话内不重复
+	NextCursor uint32 // latest 模式下一页的游标
+	HasMore    bool
+}
+
+type BanAuditListReply struct {
+	Audits   []*bizProfile.BanAuditTB
+	Total    int64
+	Page     int
+	PageSize int
+}
+
+type BlockListReply struct {
+	Blocks   []*bizProfile.BlockTB
+	Total    int64
+	Page     int
+	PageSize int
+}
+
+// GroupDelivery 群消息的投递对象
+type GroupDelivery struct {
+	Group        *bizChat.GroupTB
+	ReceiverIDs  []uint32 // 除发送者外的群成员
+	MentionedIDs []uint32 // 被@的成员，@所有人时等于 ReceiverIDs
+}
+
+type GroupInviteReply struct {
+	Group     *bizChat.GroupTB
+	Invite    *bizChat.GroupInviteTB
+	Link      string
+	QrPayload string
+}
+
+type JoinGroupReply struct {
+	Group   *bizChat.GroupTB
+	Joined  bool // true 已入群，false 已提交申请等待审批
+	Request *bizChat.GroupJoinRequestTB
+}
+
+type ExportReply struct {
+	Job         *bizChat.ExportJobTB
+	DownloadUrl string
+}
+
+type ConversationTimerReply struct {
+	TargetID         string
+	ConversationType uint16
+	TimerSeconds     uint32
+}
+
+// ConversationSettingsPatch 为 nil 的字段不修改
+type ConversationSettingsPatch struct {
+	MutedUntil  *time.Time
+	MuteForever *bool
+	Pinned      *bool
+	Archived    *bool
+}
+
+// GroupSettingsPatch 为 nil 的字段不修改
+type GroupSettingsPatch struct {
+	MuteAll       *bool
+	OnlyAdminEdit *bool
+	JoinApproval  *bool
+}
+
+type ConversationReply struct {
+	TargetID          string
+	ConversationType  uint16
+	LastMessageID     uint32
+	LastReadMessageID uint32
+	UnreadCount       uint32
+	Mentioned         bool
+	MentionMessageID  uint32
+	MutedUntil        *time.Time
+	Muted             bool
+	PinnedOrder       uint32
+	Archived          bool
+}
+
 // IsValidPhone 校验手机号是否符合规则
 func IsValidPhone(phone string) bool {
 	// 中国大陆手机号规则：以 1 开头，第二位是 3-9，后面 9 位数字，总长度 11 位
@@ -118,3 +291,12 @@ func Md5String(s string) string {
 func GenerateSession(userName string) string {
 	return Md5String(fmt.Sprintf("%s:%s", userName, "session"))
 }
+
+// newRandomToken 生成32位十六进制随机串，用于邀请链接、下载链接等
+func newRandomToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
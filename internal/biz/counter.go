@@ -0,0 +1,131 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	bizProfile "kratos-realworld/internal/biz/profile"
+	kafka "kratos-realworld/internal/kafka"
+	"time"
+)
+
+const (
+	rebuildProfileCountersLockKey = "job:lock:rebuildProfileCounters"
+	// 去重记录保留时长，超过这个时间的重复投递不再识别
+	counterEventRetention = 7 * 24 * time.Hour
+)
+
+// publishCounterEvent 发布计数事件，消费端异步更新 profile 的统计字段
+func publishCounterEvent(eventType string, userID uint32, actorID uint32, momentID uint32, sourceID uint32) error {
+	event := &kafka.CounterEvent{
+		EventID:  fmt.Sprintf("%s:%d", eventType, sourceID),
+		Type:     eventType,
+		UserID:   userID,
+		ActorID:  actorID,
+		MomentID: momentID,
+	}
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	kafka.SendTopic(kafka.PROFILE_COUNTER_TOPIC, body)
+	return nil
+}
+
+// counterEventDelta 事件对应的统计字段和增量
+func counterEventDelta(eventType string) (string, int, bool) {
+	switch eventType {
+	case kafka.EVENT_MOMENT_CREATED:
+		return bizProfile.CounterNote, 1, true
+	case kafka.EVENT_MOMENT_DELETED:
+		return bizProfile.CounterNote, -1, true
+	case kafka.EVENT_LIKE_ADDED:
+		return bizProfile.CounterReceivedLike, 1, true
+	case kafka.EVENT_LIKE_REMOVED:
+		return bizProfile.CounterReceivedLike, -1, true
+	case kafka.EVENT_COMMENT_ADDED:
+		return bizProfile.CounterComment, 1, true
+	case kafka.EVENT_COMMENT_REMOVED:
+		return bizProfile.CounterComment, -1, true
+	}
+	return "", 0, false
+}
+
+// ConsumeCounterEvent kafka 消费回调
+func (pc *ProfileUsecase) ConsumeCounterEvent(data []byte) {
+	var event kafka.CounterEvent
+	if err := json.Unmarshal(data, &event); err != nil {
+		pc.log.Errorf("unmarshal counter event error: %v", err)
+		return
+	}
+	if err := pc.ApplyCounterEvent(context.Background(), &event); err != nil {
+		pc.log.Errorf("apply counter event %s error: %v", event.EventID, err)
+	}
+}
+
+// ApplyCounterEvent 去重记录和计数更新在同一个事务里，重复投递的事件直接跳过
+func (pc *ProfileUsecase) ApplyCounterEvent(ctx context.Context, event *kafka.CounterEvent) error {
+	counter, delta, ok := counterEventDelta(event.Type)
+	if !ok || event.EventID == "" || event.UserID == 0 {
+		pc.log.Warnf("ignore invalid counter event: %+v", event)
+		return nil
+	}
+
+	return pc.tx.InTx(ctx, func(ctx context.Context) error {
+		created, err := pc.pr.CreateCounterEvent(ctx, &bizProfile.CounterEventTB{
+			EventID:   event.EventID,
+			EventType: event.Type,
+			UserID:    event.UserID,
+		})
+		if err != nil {
+			return err
+		}
+		if !created {
+			pc.log.Debugf("counter event %s already applied", event.EventID)
+			return nil
+		}
+
+		switch counter {
+		case bizProfile.CounterNote:
+			return pc.pr.IncrementNoteCount(ctx, event.UserID, delta)
+		case bizProfile.CounterReceivedLike:
+			return pc.pr.IncrementReceivedLikeCount(ctx, event.UserID, delta)
+		case bizProfile.CounterComment:
+			return pc.pr.IncrementCommentCount(ctx, event.UserID, delta)
+		}
+		return nil
+	})
+}
+
+// RebuildProfileCounters 按 user_id 分批以源数据为准重算统计字段，同时清理过期的去重记录
+// 消费端宕机期间丢失的事件靠这里兜底；没抢到锁说明别的实例正在执行，返回 false
+func (pc *ProfileUsecase) RebuildProfileCounters(ctx context.Context, batchSize int, lockTTL time.Duration) (int, bool, error) {
+	token, ok, err := pc.lk.TryLock(ctx, rebuildProfileCountersLockKey, lockTTL)
+	if err != nil || !ok {
+		return 0, false, err
+	}
+	defer func() {
+		if err := pc.lk.Unlock(context.Background(), rebuildProfileCountersLockKey, token); err != nil {
+			pc.log.Warnf("failed to release lock %s: %v", rebuildProfileCountersLockKey, err)
+		}
+	}()
+
+	total := 0
+	var lastID uint32
+	for {
+		next, n, err := pc.pr.RebuildProfileCounters(ctx, lastID, batchSize)
+		if err != nil {
+			return total, true, err
+		}
+		total += n
+		if n < batchSize {
+			break
+		}
+		lastID = next
+	}
+
+	if _, err := pc.pr.DeleteCounterEventsBefore(ctx, time.Now().Add(-counterEventRetention)); err != nil {
+		pc.log.Warnf("failed to purge counter events: %v", err)
+	}
+	return total, true, nil
+}
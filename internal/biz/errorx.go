@@ -26,20 +26,50 @@ const (
 	ErrCodeCreateTokenFailed       = 50001
 	ErrCodeUpdatePasswordFailed    = 50002
 	ErrCodeUpdateUserInfoFailed    = 50003
+	ErrCodeAccountBanned           = 40301
+	ErrCodePermissionDenied        = 40302
+	ErrCodeBanFailed               = 50004
+	ErrCodeInvalidHandle           = 42204
+	ErrCodeHandleTaken             = 42205
+	ErrCodeHandleChangeLimited     = 42206
+	ErrCodePrivacyRestricted       = 40303
 
 	// 数据库、redis缓存相关
 	ErrCodeDBQueryFailed    = 50010
 	ErrCodeCreateUserFailed = 50011
 
 	// 关注、取关、好友相关
-	ErrCodeFollowFailed   = 60000
-	ErrCodeUnfollowFailed = 60001
+	ErrCodeFollowFailed          = 60000
+	ErrCodeUnfollowFailed        = 60001
+	ErrCodeFriendRequestFailed   = 60002
+	ErrCodeAlreadyFriend         = 60003
+	ErrCodeFriendRequestNotFound = 60004
+	ErrCodeFriendRequestHandled  = 60005
+	ErrCodeNotFriend             = 60006
+	ErrCodeUserBlocked           = 60007
+	ErrCodeBlockFailed           = 60008
 
 	// 聊天相关
-	ErrCodeMessageFailed = 70000
+	ErrCodeMessageFailed        = 70000
+	ErrCodeNotGroupMember       = 70001
+	ErrCodeInvalidMention       = 70002
+	ErrCodeMentionAllDenied     = 70003
+	ErrCodeConversationNotFound = 70004
+	ErrCodeGroupNotFound        = 70005
+	ErrCodeGroupPermission      = 70006
+	ErrCodeMemberMuted          = 70007
+	ErrCodeGroupMuted           = 70008
+	ErrCodeInviteInvalid        = 70009
+	ErrCodeAlreadyGroupMember   = 70010
+	ErrCodeJoinRequestNotFound  = 70011
+	ErrCodeJoinRequestHandled   = 70012
+	ErrCodeExportFailed         = 70013
+	ErrCodeExportNotFound       = 70014
 
 	// 动态相关
-	ErrCodeMomentFailed = 80000
+	ErrCodeMomentFailed     = 80000
+	ErrCodeMomentNotFound   = 80001
+	ErrCodeMomentPermission = 80002
 )
 
 // error reason
@@ -59,18 +89,48 @@ const (
 	CREATE_TOKEN_FAILED       = "CREATE_TOKEN_FAILED"
 	UPDATE_PASSWORD_FAILED    = "UPDATE_PASSWORD_FAILED"
 	UPDATE_USER_INFO_FAILED   = "UPDATE_USER_INFO_FAILED"
+	ACCOUNT_BANNED            = "ACCOUNT_BANNED"
+	PERMISSION_DENIED         = "PERMISSION_DENIED"
+	BAN_FAILED                = "BAN_FAILED"
+	INVALID_HANDLE            = "INVALID_HANDLE"
+	HANDLE_TAKEN              = "HANDLE_TAKEN"
+	HANDLE_CHANGE_LIMITED     = "HANDLE_CHANGE_LIMITED"
+	PRIVACY_RESTRICTED        = "PRIVACY_RESTRICTED"
 
 	// 数据库相关
 	DB_QUERY_FAILED    = "DB_QUERY_FAILED"
 	CREATE_USER_FAILED = "CREATE_USER_FAILED"
 
 	// 关注、取关、好友相关
-	FOLLOW_USER_FAILED   = "FOLLOW_USER_FAILED"
-	UNFOLLOW_USER_FAILED = "UNFOLLOW_USER_FAILED"
+	FOLLOW_USER_FAILED       = "FOLLOW_USER_FAILED"
+	UNFOLLOW_USER_FAILED     = "UNFOLLOW_USER_FAILED"
+	FRIEND_REQUEST_FAILED    = "FRIEND_REQUEST_FAILED"
+	ALREADY_FRIEND           = "ALREADY_FRIEND"
+	FRIEND_REQUEST_NOT_FOUND = "FRIEND_REQUEST_NOT_FOUND"
+	FRIEND_REQUEST_HANDLED   = "FRIEND_REQUEST_HANDLED"
+	NOT_FRIEND               = "NOT_FRIEND"
+	USER_BLOCKED             = "USER_BLOCKED"
+	BLOCK_FAILED             = "BLOCK_FAILED"
 
 	// 聊天相关
-	MESSAGE_FAILED = "MESSAGE_FAILED"
+	MESSAGE_FAILED         = "MESSAGE_FAILED"
+	NOT_GROUP_MEMBER       = "NOT_GROUP_MEMBER"
+	INVALID_MENTION        = "INVALID_MENTION"
+	MENTION_ALL_DENIED     = "MENTION_ALL_DENIED"
+	CONVERSATION_NOT_FOUND = "CONVERSATION_NOT_FOUND"
+	GROUP_NOT_FOUND        = "GROUP_NOT_FOUND"
+	GROUP_PERMISSION       = "GROUP_PERMISSION_DENIED"
+	MEMBER_MUTED           = "MEMBER_MUTED"
+	GROUP_MUTED            = "GROUP_MUTED"
+	INVITE_INVALID         = "INVITE_INVALID"
+	ALREADY_GROUP_MEMBER   = "ALREADY_GROUP_MEMBER"
+	JOIN_REQUEST_NOT_FOUND = "JOIN_REQUEST_NOT_FOUND"
+	JOIN_REQUEST_HANDLED   = "JOIN_REQUEST_HANDLED"
+	EXPORT_FAILED          = "EXPORT_FAILED"
+	EXPORT_NOT_FOUND       = "EXPORT_NOT_FOUND"
 
 	// 动态相关
-	MOMENT_FAILED = "MOMENT_FAILED"
+	MOMENT_FAILED            = "MOMENT_FAILED"
+	MOMENT_NOT_FOUND         = "MOMENT_NOT_FOUND"
+	MOMENT_PERMISSION_DENIED = "MOMENT_PERMISSION_DENIED"
 )
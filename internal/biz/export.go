@@ -0,0 +1,554 @@
+package biz
+
+import (
+	"archive/zip"
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html/template"
+	"io"
+	bizChat "kratos-realworld/internal/biz/messageGroup"
+	"kratos-realworld/internal/common"
+	"kratos-realworld/internal/conf"
+	"kratos-realworld/internal/pkg/middleware/auth"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+const (
+	ExportDownloadPath = "/api/chat/export/download" // 不能放在 /api/exports 下，会被 /api/exports/{export_id} 先匹配
+
+	defaultExportDir     = "./exports/"
+	defaultExportLinkTTL = 24 * time.Hour
+	exportBatchSize      = 1000
+	exportMaxRange       = 366 * 24 * time.Hour // 单次最多导出一年
+	exportConcurrency    = 2                    // 同时执行的导出任务数
+)
+
+type ExportUsecase struct {
+	mr  bizChat.MessageRepo
+	gr  bizChat.GroupRepo
+	er  bizChat.ExportRepo
+	dc  *conf.Data
+	sem chan struct{}
+	log *log.Helper
+
+	mu     sync.Mutex
+	active map[uint32]struct{} // 本节点排队中和执行中的任务
+}
+
+func NewExportUsecase(mr bizChat.MessageRepo, gr bizChat.GroupRepo, er bizChat.ExportRepo, dc *conf.Data, logger log.Logger) *ExportUsecase {
+	return &ExportUsecase{
+		mr:  mr,
+		gr:  gr,
+		er:  er,
+		dc:  dc,
+		sem: make(chan struct{}, exportConcurrency),
+		log: log.NewHelper(logger),
+
+		active: make(map[uint32]struct{}),
+	}
+}
+
+func (ec *ExportUsecase) exportDir() string {
+	if ec.dc != nil && ec.dc.Storage != nil && ec.dc.Storage.ExportDir != "" {
+		return ec.dc.Storage.ExportDir
+	}
+	return defaultExportDir
+}
+
+func (ec *ExportUsecase) staticDir() string {
+	if ec.dc != nil && ec.dc.Storage != nil {
+		return ec.dc.Storage.StaticDir
+	}
+	return ""
+}
+
+func (ec *ExportUsecase) linkTTL() time.Duration {
+	if ec.dc != nil && ec.dc.Storage != nil && ec.dc.Storage.ExportLinkTtl != nil && ec.dc.Storage.ExportLinkTtl.AsDuration() > 0 {
+		return ec.dc.Storage.ExportLinkTtl.AsDuration()
+	}
+	return defaultExportLinkTTL
+}
+
+// CreateExport 创建导出任务并在后台执行，完成后通过 websocket 推送下载链接
+func (ec *ExportUsecase) CreateExport(ctx context.Context, targetID string, conversationType uint16, start time.Time, end time.Time) (*ExportReply, error) {
+	uid := uint32(auth.FromContext(ctx).UserID)
+	if !end.After(start) || end.Sub(start) > exportMaxRange {
+		return nil, NewErr(ErrCodeInvalidParams, INVALID_PARAMS, "invalid time range, at most one year")
+	}
+
+	switch conversationType {
+	case common.MESSAGE_TYPE_USER:
+		if _, err := strconv.ParseUint(targetID, 10, 32); err != nil {
+			return nil, NewErr(ErrCodeInvalidParams, INVALID_PARAMS, "invalid target user")
+		}
+	case common.MESSAGE_TYPE_GROUP:
+		group, err := getGroup(ctx, ec.gr, targetID)
+		if err != nil {
+			return nil, err
+		}
+		member, err := getGroupMember(ctx, ec.gr, group, uid)
+		if err != nil {
+			return nil, err
+		}
+		// 只能导出入群之后的消息
+		if member.SysCreated != nil && member.SysCreated.After(start) {
+			start = *member.SysCreated
+		}
+		if !end.After(start) {
+			return nil, NewErr(ErrCodeInvalidParams, INVALID_PARAMS, "invalid time range, before you joined the group")
+		}
+	default:
+		return nil, NewErr(ErrCodeInvalidParams, INVALID_PARAMS, "invalid conversation type")
+	}
+
+	job := &bizChat.ExportJobTB{
+		UserID:           uid,
+		TargetID:         targetID,
+		ConversationType: conversationType,
+		StartAt:          &start,
+		EndAt:            &end,
+		Status:           bizChat.ExportPending,
+	}
+	if err := ec.er.CreateExportJob(ctx, job); err != nil {
+		return nil, NewErr(ErrCodeExportFailed, EXPORT_FAILED, "failed to create export job")
+	}
+
+	ec.track(job.ID)
+	go ec.runExport(job)
+	return &ExportReply{Job: job}, nil
+}
+
+// GetExport 查询自己的导出任务
+func (ec *ExportUsecase) GetExport(ctx context.Context, exportID uint32) (*ExportReply, error) {
+	uid := uint32(auth.FromContext(ctx).UserID)
+	job, err := ec.er.GetExportJob(ctx, exportID)
+	if err != nil || job.UserID != uid {
+		return nil, NewErr(ErrCodeExportNotFound, EXPORT_NOT_FOUND, "export not found")
+	}
+
+	res := &ExportReply{Job: job}
+	if job.Status == bizChat.ExportDone && job.ExpireAt != nil && job.ExpireAt.After(time.Now()) {
+		res.DownloadUrl = ExportDownloadPath + "?token=" + job.Token
+	}
+	return res, nil
+}
+
+// OpenExport 校验下载 token，返回导出文件路径和下载文件名
+func (ec *ExportUsecase) OpenExport(ctx context.Context, token string) (string, string, error) {
+	job, err := ec.er.GetExportJobByToken(ctx, token)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", "", NewErr(ErrCodeExportNotFound, EXPORT_NOT_FOUND, "export not found")
+		}
+		return "", "", NewErr(ErrCodeDBQueryFailed, DB_QUERY_FAILED, "failed to query export")
+	}
+	if job.Status != bizChat.ExportDone || job.ExpireAt == nil || !job.ExpireAt.After(time.Now()) {
+		return "", "", NewErr(ErrCodeExportNotFound, EXPORT_NOT_FOUND, "download link has expired")
+	}
+	return filepath.Join(ec.exportDir(), job.FileName), job.FileName, nil
+}
+
+func (ec *ExportUsecase) runExport(job *bizChat.ExportJobTB) {
+	defer ec.untrack(job.ID)
+	ec.sem <- struct{}{}
+	defer func() { <-ec.sem }()
+
+	ctx := context.Background()
+	to := strconv.Itoa(int(job.UserID))
+	if err := ec.er.UpdateExportJob(ctx, job.ID, map[string]interface{}{"status": bizChat.ExportRunning}); err != nil {
+		ec.log.Errorf("UpdateExportJob %d error: %v", job.ID, err)
+	}
+
+	fileName, err := ec.writeExport(ctx, job)
+	if err != nil {
+		ec.failExport(ctx, job, err)
+		return
+	}
+
+	token, err := newRandomToken()
+	if err != nil {
+		os.Remove(filepath.Join(ec.exportDir(), fileName))
+		ec.failExport(ctx, job, fmt.Errorf("generate token: %w", err))
+		return
+	}
+	expireAt := time.Now().Add(ec.linkTTL())
+	err = ec.er.UpdateExportJob(ctx, job.ID, map[string]interface{}{
+		"status":    bizChat.ExportDone,
+		"file_name": fileName,
+		"token":     token,
+		"expire_at": expireAt,
+	})
+	if err != nil {
+		os.Remove(filepath.Join(ec.exportDir(), fileName))
+		ec.failExport(ctx, job, err)
+		return
+	}
+
+	err = pushSystemNotify(to, common.EXPORT_READY, map[string]interface{}{
+		"exportId":    job.ID,
+		"downloadUrl": ExportDownloadPath + "?token=" + token,
+		"expireAt":    expireAt.Unix(),
+	})
+	if err != nil {
+		ec.log.Errorf("push export ready to %s error: %v", to, err)
+	}
+}
+
+// failExport 标记任务失败并通知用户，任务不能停留在导出中
+func (ec *ExportUsecase) failExport(ctx context.Context, job *bizChat.ExportJobTB, cause error) {
+	ec.log.Errorf("export %d error: %v", job.ID, cause)
+	msg := cause.Error()
+	if len(msg) > 350 {
+		msg = msg[:350]
+	}
+	err := ec.er.UpdateExportJob(ctx, job.ID, map[string]interface{}{
+		"status":    bizChat.ExportFailed,
+		"error_msg": msg,
+	})
+	if err != nil {
+		ec.log.Errorf("UpdateExportJob %d error: %v", job.ID, err)
+	}
+	to := strconv.Itoa(int(job.UserID))
+	if err := pushSystemNotify(to, common.EXPORT_FAILED, map[string]interface{}{"exportId": job.ID}); err != nil {
+		ec.log.Errorf("push export failed to %s error: %v", to, err)
+	}
+}
+
+func (ec *ExportUsecase) track(id uint32) {
+	ec.mu.Lock()
+	ec.active[id] = struct{}{}
+	ec.mu.Unlock()
+}
+
+func (ec *ExportUsecase) untrack(id uint32) {
+	ec.mu.Lock()
+	delete(ec.active, id)
+	ec.mu.Unlock()
+}
+
+// TouchActiveExports 刷新本节点排队中和执行中的任务，其他节点据此判断任务没有丢
+func (ec *ExportUsecase) TouchActiveExports(ctx context.Context) error {
+	ec.mu.Lock()
+	ids := make([]uint32, 0, len(ec.active))
+	for id := range ec.active {
+		ids = append(ids, id)
+	}
+	ec.mu.Unlock()
+	return ec.er.TouchExportJobs(ctx, ids)
+}
+
+// ResumeStaleExports 重新执行 staleAfter 内没有刷新过的任务，通常是所在节点重启或宕机
+func (ec *ExportUsecase) ResumeStaleExports(ctx context.Context, staleAfter time.Duration, limit int) (int, error) {
+	before := time.Now().Add(-staleAfter)
+	jobs, err := ec.er.ListStaleExportJobs(ctx, before, limit)
+	if err != nil {
+		return 0, err
+	}
+	n := 0
+	for _, job := range jobs {
+		ok, err := ec.er.ClaimExportJob(ctx, job.ID, before)
+		if err != nil {
+			return n, err
+		}
+		if !ok {
+			continue
+		}
+		ec.track(job.ID)
+		go ec.runExport(job)
+		n++
+	}
+	return n, nil
+}
+
+// PurgeExpiredExports 删除下载链接已过期的导出文件，任务记录保留
+func (ec *ExportUsecase) PurgeExpiredExports(ctx context.Context, limit int) (int, error) {
+	jobs, err := ec.er.ListExpiredExportJobs(ctx, time.Now(), limit)
+	if err != nil {
+		return 0, err
+	}
+	for i, job := range jobs {
+		err := os.Remove(filepath.Join(ec.exportDir(), filepath.Base(job.FileName)))
+		if err != nil && !os.IsNotExist(err) {
+			return i, err
+		}
+		if err := ec.er.UpdateExportJob(ctx, job.ID, map[string]interface{}{"file_name": ""}); err != nil {
+			return i, err
+		}
+	}
+	return len(jobs), nil
+}
+
+// exportMessage 导出文件里的一条消息
+type exportMessage struct {
+	ID          uint32    `json:"id"`
+	From        string    `json:"from"`
+	To          string    `json:"to"`
+	ContentType uint16    `json:"contentType"`
+	Content     string    `json:"content"`
+	Attachment  string    `json:"attachment,omitempty"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+// 打包进 zip 的文件，按这个顺序写入
+var exportFiles = []string{"messages.json", "messages.csv", "transcript.html"}
+
+// writeExport 生成 zip：messages.json、messages.csv、transcript.html 以及 attachments 目录
+// 消息分批查询，边查边写到工作目录的临时文件里，最后再打包，不把整个导出放在内存里
+func (ec *ExportUsecase) writeExport(ctx context.Context, job *bizChat.ExportJobTB) (string, error) {
+	// 工作目录按任务固定命名，任务被重新执行时会覆盖上次没写完的文件
+	workDir := filepath.Join(ec.exportDir(), fmt.Sprintf(".export_%d", job.ID))
+	if err := os.RemoveAll(workDir); err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(workDir, 0755); err != nil {
+		return "", err
+	}
+	defer os.RemoveAll(workDir)
+
+	attachments, err := ec.writeMessages(ctx, job, workDir)
+	if err != nil {
+		return "", err
+	}
+
+	fileName := fmt.Sprintf("export_%d_%d.zip", job.ID, time.Now().Unix())
+	tmpPath := filepath.Join(workDir, fileName)
+	f, err := os.Create(tmpPath)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	zw := zip.NewWriter(f)
+	for _, name := range exportFiles {
+		if err := copyToZip(zw, name, filepath.Join(workDir, name)); err != nil {
+			zw.Close()
+			return "", err
+		}
+	}
+	// 附件从静态目录拷贝，文件不存在（例如已过期删除）时跳过
+	if staticDir := ec.staticDir(); staticDir != "" {
+		for _, name := range attachments {
+			if err := copyToZip(zw, name, filepath.Join(staticDir, filepath.Base(name))); err != nil {
+				zw.Close()
+				return "", err
+			}
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return "", err
+	}
+	if err := f.Close(); err != nil {
+		return "", err
+	}
+	// 写完整之后再移到导出目录，避免留下不完整的 zip
+	if err := os.Rename(tmpPath, filepath.Join(ec.exportDir(), fileName)); err != nil {
+		return "", err
+	}
+	return fileName, nil
+}
+
+// writeMessages 分批查询消息写入 workDir 下的三个文件，返回去重后的附件列表
+func (ec *ExportUsecase) writeMessages(ctx context.Context, job *bizChat.ExportJobTB, workDir string) ([]string, error) {
+	w, err := newExportWriter(workDir)
+	if err != nil {
+		return nil, err
+	}
+	defer w.close()
+
+	err = w.begin(job)
+	if err != nil {
+		return nil, err
+	}
+
+	userID := strconv.Itoa(int(job.UserID))
+	var attachments []string
+	added := make(map[string]struct{})
+	var afterID uint32
+	for {
+		batch, err := ec.mr.ListConversationMessages(ctx, job.ConversationType, userID, job.TargetID, *job.StartAt, *job.EndAt, afterID, exportBatchSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range batch {
+			em := exportMessage{
+				ID:          m.ID,
+				From:        m.FromUserID,
+				To:          m.ToUserID,
+				ContentType: m.ContentType,
+				Content:     m.Content,
+			}
+			if m.Url != "" {
+				em.Attachment = "attachments/" + filepath.Base(m.Url)
+				if _, ok := added[em.Attachment]; !ok {
+					added[em.Attachment] = struct{}{}
+					attachments = append(attachments, em.Attachment)
+				}
+			}
+			if m.CreatedAt != nil {
+				em.CreatedAt = *m.CreatedAt
+			}
+			if err := w.write(&em); err != nil {
+				return nil, err
+			}
+			afterID = m.ID
+		}
+		if len(batch) < exportBatchSize {
+			break
+		}
+	}
+
+	if err := w.end(); err != nil {
+		return nil, err
+	}
+	return attachments, w.close()
+}
+
+// exportWriter 把消息逐条写到 messages.json、messages.csv、transcript.html
+type exportWriter struct {
+	files []*os.File
+	json  *bufio.Writer
+	csv   *csv.Writer
+	html  *bufio.Writer
+	count int
+}
+
+func newExportWriter(dir string) (*exportWriter, error) {
+	w := &exportWriter{}
+	for _, name := range exportFiles {
+		f, err := os.Create(filepath.Join(dir, name))
+		if err != nil {
+			w.close()
+			return nil, err
+		}
+		w.files = append(w.files, f)
+	}
+	w.json = bufio.NewWriter(w.files[0])
+	w.csv = csv.NewWriter(w.files[1])
+	w.html = bufio.NewWriter(w.files[2])
+	return w, nil
+}
+
+func (w *exportWriter) begin(job *bizChat.ExportJobTB) error {
+	if _, err := w.json.WriteString("["); err != nil {
+		return err
+	}
+	if err := w.csv.Write([]string{"id", "from", "to", "content_type", "content", "attachment", "created_at"}); err != nil {
+		return err
+	}
+	return transcriptTmpl.ExecuteTemplate(w.html, "header", map[string]interface{}{
+		"Target": job.TargetID,
+		"Start":  job.StartAt.Format("2006-01-02 15:04:05"),
+		"End":    job.EndAt.Format("2006-01-02 15:04:05"),
+	})
+}
+
+func (w *exportWriter) write(m *exportMessage) error {
+	b, err := json.MarshalIndent(m, "  ", "  ")
+	if err != nil {
+		return err
+	}
+	sep := ",\n  "
+	if w.count == 0 {
+		sep = "\n  "
+	}
+	if _, err := w.json.WriteString(sep); err != nil {
+		return err
+	}
+	if _, err := w.json.Write(b); err != nil {
+		return err
+	}
+	w.count++
+
+	err = w.csv.Write([]string{
+		strconv.Itoa(int(m.ID)),
+		m.From,
+		m.To,
+		strconv.Itoa(int(m.ContentType)),
+		m.Content,
+		m.Attachment,
+		m.CreatedAt.Format(time.RFC3339),
+	})
+	if err != nil {
+		return err
+	}
+	return transcriptTmpl.ExecuteTemplate(w.html, "message", m)
+}
+
+func (w *exportWriter) end() error {
+	if _, err := w.json.WriteString("\n]\n"); err != nil {
+		return err
+	}
+	if err := w.json.Flush(); err != nil {
+		return err
+	}
+	w.csv.Flush()
+	if err := w.csv.Error(); err != nil {
+		return err
+	}
+	if err := transcriptTmpl.ExecuteTemplate(w.html, "footer", nil); err != nil {
+		return err
+	}
+	return w.html.Flush()
+}
+
+// close 关闭文件，可以重复调用
+func (w *exportWriter) close() error {
+	var first error
+	for _, f := range w.files {
+		if err := f.Close(); err != nil && first == nil && !errors.Is(err, os.ErrClosed) {
+			first = err
+		}
+	}
+	return first
+}
+
+func copyToZip(zw *zip.Writer, name string, path string) error {
+	src, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	defer src.Close()
+
+	w, err := zw.Create(name)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(w, src)
+	return err
+}
+
+var transcriptTmpl = template.Must(template.New("transcript").Parse(`{{define "header"}}<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>聊天记录 {{.Target}}</title>
+<style>
+body { font-family: sans-serif; max-width: 800px; margin: 0 auto; }
+.msg { padding: 6px 0; border-bottom: 1px solid #eee; }
+.meta { color: #888; font-size: 12px; }
+</style>
+</head>
+<body>
+<h2>聊天记录 {{.Target}}</h2>
+<p class="meta">{{.Start}} ~ {{.End}}</p>
+{{end}}{{define "message"}}<div class="msg">
+<div class="meta">{{.From}} · {{.CreatedAt.Format "2006-01-02 15:04:05"}}</div>
+<div>{{if .Attachment}}<a href="{{.Attachment}}">{{.Attachment}}</a> {{end}}{{.Content}}</div>
+</div>
+{{end}}{{define "footer"}}</body>
+</html>
+{{end}}`))
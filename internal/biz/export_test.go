@@ -0,0 +1,242 @@
+package biz
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	bizChat "kratos-realworld/internal/biz/messageGroup"
+	"kratos-realworld/internal/common"
+	"kratos-realworld/internal/conf"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeExportMessageRepo struct {
+	bizChat.MessageRepo
+	messages []*bizChat.MessageTB
+}
+
+func (r *fakeExportMessageRepo) ListConversationMessages(ctx context.Context, conversationType uint16, userID string, targetID string, start time.Time, end time.Time, afterID uint32, limit int) ([]*bizChat.MessageTB, error) {
+	var res []*bizChat.MessageTB
+	for _, m := range r.messages {
+		if m.ID > afterID && len(res) < limit {
+			res = append(res, m)
+		}
+	}
+	return res, nil
+}
+
+type fakeExportRepo struct {
+	bizChat.ExportRepo
+	mu   sync.Mutex
+	jobs map[uint32]*bizChat.ExportJobTB
+	done chan uint32
+}
+
+func newFakeExportRepo() *fakeExportRepo {
+	return &fakeExportRepo{jobs: make(map[uint32]*bizChat.ExportJobTB), done: make(chan uint32, 10)}
+}
+
+func (r *fakeExportRepo) CreateExportJob(ctx context.Context, job *bizChat.ExportJobTB) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	job.ID = uint32(len(r.jobs) + 1)
+	now := time.Now()
+	r.jobs[job.ID] = &bizChat.ExportJobTB{ID: job.ID, UserID: job.UserID, Status: job.Status, SysUpdated: &now}
+	return nil
+}
+
+func (r *fakeExportRepo) UpdateExportJob(ctx context.Context, id uint32, updates map[string]interface{}) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	job := r.jobs[id]
+	if status, ok := updates["status"]; ok {
+		job.Status = status.(uint16)
+	}
+	if name, ok := updates["file_name"]; ok {
+		job.FileName = name.(string)
+	}
+	if job.Status == bizChat.ExportDone || job.Status == bizChat.ExportFailed {
+		r.done <- id
+	}
+	return nil
+}
+
+func (r *fakeExportRepo) ListExpiredExportJobs(ctx context.Context, before time.Time, limit int) ([]*bizChat.ExportJobTB, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var res []*bizChat.ExportJobTB
+	for _, job := range r.jobs {
+		if job.Status == bizChat.ExportDone && job.FileName != "" && job.ExpireAt != nil && job.ExpireAt.Before(before) {
+			res = append(res, job)
+		}
+	}
+	return res, nil
+}
+
+func (r *fakeExportRepo) ListStaleExportJobs(ctx context.Context, before time.Time, limit int) ([]*bizChat.ExportJobTB, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var res []*bizChat.ExportJobTB
+	for _, job := range r.jobs {
+		if job.Status != bizChat.ExportDone && job.Status != bizChat.ExportFailed && job.SysUpdated.Before(before) {
+			copied := *job
+			res = append(res, &copied)
+		}
+	}
+	return res, nil
+}
+
+func (r *fakeExportRepo) ClaimExportJob(ctx context.Context, id uint32, before time.Time) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	job := r.jobs[id]
+	if !job.SysUpdated.Before(before) {
+		return false, nil
+	}
+	now := time.Now()
+	job.Status = bizChat.ExportPending
+	job.SysUpdated = &now
+	return true, nil
+}
+
+func newExportTestConf(t *testing.T) *conf.Data {
+	return &conf.Data{Storage: &conf.Data_Storage{StaticDir: t.TempDir(), ExportDir: t.TempDir()}}
+}
+
+func readZipFile(t *testing.T, zr *zip.ReadCloser, name string) []byte {
+	for _, f := range zr.File {
+		if f.Name != name {
+			continue
+		}
+		rc, err := f.Open()
+		assert.NoError(t, err)
+		defer rc.Close()
+		b, err := io.ReadAll(rc)
+		assert.NoError(t, err)
+		return b
+	}
+	t.Fatalf("%s not found in zip", name)
+	return nil
+}
+
+func TestWriteExport(t *testing.T) {
+	dc := newExportTestConf(t)
+	assert.NoError(t, os.WriteFile(filepath.Join(dc.Storage.StaticDir, "a.png"), []byte("png"), 0644))
+
+	// 超过一批，验证分批查询
+	mr := &fakeExportMessageRepo{}
+	created := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	for i := 1; i <= exportBatchSize+5; i++ {
+		m := &bizChat.MessageTB{ID: uint32(i), FromUserID: "1", ToUserID: "2", Content: "<b>hi</b>", CreatedAt: &created}
+		if i%2 == 0 {
+			m.Url = "/static/a.png"
+		}
+		mr.messages = append(mr.messages, m)
+	}
+	ec := NewExportUsecase(mr, nil, newFakeExportRepo(), dc, log.DefaultLogger)
+
+	start, end := created.Add(-time.Hour), created.Add(time.Hour)
+	job := &bizChat.ExportJobTB{ID: 9, UserID: 1, TargetID: "2", ConversationType: common.MESSAGE_TYPE_USER, StartAt: &start, EndAt: &end}
+	fileName, err := ec.writeExport(context.Background(), job)
+	assert.NoError(t, err)
+
+	// 工作目录已经清掉，只剩 zip
+	entries, err := os.ReadDir(dc.Storage.ExportDir)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+	assert.Equal(t, fileName, entries[0].Name())
+
+	zr, err := zip.OpenReader(filepath.Join(dc.Storage.ExportDir, fileName))
+	assert.NoError(t, err)
+	defer zr.Close()
+
+	var messages []exportMessage
+	assert.NoError(t, json.Unmarshal(readZipFile(t, zr, "messages.json"), &messages))
+	assert.Len(t, messages, exportBatchSize+5)
+	assert.Equal(t, "attachments/a.png", messages[1].Attachment)
+
+	records, err := csv.NewReader(bytes.NewReader(readZipFile(t, zr, "messages.csv"))).ReadAll()
+	assert.NoError(t, err)
+	assert.Len(t, records, exportBatchSize+6)
+
+	html := string(readZipFile(t, zr, "transcript.html"))
+	assert.Contains(t, html, "&lt;b&gt;hi&lt;/b&gt;")
+	assert.Contains(t, html, "</html>")
+	assert.Equal(t, []byte("png"), readZipFile(t, zr, "attachments/a.png"))
+}
+
+// 群成员只能导出入群之后的消息
+func TestCreateExportClampsToJoinTime(t *testing.T) {
+	joined := time.Now().Add(-time.Hour)
+	gr := newApprovalGroupRepo()
+	gr.members[2] = &bizChat.GroupMemberTB{UserID: 2, GroupID: 1, Role: bizChat.GroupRoleMember, SysCreated: &joined}
+	er := newFakeExportRepo()
+	ec := NewExportUsecase(&fakeExportMessageRepo{}, gr, er, newExportTestConf(t), log.DefaultLogger)
+
+	start := joined.Add(-24 * time.Hour)
+	res, err := ec.CreateExport(userCtx(2), "g1", common.MESSAGE_TYPE_GROUP, start, time.Now())
+	assert.NoError(t, err)
+	assert.Equal(t, joined, *res.Job.StartAt)
+	<-er.done
+
+	_, err = ec.CreateExport(userCtx(2), "g1", common.MESSAGE_TYPE_GROUP, start, joined.Add(-time.Minute))
+	assert.Equal(t, INVALID_PARAMS, errors.FromError(err).Reason)
+}
+
+// 节点退出后留下的任务只会被一个节点重新执行，还在刷新的任务不动
+func TestResumeStaleExports(t *testing.T) {
+	er := newFakeExportRepo()
+	dc := newExportTestConf(t)
+	ec1 := NewExportUsecase(&fakeExportMessageRepo{}, nil, er, dc, log.DefaultLogger)
+	ec2 := NewExportUsecase(&fakeExportMessageRepo{}, nil, er, dc, log.DefaultLogger)
+
+	start, end := time.Now().Add(-time.Hour), time.Now()
+	stale, fresh := time.Now().Add(-time.Hour), time.Now()
+	er.jobs[1] = &bizChat.ExportJobTB{ID: 1, UserID: 1, TargetID: "2", ConversationType: common.MESSAGE_TYPE_USER, StartAt: &start, EndAt: &end, Status: bizChat.ExportRunning, SysUpdated: &stale}
+	er.jobs[2] = &bizChat.ExportJobTB{ID: 2, UserID: 1, TargetID: "3", ConversationType: common.MESSAGE_TYPE_USER, StartAt: &start, EndAt: &end, Status: bizChat.ExportRunning, SysUpdated: &fresh}
+
+	n, err := ec1.ResumeStaleExports(context.Background(), 10*time.Minute, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, n)
+	n, err = ec2.ResumeStaleExports(context.Background(), 10*time.Minute, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, n)
+
+	assert.Equal(t, uint32(1), <-er.done)
+	er.mu.Lock()
+	defer er.mu.Unlock()
+	assert.Equal(t, bizChat.ExportDone, er.jobs[1].Status)
+	assert.Equal(t, bizChat.ExportRunning, er.jobs[2].Status)
+}
+
+func TestPurgeExpiredExports(t *testing.T) {
+	er := newFakeExportRepo()
+	dc := newExportTestConf(t)
+	ec := NewExportUsecase(&fakeExportMessageRepo{}, nil, er, dc, log.DefaultLogger)
+
+	expired, valid := time.Now().Add(-time.Minute), time.Now().Add(time.Hour)
+	for id, expireAt := range map[uint32]*time.Time{1: &expired, 2: &valid} {
+		name := fmt.Sprintf("export_%d.zip", id)
+		assert.NoError(t, os.WriteFile(filepath.Join(dc.Storage.ExportDir, name), []byte("zip"), 0644))
+		er.jobs[id] = &bizChat.ExportJobTB{ID: id, Status: bizChat.ExportDone, FileName: name, ExpireAt: expireAt}
+	}
+
+	n, err := ec.PurgeExpiredExports(context.Background(), 10)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.NoFileExists(t, filepath.Join(dc.Storage.ExportDir, "export_1.zip"))
+	assert.FileExists(t, filepath.Join(dc.Storage.ExportDir, "export_2.zip"))
+	assert.Equal(t, "", er.jobs[1].FileName)
+}
@@ -0,0 +1,125 @@
+package biz
+
+import (
+	"context"
+	bizProfile "kratos-realworld/internal/biz/profile"
+	"kratos-realworld/internal/pkg/middleware/auth"
+)
+
+// ListFollowing 查询 userID 关注的人，按关注时间倒序，cursor 为 0 表示第一页
+func (pc *ProfileUsecase) ListFollowing(ctx context.Context, userID string, cursor uint32, limit int) (*FollowListReply, error) {
+	uID, err := parseTargetID(userID)
+	if err != nil {
+		return nil, err
+	}
+	_, limit = normalizePage(1, limit)
+	if err := pc.privacy.CheckCanViewFollowList(ctx, uID, uint32(auth.FromContext(ctx).UserID)); err != nil {
+		return nil, err
+	}
+
+	edges, err := pc.pr.ListFollowing(ctx, uID, cursor, limit+1)
+	if err != nil {
+		return nil, NewErr(ErrCodeDBQueryFailed, DB_QUERY_FAILED, "failed to query following list")
+	}
+	return pc.buildFollowList(ctx, edges, limit, func(e *bizProfile.FollowFanTB) uint32 { return e.FolloweeID })
+}
+
+// ListFollowers 查询关注 userID 的人，按关注时间倒序，cursor 为 0 表示第一页
+func (pc *ProfileUsecase) ListFollowers(ctx context.Context, userID string, cursor uint32, limit int) (*FollowListReply, error) {
+	uID, err := parseTargetID(userID)
+	if err != nil {
+		return nil, err
+	}
+	_, limit = normalizePage(1, limit)
+	if err := pc.privacy.CheckCanViewFollowList(ctx, uID, uint32(auth.FromContext(ctx).UserID)); err != nil {
+		return nil, err
+	}
+
+	edges, err := pc.pr.ListFollowers(ctx, uID, cursor, limit+1)
+	if err != nil {
+		return nil, NewErr(ErrCodeDBQueryFailed, DB_QUERY_FAILED, "failed to query follower list")
+	}
+	return pc.buildFollowList(ctx, edges, limit, func(e *bizProfile.FollowFanTB) uint32 { return e.FollowerID })
+}
+
+// buildFollowList 多查一条判断是否还有下一页，再批量补全用户信息和与当前用户的关系
+func (pc *ProfileUsecase) buildFollowList(ctx context.Context, edges []*bizProfile.FollowFanTB, limit int, other func(*bizProfile.FollowFanTB) uint32) (*FollowListReply, error) {
+	reply := &FollowListReply{Users: make([]*FollowUserItem, 0, len(edges))}
+	if len(edges) > limit {
+		edges = edges[:limit]
+		reply.HasMore = true
+	}
+	if len(edges) == 0 {
+		return reply, nil
+	}
+	reply.NextCursor = edges[len(edges)-1].ID
+
+	ids := make([]uint32, 0, len(edges))
+	for _, e := range edges {
+		ids = append(ids, other(e))
+	}
+
+	users, err := pc.ur.GetUsersByIDs(ctx, ids)
+	if err != nil {
+		return nil, NewErr(ErrCodeDBQueryFailed, DB_QUERY_FAILED, "failed to query users")
+	}
+	items := make(map[uint32]*FollowUserItem, len(users))
+	for _, u := range users {
+		items[u.ID] = &FollowUserItem{
+			UserID:    u.ID,
+			UserName:  u.UserName,
+			Handle:    u.Handle,
+			HeadImage: u.HeadImage,
+			Bio:       u.Bio,
+		}
+	}
+
+	if err := pc.fillRelationFlags(ctx, uint32(auth.FromContext(ctx).UserID), items, ids); err != nil {
+		return nil, err
+	}
+
+	// 按关注时间的顺序输出，已注销的用户跳过
+	for _, id := range ids {
+		item, ok := items[id]
+		if !ok {
+			continue
+		}
+		reply.Users = append(reply.Users, item)
+	}
+	return reply, nil
+}
+
+// fillRelationFlags 批量补全 items 里每个人和 viewerID 的关注、好友关系
+func (pc *ProfileUsecase) fillRelationFlags(ctx context.Context, viewerID uint32, items map[uint32]*FollowUserItem, ids []uint32) error {
+	following, err := pc.pr.FilterFollowing(ctx, viewerID, ids)
+	if err != nil {
+		return NewErr(ErrCodeDBQueryFailed, DB_QUERY_FAILED, "failed to query follow relationship")
+	}
+	followers, err := pc.pr.FilterFollowers(ctx, viewerID, ids)
+	if err != nil {
+		return NewErr(ErrCodeDBQueryFailed, DB_QUERY_FAILED, "failed to query follow relationship")
+	}
+	friends, err := pc.pr.FilterFriends(ctx, viewerID, ids)
+	if err != nil {
+		return NewErr(ErrCodeDBQueryFailed, DB_QUERY_FAILED, "failed to query friend relationship")
+	}
+	for _, id := range following {
+		if item, ok := items[id]; ok {
+			item.IsFollowing = true
+		}
+	}
+	for _, id := range followers {
+		if item, ok := items[id]; ok {
+			item.IsFollowedBy = true
+		}
+	}
+	for _, id := range friends {
+		if item, ok := items[id]; ok {
+			item.IsFriend = true
+		}
+	}
+	for _, item := range items {
+		item.IsMutual = item.IsFollowing && item.IsFollowedBy
+	}
+	return nil
+}
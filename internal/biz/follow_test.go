@@ -0,0 +1,254 @@
+package biz
+
+import (
+	"context"
+	bizProfile "kratos-realworld/internal/biz/profile"
+	bizUser "kratos-realworld/internal/biz/user"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeSocialRepo 内存里的关注、好友关系和隐私设置
+type fakeSocialRepo struct {
+	bizProfile.ProfileRepo
+	edges    []*bizProfile.FollowFanTB // 按 ID 递增，即关注时间顺序
+	friends  map[[2]uint32]bool
+	privacy  map[uint32]*bizProfile.PrivacySettingTB
+	blocked  map[[2]uint32]bool
+	profiles map[uint32]*bizProfile.ProfileTB
+}
+
+func newFakeSocialRepo() *fakeSocialRepo {
+	return &fakeSocialRepo{
+		friends:  make(map[[2]uint32]bool),
+		privacy:  make(map[uint32]*bizProfile.PrivacySettingTB),
+		blocked:  make(map[[2]uint32]bool),
+		profiles: make(map[uint32]*bizProfile.ProfileTB),
+	}
+}
+
+func (r *fakeSocialRepo) follow(followerID uint32, followeeID uint32) {
+	r.edges = append(r.edges, &bizProfile.FollowFanTB{ID: uint32(len(r.edges) + 1), FollowerID: followerID, FolloweeID: followeeID})
+}
+
+func (r *fakeSocialRepo) befriend(a uint32, b uint32) {
+	r.friends[[2]uint32{a, b}] = true
+	r.friends[[2]uint32{b, a}] = true
+}
+
+func (r *fakeSocialRepo) follows(followerID uint32, followeeID uint32) bool {
+	for _, e := range r.edges {
+		if e.FollowerID == followerID && e.FolloweeID == followeeID {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *fakeSocialRepo) listEdges(match func(*bizProfile.FollowFanTB) bool, cursor uint32, limit int) []*bizProfile.FollowFanTB {
+	var res []*bizProfile.FollowFanTB
+	for i := len(r.edges) - 1; i >= 0 && len(res) < limit; i-- {
+		e := r.edges[i]
+		if (cursor == 0 || e.ID < cursor) && match(e) {
+			res = append(res, e)
+		}
+	}
+	return res
+}
+
+func (r *fakeSocialRepo) ListFollowing(ctx context.Context, userID uint32, cursor uint32, limit int) ([]*bizProfile.FollowFanTB, error) {
+	return r.listEdges(func(e *bizProfile.FollowFanTB) bool { return e.FollowerID == userID }, cursor, limit), nil
+}
+
+func (r *fakeSocialRepo) ListFollowers(ctx context.Context, userID uint32, cursor uint32, limit int) ([]*bizProfile.FollowFanTB, error) {
+	return r.listEdges(func(e *bizProfile.FollowFanTB) bool { return e.FolloweeID == userID }, cursor, limit), nil
+}
+
+func (r *fakeSocialRepo) filter(targetIDs []uint32, match func(id uint32) bool) []uint32 {
+	var res []uint32
+	for _, id := range targetIDs {
+		if match(id) {
+			res = append(res, id)
+		}
+	}
+	return res
+}
+
+func (r *fakeSocialRepo) FilterFollowing(ctx context.Context, userID uint32, targetIDs []uint32) ([]uint32, error) {
+	return r.filter(targetIDs, func(id uint32) bool { return r.follows(userID, id) }), nil
+}
+
+func (r *fakeSocialRepo) FilterFollowers(ctx context.Context, userID uint32, targetIDs []uint32) ([]uint32, error) {
+	return r.filter(targetIDs, func(id uint32) bool { return r.follows(id, userID) }), nil
+}
+
+func (r *fakeSocialRepo) FilterFriends(ctx context.Context, userID uint32, targetIDs []uint32) ([]uint32, error) {
+	return r.filter(targetIDs, func(id uint32) bool { return r.friends[[2]uint32{userID, id}] }), nil
+}
+
+func (r *fakeSocialRepo) CheckFriend(ctx context.Context, userID uint32, targetID uint32) (bool, error) {
+	return r.friends[[2]uint32{userID, targetID}], nil
+}
+
+func (r *fakeSocialRepo) GetFollowerIDs(ctx context.Context, userID uint32) ([]uint32, error) {
+	var ids []uint32
+	for _, e := range r.edges {
+		if e.FolloweeID == userID {
+			ids = append(ids, e.FollowerID)
+		}
+	}
+	return ids, nil
+}
+
+func (r *fakeSocialRepo) GetFollowingIDs(ctx context.Context, userID uint32) ([]uint32, error) {
+	var ids []uint32
+	for _, e := range r.edges {
+		if e.FollowerID == userID {
+			ids = append(ids, e.FolloweeID)
+		}
+	}
+	return ids, nil
+}
+
+func (r *fakeSocialRepo) CheckBlock(ctx context.Context, userID uint32, targetID uint32) (bool, error) {
+	return r.blocked[[2]uint32{userID, targetID}], nil
+}
+
+func (r *fakeSocialRepo) ListBlockedIDs(ctx context.Context, userID uint32) ([]uint32, error) {
+	var ids []uint32
+	for pair := range r.blocked {
+		if pair[0] == userID {
+			ids = append(ids, pair[1])
+		}
+	}
+	return ids, nil
+}
+
+// GetPrivacySettings 没有设置过的人按默认设置，和数据层一致
+func (r *fakeSocialRepo) GetPrivacySettings(ctx context.Context, userIDs []uint32) (map[uint32]*bizProfile.PrivacySettingTB, error) {
+	res := make(map[uint32]*bizProfile.PrivacySettingTB, len(userIDs))
+	for _, id := range userIDs {
+		if s, ok := r.privacy[id]; ok {
+			copied := *s
+			res[id] = &copied
+			continue
+		}
+		res[id] = bizProfile.DefaultPrivacySetting(id)
+	}
+	return res, nil
+}
+
+func (r *fakeSocialRepo) SavePrivacySetting(ctx context.Context, setting *bizProfile.PrivacySettingTB) error {
+	copied := *setting
+	r.privacy[setting.UserID] = &copied
+	return nil
+}
+
+type fakeUserRepo struct {
+	bizUser.UserRepo
+	users map[uint32]*bizUser.UserTB
+}
+
+func newFakeUserRepo(ids ...uint32) *fakeUserRepo {
+	r := &fakeUserRepo{users: make(map[uint32]*bizUser.UserTB)}
+	for _, id := range ids {
+		r.users[id] = &bizUser.UserTB{ID: id, UserName: "user"}
+	}
+	return r
+}
+
+func (r *fakeUserRepo) GetUsersByIDs(ctx context.Context, userIDs []uint32) ([]*bizUser.UserTB, error) {
+	var res []*bizUser.UserTB
+	for _, id := range userIDs {
+		if u, ok := r.users[id]; ok {
+			res = append(res, u)
+		}
+	}
+	return res, nil
+}
+
+func newSocialUsecase(pr *fakeSocialRepo, ur bizUser.UserRepo) *ProfileUsecase {
+	return NewProfileUsecase(pr, ur, fakeTx{}, nil, NewPrivacyPolicy(pr, log.DefaultLogger), nil, nil, log.DefaultLogger)
+}
+
+func followUserIDs(reply *FollowListReply) []uint32 {
+	ids := make([]uint32, 0, len(reply.Users))
+	for _, u := range reply.Users {
+		ids = append(ids, u.UserID)
+	}
+	return ids
+}
+
+// 按关注时间倒序分页，最后一页 HasMore 为 false
+func TestListFollowersPaging(t *testing.T) {
+	pr := newFakeSocialRepo()
+	for _, id := range []uint32{2, 3, 4, 5, 6} {
+		pr.follow(id, 1)
+	}
+	pr.follow(2, 9) // 其他人的关注关系不影响
+	pc := newSocialUsecase(pr, newFakeUserRepo(2, 3, 4, 5, 6))
+	ctx := userCtx(1)
+
+	page, err := pc.ListFollowers(ctx, "1", 0, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint32{6, 5}, followUserIDs(page))
+	assert.True(t, page.HasMore)
+
+	page, err = pc.ListFollowers(ctx, "1", page.NextCursor, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint32{4, 3}, followUserIDs(page))
+	assert.True(t, page.HasMore)
+
+	page, err = pc.ListFollowers(ctx, "1", page.NextCursor, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint32{2}, followUserIDs(page))
+	assert.False(t, page.HasMore)
+}
+
+// 关系标记是相对当前查看的人，已注销的用户跳过
+func TestListFollowingRelationFlags(t *testing.T) {
+	pr := newFakeSocialRepo()
+	pr.follow(1, 2)
+	pr.follow(1, 3)
+	pr.follow(1, 4)
+	// 查看者 5 关注了 2，3 关注了 5，4 和 5 是好友
+	pr.follow(5, 2)
+	pr.follow(3, 5)
+	pr.befriend(4, 5)
+	pc := newSocialUsecase(pr, newFakeUserRepo(2, 3))
+
+	res, err := pc.ListFollowing(userCtx(5), "1", 0, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint32{3, 2}, followUserIDs(res))
+
+	flags := map[uint32]*FollowUserItem{}
+	for _, u := range res.Users {
+		flags[u.UserID] = u
+	}
+	assert.True(t, flags[2].IsFollowing)
+	assert.False(t, flags[2].IsFollowedBy)
+	assert.False(t, flags[3].IsFollowing)
+	assert.True(t, flags[3].IsFollowedBy)
+	assert.False(t, flags[3].IsMutual)
+	assert.False(t, flags[3].IsFriend)
+}
+
+// 关注列表设置为仅自己可见时，别人查看返回 PRIVACY_RESTRICTED
+func TestListFollowingPrivacy(t *testing.T) {
+	pr := newFakeSocialRepo()
+	pr.follow(1, 2)
+	setting := bizProfile.DefaultPrivacySetting(1)
+	setting.FollowListVisibility = bizProfile.AudienceNobody
+	pr.privacy[1] = setting
+	pc := newSocialUsecase(pr, newFakeUserRepo(2))
+
+	_, err := pc.ListFollowing(userCtx(3), "1", 0, 10)
+	assert.Equal(t, PRIVACY_RESTRICTED, errors.FromError(err).Reason)
+
+	res, err := pc.ListFollowing(userCtx(1), "1", 0, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint32{2}, followUserIDs(res))
+}
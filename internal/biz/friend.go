@@ -0,0 +1,284 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	bizProfile "kratos-realworld/internal/biz/profile"
+	"kratos-realworld/internal/common"
+	"kratos-realworld/internal/pkg/middleware/auth"
+	"strconv"
+
+	"gorm.io/gorm"
+)
+
+// 处理好友申请的动作
+const (
+	FriendActionAccept  = "accept"
+	FriendActionDecline = "decline"
+	FriendActionIgnore  = "ignore"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+// normalizePage 分页参数兜底，page 从 1 开始
+func normalizePage(page int, pageSize int) (int, int) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+	return page, pageSize
+}
+
+func parseTargetID(targetID string) (uint32, error) {
+	// 参数：字符串, 进制(10), 位数(32)
+	tID, err := strconv.ParseUint(targetID, 10, 32)
+	if err != nil {
+		return 0, NewErr(ErrCodeInvalidParams, INVALID_PARAMS, "invalid target id")
+	}
+	return uint32(tID), nil
+}
+
+// CanAddFriend 判断当前用户能否向对方发起好友申请
+func (pc *ProfileUsecase) CanAddFriend(ctx context.Context, targetID string) (*CanAddFriendReply, error) {
+	userID := uint32(auth.FromContext(ctx).UserID)
+	tID, err := parseTargetID(targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &CanAddFriendReply{}
+	if userID == tID {
+		reply.Reason = "cannot add yourself as a friend"
+		return reply, nil
+	}
+	if err := checkBlocked(ctx, pc.pr, userID, tID); err != nil {
+		reply.Reason = "blocked relationship exists"
+		return reply, nil
+	}
+
+	isFriend, err := pc.pr.CheckFriend(ctx, userID, tID)
+	if err != nil {
+		return nil, NewErr(ErrCodeDBQueryFailed, DB_QUERY_FAILED, "failed to query friend relationship")
+	}
+	if isFriend {
+		reply.IsFriend = true
+		reply.Reason = "already friends"
+		return reply, nil
+	}
+
+	if pending, err := pc.pr.GetPendingFriendRequest(ctx, userID, tID); err == nil {
+		reply.PendingRequestID = pending.ID
+		reply.Reason = "friend request already sent"
+		return reply, nil
+	}
+	if incoming, err := pc.pr.GetPendingFriendRequest(ctx, tID, userID); err == nil {
+		reply.IncomingRequestID = incoming.ID
+	}
+
+	reply.CanAdd = true
+	return reply, nil
+}
+
+// SendFriendRequest 发起好友申请，对方已经向我发过申请时直接同意对方的申请
+func (pc *ProfileUsecase) SendFriendRequest(ctx context.Context, targetID string, greeting string) (*bizProfile.FriendRequestTB, error) {
+	userID := uint32(auth.FromContext(ctx).UserID)
+	tID, err := parseTargetID(targetID)
+	if err != nil {
+		return nil, err
+	}
+	if userID == tID {
+		return nil, NewErr(ErrCodeFriendRequestFailed, FRIEND_REQUEST_FAILED, "cannot add yourself as a friend")
+	}
+	if len([]rune(greeting)) > 100 {
+		return nil, NewErr(ErrCodeInvalidParams, INVALID_PARAMS, "greeting is too long")
+	}
+
+	if _, err := pc.pr.GetProfileByUserID(ctx, tID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, NewErr(ErrCodeFriendRequestFailed, FRIEND_REQUEST_FAILED, "target user not found")
+		}
+		return nil, NewErr(ErrCodeDBQueryFailed, DB_QUERY_FAILED, "failed to query profile by UserID")
+	}
+	if err := checkBlocked(ctx, pc.pr, userID, tID); err != nil {
+		return nil, err
+	}
+
+	isFriend, err := pc.pr.CheckFriend(ctx, userID, tID)
+	if err != nil {
+		return nil, NewErr(ErrCodeDBQueryFailed, DB_QUERY_FAILED, "failed to query friend relationship")
+	}
+	if isFriend {
+		return nil, NewErr(ErrCodeAlreadyFriend, ALREADY_FRIEND, "you are already friends")
+	}
+
+	// 重复申请直接返回之前的申请
+	if pending, err := pc.pr.GetPendingFriendRequest(ctx, userID, tID); err == nil {
+		return pending, nil
+	}
+	if incoming, err := pc.pr.GetPendingFriendRequest(ctx, tID, userID); err == nil {
+		return pc.handleFriendRequest(ctx, incoming, bizProfile.FriendRequestAccepted)
+	}
+
+	req := &bizProfile.FriendRequestTB{
+		FromID:   userID,
+		ToID:     tID,
+		Greeting: greeting,
+		Status:   bizProfile.FriendRequestPending,
+	}
+	if err := pc.pr.CreateFriendRequest(ctx, req); err != nil {
+		if !errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, NewErr(ErrCodeFriendRequestFailed, FRIEND_REQUEST_FAILED, "failed to create friend request")
+		}
+		// 并发请求已经插入了待处理申请，按上面同样的规则处理
+		if pending, err := pc.pr.GetPendingFriendRequest(ctx, userID, tID); err == nil {
+			return pending, nil
+		}
+		if incoming, err := pc.pr.GetPendingFriendRequest(ctx, tID, userID); err == nil {
+			return pc.handleFriendRequest(ctx, incoming, bizProfile.FriendRequestAccepted)
+		}
+		return nil, NewErr(ErrCodeFriendRequestFailed, FRIEND_REQUEST_FAILED, "failed to create friend request")
+	}
+
+	err = pushSystemNotify(strconv.Itoa(int(tID)), common.FRIEND_REQUEST, map[string]interface{}{
+		"requestId": req.ID,
+		"fromId":    req.FromID,
+		"greeting":  req.Greeting,
+	})
+	if err != nil {
+		pc.log.Errorf("push friend request to %d error: %v", tID, err)
+	}
+	return req, nil
+}
+
+// ListFriendRequests incoming 为 true 返回收到的待处理申请，否则返回发出的待处理申请
+func (pc *ProfileUsecase) ListFriendRequests(ctx context.Context, incoming bool) ([]*bizProfile.FriendRequestTB, error) {
+	userID := uint32(auth.FromContext(ctx).UserID)
+
+	reqs, err := pc.pr.ListPendingFriendRequests(ctx, userID, incoming)
+	if err != nil {
+		return nil, NewErr(ErrCodeDBQueryFailed, DB_QUERY_FAILED, "failed to query friend requests")
+	}
+	return reqs, nil
+}
+
+// HandleFriendRequest 被申请人同意、拒绝或者忽略好友申请
+func (pc *ProfileUsecase) HandleFriendRequest(ctx context.Context, requestID uint32, action string) (*bizProfile.FriendRequestTB, error) {
+	userID := uint32(auth.FromContext(ctx).UserID)
+
+	var status uint16
+	switch action {
+	case FriendActionAccept:
+		status = bizProfile.FriendRequestAccepted
+	case FriendActionDecline:
+		status = bizProfile.FriendRequestDeclined
+	case FriendActionIgnore:
+		status = bizProfile.FriendRequestIgnored
+	default:
+		return nil, NewErr(ErrCodeInvalidParams, INVALID_PARAMS, "action must be accept, decline or ignore")
+	}
+
+	req, err := pc.pr.GetFriendRequest(ctx, requestID)
+	if err != nil || req.ToID != userID {
+		return nil, NewErr(ErrCodeFriendRequestNotFound, FRIEND_REQUEST_NOT_FOUND, "friend request not found")
+	}
+	return pc.handleFriendRequest(ctx, req, status)
+}
+
+func (pc *ProfileUsecase) handleFriendRequest(ctx context.Context, req *bizProfile.FriendRequestTB, status uint16) (*bizProfile.FriendRequestTB, error) {
+	err := pc.tx.InTx(ctx, func(ctx context.Context) error {
+		ok, err := pc.pr.HandleFriendRequest(ctx, req.ID, status)
+		if err != nil {
+			return NewErr(ErrCodeFriendRequestFailed, FRIEND_REQUEST_FAILED, "failed to update friend request")
+		}
+		if !ok {
+			return NewErr(ErrCodeFriendRequestHandled, FRIEND_REQUEST_HANDLED, "friend request has already been handled")
+		}
+		if status != bizProfile.FriendRequestAccepted {
+			return nil
+		}
+		// 申请发出之后任一方拉黑了对方，不能再通过同意申请成为好友，处理人是 ToID
+		if err := checkBlocked(ctx, pc.pr, req.ToID, req.FromID); err != nil {
+			return err
+		}
+		if err := pc.pr.AddFriend(ctx, req.FromID, req.ToID); err != nil {
+			return NewErr(ErrCodeFriendRequestFailed, FRIEND_REQUEST_FAILED, "failed to add friend")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	req.Status = status
+
+	payload := map[string]interface{}{
+		"requestId": req.ID,
+		"fromId":    req.FromID,
+		"toId":      req.ToID,
+		"status":    req.Status,
+	}
+	// 忽略的申请不通知申请人，只同步给处理人自己
+	receivers := []uint32{req.ToID}
+	if status != bizProfile.FriendRequestIgnored {
+		receivers = append(receivers, req.FromID)
+	}
+	for _, to := range receivers {
+		if err := pushSystemNotify(strconv.Itoa(int(to)), common.FRIEND_UPDATE, payload); err != nil {
+			pc.log.Errorf("push friend update to %d error: %v", to, err)
+		}
+	}
+	return req, nil
+}
+
+// ListFriends 分页查询当前用户的好友
+func (pc *ProfileUsecase) ListFriends(ctx context.Context, page int, pageSize int) (*FriendListReply, error) {
+	userID := uint32(auth.FromContext(ctx).UserID)
+
+	page, pageSize = normalizePage(page, pageSize)
+	friends, total, err := pc.pr.ListFriends(ctx, userID, page, pageSize)
+	if err != nil {
+		return nil, NewErr(ErrCodeDBQueryFailed, DB_QUERY_FAILED, "failed to query friends")
+	}
+	return &FriendListReply{
+		Friends:  friends,
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	}, nil
+}
+
+// RemoveFriend 解除好友关系，双方都会收到通知
+func (pc *ProfileUsecase) RemoveFriend(ctx context.Context, targetID string) error {
+	userID := uint32(auth.FromContext(ctx).UserID)
+	tID, err := parseTargetID(targetID)
+	if err != nil {
+		return err
+	}
+
+	ok, err := pc.pr.DeleteFriend(ctx, userID, tID)
+	if err != nil {
+		return NewErr(ErrCodeFriendRequestFailed, FRIEND_REQUEST_FAILED, "failed to delete friend")
+	}
+	if !ok {
+		return NewErr(ErrCodeNotFriend, NOT_FRIEND, "you are not friends")
+	}
+
+	payload := map[string]interface{}{
+		"fromId":  userID,
+		"toId":    tID,
+		"removed": true,
+	}
+	for _, to := range []uint32{userID, tID} {
+		if err := pushSystemNotify(strconv.Itoa(int(to)), common.FRIEND_UPDATE, payload); err != nil {
+			pc.log.Errorf("push friend update to %d error: %v", to, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	bizProfile "kratos-realworld/internal/biz/profile"
+	"testing"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+type fakeFriendRepo struct {
+	bizProfile.ProfileRepo
+	requests []*bizProfile.FriendRequestTB
+	friends  map[string]bool
+	blocks   map[string]bool
+	// 前几次查询待处理申请时查不到，模拟并发请求同时通过了检查
+	stalePendingReads int
+}
+
+func newFakeFriendRepo() *fakeFriendRepo {
+	return &fakeFriendRepo{friends: make(map[string]bool), blocks: make(map[string]bool)}
+}
+
+func (r *fakeFriendRepo) GetProfileByUserID(ctx context.Context, userID uint32) (*bizProfile.ProfileTB, error) {
+	return &bizProfile.ProfileTB{UserID: userID}, nil
+}
+
+func (r *fakeFriendRepo) CheckBlock(ctx context.Context, userID uint32, targetID uint32) (bool, error) {
+	return r.blocks[fmt.Sprintf("%d_%d", userID, targetID)], nil
+}
+
+func (r *fakeFriendRepo) CheckFriend(ctx context.Context, userID uint32, targetID uint32) (bool, error) {
+	return r.friends[fmt.Sprintf("%d_%d", userID, targetID)], nil
+}
+
+func (r *fakeFriendRepo) GetFriendRequest(ctx context.Context, id uint32) (*bizProfile.FriendRequestTB, error) {
+	for _, req := range r.requests {
+		if req.ID == id {
+			return req, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (r *fakeFriendRepo) GetPendingFriendRequest(ctx context.Context, fromID uint32, toID uint32) (*bizProfile.FriendRequestTB, error) {
+	if r.stalePendingReads > 0 {
+		r.stalePendingReads--
+		return nil, gorm.ErrRecordNotFound
+	}
+	for _, req := range r.requests {
+		if req.FromID == fromID && req.ToID == toID && req.Status == bizProfile.FriendRequestPending {
+			return req, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+// CreateFriendRequest 和唯一索引 idx_pending_pair 一样，两人之间只能有一条待处理申请
+func (r *fakeFriendRepo) CreateFriendRequest(ctx context.Context, req *bizProfile.FriendRequestTB) error {
+	for _, existing := range r.requests {
+		if existing.Status != bizProfile.FriendRequestPending {
+			continue
+		}
+		if (existing.FromID == req.FromID && existing.ToID == req.ToID) || (existing.FromID == req.ToID && existing.ToID == req.FromID) {
+			return gorm.ErrDuplicatedKey
+		}
+	}
+	req.ID = uint32(len(r.requests) + 1)
+	r.requests = append(r.requests, req)
+	return nil
+}
+
+func (r *fakeFriendRepo) HandleFriendRequest(ctx context.Context, id uint32, status uint16) (bool, error) {
+	for _, req := range r.requests {
+		if req.ID == id && req.Status == bizProfile.FriendRequestPending {
+			req.Status = status
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *fakeFriendRepo) AddFriend(ctx context.Context, userID uint32, friendID uint32) error {
+	r.friends[fmt.Sprintf("%d_%d", userID, friendID)] = true
+	r.friends[fmt.Sprintf("%d_%d", friendID, userID)] = true
+	return nil
+}
+
+func newFriendUsecase(pr bizProfile.ProfileRepo) *ProfileUsecase {
+	return NewProfileUsecase(pr, nil, fakeTx{}, nil, nil, nil, nil, log.DefaultLogger)
+}
+
+// 双方同时向对方发起申请，后到的请求插入失败，改为同意先到的申请
+func TestSendFriendRequestMutualRace(t *testing.T) {
+	pr := newFakeFriendRepo()
+	pc := newFriendUsecase(pr)
+
+	first, err := pc.SendFriendRequest(userCtx(1), "2", "hi")
+	assert.NoError(t, err)
+	assert.Equal(t, bizProfile.FriendRequestPending, first.Status)
+
+	pr.stalePendingReads = 2
+	second, err := pc.SendFriendRequest(userCtx(2), "1", "hello")
+	assert.NoError(t, err)
+	assert.Equal(t, first.ID, second.ID)
+	assert.Equal(t, bizProfile.FriendRequestAccepted, second.Status)
+	assert.Len(t, pr.requests, 1)
+	assert.True(t, pr.friends["1_2"])
+	assert.True(t, pr.friends["2_1"])
+}
+
+// 同一个人重复发起申请，返回已有的申请
+func TestSendFriendRequestDuplicate(t *testing.T) {
+	pr := newFakeFriendRepo()
+	pc := newFriendUsecase(pr)
+
+	first, err := pc.SendFriendRequest(userCtx(1), "2", "hi")
+	assert.NoError(t, err)
+
+	pr.stalePendingReads = 2
+	second, err := pc.SendFriendRequest(userCtx(1), "2", "hi again")
+	assert.NoError(t, err)
+	assert.Equal(t, first.ID, second.ID)
+	assert.Equal(t, bizProfile.FriendRequestPending, second.Status)
+	assert.Len(t, pr.requests, 1)
+}
+
+// 申请发出之后被申请人拉黑了对方，同意时不能加上好友
+func TestAcceptFriendRequestAfterBlock(t *testing.T) {
+	pr := newFakeFriendRepo()
+	pc := newFriendUsecase(pr)
+
+	req, err := pc.SendFriendRequest(userCtx(1), "2", "hi")
+	assert.NoError(t, err)
+
+	pr.blocks["2_1"] = true
+	_, err = pc.HandleFriendRequest(userCtx(2), req.ID, FriendActionAccept)
+	assert.Equal(t, USER_BLOCKED, kerrors.FromError(err).Reason)
+	assert.False(t, pr.friends["1_2"])
+	assert.False(t, pr.friends["2_1"])
+}
@@ -65,6 +65,11 @@ func (gc *GateWayUsecase) Register(ctx context.Context, username string, phone s
 		return nil, NewErr(ErrCodeCreateUserFailed, CREATE_USER_FAILED, "failed to create user")
 	}
 
+	// 先分配一个默认的@用户名，之后用户可以自己修改
+	if err := gc.ur.UpdateHandle(ctx, user.ID, defaultHandle(user.ID)); err != nil {
+		gc.log.Warnf("failed to assign default handle to user %d: %v", user.ID, err)
+	}
+
 	// 首次注册创建用户后，同时创建当前用户的空白主页profile，后续可以更新完善信息
 	userProfile := &bizProfile.ProfileTB{
 		UserID: user.ID,
@@ -111,11 +116,18 @@ func (gc *GateWayUsecase) Login(ctx context.Context, phone string, password stri
 		return nil, NewErr(ErrCodeInvalidPassword, INVALID_PASSWORD, "password is incorrect")
 	}
 
+	// 封禁中的账号不签发 token
+	if err := checkAccountBanned(ctx, gc.pr, res.ID); err != nil {
+		return nil, err
+	}
+
 	token, err := gc.generateToken(res.ID)
 	if err != nil {
 		return nil, NewErr(ErrCodeCreateTokenFailed, CREATE_TOKEN_FAILED, "failed to create token")
 	}
 
+	gc.recordLogin(ctx, res.ID, bizProfile.LoginSourcePassword)
+
 	return &UserLoginReply{
 		Phone:    res.Phone,
 		UserName: res.UserName,
@@ -143,11 +155,17 @@ func (gc *GateWayUsecase) LoginBySms(ctx context.Context, phone string, inputCod
 		return nil, NewErr(ErrCodeInvalidVerificationCode, INVALID_VERIFICATION_CODE, "SMS verification code is incorrect")
 	}
 
+	if err := checkAccountBanned(ctx, gc.pr, res.ID); err != nil {
+		return nil, err
+	}
+
 	token, err := gc.generateToken(res.ID)
 	if err != nil {
 		return nil, NewErr(ErrCodeCreateTokenFailed, CREATE_TOKEN_FAILED, "failed to create token")
 	}
 
+	gc.recordLogin(ctx, res.ID, bizProfile.LoginSourceSms)
+
 	return &UserLoginReply{
 		Phone:    res.Phone,
 		UserName: res.UserName,
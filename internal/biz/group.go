@@ -0,0 +1,208 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	bizChat "kratos-realworld/internal/biz/messageGroup"
+	"kratos-realworld/internal/model"
+	"kratos-realworld/internal/pkg/middleware/auth"
+	"time"
+)
+
+type GroupUsecase struct {
+	gr  bizChat.GroupRepo
+	tx  model.Transaction
+	log *log.Helper
+}
+
+func NewGroupUsecase(gr bizChat.GroupRepo, tx model.Transaction, logger log.Logger) *GroupUsecase {
+	return &GroupUsecase{
+		gr:  gr,
+		tx:  tx,
+		log: log.NewHelper(logger),
+	}
+}
+
+func getGroup(ctx context.Context, gr bizChat.GroupRepo, groupUuid string) (*bizChat.GroupTB, error) {
+	group, err := gr.GetGroupByUuid(ctx, groupUuid)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, NewErr(ErrCodeGroupNotFound, GROUP_NOT_FOUND, "group not found")
+		}
+		return nil, NewErr(ErrCodeDBQueryFailed, DB_QUERY_FAILED, "failed to query group")
+	}
+	return group, nil
+}
+
+func getGroupMember(ctx context.Context, gr bizChat.GroupRepo, group *bizChat.GroupTB, userID uint32) (*bizChat.GroupMemberTB, error) {
+	member, err := gr.GetGroupMember(ctx, group.ID, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, NewErr(ErrCodeNotGroupMember, NOT_GROUP_MEMBER, "user is not a member of this group")
+		}
+		return nil, NewErr(ErrCodeDBQueryFailed, DB_QUERY_FAILED, "failed to query group member")
+	}
+	return member, nil
+}
+
+// groupRole 群主以 GroupTB.UserID 为准，兼容还没有写入角色的老数据
+func groupRole(group *bizChat.GroupTB, member *bizChat.GroupMemberTB) uint16 {
+	if group.UserID == member.UserID {
+		return bizChat.GroupRoleOwner
+	}
+	if member.Role == bizChat.GroupRoleAdmin {
+		return bizChat.GroupRoleAdmin
+	}
+	return bizChat.GroupRoleMember
+}
+
+func isGroupManager(group *bizChat.GroupTB, member *bizChat.GroupMemberTB) bool {
+	return groupRole(group, member) != bizChat.GroupRoleMember
+}
+
+// loadOperator 查询当前登录用户在群里的身份
+func (gc *GroupUsecase) loadOperator(ctx context.Context, groupUuid string) (*bizChat.GroupTB, *bizChat.GroupMemberTB, error) {
+	group, err := getGroup(ctx, gc.gr, groupUuid)
+	if err != nil {
+		return nil, nil, err
+	}
+	userID := auth.FromContext(ctx).UserID
+	operator, err := getGroupMember(ctx, gc.gr, group, uint32(userID))
+	if err != nil {
+		return nil, nil, err
+	}
+	return group, operator, nil
+}
+
+// UpdateGroupInfo 修改群名称和公告，开启 OnlyAdminEdit 后只有群主和管理员可以修改
+// notice 为 nil 表示不修改，空字符串表示清空公告
+func (gc *GroupUsecase) UpdateGroupInfo(ctx context.Context, groupUuid string, name string, notice *string) (*bizChat.GroupTB, error) {
+	group, operator, err := gc.loadOperator(ctx, groupUuid)
+	if err != nil {
+		return nil, err
+	}
+	if group.OnlyAdminEdit != 0 && !isGroupManager(group, operator) {
+		return nil, NewErr(ErrCodeGroupPermission, GROUP_PERMISSION, "only group managers can edit group info")
+	}
+
+	updates := map[string]interface{}{}
+	if name != "" {
+		updates["name"] = name
+		group.Name = name
+	}
+	if notice != nil {
+		updates["notice"] = *notice
+		group.Notice = *notice
+	}
+	if len(updates) == 0 {
+		return group, nil
+	}
+	if err := gc.gr.UpdateGroup(ctx, group.ID, updates); err != nil {
+		return nil, NewErr(ErrCodeMessageFailed, MESSAGE_FAILED, "failed to update group")
+	}
+	return group, nil
+}
+
+// UpdateGroupSettings 全员禁言、修改群资料的权限和入群审批，只有群主和管理员可以设置
+// 只修改 patch 里传了的字段，并发修改不同开关时不会互相覆盖
+func (gc *GroupUsecase) UpdateGroupSettings(ctx context.Context, groupUuid string, patch *GroupSettingsPatch) (*bizChat.GroupTB, error) {
+	group, operator, err := gc.loadOperator(ctx, groupUuid)
+	if err != nil {
+		return nil, err
+	}
+	if !isGroupManager(group, operator) {
+		return nil, NewErr(ErrCodeGroupPermission, GROUP_PERMISSION, "only group managers can change group settings")
+	}
+
+	updates := make(map[string]interface{})
+	if patch.MuteAll != nil {
+		group.MuteAll = boolToUint16(*patch.MuteAll)
+		updates["mute_all"] = group.MuteAll
+	}
+	if patch.OnlyAdminEdit != nil {
+		group.OnlyAdminEdit = boolToUint16(*patch.OnlyAdminEdit)
+		updates["only_admin_edit"] = group.OnlyAdminEdit
+	}
+	if patch.JoinApproval != nil {
+		group.JoinApproval = boolToUint16(*patch.JoinApproval)
+		updates["join_approval"] = group.JoinApproval
+	}
+	if len(updates) == 0 {
+		return group, nil
+	}
+	if err := gc.gr.UpdateGroup(ctx, group.ID, updates); err != nil {
+		return nil, NewErr(ErrCodeMessageFailed, MESSAGE_FAILED, "failed to update group settings")
+	}
+	return group, nil
+}
+
+// SetGroupAdmin 设置或取消管理员，只有群主可以操作
+func (gc *GroupUsecase) SetGroupAdmin(ctx context.Context, groupUuid string, userID uint32, isAdmin bool) (*bizChat.GroupTB, *bizChat.GroupMemberTB, error) {
+	group, operator, err := gc.loadOperator(ctx, groupUuid)
+	if err != nil {
+		return nil, nil, err
+	}
+	if groupRole(group, operator) != bizChat.GroupRoleOwner {
+		return nil, nil, NewErr(ErrCodeGroupPermission, GROUP_PERMISSION, "only the group owner can set admins")
+	}
+	if userID == group.UserID {
+		return nil, nil, NewErr(ErrCodeInvalidParams, INVALID_PARAMS, "cannot change the role of the group owner")
+	}
+
+	member, err := getGroupMember(ctx, gc.gr, group, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	member.Role = bizChat.GroupRoleMember
+	if isAdmin {
+		member.Role = bizChat.GroupRoleAdmin
+	}
+	if err := gc.gr.UpdateGroupMember(ctx, member.ID, map[string]interface{}{"role": member.Role}); err != nil {
+		return nil, nil, NewErr(ErrCodeMessageFailed, MESSAGE_FAILED, "failed to update group member")
+	}
+	return group, member, nil
+}
+
+// MuteGroupMember 禁言到 muteUntil，forever 为永久禁言，两者都为空时解除禁言
+// 管理员只能禁言普通成员，群主可以禁言管理员
+func (gc *GroupUsecase) MuteGroupMember(ctx context.Context, groupUuid string, userID uint32, muteUntil *time.Time, forever bool) (*bizChat.GroupTB, *bizChat.GroupMemberTB, error) {
+	group, operator, err := gc.loadOperator(ctx, groupUuid)
+	if err != nil {
+		return nil, nil, err
+	}
+	member, err := getGroupMember(ctx, gc.gr, group, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if groupRole(group, operator) >= groupRole(group, member) {
+		return nil, nil, NewErr(ErrCodeGroupPermission, GROUP_PERMISSION, "no permission to mute this member")
+	}
+
+	if muteUntil != nil && !muteUntil.After(time.Now()) {
+		return nil, nil, NewErr(ErrCodeInvalidParams, INVALID_PARAMS, "mute_until must be in the future")
+	}
+
+	member.Mute = boolToUint16(forever)
+	member.MuteUntil = muteUntil
+	if forever {
+		member.MuteUntil = nil
+	}
+	err = gc.gr.UpdateGroupMember(ctx, member.ID, map[string]interface{}{
+		"mute":       member.Mute,
+		"mute_until": member.MuteUntil,
+	})
+	if err != nil {
+		return nil, nil, NewErr(ErrCodeMessageFailed, MESSAGE_FAILED, "failed to update group member")
+	}
+	return group, member, nil
+}
+
+func boolToUint16(b bool) uint16 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,262 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	bizChat "kratos-realworld/internal/biz/messageGroup"
+	"kratos-realworld/internal/common"
+	"kratos-realworld/internal/pkg/middleware/auth"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// 邀请链接直接指向入群接口，二维码里放 app 内的跳转协议
+	GroupInviteLinkPrefix = "/api/groups/join?token="
+	GroupInviteQrPrefix   = "conduit://group/join?token="
+)
+
+// CreateInvite 生成邀请token，expireSeconds 为 0 表示永不过期，maxUses 为 0 表示不限次数
+func (gc *GroupUsecase) CreateInvite(ctx context.Context, groupUuid string, expireSeconds int64, maxUses uint32) (*GroupInviteReply, error) {
+	group, operator, err := gc.loadOperator(ctx, groupUuid)
+	if err != nil {
+		return nil, err
+	}
+	if !isGroupManager(group, operator) {
+		return nil, NewErr(ErrCodeGroupPermission, GROUP_PERMISSION, "only group managers can create invites")
+	}
+	if expireSeconds < 0 {
+		return nil, NewErr(ErrCodeInvalidParams, INVALID_PARAMS, "expire_seconds must not be negative")
+	}
+
+	token, err := newRandomToken()
+	if err != nil {
+		return nil, NewErr(ErrCodeInternalServer, INTERNAL_SERVER, "failed to generate invite token")
+	}
+	invite := &bizChat.GroupInviteTB{
+		GroupID:   group.ID,
+		Token:     token,
+		CreatorID: operator.UserID,
+		MaxUses:   maxUses,
+	}
+	if expireSeconds > 0 {
+		expireAt := time.Now().Add(time.Duration(expireSeconds) * time.Second)
+		invite.ExpireAt = &expireAt
+	}
+	if err := gc.gr.CreateInvite(ctx, invite); err != nil {
+		return nil, NewErr(ErrCodeMessageFailed, MESSAGE_FAILED, "failed to create invite")
+	}
+
+	return &GroupInviteReply{
+		Group:     group,
+		Invite:    invite,
+		Link:      GroupInviteLinkPrefix + token,
+		QrPayload: GroupInviteQrPrefix + token,
+	}, nil
+}
+
+// RevokeInvite 撤销邀请，撤销后token立即失效
+func (gc *GroupUsecase) RevokeInvite(ctx context.Context, groupUuid string, token string) error {
+	group, operator, err := gc.loadOperator(ctx, groupUuid)
+	if err != nil {
+		return err
+	}
+	if !isGroupManager(group, operator) {
+		return NewErr(ErrCodeGroupPermission, GROUP_PERMISSION, "only group managers can revoke invites")
+	}
+
+	invite, err := gc.gr.GetInviteByToken(ctx, token)
+	if err != nil || invite.GroupID != group.ID {
+		return NewErr(ErrCodeInviteInvalid, INVITE_INVALID, "invite not found")
+	}
+	if err := gc.gr.RevokeInvite(ctx, invite.ID); err != nil {
+		return NewErr(ErrCodeMessageFailed, MESSAGE_FAILED, "failed to revoke invite")
+	}
+	return nil
+}
+
+// JoinGroup 通过邀请token入群，需要审批的群只提交申请
+func (gc *GroupUsecase) JoinGroup(ctx context.Context, token string, message string) (*JoinGroupReply, error) {
+	userID := uint32(auth.FromContext(ctx).UserID)
+
+	invite, err := gc.gr.GetInviteByToken(ctx, token)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, NewErr(ErrCodeInviteInvalid, INVITE_INVALID, "invite not found")
+		}
+		return nil, NewErr(ErrCodeDBQueryFailed, DB_QUERY_FAILED, "failed to query invite")
+	}
+	if !invite.Usable(time.Now()) {
+		return nil, NewErr(ErrCodeInviteInvalid, INVITE_INVALID, "invite has expired or been revoked")
+	}
+
+	group, err := gc.gr.GetGroupByID(ctx, invite.GroupID)
+	if err != nil {
+		return nil, NewErr(ErrCodeGroupNotFound, GROUP_NOT_FOUND, "group not found")
+	}
+	if _, err := gc.gr.GetGroupMember(ctx, group.ID, userID); err == nil {
+		return nil, NewErr(ErrCodeAlreadyGroupMember, ALREADY_GROUP_MEMBER, "you are already a member of this group")
+	}
+
+	if group.JoinApproval != 0 {
+		// 重复申请直接返回之前的申请
+		if pending, err := gc.gr.GetPendingJoinRequest(ctx, group.ID, userID); err == nil {
+			return &JoinGroupReply{Group: group, Request: pending}, nil
+		}
+
+		req := &bizChat.GroupJoinRequestTB{
+			GroupID:  group.ID,
+			UserID:   userID,
+			InviteID: invite.ID,
+			Message:  message,
+			Status:   bizChat.JoinRequestPending,
+		}
+		// 先插入申请再占用邀请次数，并发重复申请时由唯一索引拦住，不会多占次数
+		err = gc.tx.InTx(ctx, func(ctx context.Context) error {
+			err := gc.gr.CreateJoinRequest(ctx, req)
+			if errors.Is(err, gorm.ErrDuplicatedKey) {
+				return err
+			}
+			if err != nil {
+				return NewErr(ErrCodeMessageFailed, MESSAGE_FAILED, "failed to create join request")
+			}
+			return gc.useInvite(ctx, invite)
+		})
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			pending, err := gc.gr.GetPendingJoinRequest(ctx, group.ID, userID)
+			if err != nil {
+				return nil, NewErr(ErrCodeDBQueryFailed, DB_QUERY_FAILED, "failed to query join request")
+			}
+			return &JoinGroupReply{Group: group, Request: pending}, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		return &JoinGroupReply{Group: group, Request: req}, nil
+	}
+
+	// 占用邀请次数和加入群在同一个事务里，并发重复加入时由唯一索引拦住，占用的次数一起回滚
+	err = gc.tx.InTx(ctx, func(ctx context.Context) error {
+		if err := gc.useInvite(ctx, invite); err != nil {
+			return err
+		}
+		err := gc.gr.AddGroupMember(ctx, &bizChat.GroupMemberTB{
+			GroupID: group.ID,
+			UserID:  userID,
+			Role:    bizChat.GroupRoleMember,
+		})
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return NewErr(ErrCodeAlreadyGroupMember, ALREADY_GROUP_MEMBER, "you are already a member of this group")
+		}
+		if err != nil {
+			return NewErr(ErrCodeMessageFailed, MESSAGE_FAILED, "failed to add group member")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &JoinGroupReply{Group: group, Joined: true}, nil
+}
+
+func (gc *GroupUsecase) useInvite(ctx context.Context, invite *bizChat.GroupInviteTB) error {
+	ok, err := gc.gr.UseInvite(ctx, invite.ID)
+	if err != nil {
+		return NewErr(ErrCodeMessageFailed, MESSAGE_FAILED, "failed to use invite")
+	}
+	if !ok {
+		return NewErr(ErrCodeInviteInvalid, INVITE_INVALID, "invite has expired or reached its max uses")
+	}
+	return nil
+}
+
+// ListJoinRequests 待审批的入群申请，只有群主和管理员可以查看
+func (gc *GroupUsecase) ListJoinRequests(ctx context.Context, groupUuid string) (*bizChat.GroupTB, []*bizChat.GroupJoinRequestTB, error) {
+	group, operator, err := gc.loadOperator(ctx, groupUuid)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !isGroupManager(group, operator) {
+		return nil, nil, NewErr(ErrCodeGroupPermission, GROUP_PERMISSION, "only group managers can view join requests")
+	}
+
+	reqs, err := gc.gr.ListJoinRequests(ctx, group.ID, bizChat.JoinRequestPending)
+	if err != nil {
+		return nil, nil, NewErr(ErrCodeDBQueryFailed, DB_QUERY_FAILED, "failed to query join requests")
+	}
+	return group, reqs, nil
+}
+
+// HandleJoinRequest 审批入群申请，处理完通过 websocket 通知申请人
+func (gc *GroupUsecase) HandleJoinRequest(ctx context.Context, groupUuid string, requestID uint32, approve bool) (*bizChat.GroupTB, *bizChat.GroupJoinRequestTB, error) {
+	group, operator, err := gc.loadOperator(ctx, groupUuid)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !isGroupManager(group, operator) {
+		return nil, nil, NewErr(ErrCodeGroupPermission, GROUP_PERMISSION, "only group managers can handle join requests")
+	}
+
+	req, err := gc.gr.GetJoinRequest(ctx, requestID)
+	if err != nil || req.GroupID != group.ID {
+		return nil, nil, NewErr(ErrCodeJoinRequestNotFound, JOIN_REQUEST_NOT_FOUND, "join request not found")
+	}
+
+	status := bizChat.JoinRequestRejected
+	if approve {
+		status = bizChat.JoinRequestApproved
+	}
+	err = gc.tx.InTx(ctx, func(ctx context.Context) error {
+		ok, err := gc.gr.HandleJoinRequest(ctx, req.ID, status, operator.UserID)
+		if err != nil {
+			return NewErr(ErrCodeMessageFailed, MESSAGE_FAILED, "failed to update join request")
+		}
+		if !ok {
+			return NewErr(ErrCodeJoinRequestHandled, JOIN_REQUEST_HANDLED, "join request has already been handled")
+		}
+		if !approve {
+			// 被拒绝的申请归还占用的邀请次数
+			if req.InviteID == 0 {
+				return nil
+			}
+			if err := gc.gr.ReleaseInvite(ctx, req.InviteID); err != nil {
+				return NewErr(ErrCodeMessageFailed, MESSAGE_FAILED, "failed to release invite")
+			}
+			return nil
+		}
+		if _, err := gc.gr.GetGroupMember(ctx, group.ID, req.UserID); err == nil {
+			return nil
+		}
+		err = gc.gr.AddGroupMember(ctx, &bizChat.GroupMemberTB{
+			GroupID: group.ID,
+			UserID:  req.UserID,
+			Role:    bizChat.GroupRoleMember,
+		})
+		// 审批期间申请人已经通过别的邀请入群，申请照常标记为通过
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil
+		}
+		if err != nil {
+			return NewErr(ErrCodeMessageFailed, MESSAGE_FAILED, "failed to add group member")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Status = status
+	req.HandlerID = operator.UserID
+
+	err = pushSystemNotify(strconv.Itoa(int(req.UserID)), common.GROUP_JOIN_RESULT, map[string]interface{}{
+		"groupId":   group.Uuid,
+		"groupName": group.Name,
+		"requestId": req.ID,
+		"approved":  approve,
+	})
+	if err != nil {
+		gc.log.Errorf("push join result to %d error: %v", req.UserID, err)
+	}
+	return group, req, nil
+}
@@ -0,0 +1,223 @@
+package biz
+
+import (
+	"context"
+	bizChat "kratos-realworld/internal/biz/messageGroup"
+	"kratos-realworld/internal/pkg/middleware/auth"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+// fakeTx 直接执行，不模拟回滚
+type fakeTx struct{}
+
+func (fakeTx) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func userCtx(userID uint32) context.Context {
+	return auth.WithContext(context.Background(), &auth.CurrentUser{UserID: uint(userID)})
+}
+
+type fakeGroupRepo struct {
+	bizChat.GroupRepo
+	group    *bizChat.GroupTB
+	members  map[uint32]*bizChat.GroupMemberTB
+	invite   *bizChat.GroupInviteTB
+	requests []*bizChat.GroupJoinRequestTB
+	// 前几次查询待审批申请时查不到，模拟两个请求同时通过了重复申请的检查
+	stalePendingReads int
+	// 下一次查询这个用户的成员记录时查不到，模拟两个请求同时通过了是否已经入群的检查
+	staleMember uint32
+	// 最近一次 UpdateGroup 写入的字段
+	updates map[string]interface{}
+}
+
+func (r *fakeGroupRepo) GetGroupByUuid(ctx context.Context, uuid string) (*bizChat.GroupTB, error) {
+	return r.group, nil
+}
+
+func (r *fakeGroupRepo) GetGroupByID(ctx context.Context, id uint32) (*bizChat.GroupTB, error) {
+	return r.group, nil
+}
+
+func (r *fakeGroupRepo) UpdateGroup(ctx context.Context, groupID uint32, updates map[string]interface{}) error {
+	r.updates = updates
+	return nil
+}
+
+func (r *fakeGroupRepo) GetGroupMember(ctx context.Context, groupID uint32, userID uint32) (*bizChat.GroupMemberTB, error) {
+	if r.staleMember != 0 && r.staleMember == userID {
+		r.staleMember = 0
+		return nil, gorm.ErrRecordNotFound
+	}
+	if m, ok := r.members[userID]; ok {
+		return m, nil
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+// AddGroupMember 和 (group_id, user_id) 唯一索引一样，重复插入返回 gorm.ErrDuplicatedKey
+func (r *fakeGroupRepo) AddGroupMember(ctx context.Context, member *bizChat.GroupMemberTB) error {
+	if _, ok := r.members[member.UserID]; ok {
+		return gorm.ErrDuplicatedKey
+	}
+	r.members[member.UserID] = member
+	return nil
+}
+
+func (r *fakeGroupRepo) GetInviteByToken(ctx context.Context, token string) (*bizChat.GroupInviteTB, error) {
+	return r.invite, nil
+}
+
+func (r *fakeGroupRepo) UseInvite(ctx context.Context, inviteID uint32) (bool, error) {
+	if r.invite.MaxUses != 0 && r.invite.UsedCount >= r.invite.MaxUses {
+		return false, nil
+	}
+	r.invite.UsedCount++
+	return true, nil
+}
+
+func (r *fakeGroupRepo) ReleaseInvite(ctx context.Context, inviteID uint32) error {
+	if r.invite.UsedCount > 0 {
+		r.invite.UsedCount--
+	}
+	return nil
+}
+
+func (r *fakeGroupRepo) CreateJoinRequest(ctx context.Context, req *bizChat.GroupJoinRequestTB) error {
+	for _, existing := range r.requests {
+		if existing.UserID == req.UserID && existing.Status == bizChat.JoinRequestPending {
+			return gorm.ErrDuplicatedKey
+		}
+	}
+	req.ID = uint32(len(r.requests) + 1)
+	r.requests = append(r.requests, req)
+	return nil
+}
+
+func (r *fakeGroupRepo) GetPendingJoinRequest(ctx context.Context, groupID uint32, userID uint32) (*bizChat.GroupJoinRequestTB, error) {
+	if r.stalePendingReads > 0 {
+		r.stalePendingReads--
+		return nil, gorm.ErrRecordNotFound
+	}
+	for _, req := range r.requests {
+		if req.UserID == userID && req.Status == bizChat.JoinRequestPending {
+			return req, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (r *fakeGroupRepo) GetJoinRequest(ctx context.Context, id uint32) (*bizChat.GroupJoinRequestTB, error) {
+	for _, req := range r.requests {
+		if req.ID == id {
+			return req, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (r *fakeGroupRepo) HandleJoinRequest(ctx context.Context, id uint32, status uint16, handlerID uint32) (bool, error) {
+	req, err := r.GetJoinRequest(ctx, id)
+	if err != nil || req.Status != bizChat.JoinRequestPending {
+		return false, nil
+	}
+	req.Status = status
+	req.HandlerID = handlerID
+	return true, nil
+}
+
+func newApprovalGroupRepo() *fakeGroupRepo {
+	return &fakeGroupRepo{
+		group:   &bizChat.GroupTB{ID: 1, UserID: 1, Uuid: "g1", JoinApproval: 1},
+		members: map[uint32]*bizChat.GroupMemberTB{1: {UserID: 1, GroupID: 1, Role: bizChat.GroupRoleOwner}},
+		invite:  &bizChat.GroupInviteTB{ID: 7, GroupID: 1, MaxUses: 2},
+	}
+}
+
+func TestJoinGroupDuplicateRequest(t *testing.T) {
+	gr := newApprovalGroupRepo()
+	gc := NewGroupUsecase(gr, fakeTx{}, log.DefaultLogger)
+
+	first, err := gc.JoinGroup(userCtx(2), "token", "hi")
+	assert.NoError(t, err)
+	assert.False(t, first.Joined)
+
+	// 第二个请求没有看到第一个申请，插入时被唯一索引拦住，返回已有的申请
+	gr.stalePendingReads = 1
+	second, err := gc.JoinGroup(userCtx(2), "token", "hi again")
+	assert.NoError(t, err)
+	assert.Equal(t, first.Request.ID, second.Request.ID)
+	assert.Len(t, gr.requests, 1)
+	assert.Equal(t, uint32(1), gr.invite.UsedCount)
+}
+
+func TestHandleJoinRequest(t *testing.T) {
+	gr := newApprovalGroupRepo()
+	gc := NewGroupUsecase(gr, fakeTx{}, log.DefaultLogger)
+
+	rejected, err := gc.JoinGroup(userCtx(2), "token", "")
+	assert.NoError(t, err)
+	approved, err := gc.JoinGroup(userCtx(3), "token", "")
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(2), gr.invite.UsedCount)
+
+	// 普通成员不能审批
+	gr.members[4] = &bizChat.GroupMemberTB{UserID: 4, GroupID: 1, Role: bizChat.GroupRoleMember}
+	_, _, err = gc.HandleJoinRequest(userCtx(4), "g1", approved.Request.ID, true)
+	assert.Equal(t, GROUP_PERMISSION, errors.FromError(err).Reason)
+
+	// 拒绝归还邀请次数，不加入群
+	_, req, err := gc.HandleJoinRequest(userCtx(1), "g1", rejected.Request.ID, false)
+	assert.NoError(t, err)
+	assert.Equal(t, bizChat.JoinRequestRejected, req.Status)
+	assert.Equal(t, uint32(1), gr.invite.UsedCount)
+	assert.NotContains(t, gr.members, uint32(2))
+
+	_, req, err = gc.HandleJoinRequest(userCtx(1), "g1", approved.Request.ID, true)
+	assert.NoError(t, err)
+	assert.Equal(t, bizChat.JoinRequestApproved, req.Status)
+	assert.Contains(t, gr.members, uint32(3))
+
+	// 已经处理过的申请不能再处理
+	_, _, err = gc.HandleJoinRequest(userCtx(1), "g1", approved.Request.ID, false)
+	assert.Equal(t, JOIN_REQUEST_HANDLED, errors.FromError(err).Reason)
+	assert.Equal(t, uint32(1), gr.invite.UsedCount)
+}
+
+func TestJoinGroupConcurrentJoin(t *testing.T) {
+	gr := newApprovalGroupRepo()
+	gr.group.JoinApproval = 0
+	gc := NewGroupUsecase(gr, fakeTx{}, log.DefaultLogger)
+
+	first, err := gc.JoinGroup(userCtx(2), "token", "")
+	assert.NoError(t, err)
+	assert.True(t, first.Joined)
+
+	// 第二个请求没有看到第一次入群，插入时被唯一索引拦住，事务回滚占用的邀请次数
+	gr.staleMember = 2
+	_, err = gc.JoinGroup(userCtx(2), "token", "")
+	assert.Equal(t, ALREADY_GROUP_MEMBER, errors.FromError(err).Reason)
+	assert.Len(t, gr.members, 2)
+}
+
+func TestHandleJoinRequestAlreadyMember(t *testing.T) {
+	gr := newApprovalGroupRepo()
+	gc := NewGroupUsecase(gr, fakeTx{}, log.DefaultLogger)
+
+	reply, err := gc.JoinGroup(userCtx(2), "token", "")
+	assert.NoError(t, err)
+
+	// 审批期间申请人已经入群，审批时的检查没有看到
+	gr.members[2] = &bizChat.GroupMemberTB{UserID: 2, GroupID: 1, Role: bizChat.GroupRoleMember}
+	gr.staleMember = 2
+	_, req, err := gc.HandleJoinRequest(userCtx(1), "g1", reply.Request.ID, true)
+	assert.NoError(t, err)
+	assert.Equal(t, bizChat.JoinRequestApproved, req.Status)
+	assert.Len(t, gr.members, 2)
+}
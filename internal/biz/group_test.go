@@ -0,0 +1,28 @@
+package biz
+
+import (
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+)
+
+// 只写传了的开关，没传的保持原值
+func TestUpdateGroupSettingsPatch(t *testing.T) {
+	gr := newApprovalGroupRepo()
+	gr.group.MuteAll = 1
+	gc := NewGroupUsecase(gr, fakeTx{}, log.DefaultLogger)
+
+	no := false
+	group, err := gc.UpdateGroupSettings(userCtx(1), "g1", &GroupSettingsPatch{JoinApproval: &no})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"join_approval": uint16(0)}, gr.updates)
+	assert.Equal(t, uint16(1), group.MuteAll)
+	assert.Equal(t, uint16(0), group.JoinApproval)
+
+	// 没传任何字段不写库
+	gr.updates = nil
+	_, err = gc.UpdateGroupSettings(userCtx(1), "g1", &GroupSettingsPatch{})
+	assert.NoError(t, err)
+	assert.Nil(t, gr.updates)
+}
@@ -0,0 +1,88 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	bizUser "kratos-realworld/internal/biz/user"
+	"kratos-realworld/internal/pkg/middleware/auth"
+	"regexp"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// 一段时间内最多修改几次@用户名，防止频繁改名冒充别人
+	maxHandleChanges   = 2
+	handleChangeWindow = 30 * 24 * time.Hour
+)
+
+var (
+	handlePattern = regexp.MustCompile(`^[a-z][a-z0-9_]{3,19}$`)
+	// u+数字 留给注册时自动分配的默认用户名
+	reservedHandlePattern = regexp.MustCompile(`^u[0-9]+$`)
+)
+
+// normalizeHandle 用户名不区分大小写，统一存小写，允许带@前缀
+func normalizeHandle(handle string) string {
+	return strings.ToLower(strings.TrimPrefix(strings.TrimSpace(handle), "@"))
+}
+
+func defaultHandle(userID uint32) string {
+	return fmt.Sprintf("u%d", userID)
+}
+
+// ChangeHandle 修改当前用户的@用户名，返回规范化之后的用户名
+func (pc *ProfileUsecase) ChangeHandle(ctx context.Context, handle string) (string, error) {
+	userID := uint32(auth.FromContext(ctx).UserID)
+
+	handle = normalizeHandle(handle)
+	if !handlePattern.MatchString(handle) {
+		return "", NewErr(ErrCodeInvalidHandle, INVALID_HANDLE,
+			"handle must be 4-20 characters of lowercase letters, digits or underscores, starting with a letter")
+	}
+	if reservedHandlePattern.MatchString(handle) {
+		return "", NewErr(ErrCodeInvalidHandle, INVALID_HANDLE, "handle is reserved")
+	}
+
+	user, err := pc.ur.GetUserByUserID(ctx, userID)
+	if err != nil {
+		return "", NewErr(ErrCodeDBQueryFailed, DB_QUERY_FAILED, "failed to query user")
+	}
+	if user.Handle == handle {
+		return handle, nil
+	}
+
+	err = pc.tx.InTx(ctx, func(ctx context.Context) error {
+		cnt, err := pc.ur.CountHandleChanges(ctx, userID, time.Now().Add(-handleChangeWindow))
+		if err != nil {
+			return NewErr(ErrCodeDBQueryFailed, DB_QUERY_FAILED, "failed to query handle history")
+		}
+		if cnt >= maxHandleChanges {
+			return NewErr(ErrCodeHandleChangeLimited, HANDLE_CHANGE_LIMITED,
+				fmt.Sprintf("handle can be changed at most %d times in %d days", maxHandleChanges, int(handleChangeWindow.Hours()/24)))
+		}
+
+		if err := pc.ur.UpdateHandle(ctx, userID, handle); err != nil {
+			if errors.Is(err, gorm.ErrDuplicatedKey) {
+				return NewErr(ErrCodeHandleTaken, HANDLE_TAKEN, "handle is already taken")
+			}
+			return NewErr(ErrCodeUpdateUserInfoFailed, UPDATE_USER_INFO_FAILED, "failed to update handle")
+		}
+		history := &bizUser.HandleHistoryTB{
+			UserID:    userID,
+			OldHandle: user.Handle,
+			NewHandle: handle,
+		}
+		if err := pc.ur.CreateHandleHistory(ctx, history); err != nil {
+			return NewErr(ErrCodeUpdateUserInfoFailed, UPDATE_USER_INFO_FAILED, "failed to save handle history")
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return handle, nil
+}
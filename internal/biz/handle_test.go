@@ -0,0 +1,133 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+
+	bizUser "kratos-realworld/internal/biz/user"
+)
+
+// fakeHandleRepo 用户名有唯一索引，被别人占用时和 mysql 一样返回 gorm.ErrDuplicatedKey
+type fakeHandleRepo struct {
+	bizUser.UserRepo
+	users   map[uint32]*bizUser.UserTB
+	history []*bizUser.HandleHistoryTB
+}
+
+func newFakeHandleRepo(users ...*bizUser.UserTB) *fakeHandleRepo {
+	r := &fakeHandleRepo{users: make(map[uint32]*bizUser.UserTB)}
+	for _, u := range users {
+		r.users[u.ID] = u
+	}
+	return r
+}
+
+func (r *fakeHandleRepo) GetUserByUserID(ctx context.Context, userID uint32) (*bizUser.UserTB, error) {
+	u, ok := r.users[userID]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	cp := *u
+	return &cp, nil
+}
+
+func (r *fakeHandleRepo) UpdateHandle(ctx context.Context, userID uint32, handle string) error {
+	for id, u := range r.users {
+		if id != userID && u.Handle == handle {
+			return gorm.ErrDuplicatedKey
+		}
+	}
+	r.users[userID].Handle = handle
+	return nil
+}
+
+func (r *fakeHandleRepo) CreateHandleHistory(ctx context.Context, history *bizUser.HandleHistoryTB) error {
+	now := time.Now()
+	history.SysCreated = &now
+	r.history = append(r.history, history)
+	return nil
+}
+
+func (r *fakeHandleRepo) CountHandleChanges(ctx context.Context, userID uint32, since time.Time) (int64, error) {
+	var cnt int64
+	for _, h := range r.history {
+		if h.UserID == userID && !h.SysCreated.Before(since) {
+			cnt++
+		}
+	}
+	return cnt, nil
+}
+
+func newHandleUsecase(ur bizUser.UserRepo) *ProfileUsecase {
+	return NewProfileUsecase(nil, ur, fakeTx{}, nil, nil, nil, nil, log.DefaultLogger)
+}
+
+func TestChangeHandleNormalizesAndRecordsHistory(t *testing.T) {
+	ur := newFakeHandleRepo(&bizUser.UserTB{ID: 1, Handle: "u1"})
+	pc := newHandleUsecase(ur)
+
+	handle, err := pc.ChangeHandle(userCtx(1), " @Alice_01 ")
+	assert.NoError(t, err)
+	assert.Equal(t, "alice_01", handle)
+	assert.Equal(t, "alice_01", ur.users[1].Handle)
+	if assert.Len(t, ur.history, 1) {
+		assert.Equal(t, "u1", ur.history[0].OldHandle)
+		assert.Equal(t, "alice_01", ur.history[0].NewHandle)
+	}
+
+	// 改成当前的用户名不算一次修改
+	_, err = pc.ChangeHandle(userCtx(1), "ALICE_01")
+	assert.NoError(t, err)
+	assert.Len(t, ur.history, 1)
+}
+
+func TestChangeHandleTaken(t *testing.T) {
+	ur := newFakeHandleRepo(
+		&bizUser.UserTB{ID: 1, Handle: "u1"},
+		&bizUser.UserTB{ID: 2, Handle: "alice"},
+	)
+	pc := newHandleUsecase(ur)
+
+	_, err := pc.ChangeHandle(userCtx(1), "Alice")
+	assert.Equal(t, HANDLE_TAKEN, errors.FromError(err).Reason)
+	assert.Equal(t, "u1", ur.users[1].Handle)
+	assert.Empty(t, ur.history)
+}
+
+func TestChangeHandleRejectsInvalidAndReserved(t *testing.T) {
+	ur := newFakeHandleRepo(&bizUser.UserTB{ID: 1, Handle: "u1"})
+	pc := newHandleUsecase(ur)
+
+	for _, handle := range []string{"abc", "1alice", "al-ice", "a23456789012345678901", "u42"} {
+		_, err := pc.ChangeHandle(userCtx(1), handle)
+		assert.Equal(t, INVALID_HANDLE, errors.FromError(err).Reason, handle)
+	}
+	assert.Equal(t, "u1", ur.users[1].Handle)
+}
+
+func TestChangeHandleLimited(t *testing.T) {
+	ur := newFakeHandleRepo(&bizUser.UserTB{ID: 1, Handle: "u1"})
+	pc := newHandleUsecase(ur)
+
+	for _, handle := range []string{"alice", "bob_1"} {
+		_, err := pc.ChangeHandle(userCtx(1), handle)
+		assert.NoError(t, err)
+	}
+	_, err := pc.ChangeHandle(userCtx(1), "carol")
+	assert.Equal(t, HANDLE_CHANGE_LIMITED, errors.FromError(err).Reason)
+	assert.Equal(t, "bob_1", ur.users[1].Handle)
+
+	// 超过时间窗口的修改不再计数
+	for _, h := range ur.history {
+		old := time.Now().Add(-handleChangeWindow - time.Hour)
+		h.SysCreated = &old
+	}
+	_, err = pc.ChangeHandle(userCtx(1), "carol")
+	assert.NoError(t, err)
+}
@@ -0,0 +1,151 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	bizMoment "kratos-realworld/internal/biz/moments"
+	"kratos-realworld/internal/common"
+	kafka "kratos-realworld/internal/kafka"
+	"kratos-realworld/internal/pkg/middleware/auth"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	flushMomentLikesLockKey = "job:lock:flushMomentLikes"
+
+	engagementLike = 1
+)
+
+// LikeMoment 看不到的动态不能点赞，重复点赞不报错
+func (uc *MomentUsecase) LikeMoment(ctx context.Context, momentID uint32) (*MomentLikeReply, error) {
+	userID := uint32(auth.FromContext(ctx).UserID)
+	moment, err := uc.GetMoment(ctx, userID, momentID)
+	if err != nil {
+		return nil, err
+	}
+
+	like := &bizMoment.MomentLikeTB{MomentID: momentID, UserID: userID}
+	created, err := uc.mr.CreateLike(ctx, like)
+	if err != nil {
+		uc.log.Errorf("CreateLike error: %v", err)
+		return nil, NewErr(ErrCodeMomentFailed, MOMENT_FAILED, "Like moment failed")
+	}
+	if created {
+		if err := publishCounterEvent(kafka.EVENT_LIKE_ADDED, moment.UserID, userID, momentID, like.ID); err != nil {
+			uc.log.Errorf("publish counter event error: %v", err)
+		}
+		uc.bumpSquare(ctx, momentID, engagementLike)
+		if moment.UserID != userID {
+			err := pushSystemNotify(strconv.Itoa(int(moment.UserID)), common.MOMENT_LIKED, map[string]interface{}{
+				"momentId": momentID,
+				"userId":   userID,
+			})
+			if err != nil {
+				uc.log.Errorf("push moment liked to %d error: %v", moment.UserID, err)
+			}
+		}
+	}
+	return uc.likeReply(ctx, momentID, true)
+}
+
+// UnlikeMoment 没点过赞的不报错
+func (uc *MomentUsecase) UnlikeMoment(ctx context.Context, momentID uint32) (*MomentLikeReply, error) {
+	userID := uint32(auth.FromContext(ctx).UserID)
+	moment, err := uc.getMoment(ctx, momentID)
+	if err != nil {
+		return nil, err
+	}
+
+	like, err := uc.mr.DeleteLike(ctx, momentID, userID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		uc.log.Errorf("DeleteLike error: %v", err)
+		return nil, NewErr(ErrCodeMomentFailed, MOMENT_FAILED, "Unlike moment failed")
+	}
+	if like != nil {
+		if err := publishCounterEvent(kafka.EVENT_LIKE_REMOVED, moment.UserID, userID, momentID, like.ID); err != nil {
+			uc.log.Errorf("publish counter event error: %v", err)
+		}
+		uc.bumpSquare(ctx, momentID, -engagementLike)
+	}
+	return uc.likeReply(ctx, momentID, false)
+}
+
+func (uc *MomentUsecase) likeReply(ctx context.Context, momentID uint32, liked bool) (*MomentLikeReply, error) {
+	counts, err := uc.mr.GetLikeCounts(ctx, []uint32{momentID})
+	if err != nil {
+		uc.log.Errorf("GetLikeCounts error: %v", err)
+		return nil, NewErr(ErrCodeMomentFailed, MOMENT_FAILED, "Get like count failed")
+	}
+	return &MomentLikeReply{LikeCount: counts[momentID], Liked: liked}, nil
+}
+
+// GetMomentDetail 动态详情，点赞数取 redis 里的实时计数，评论只带第一页
+func (uc *MomentUsecase) GetMomentDetail(ctx context.Context, momentID uint32) (*MomentDetailReply, error) {
+	viewerID := uint32(auth.FromContext(ctx).UserID)
+	moment, err := uc.GetMoment(ctx, viewerID, momentID)
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := uc.mr.GetLikeCounts(ctx, []uint32{momentID})
+	if err != nil {
+		uc.log.Errorf("GetLikeCounts error: %v", err)
+		return nil, NewErr(ErrCodeMomentFailed, MOMENT_FAILED, "Get like count failed")
+	}
+	moment.LikeCount = int(counts[momentID])
+
+	liked, err := uc.mr.HasLiked(ctx, momentID, viewerID)
+	if err != nil {
+		uc.log.Errorf("HasLiked error: %v", err)
+		return nil, NewErr(ErrCodeMomentFailed, MOMENT_FAILED, "Get like status failed")
+	}
+
+	comments, err := uc.listComments(ctx, moment, viewerID, 0, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	return &MomentDetailReply{Moment: moment, Liked: liked, Comments: comments}, nil
+}
+
+// FlushMomentLikes 把 redis 里有变化的点赞数分批刷回 mysql，返回刷新的动态数
+// 没抢到锁说明别的实例正在执行，返回 false
+func (uc *MomentUsecase) FlushMomentLikes(ctx context.Context, batchSize int, lockTTL time.Duration) (int, bool, error) {
+	token, ok, err := uc.lk.TryLock(ctx, flushMomentLikesLockKey, lockTTL)
+	if err != nil || !ok {
+		return 0, false, err
+	}
+	defer func() {
+		if err := uc.lk.Unlock(context.Background(), flushMomentLikesLockKey, token); err != nil {
+			uc.log.Warnf("failed to release lock %s: %v", flushMomentLikesLockKey, err)
+		}
+	}()
+
+	flushed := 0
+	for {
+		ids, err := uc.mr.PopPendingLikeCounts(ctx, batchSize)
+		if err != nil {
+			return flushed, true, err
+		}
+		if len(ids) == 0 {
+			return flushed, true, nil
+		}
+
+		counts, err := uc.mr.GetLikeCounts(ctx, ids)
+		if err == nil {
+			err = uc.mr.UpdateLikeCounts(ctx, counts)
+		}
+		if err != nil {
+			if err := uc.mr.AddPendingLikeCounts(ctx, ids); err != nil {
+				uc.log.Errorf("requeue pending like counts error: %v", err)
+			}
+			return flushed, true, err
+		}
+		flushed += len(ids)
+		if len(ids) < batchSize {
+			return flushed, true, nil
+		}
+	}
+}
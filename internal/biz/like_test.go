@@ -0,0 +1,136 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+
+	bizMoment "kratos-realworld/internal/biz/moments"
+)
+
+// CreateLike 和数据层一样，唯一索引冲突时返回 false 而不是错误
+func (r *fakeMomentStore) CreateLike(ctx context.Context, like *bizMoment.MomentLikeTB) (bool, error) {
+	key := [2]uint32{like.MomentID, like.UserID}
+	if _, ok := r.likes[key]; ok {
+		return false, nil
+	}
+	like.ID = r.id()
+	r.likes[key] = like.ID
+	return true, nil
+}
+
+func (r *fakeMomentStore) DeleteLike(ctx context.Context, momentID uint32, userID uint32) (*bizMoment.MomentLikeTB, error) {
+	key := [2]uint32{momentID, userID}
+	id, ok := r.likes[key]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	delete(r.likes, key)
+	return &bizMoment.MomentLikeTB{ID: id, MomentID: momentID, UserID: userID}, nil
+}
+
+func (r *fakeMomentStore) GetLikeCounts(ctx context.Context, momentIDs []uint32) (map[uint32]int64, error) {
+	counts := make(map[uint32]int64, len(momentIDs))
+	for key := range r.likes {
+		if inIDs(momentIDs, key[0]) {
+			counts[key[0]]++
+		}
+	}
+	return counts, nil
+}
+
+// fakeLikeFlushRepo 待刷回的动态ID按顺序弹出，updateErr 不为空时刷回失败
+type fakeLikeFlushRepo struct {
+	*fakeMomentStore
+	pending   []uint32
+	updated   map[uint32]int64
+	updateErr error
+}
+
+func (r *fakeLikeFlushRepo) PopPendingLikeCounts(ctx context.Context, limit int) ([]uint32, error) {
+	n := min(limit, len(r.pending))
+	ids := r.pending[:n]
+	r.pending = r.pending[n:]
+	return ids, nil
+}
+
+func (r *fakeLikeFlushRepo) AddPendingLikeCounts(ctx context.Context, momentIDs []uint32) error {
+	r.pending = append(r.pending, momentIDs...)
+	return nil
+}
+
+func (r *fakeLikeFlushRepo) UpdateLikeCounts(ctx context.Context, counts map[uint32]int64) error {
+	if r.updateErr != nil {
+		return r.updateErr
+	}
+	for id, cnt := range counts {
+		r.updated[id] = cnt
+	}
+	return nil
+}
+
+func TestLikeMomentTwiceDoesNotError(t *testing.T) {
+	mr := newFakeMomentStore()
+	uc := newTestMomentUsecase(mr, newFakeSocialRepo())
+	id := mr.add(&bizMoment.MomentTB{UserID: 1})
+
+	for i := 0; i < 2; i++ {
+		reply, err := uc.LikeMoment(userCtx(2), id)
+		assert.NoError(t, err)
+		assert.True(t, reply.Liked)
+		assert.Equal(t, int64(1), reply.LikeCount)
+	}
+
+	for i := 0; i < 2; i++ {
+		reply, err := uc.UnlikeMoment(userCtx(2), id)
+		assert.NoError(t, err)
+		assert.False(t, reply.Liked)
+		assert.Equal(t, int64(0), reply.LikeCount)
+	}
+}
+
+func TestLikeMomentInvisible(t *testing.T) {
+	mr := newFakeMomentStore()
+	uc := newTestMomentUsecase(mr, newFakeSocialRepo())
+	id := mr.add(&bizMoment.MomentTB{UserID: 1, Visibility: bizMoment.VisibilityPrivate})
+
+	_, err := uc.LikeMoment(userCtx(2), id)
+	assert.Equal(t, MOMENT_NOT_FOUND, errors.FromError(err).Reason)
+	assert.Empty(t, mr.likes)
+}
+
+func TestFlushMomentLikesBatches(t *testing.T) {
+	mr := newFakeMomentStore()
+	mr.likes[[2]uint32{1, 7}] = 1
+	mr.likes[[2]uint32{1, 8}] = 2
+	mr.likes[[2]uint32{2, 7}] = 3
+	repo := &fakeLikeFlushRepo{fakeMomentStore: mr, pending: []uint32{1, 2, 3}, updated: make(map[uint32]int64)}
+	lk := newFakeLocker()
+	uc := NewMomentUsecase(repo, newFakeSquareRepo(), newFakeSocialRepo(), nil, lk, nil, nil, log.DefaultLogger)
+
+	n, ok, err := uc.FlushMomentLikes(context.Background(), 2, time.Minute)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, 3, n)
+	assert.Equal(t, map[uint32]int64{1: 2, 2: 1}, repo.updated)
+	assert.Empty(t, repo.pending)
+	assert.Empty(t, lk.held)
+}
+
+// 刷回失败的放回待刷回集合，下一轮重试
+func TestFlushMomentLikesRequeuesOnError(t *testing.T) {
+	repo := &fakeLikeFlushRepo{fakeMomentStore: newFakeMomentStore(), pending: []uint32{1, 2}, updated: make(map[uint32]int64), updateErr: errFakeDB}
+	lk := newFakeLocker()
+	uc := NewMomentUsecase(repo, newFakeSquareRepo(), newFakeSocialRepo(), nil, lk, nil, nil, log.DefaultLogger)
+
+	_, ok, err := uc.FlushMomentLikes(context.Background(), 10, time.Minute)
+	assert.ErrorIs(t, err, errFakeDB)
+	assert.True(t, ok)
+	assert.Equal(t, []uint32{1, 2}, repo.pending)
+	assert.Empty(t, lk.held)
+}
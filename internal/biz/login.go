@@ -0,0 +1,49 @@
+package biz
+
+import (
+	"context"
+	bizProfile "kratos-realworld/internal/biz/profile"
+	"kratos-realworld/internal/pkg/middleware/auth"
+	"kratos-realworld/internal/pkg/middleware/clientinfo"
+)
+
+// recordLogin 记录客户端IP、设备，失败只打日志，不影响登录
+func (gc *GateWayUsecase) recordLogin(ctx context.Context, userID uint32, source string) {
+	info := clientinfo.FromContext(ctx)
+	history := &bizProfile.LoginHistoryTB{
+		UserID:    userID,
+		Source:    source,
+		IP:        info.IP,
+		UserAgent: info.UserAgent,
+		Platform:  info.Platform,
+	}
+	if err := gc.pr.RecordLogin(ctx, history); err != nil {
+		gc.log.Warnf("failed to record login, user=%d source=%s err=%v", userID, source, err)
+	}
+}
+
+// RecordSession websocket 握手成功后调用，客户端信息由握手请求放进 ctx
+func (gc *GateWayUsecase) RecordSession(ctx context.Context, userID uint32) {
+	gc.recordLogin(ctx, userID, bizProfile.LoginSourceWebsocket)
+}
+
+// ListLoginHistory 当前用户的登录记录，按时间倒序，cursor 为 0 表示第一页
+func (gc *GateWayUsecase) ListLoginHistory(ctx context.Context, cursor uint32, limit int) (*LoginHistoryListReply, error) {
+	userID := uint32(auth.FromContext(ctx).UserID)
+	_, limit = normalizePage(1, limit)
+
+	histories, err := gc.pr.ListLoginHistory(ctx, userID, cursor, limit+1)
+	if err != nil {
+		return nil, NewErr(ErrCodeDBQueryFailed, DB_QUERY_FAILED, "failed to query login history")
+	}
+
+	reply := &LoginHistoryListReply{Histories: histories}
+	if len(histories) > limit {
+		reply.Histories = histories[:limit]
+		reply.HasMore = true
+	}
+	if len(reply.Histories) > 0 {
+		reply.NextCursor = reply.Histories[len(reply.Histories)-1].ID
+	}
+	return reply, nil
+}
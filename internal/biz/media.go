@@ -0,0 +1,275 @@
+package biz
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"image"
+	_ "image/gif"
+	"image/jpeg"
+	_ "image/png"
+	"io"
+	bizMoment "kratos-realworld/internal/biz/moments"
+	"kratos-realworld/internal/pkg/middleware/auth"
+	"kratos-realworld/internal/pkg/util"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MomentMediaUploadPath 动态附件上传，不走 proto 的 bytes 字段，请求体以流的方式写进存储
+const MomentMediaUploadPath = "/api/moments/media"
+
+const (
+	defaultMomentImageMaxSize = 10 << 20
+	defaultMomentVideoMaxSize = 100 << 20
+
+	maxMomentImages = 9
+	// http.DetectContentType 最多看前 512 个字节
+	sniffLen = 512
+	// 超过这个像素数的图片不解码，防止解压炸弹
+	maxMomentImagePixels = 50_000_000
+
+	momentThumbnailEdge = 320
+	blurHashXComponents = 4
+	blurHashYComponents = 3
+)
+
+// 允许上传的类型和保存的后缀，类型按文件内容判断，不信任客户端传的后缀
+var momentMediaTypes = map[string]struct {
+	kind   string
+	suffix string
+}{
+	"image/jpeg": {bizMoment.MediaImage, "jpg"},
+	"image/png":  {bizMoment.MediaImage, "png"},
+	"image/gif":  {bizMoment.MediaImage, "gif"},
+	"video/mp4":  {bizMoment.MediaVideo, "mp4"},
+	"video/webm": {bizMoment.MediaVideo, "webm"},
+}
+
+// MomentMediaInput 发布动态时引用的上传，视频必须带一张图片作为封面
+type MomentMediaInput struct {
+	UploadID      uint32
+	CoverUploadID uint32
+}
+
+func (uc *MomentUsecase) mediaMaxSize(kind string) int64 {
+	if kind == bizMoment.MediaVideo {
+		if uc.dc != nil && uc.dc.Storage != nil && uc.dc.Storage.MomentVideoMaxSize > 0 {
+			return uc.dc.Storage.MomentVideoMaxSize
+		}
+		return defaultMomentVideoMaxSize
+	}
+	if uc.dc != nil && uc.dc.Storage != nil && uc.dc.Storage.MomentImageMaxSize > 0 {
+		return uc.dc.Storage.MomentImageMaxSize
+	}
+	return defaultMomentImageMaxSize
+}
+
+// saveMediaFile 文件名随机生成，返回的 url 就是文件名
+func (uc *MomentUsecase) saveMediaFile(ctx context.Context, r io.Reader, suffix string) (string, int64, error) {
+	url := uuid.New().String() + "." + suffix
+	n, err := uc.st.Save(ctx, url, r)
+	if err != nil {
+		return "", 0, err
+	}
+	return url, n, nil
+}
+
+func (uc *MomentUsecase) removeMediaFiles(ctx context.Context, urls ...string) {
+	for _, url := range urls {
+		if url == "" {
+			continue
+		}
+		if err := uc.st.Remove(ctx, url); err != nil {
+			uc.log.Errorf("remove media %s error: %v", url, err)
+		}
+	}
+}
+
+// UploadMomentMedia 上传动态的图片或视频，图片同时生成缩略图、记录宽高和 blurhash
+// 图片要解码，读进内存；视频边读边写进存储，不整个放进内存
+func (uc *MomentUsecase) UploadMomentMedia(ctx context.Context, file io.Reader) (*bizMoment.MediaUploadTB, error) {
+	br := bufio.NewReaderSize(file, sniffLen)
+	head, err := br.Peek(sniffLen)
+	if err != nil && err != io.EOF {
+		uc.log.Errorf("read media error: %v", err)
+		return nil, NewErr(ErrCodeInvalidParams, INVALID_PARAMS, "read file failed")
+	}
+	if len(head) == 0 {
+		return nil, NewErr(ErrCodeInvalidParams, INVALID_PARAMS, "file is empty")
+	}
+	mediaType, ok := momentMediaTypes[http.DetectContentType(head)]
+	if !ok {
+		return nil, NewErr(ErrCodeInvalidParams, INVALID_PARAMS, "only jpg, png, gif, mp4 and webm are supported")
+	}
+	tooLarge := NewErr(ErrCodeInvalidParams, INVALID_PARAMS, "file is too large")
+	maxSize := uc.mediaMaxSize(mediaType.kind)
+	// 多读一个字节，读到了就说明超过上限
+	var src io.Reader = io.LimitReader(br, maxSize+1)
+
+	upload := &bizMoment.MediaUploadTB{
+		UserID: uint32(auth.FromContext(ctx).UserID),
+		Kind:   mediaType.kind,
+	}
+	if mediaType.kind == bizMoment.MediaImage {
+		data, err := io.ReadAll(src)
+		if err != nil {
+			uc.log.Errorf("read media error: %v", err)
+			return nil, NewErr(ErrCodeInvalidParams, INVALID_PARAMS, "read file failed")
+		}
+		if int64(len(data)) > maxSize {
+			return nil, tooLarge
+		}
+		thumbnail, err := uc.processImage(upload, data)
+		if err != nil {
+			return nil, err
+		}
+		if upload.ThumbnailURL, _, err = uc.saveMediaFile(ctx, bytes.NewReader(thumbnail), "jpg"); err != nil {
+			uc.log.Errorf("save thumbnail error: %v", err)
+			return nil, NewErr(ErrCodeMomentFailed, MOMENT_FAILED, "Upload media failed")
+		}
+		src = bytes.NewReader(data)
+	}
+
+	url, size, err := uc.saveMediaFile(ctx, src, mediaType.suffix)
+	if err != nil {
+		uc.log.Errorf("save media error: %v", err)
+		uc.removeMediaFiles(ctx, upload.ThumbnailURL)
+		return nil, NewErr(ErrCodeMomentFailed, MOMENT_FAILED, "Upload media failed")
+	}
+	if size > maxSize {
+		uc.removeMediaFiles(ctx, url, upload.ThumbnailURL)
+		return nil, tooLarge
+	}
+	upload.URL = url
+	upload.Size = size
+
+	if err := uc.mr.CreateUpload(ctx, upload); err != nil {
+		uc.log.Errorf("CreateUpload error: %v", err)
+		uc.removeMediaFiles(ctx, upload.URL, upload.ThumbnailURL)
+		return nil, NewErr(ErrCodeMomentFailed, MOMENT_FAILED, "Upload media failed")
+	}
+	return upload, nil
+}
+
+// CleanOrphanUploads 删除一批上传后超过 olderThan 还没被动态引用的附件，返回删除的个数
+func (uc *MomentUsecase) CleanOrphanUploads(ctx context.Context, olderThan time.Duration, batchSize int) (int, error) {
+	uploads, err := uc.mr.ListOrphanUploads(ctx, time.Now().Add(-olderThan), batchSize)
+	if err != nil {
+		return 0, NewErr(ErrCodeDBQueryFailed, DB_QUERY_FAILED, "failed to query orphan uploads")
+	}
+	n := 0
+	for _, upload := range uploads {
+		// 先删记录再删文件，删记录时刚好被发布引用的跳过，不会删掉已经发布的文件
+		deleted, err := uc.mr.DeleteOrphanUpload(ctx, upload.ID)
+		if err != nil {
+			uc.log.Errorf("DeleteOrphanUpload %d error: %v", upload.ID, err)
+			continue
+		}
+		if !deleted {
+			continue
+		}
+		uc.removeMediaFiles(ctx, upload.URL, upload.ThumbnailURL)
+		n++
+	}
+	return n, nil
+}
+
+// removeMomentMedia 动态删除后附件文件也没用了，视频的缩略图来自封面
+func (uc *MomentUsecase) removeMomentMedia(ctx context.Context, moment *bizMoment.MomentTB) {
+	for _, m := range moment.Media {
+		uc.removeMediaFiles(ctx, m.URL, m.CoverURL, m.ThumbnailURL)
+	}
+}
+
+// processImage 填充宽高和 blurhash，返回 jpeg 编码的缩略图
+func (uc *MomentUsecase) processImage(upload *bizMoment.MediaUploadTB, data []byte) ([]byte, error) {
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, NewErr(ErrCodeInvalidParams, INVALID_PARAMS, "invalid image")
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 || cfg.Width*cfg.Height > maxMomentImagePixels {
+		return nil, NewErr(ErrCodeInvalidParams, INVALID_PARAMS, "image dimensions are too large")
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, NewErr(ErrCodeInvalidParams, INVALID_PARAMS, "invalid image")
+	}
+
+	upload.Width = uint32(cfg.Width)
+	upload.Height = uint32(cfg.Height)
+	thumbnail := util.Thumbnail(img, momentThumbnailEdge)
+	upload.BlurHash = util.BlurHash(thumbnail, blurHashXComponents, blurHashYComponents)
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, thumbnail, &jpeg.Options{Quality: 80}); err != nil {
+		uc.log.Errorf("encode thumbnail error: %v", err)
+		return nil, NewErr(ErrCodeMomentFailed, MOMENT_FAILED, "Upload media failed")
+	}
+	return buf.Bytes(), nil
+}
+
+// momentUploadIDs 附件和封面引用的所有上传，同一个上传不能引用两次
+func momentUploadIDs(items []MomentMediaInput) ([]uint32, error) {
+	ids := make([]uint32, 0, len(items))
+	for _, item := range items {
+		for _, id := range []uint32{item.UploadID, item.CoverUploadID} {
+			if id == 0 {
+				continue
+			}
+			if inIDs(ids, id) {
+				return nil, NewErr(ErrCodeInvalidParams, INVALID_PARAMS, "media can not be used twice")
+			}
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+// buildMomentMedia 最多 9 张图片，或者 1 个视频加 1 张图片封面，顺序按传入的顺序
+func buildMomentMedia(items []MomentMediaInput, uploads map[uint32]*bizMoment.MediaUploadTB) ([]bizMoment.MomentMediaTB, error) {
+	notFound := NewErr(ErrCodeInvalidParams, INVALID_PARAMS, "media not found or already used")
+	media := make([]bizMoment.MomentMediaTB, 0, len(items))
+	for i, item := range items {
+		upload, ok := uploads[item.UploadID]
+		if !ok {
+			return nil, notFound
+		}
+		m := bizMoment.MomentMediaTB{
+			UploadID:     upload.ID,
+			Kind:         upload.Kind,
+			URL:          upload.URL,
+			ThumbnailURL: upload.ThumbnailURL,
+			Width:        upload.Width,
+			Height:       upload.Height,
+			BlurHash:     upload.BlurHash,
+			Sort:         i,
+		}
+		if upload.Kind == bizMoment.MediaVideo {
+			if len(items) > 1 {
+				return nil, NewErr(ErrCodeInvalidParams, INVALID_PARAMS, "a moment can have only one video")
+			}
+			cover, ok := uploads[item.CoverUploadID]
+			if !ok {
+				return nil, NewErr(ErrCodeInvalidParams, INVALID_PARAMS, "video cover not found or already used")
+			}
+			if cover.Kind != bizMoment.MediaImage {
+				return nil, NewErr(ErrCodeInvalidParams, INVALID_PARAMS, "video cover must be an image")
+			}
+			m.CoverURL = cover.URL
+			m.ThumbnailURL = cover.ThumbnailURL
+			m.Width = cover.Width
+			m.Height = cover.Height
+			m.BlurHash = cover.BlurHash
+		} else if item.CoverUploadID != 0 {
+			return nil, NewErr(ErrCodeInvalidParams, INVALID_PARAMS, "only video can have a cover")
+		}
+		media = append(media, m)
+	}
+	if len(media) > maxMomentImages {
+		return nil, NewErr(ErrCodeInvalidParams, INVALID_PARAMS, "a moment can have at most 9 images")
+	}
+	return media, nil
+}
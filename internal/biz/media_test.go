@@ -0,0 +1,222 @@
+package biz
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	bizMoment "kratos-realworld/internal/biz/moments"
+	"kratos-realworld/internal/conf"
+	"kratos-realworld/internal/pkg/util"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeMediaStorage 内存里的文件，文件名 -> 内容
+type fakeMediaStorage struct {
+	files map[string][]byte
+}
+
+func newFakeMediaStorage() *fakeMediaStorage {
+	return &fakeMediaStorage{files: make(map[string][]byte)}
+}
+
+func (s *fakeMediaStorage) Save(ctx context.Context, name string, r io.Reader) (int64, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return 0, err
+	}
+	s.files[name] = data
+	return int64(len(data)), nil
+}
+
+func (s *fakeMediaStorage) Remove(ctx context.Context, name string) error {
+	delete(s.files, name)
+	return nil
+}
+
+// fakeUploadRepo 内存里的上传记录，claimed 模拟删除前刚好被发布引用的上传
+type fakeUploadRepo struct {
+	bizMoment.MomentRepo
+	uploads map[uint32]*bizMoment.MediaUploadTB
+	claimed map[uint32]bool
+}
+
+func newFakeUploadRepo() *fakeUploadRepo {
+	return &fakeUploadRepo{
+		uploads: make(map[uint32]*bizMoment.MediaUploadTB),
+		claimed: make(map[uint32]bool),
+	}
+}
+
+func (r *fakeUploadRepo) CreateUpload(ctx context.Context, upload *bizMoment.MediaUploadTB) error {
+	upload.ID = uint32(len(r.uploads) + 1)
+	r.uploads[upload.ID] = upload
+	return nil
+}
+
+func (r *fakeUploadRepo) ListOrphanUploads(ctx context.Context, before time.Time, limit int) ([]*bizMoment.MediaUploadTB, error) {
+	var uploads []*bizMoment.MediaUploadTB
+	for id := uint32(1); id <= uint32(len(r.uploads)) && len(uploads) < limit; id++ {
+		u, ok := r.uploads[id]
+		if ok && !u.Used && u.SysCreated.Before(before) {
+			uploads = append(uploads, u)
+		}
+	}
+	return uploads, nil
+}
+
+func (r *fakeUploadRepo) DeleteOrphanUpload(ctx context.Context, id uint32) (bool, error) {
+	if r.claimed[id] {
+		return false, nil
+	}
+	delete(r.uploads, id)
+	return true, nil
+}
+
+func pngBytes(t *testing.T, w, h int) []byte {
+	var buf bytes.Buffer
+	assert.NoError(t, png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, w, h))))
+	return buf.Bytes()
+}
+
+func TestUploadMomentMedia(t *testing.T) {
+	repo := newFakeUploadRepo()
+	st := newFakeMediaStorage()
+	dc := &conf.Data{Storage: &conf.Data_Storage{MomentVideoMaxSize: 64}}
+	uc := NewMomentUsecase(repo, nil, nil, nil, nil, st, dc, log.DefaultLogger)
+
+	data := pngBytes(t, 640, 480)
+	upload, err := uc.UploadMomentMedia(userCtx(1), bytes.NewReader(data))
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(1), upload.UserID)
+	assert.Equal(t, bizMoment.MediaImage, upload.Kind)
+	assert.Equal(t, int64(len(data)), upload.Size)
+	assert.Equal(t, uint32(640), upload.Width)
+	assert.NotEmpty(t, upload.BlurHash)
+	assert.Equal(t, data, st.files[upload.URL])
+	assert.Contains(t, st.files, upload.ThumbnailURL)
+	assert.Len(t, repo.uploads, 1)
+
+	// 视频不解码，流式写入，超过上限时写进去的文件要删掉
+	video := append([]byte("\x00\x00\x00\x18ftypmp42"), make([]byte, 40)...)
+	upload, err = uc.UploadMomentMedia(userCtx(1), bytes.NewReader(video))
+	assert.NoError(t, err)
+	assert.Equal(t, bizMoment.MediaVideo, upload.Kind)
+	assert.Empty(t, upload.ThumbnailURL)
+	assert.Equal(t, video, st.files[upload.URL])
+
+	video = append(video, make([]byte, 64)...)
+	_, err = uc.UploadMomentMedia(userCtx(1), bytes.NewReader(video))
+	assert.Equal(t, "file is too large", errors.FromError(err).Message)
+	assert.Len(t, st.files, 3)
+	assert.Len(t, repo.uploads, 2)
+
+	_, err = uc.UploadMomentMedia(userCtx(1), strings.NewReader("hello"))
+	assert.Equal(t, INVALID_PARAMS, errors.FromError(err).Reason)
+	_, err = uc.UploadMomentMedia(userCtx(1), strings.NewReader(""))
+	assert.Equal(t, "file is empty", errors.FromError(err).Message)
+	assert.Len(t, st.files, 3)
+}
+
+func TestCleanOrphanUploads(t *testing.T) {
+	repo := newFakeUploadRepo()
+	st := newFakeMediaStorage()
+	uc := NewMomentUsecase(repo, nil, nil, nil, nil, st, nil, log.DefaultLogger)
+
+	old := time.Now().Add(-48 * time.Hour)
+	recent := time.Now()
+	for _, u := range []*bizMoment.MediaUploadTB{
+		{URL: "a.jpg", ThumbnailURL: "a_t.jpg", SysCreated: &old},
+		{URL: "b.jpg", ThumbnailURL: "b_t.jpg", SysCreated: &old},
+		{URL: "c.mp4", SysCreated: &old, Used: true},
+		{URL: "d.mp4", SysCreated: &recent},
+	} {
+		assert.NoError(t, repo.CreateUpload(context.Background(), u))
+		for _, name := range []string{u.URL, u.ThumbnailURL} {
+			if name != "" {
+				st.files[name] = []byte("x")
+			}
+		}
+	}
+	// 2 在删除前刚好被发布引用，文件不能删
+	repo.claimed[2] = true
+
+	n, err := uc.CleanOrphanUploads(context.Background(), 24*time.Hour, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.NotContains(t, repo.uploads, uint32(1))
+	assert.NotContains(t, st.files, "a.jpg")
+	assert.NotContains(t, st.files, "a_t.jpg")
+	assert.Len(t, st.files, 4)
+}
+
+func TestDeleteMomentRemovesMedia(t *testing.T) {
+	mr := newFakeMomentStore()
+	uc := newTestMomentUsecase(mr, newFakeSocialRepo())
+	st := uc.st.(*fakeMediaStorage)
+	for _, name := range []string{"v.mp4", "c.jpg", "c_t.jpg", "other.jpg"} {
+		st.files[name] = []byte("x")
+	}
+	id := mr.add(&bizMoment.MomentTB{UserID: 1, Media: []bizMoment.MomentMediaTB{
+		{Kind: bizMoment.MediaVideo, URL: "v.mp4", CoverURL: "c.jpg", ThumbnailURL: "c_t.jpg"},
+	}})
+
+	assert.NoError(t, uc.DeleteMoment(userCtx(1), id))
+	assert.Equal(t, []byte("x"), st.files["other.jpg"])
+	assert.Len(t, st.files, 1)
+}
+
+func TestBuildMomentMedia(t *testing.T) {
+	uploads := map[uint32]*bizMoment.MediaUploadTB{
+		1: {ID: 1, Kind: bizMoment.MediaImage, URL: "a.jpg", ThumbnailURL: "a_t.jpg", Width: 800, Height: 600, BlurHash: "LEHV6nWB"},
+		2: {ID: 2, Kind: bizMoment.MediaImage, URL: "b.png", ThumbnailURL: "b_t.jpg"},
+		3: {ID: 3, Kind: bizMoment.MediaVideo, URL: "c.mp4"},
+	}
+
+	media, err := buildMomentMedia([]MomentMediaInput{{UploadID: 2}, {UploadID: 1}}, uploads)
+	assert.NoError(t, err)
+	assert.Equal(t, "b.png", media[0].URL)
+	assert.Equal(t, 1, media[1].Sort)
+
+	media, err = buildMomentMedia([]MomentMediaInput{{UploadID: 3, CoverUploadID: 1}}, uploads)
+	assert.NoError(t, err)
+	assert.Equal(t, "a.jpg", media[0].CoverURL)
+	assert.Equal(t, "a_t.jpg", media[0].ThumbnailURL)
+	assert.Equal(t, uint32(800), media[0].Width)
+
+	_, err = buildMomentMedia([]MomentMediaInput{{UploadID: 3}}, uploads)
+	assert.Error(t, err)
+	_, err = buildMomentMedia([]MomentMediaInput{{UploadID: 3, CoverUploadID: 1}, {UploadID: 2}}, uploads)
+	assert.Error(t, err)
+	_, err = buildMomentMedia([]MomentMediaInput{{UploadID: 4}}, uploads)
+	assert.Error(t, err)
+
+	_, err = momentUploadIDs([]MomentMediaInput{{UploadID: 1}, {UploadID: 1}})
+	assert.Error(t, err)
+}
+
+func TestThumbnailAndBlurHash(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 640, 480))
+	for y := 0; y < 480; y++ {
+		for x := 0; x < 640; x++ {
+			img.Set(x, y, color.RGBA{R: 255, A: 255})
+		}
+	}
+	thumb := util.Thumbnail(img, momentThumbnailEdge)
+	assert.Equal(t, image.Rect(0, 0, 320, 240), thumb.Bounds())
+
+	// 纯色图片没有交流分量，每个交流分量都编码成 fQ
+	hash := util.BlurHash(thumb, blurHashXComponents, blurHashYComponents)
+	assert.Len(t, hash, 6+2*(blurHashXComponents*blurHashYComponents-1))
+	assert.Equal(t, "L", hash[:1])
+	assert.Equal(t, "fQfQfQ", hash[len(hash)-6:])
+}
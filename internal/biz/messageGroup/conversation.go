@@ -0,0 +1,96 @@
+package messageGroup
+
+import (
+	"context"
+	"time"
+)
+
+// ConversationTB 用户维度的会话列表，每个用户和每个单聊对象/群组各有一行
+type ConversationTB struct {
+	ID                uint32 `gorm:"column:id;type:int(10) unsigned;primary_key;AUTO_INCREMENT" json:"id"`
+	UserID            uint32 `gorm:"column:user_id;type:int(10) unsigned;not null;uniqueIndex:idx_user_target;comment:会话所属用户ID" json:"userId"`
+	TargetID          string `gorm:"column:target_id;type:varchar(150);not null;uniqueIndex:idx_user_target;comment:单聊为对端用户ID，群聊为群uuid" json:"targetId"`
+	ConversationType  uint16 `gorm:"column:conversation_type;type:smallint unsigned;not null;default:1;comment:会话类型：1单聊，2群聊" json:"conversationType"`
+	LastMessageID     uint32 `gorm:"column:last_message_id;type:int(10) unsigned;not null;default:0;comment:最后一条消息ID" json:"lastMessageId"`
+	LastReadMessageID uint32 `gorm:"column:last_read_message_id;type:int(10) unsigned;not null;default:0;comment:已读到的消息ID" json:"lastReadMessageId"`
+	UnreadCount       uint32 `gorm:"column:unread_count;type:int(10) unsigned;not null;default:0;comment:未读消息数" json:"unreadCount"`
+	MentionMessageID  uint32 `gorm:"column:mention_message_id;type:int(10) unsigned;not null;default:0;comment:最早一条未读的@我消息ID，0表示没有" json:"mentionMessageId"`
+	LastMentionID     uint32 `gorm:"column:last_mention_message_id;type:int(10) unsigned;not null;default:0;comment:最近一条@我的消息ID" json:"lastMentionMessageId"`
+
+	MutedUntil  *time.Time `gorm:"column:muted_until;type:datetime;default:null;comment:免打扰截止时间" json:"mutedUntil"`
+	PinnedOrder uint32     `gorm:"column:pinned_order;type:int(10) unsigned;not null;default:0;comment:置顶顺序，越大越靠前，0表示未置顶" json:"pinnedOrder"`
+	Archived    uint16     `gorm:"column:archived;type:smallint unsigned;not null;default:0;comment:是否归档" json:"archived"`
+
+	SysCreated *time.Time `gorm:"autoCreateTime;column:sys_created;type:datetime;not null;comment:创建时间" json:"sys_created"`
+	SysUpdated *time.Time `gorm:"autoUpdateTime;column:sys_updated;type:datetime;not null;comment:更新时间" json:"sys_updated"`
+}
+
+func (c *ConversationTB) TableName() string {
+	return "t_conversation"
+}
+
+// ConversationMuteForever 永久免打扰用一个足够远的时间表示
+var ConversationMuteForever = time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC)
+
+// ReadMentionsTo 已读到 messageID 后的@标记：读过了最早那条时，如果最近一条还没读就改成最近一条，否则清除
+// 中间的@消息没有单独记录，跳到最近一条不会漏掉还没读的@提醒
+func (c *ConversationTB) ReadMentionsTo(messageID uint32) {
+	if c.MentionMessageID == 0 || messageID < c.MentionMessageID {
+		return
+	}
+	if c.LastMentionID > messageID {
+		c.MentionMessageID = c.LastMentionID
+		return
+	}
+	c.MentionMessageID = 0
+}
+
+// IsMuted 免打扰只影响推送和提醒，不影响消息投递
+func (c *ConversationTB) IsMuted(now time.Time) bool {
+	return c.MutedUntil != nil && c.MutedUntil.After(now)
+}
+
+// 阅后即焚的可选时长
+const (
+	ConversationTimerOff   uint32 = 0
+	ConversationTimerDay   uint32 = 24 * 3600
+	ConversationTimerWeek  uint32 = 7 * 24 * 3600
+	ConversationTimerMonth uint32 = 30 * 24 * 3600
+)
+
+// ConversationPolicyTB 会话级别的阅后即焚设置，会话双方/群成员共用一条
+type ConversationPolicyTB struct {
+	ID               uint32 `gorm:"column:id;type:int(10) unsigned;primary_key;AUTO_INCREMENT" json:"id"`
+	ConversationKey  string `gorm:"column:conversation_key;type:varchar(150);not null;uniqueIndex:idx_conversation_key;comment:单聊为 u:小ID:大ID，群聊为 g:群uuid" json:"conversationKey"`
+	ConversationType uint16 `gorm:"column:conversation_type;type:smallint unsigned;not null;default:1;comment:会话类型：1单聊，2群聊" json:"conversationType"`
+	TimerSeconds     uint32 `gorm:"column:timer_seconds;type:int(10) unsigned;not null;default:0;comment:消息保留秒数，0表示关闭" json:"timerSeconds"`
+	UpdatedBy        uint32 `gorm:"column:updated_by;type:int(10) unsigned;not null;default:0;comment:最后修改人ID" json:"updatedBy"`
+
+	SysCreated *time.Time `gorm:"autoCreateTime;column:sys_created;type:datetime;not null;comment:创建时间" json:"sys_created"`
+	SysUpdated *time.Time `gorm:"autoUpdateTime;column:sys_updated;type:datetime;not null;comment:更新时间" json:"sys_updated"`
+}
+
+func (c *ConversationPolicyTB) TableName() string {
+	return "t_conversation_policy"
+}
+
+type ConversationRepo interface {
+	// 发送者的会话：最后一条消息即为已读位置
+	TouchSenderConversation(ctx context.Context, userID uint32, targetID string, conversationType uint16, messageID uint32) error
+	// 接收者的会话：未读数+1，mentionedIDs 中的用户额外记录@标记
+	TouchReceiverConversations(ctx context.Context, userIDs []uint32, targetID string, conversationType uint16, messageID uint32, mentionedIDs []uint32) error
+	// 置顶的排在前面，其余按最后一条消息倒序
+	ListConversations(ctx context.Context, userID uint32, archived bool) ([]*ConversationTB, error)
+	GetConversation(ctx context.Context, userID uint32, targetID string) (*ConversationTB, error)
+	ReadConversation(ctx context.Context, conversation *ConversationTB, lastReadMessageID uint32, unreadCount uint32) error
+	// 统计会话中 afterMessageID 之后别人发来的消息数
+	CountUnread(ctx context.Context, conversation *ConversationTB, afterMessageID uint32) (uint32, error)
+	// 会话不存在时先创建，用户可以在收到消息之前就设置免打扰，已存在时只更新 columns 里的字段
+	SaveConversationSettings(ctx context.Context, conversation *ConversationTB, columns []string) error
+	MaxPinnedOrder(ctx context.Context, userID uint32) (uint32, error)
+	// 返回 userIDs 中对该会话开启了免打扰的用户，每条消息都会查，走缓存
+	ListMutedUserIDs(ctx context.Context, userIDs []uint32, targetID string, now time.Time) ([]uint32, error)
+
+	GetConversationPolicy(ctx context.Context, conversationKey string) (*ConversationPolicyTB, error)
+	SaveConversationPolicy(ctx context.Context, policy *ConversationPolicyTB) error
+}
@@ -0,0 +1,51 @@
+package messageGroup
+
+import (
+	"context"
+	"time"
+)
+
+// 导出任务状态
+const (
+	ExportPending uint16 = 0 // 排队中
+	ExportRunning uint16 = 1 // 导出中
+	ExportDone    uint16 = 2 // 已完成
+	ExportFailed  uint16 = 3 // 失败
+)
+
+// ExportJobTB 聊天记录导出任务，导出完成后通过带过期时间的 token 下载
+type ExportJobTB struct {
+	ID               uint32     `gorm:"column:id;type:int(10) unsigned;primary_key;AUTO_INCREMENT" json:"id"`
+	UserID           uint32     `gorm:"column:user_id;type:int(10) unsigned;not null;index;comment:发起导出的用户ID" json:"userId"`
+	TargetID         string     `gorm:"column:target_id;type:varchar(150);not null;comment:单聊为对端用户ID，群聊为群uuid" json:"targetId"`
+	ConversationType uint16     `gorm:"column:conversation_type;type:smallint unsigned;not null;default:1;comment:会话类型：1单聊，2群聊" json:"conversationType"`
+	StartAt          *time.Time `gorm:"column:start_at;type:datetime;default:null;comment:导出的起始时间" json:"startAt"`
+	EndAt            *time.Time `gorm:"column:end_at;type:datetime;default:null;comment:导出的结束时间" json:"endAt"`
+	Status           uint16     `gorm:"column:status;type:smallint unsigned;not null;default:0;comment:状态：0排队中 1导出中 2已完成 3失败" json:"status"`
+	FileName         string     `gorm:"column:file_name;type:varchar(150);comment:导出的zip文件名" json:"fileName"`
+	Token            string     `gorm:"column:token;type:varchar(64);default:null;uniqueIndex:idx_token;comment:下载token" json:"token"`
+	ExpireAt         *time.Time `gorm:"column:expire_at;type:datetime;default:null;comment:下载链接过期时间" json:"expireAt"`
+	ErrorMsg         string     `gorm:"column:error_msg;type:varchar(350);comment:失败原因" json:"errorMsg"`
+
+	SysCreated *time.Time `gorm:"autoCreateTime;column:sys_created;type:datetime;not null;comment:创建时间" json:"sys_created"`
+	SysUpdated *time.Time `gorm:"autoUpdateTime;column:sys_updated;type:datetime;not null;comment:更新时间" json:"sys_updated"`
+}
+
+func (e *ExportJobTB) TableName() string {
+	return "t_export_job"
+}
+
+type ExportRepo interface {
+	CreateExportJob(ctx context.Context, job *ExportJobTB) error
+	GetExportJob(ctx context.Context, id uint32) (*ExportJobTB, error)
+	GetExportJobByToken(ctx context.Context, token string) (*ExportJobTB, error)
+	UpdateExportJob(ctx context.Context, id uint32, updates map[string]interface{}) error
+	// ListExpiredExportJobs 下载链接已过期但文件还没删的任务
+	ListExpiredExportJobs(ctx context.Context, before time.Time, limit int) ([]*ExportJobTB, error)
+	// TouchExportJobs 刷新 sys_updated，表示任务还在某个节点上排队或执行
+	TouchExportJobs(ctx context.Context, ids []uint32) error
+	// ListStaleExportJobs 排队中或导出中，但 before 之后没有刷新过的任务，说明所在节点已经退出
+	ListStaleExportJobs(ctx context.Context, before time.Time, limit int) ([]*ExportJobTB, error)
+	// ClaimExportJob 把过期任务重新置为排队中，多个节点同时认领时只有一个成功
+	ClaimExportJob(ctx context.Context, id uint32, before time.Time) (bool, error)
+}
@@ -1,6 +1,9 @@
 package messageGroup
 
-import "time"
+import (
+	"context"
+	"time"
+)
 
 type GroupTB struct {
 	ID     uint32 `gorm:"column:id;type:int(10) unsigned;primary_key;AUTO_INCREMENT" json:"id"`
@@ -9,6 +12,10 @@ type GroupTB struct {
 	Name   string `gorm:"column:name;type:varchar(150);not null;comment:群名称" json:"name"`
 	Notice string `gorm:"column:notice;type:varchar(350);comment:群公告" json:"notice"`
 
+	MuteAll       uint16 `gorm:"column:mute_all;type:smallint unsigned;not null;default:0;comment:全员禁言，群主和管理员不受影响" json:"muteAll"`
+	OnlyAdminEdit uint16 `gorm:"column:only_admin_edit;type:smallint unsigned;not null;default:0;comment:是否仅群主和管理员可修改群名称和公告" json:"onlyAdminEdit"`
+	JoinApproval  uint16 `gorm:"column:join_approval;type:smallint unsigned;not null;default:0;comment:入群是否需要审批" json:"joinApproval"`
+
 	SysCreated *time.Time `gorm:"autoCreateTime;column:sys_created;type:datetime;not null;comment:创建时间" json:"sys_created"`
 	SysUpdated *time.Time `gorm:"autoUpdateTime;column:sys_updated;type:datetime;not null;comment:更新时间" json:"sys_updated"`
 	DeletedAt  *uint64    `gorm:"column:deleted_at;type:bigint unsigned;default:null;comment:删除时间戳" json:"deleted_at"` // 删除时间戳
@@ -17,3 +24,29 @@ type GroupTB struct {
 func (g *GroupTB) TableName() string {
 	return "t_group"
 }
+
+type GroupRepo interface {
+	GetGroupByUuid(ctx context.Context, uuid string) (*GroupTB, error)
+	GetGroupByID(ctx context.Context, id uint32) (*GroupTB, error)
+	GetGroupMember(ctx context.Context, groupID uint32, userID uint32) (*GroupMemberTB, error)
+	ListGroupMembers(ctx context.Context, groupID uint32) ([]*GroupMemberTB, error)
+	UpdateGroup(ctx context.Context, groupID uint32, updates map[string]interface{}) error
+	UpdateGroupMember(ctx context.Context, memberID uint32, updates map[string]interface{}) error
+	AddGroupMember(ctx context.Context, member *GroupMemberTB) error
+
+	CreateInvite(ctx context.Context, invite *GroupInviteTB) error
+	GetInviteByToken(ctx context.Context, token string) (*GroupInviteTB, error)
+	RevokeInvite(ctx context.Context, inviteID uint32) error
+	// 使用次数+1，次数用完时返回 false
+	UseInvite(ctx context.Context, inviteID uint32) (bool, error)
+	// 使用次数-1，入群申请被拒绝时归还
+	ReleaseInvite(ctx context.Context, inviteID uint32) error
+
+	// 同一个人在同一个群只能有一条待审批的申请，重复时返回 gorm.ErrDuplicatedKey
+	CreateJoinRequest(ctx context.Context, req *GroupJoinRequestTB) error
+	GetJoinRequest(ctx context.Context, id uint32) (*GroupJoinRequestTB, error)
+	GetPendingJoinRequest(ctx context.Context, groupID uint32, userID uint32) (*GroupJoinRequestTB, error)
+	ListJoinRequests(ctx context.Context, groupID uint32, status uint16) ([]*GroupJoinRequestTB, error)
+	// 只有待审批的申请能被处理，已经被别人处理过时返回 false
+	HandleJoinRequest(ctx context.Context, id uint32, status uint16, handlerID uint32) (bool, error)
+}
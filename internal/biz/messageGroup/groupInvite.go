@@ -0,0 +1,60 @@
+package messageGroup
+
+import "time"
+
+// GroupInviteTB 群邀请链接，token 放在链接或者二维码里
+type GroupInviteTB struct {
+	ID        uint32     `gorm:"column:id;type:int(10) unsigned;primary_key;AUTO_INCREMENT" json:"id"`
+	GroupID   uint32     `gorm:"column:group_id;type:int(10) unsigned;not null;index;comment:群组ID" json:"groupId"`
+	Token     string     `gorm:"column:token;type:varchar(64);not null;uniqueIndex:idx_token;comment:邀请token" json:"token"`
+	CreatorID uint32     `gorm:"column:creator_id;type:int(10) unsigned;not null;comment:创建人ID" json:"creatorId"`
+	MaxUses   uint32     `gorm:"column:max_uses;type:int(10) unsigned;not null;default:0;comment:最大使用次数，0表示不限" json:"maxUses"`
+	UsedCount uint32     `gorm:"column:used_count;type:int(10) unsigned;not null;default:0;comment:已使用次数" json:"usedCount"`
+	ExpireAt  *time.Time `gorm:"column:expire_at;type:datetime;default:null;comment:过期时间，为空表示永不过期" json:"expireAt"`
+	RevokedAt *time.Time `gorm:"column:revoked_at;type:datetime;default:null;comment:撤销时间" json:"revokedAt"`
+
+	SysCreated *time.Time `gorm:"autoCreateTime;column:sys_created;type:datetime;not null;comment:创建时间" json:"sys_created"`
+	SysUpdated *time.Time `gorm:"autoUpdateTime;column:sys_updated;type:datetime;not null;comment:更新时间" json:"sys_updated"`
+}
+
+func (gi *GroupInviteTB) TableName() string {
+	return "t_group_invite"
+}
+
+// Usable 没有撤销、没有过期、还有剩余次数
+func (gi *GroupInviteTB) Usable(now time.Time) bool {
+	if gi.RevokedAt != nil {
+		return false
+	}
+	if gi.ExpireAt != nil && !gi.ExpireAt.After(now) {
+		return false
+	}
+	return gi.MaxUses == 0 || gi.UsedCount < gi.MaxUses
+}
+
+// 入群申请状态
+const (
+	JoinRequestPending  uint16 = 0 // 待审批
+	JoinRequestApproved uint16 = 1 // 已通过
+	JoinRequestRejected uint16 = 2 // 已拒绝
+)
+
+// GroupJoinRequestTB 需要审批的群的入群申请
+// Pending 待审批时为 1，处理后置空，唯一索引保证同一个人在同一个群只有一条待审批的申请
+type GroupJoinRequestTB struct {
+	ID        uint32  `gorm:"column:id;type:int(10) unsigned;primary_key;AUTO_INCREMENT" json:"id"`
+	GroupID   uint32  `gorm:"column:group_id;type:int(10) unsigned;not null;index:idx_group_status;uniqueIndex:idx_group_user_pending,priority:1;comment:群组ID" json:"groupId"`
+	UserID    uint32  `gorm:"column:user_id;type:int(10) unsigned;not null;index;uniqueIndex:idx_group_user_pending,priority:2;comment:申请人ID" json:"userId"`
+	InviteID  uint32  `gorm:"column:invite_id;type:int(10) unsigned;not null;default:0;comment:使用的邀请ID" json:"inviteId"`
+	Message   string  `gorm:"column:message;type:varchar(350);comment:申请留言" json:"message"`
+	Status    uint16  `gorm:"column:status;type:smallint unsigned;not null;default:0;index:idx_group_status;comment:状态：0待审批 1已通过 2已拒绝" json:"status"`
+	HandlerID uint32  `gorm:"column:handler_id;type:int(10) unsigned;not null;default:0;comment:审批人ID" json:"handlerId"`
+	Pending   *uint16 `gorm:"column:pending;type:tinyint unsigned;default:null;uniqueIndex:idx_group_user_pending,priority:3;comment:待审批时为1，处理后为空" json:"-"`
+
+	SysCreated *time.Time `gorm:"autoCreateTime;column:sys_created;type:datetime;not null;comment:创建时间" json:"sys_created"`
+	SysUpdated *time.Time `gorm:"autoUpdateTime;column:sys_updated;type:datetime;not null;comment:更新时间" json:"sys_updated"`
+}
+
+func (gj *GroupJoinRequestTB) TableName() string {
+	return "t_group_join_request"
+}
@@ -2,12 +2,22 @@ package messageGroup
 
 import "time"
 
+// 群成员角色
+const (
+	GroupRoleOwner  uint16 = 1 // 群主
+	GroupRoleAdmin  uint16 = 2 // 管理员
+	GroupRoleMember uint16 = 3 // 普通成员
+)
+
+// GroupMemberTB 唯一索引保证同一个人在同一个群只有一条成员记录，并发入群时重复插入返回 gorm.ErrDuplicatedKey
 type GroupMemberTB struct {
-	ID       uint32 `gorm:"column:id;type:int(10) unsigned;primary_key;AUTO_INCREMENT" json:"id"`
-	UserID   uint32 `gorm:"column:user_id;type:int(10) unsigned;not null;index;comment:用户ID" json:"userId"`
-	GroupID  uint32 `gorm:"column:group_id;type:int(10) unsigned;not null;index;comment:群组ID" json:"groupId"`
-	Nickname string `gorm:"column:nickname;type:varchar(350);comment:昵称" json:"nickname"`
-	Mute     uint16 `gorm:"column:mute;type:smallint;not null;default:0;comment:是否禁言" json:"mute"`
+	ID        uint32     `gorm:"column:id;type:int(10) unsigned;primary_key;AUTO_INCREMENT" json:"id"`
+	UserID    uint32     `gorm:"column:user_id;type:int(10) unsigned;not null;index;uniqueIndex:idx_group_user,priority:2;comment:用户ID" json:"userId"`
+	GroupID   uint32     `gorm:"column:group_id;type:int(10) unsigned;not null;index;uniqueIndex:idx_group_user,priority:1;comment:群组ID" json:"groupId"`
+	Nickname  string     `gorm:"column:nickname;type:varchar(350);comment:昵称" json:"nickname"`
+	Role      uint16     `gorm:"column:role;type:smallint unsigned;not null;default:3;comment:角色：1群主 2管理员 3普通成员" json:"role"`
+	Mute      uint16     `gorm:"column:mute;type:smallint;not null;default:0;comment:是否永久禁言" json:"mute"`
+	MuteUntil *time.Time `gorm:"column:mute_until;type:datetime;default:null;comment:禁言截止时间" json:"muteUntil"`
 
 	SysCreated *time.Time `gorm:"autoCreateTime;column:sys_created;type:datetime;not null;comment:创建时间" json:"sys_created"`
 	SysUpdated *time.Time `gorm:"autoUpdateTime;column:sys_updated;type:datetime;not null;comment:更新时间" json:"sys_updated"`
@@ -17,3 +27,11 @@ type GroupMemberTB struct {
 func (gm *GroupMemberTB) TableName() string {
 	return "t_groupMember"
 }
+
+// IsMuted 永久禁言或者禁言还没到期
+func (gm *GroupMemberTB) IsMuted(now time.Time) bool {
+	if gm.Mute != 0 {
+		return true
+	}
+	return gm.MuteUntil != nil && gm.MuteUntil.After(now)
+}
@@ -8,8 +8,8 @@ import (
 
 type MessageTB struct {
 	ID          uint32     `gorm:"column:id;type:int(10) unsigned;primary_key;AUTO_INCREMENT" json:"id"`
-	CreatedAt   *time.Time `gorm:"column:created_at;type:datetime(3);default:null;comment:创建时间" json:"created_at"` // 创建时间
-	UpdatedAt   *time.Time `gorm:"column:updated_at;type:datetime(3);default:null;comment:更新时间" json:"updated_at"` // 更新时间
+	CreatedAt   *time.Time `gorm:"column:created_at;type:datetime(3);default:null;index;comment:创建时间" json:"created_at"` // 创建时间
+	UpdatedAt   *time.Time `gorm:"column:updated_at;type:datetime(3);default:null;comment:更新时间" json:"updated_at"`       // 更新时间
 	FromUserID  string     `gorm:"column:from_user_id;type:varchar(64);not null;index;comment:发送者用户ID" json:"fromUserId"`
 	ToUserID    string     `gorm:"column:to_user_id;type:varchar(64);not null;index;comment:接收者用户ID或群ID" json:"toUserId"`
 	Content     string     `gorm:"column:content;type:varchar(2500);not null;comment:消息内容" json:"content"`
@@ -17,6 +17,7 @@ type MessageTB struct {
 	ContentType uint16     `gorm:"column:content_type;type:smallint unsigned;not null;default:1;comment:消息内容类型：1文字 2普通文件 3图片 4音频 5视频 6语音聊天 7视频聊天" json:"contentType"`
 	Url         string     `gorm:"column:url;type:varchar(350);comment:文件或者图片地址" json:"url"`
 	Pic         string     `gorm:"column:pic;type:text;comment:缩略图" json:"pic"`
+	ExpireAt    *time.Time `gorm:"column:expire_at;type:datetime;default:null;index;comment:阅后即焚过期时间，为空表示不过期" json:"expireAt"`
 
 	SysCreated *time.Time `gorm:"autoCreateTime;column:sys_created;type:datetime;comment:创建时间;NOT NULL" json:"sys_created"`
 	SysUpdated *time.Time `gorm:"autoUpdateTime;column:sys_updated;type:datetime;comment:更新时间;NOT NULL" json:"sys_updated"`
@@ -31,4 +32,10 @@ type MessageRepo interface {
 	GetMessages(ctx context.Context, message common.MessageRequest) ([]common.MessageResponse, error) // 分页查询 1. 分页offset  2. 游标cursor
 	FetchGroupMessage(ctx context.Context, toUuid string) ([]common.MessageResponse, error)
 	SaveMessage(message *MessageTB) error
+	// 查询 expire_at 已到期，或者创建时间早于 createdBefore 的消息，createdBefore 为空时不按创建时间过滤
+	ListExpiredMessages(ctx context.Context, now time.Time, createdBefore *time.Time, limit int) ([]*MessageTB, error)
+	// 硬删除
+	DeleteMessages(ctx context.Context, ids []uint32) error
+	// 按时间范围分批查询一个会话的消息，afterID 为上一批最后一条消息的ID
+	ListConversationMessages(ctx context.Context, conversationType uint16, userID string, targetID string, start time.Time, end time.Time, afterID uint32, limit int) ([]*MessageTB, error)
 }
@@ -2,28 +2,158 @@ package biz
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	bizMoment "kratos-realworld/internal/biz/moments"
+	bizProfile "kratos-realworld/internal/biz/profile"
+	"kratos-realworld/internal/conf"
 	kafka "kratos-realworld/internal/kafka"
+	"kratos-realworld/internal/model"
+	"kratos-realworld/internal/pkg/middleware/auth"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// 动态正文、评论的最大长度，和表字段保持一致
+const (
+	maxMomentMessageLen = 500
+	maxCommentLen       = 500
 )
 
 type MomentUsecase struct {
 	mr bizMoment.MomentRepo
+	sq bizMoment.SquareRepo
+	pr bizProfile.ProfileRepo
+	pp *PrivacyPolicy
+	lk model.Locker
+	st bizMoment.MediaStorage
 
+	dc  *conf.Data
 	log *log.Helper
 }
 
-func NewMomentUsecase(mr bizMoment.MomentRepo, logger log.Logger) *MomentUsecase {
+func NewMomentUsecase(mr bizMoment.MomentRepo, sq bizMoment.SquareRepo, pr bizProfile.ProfileRepo, pp *PrivacyPolicy, lk model.Locker, st bizMoment.MediaStorage, dc *conf.Data, logger log.Logger) *MomentUsecase {
 	return &MomentUsecase{
 		mr:  mr,
+		sq:  sq,
+		pr:  pr,
+		pp:  pp,
+		lk:  lk,
+		st:  st,
+		dc:  dc,
 		log: log.NewHelper(logger),
 	}
 }
 
-func (uc *MomentUsecase) CreateMoment(ctx context.Context, moment *bizMoment.MomentTB) error {
-	err := uc.mr.CreateMoment(ctx, moment)
+// isBlockedBy viewer 是否被 owner 拉黑，被拉黑的用户看不到 owner 的动态也不能评论
+// 查询失败时返回错误，调用方不能当作没有拉黑
+func (uc *MomentUsecase) isBlockedBy(ctx context.Context, ownerID uint32, viewerID uint32) (bool, error) {
+	if ownerID == viewerID {
+		return false, nil
+	}
+	return uc.pr.CheckBlock(ctx, ownerID, viewerID)
+}
+
+// getMoment 动态不存在或者已经删除时返回 MOMENT_NOT_FOUND
+func (uc *MomentUsecase) getMoment(ctx context.Context, momentID uint32) (*bizMoment.MomentTB, error) {
+	moment, err := uc.mr.GetMoment(ctx, momentID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, NewErr(ErrCodeMomentNotFound, MOMENT_NOT_FOUND, "moment not found")
+	}
+	if err != nil {
+		uc.log.Errorf("GetMoment error: %v", err)
+		return nil, NewErr(ErrCodeMomentFailed, MOMENT_FAILED, "Get moment failed")
+	}
+	return moment, nil
+}
+
+// getOwnMoment 只有作者本人可以修改、删除动态
+func (uc *MomentUsecase) getOwnMoment(ctx context.Context, momentID uint32) (*bizMoment.MomentTB, error) {
+	moment, err := uc.getMoment(ctx, momentID)
+	if err != nil {
+		return nil, err
+	}
+	if moment.UserID != uint32(auth.FromContext(ctx).UserID) {
+		return nil, NewErr(ErrCodeMomentPermission, MOMENT_PERMISSION_DENIED, "only the author can modify this moment")
+	}
+	return moment, nil
+}
+
+func inIDs(ids []uint32, id uint32) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
+
+// CreateMoment 作者取当前登录用户，推送给作者的粉丝；media 引用作者之前上传、还没用过的附件
+func (uc *MomentUsecase) CreateMoment(ctx context.Context, moment *bizMoment.MomentTB, media []MomentMediaInput) error {
+	moment.UserID = uint32(auth.FromContext(ctx).UserID)
+	moment.Message = strings.TrimSpace(moment.Message)
+	if moment.Message == "" && moment.MediaURL == "" && len(media) == 0 {
+		return NewErr(ErrCodeInvalidParams, INVALID_PARAMS, "moment content is empty")
+	}
+	if utf8.RuneCountInString(moment.Message) > maxMomentMessageLen {
+		return NewErr(ErrCodeInvalidParams, INVALID_PARAMS, "moment content is too long")
+	}
+	tags, err := normalizeTags(moment.Tags)
+	if err != nil {
+		return err
+	}
+	moment.Tags = tags
+
+	uploadIDs, err := momentUploadIDs(media)
+	if err != nil {
+		return err
+	}
+	uploads, err := uc.mr.GetUploads(ctx, moment.UserID, uploadIDs)
+	if err != nil {
+		uc.log.Errorf("GetUploads error: %v", err)
+		return NewErr(ErrCodeMomentFailed, MOMENT_FAILED, "Create moment failed")
+	}
+	if moment.Media, err = buildMomentMedia(media, uploads); err != nil {
+		return err
+	}
+
+	if moment.Visibility == "" {
+		setting, err := uc.pp.Settings(ctx, moment.UserID)
+		if err != nil {
+			return err
+		}
+		moment.Visibility = defaultMomentVisibility(setting.MomentVisibility)
+	}
+	moment.Visibility, moment.ScopeIDs, err = normalizeMomentScope(moment.Visibility, moment.ScopeIDs)
+	if err != nil {
+		return err
+	}
+
+	followerIDs, err := uc.pr.GetFollowerIDs(ctx, moment.UserID)
+	if err != nil {
+		uc.log.Errorf("GetFollowerIDs error: %v", err)
+		return NewErr(ErrCodeMomentFailed, MOMENT_FAILED, "Create moment failed")
+	}
+	// 粉丝太多的作者不写接收盒，粉丝刷时间线时拉取
+	if len(followerIDs) > uc.pullThreshold() {
+		moment.FanoutPull = true
+	} else {
+		moment.PushIDs = followerIDs
+	}
+
+	// 只推送给没被作者拉黑、在这条动态可见范围内的人
+	moment.PushIDs, err = uc.pushAudience(ctx, moment, moment.PushIDs)
+	if err != nil {
+		uc.log.Errorf("pushAudience error: %v", err)
+		return NewErr(ErrCodeMomentFailed, MOMENT_FAILED, "Create moment failed")
+	}
+
+	err = uc.mr.CreateMoment(ctx, moment, uploadIDs)
+	if errors.Is(err, bizMoment.ErrUploadUsed) {
+		return NewErr(ErrCodeInvalidParams, INVALID_PARAMS, "media not found or already used")
+	}
 	if err != nil {
 		uc.log.Errorf("CreateMoment error: %v", err)
 		return NewErr(ErrCodeMomentFailed, MOMENT_FAILED, "Create moment failed")
@@ -33,21 +163,27 @@ func (uc *MomentUsecase) CreateMoment(ctx context.Context, moment *bizMoment.Mom
 	msg := &kafka.MomentMessage{
 		UserID:   moment.UserID,
 		MomentID: moment.ID,
-		Action:   "create",
+		Action:   kafka.MOMENT_ACTION_CREATE,
 		PushIDs:  moment.PushIDs,
 	}
-	body, err := json.Marshal(msg)
-	if err != nil {
-		uc.log.Errorf("Marshal MomentMessage error: %v", err)
-		return NewErr(ErrCodeMomentFailed, MOMENT_FAILED, "Marshal moment message failed")
+	if err := uc.sendMomentMessage(msg); err != nil {
+		return err
 	}
-	kafka.Send(body)
-	uc.log.Infof("Send MomentMessage to Kafka: %s", body)
+
+	if err := publishCounterEvent(kafka.EVENT_MOMENT_CREATED, moment.UserID, moment.UserID, moment.ID, moment.ID); err != nil {
+		uc.log.Errorf("publish counter event error: %v", err)
+	}
+
+	uc.addToSquare(ctx, moment)
 
 	return nil
 }
 
 func (uc *MomentUsecase) DeleteMoment(ctx context.Context, momentID uint32) error {
+	if _, err := uc.getOwnMoment(ctx, momentID); err != nil {
+		return err
+	}
+
 	moment, err := uc.mr.DeleteMoment(ctx, momentID)
 	if err != nil {
 		uc.log.Errorf("DeleteMoment error: %v", err)
@@ -58,26 +194,48 @@ func (uc *MomentUsecase) DeleteMoment(ctx context.Context, momentID uint32) erro
 	msg := &kafka.MomentMessage{
 		UserID:   moment.UserID,
 		MomentID: moment.ID,
-		Action:   "delete",
+		Action:   kafka.MOMENT_ACTION_DELETE,
 		PushIDs:  moment.PushIDs,
 	}
-	body, err := json.Marshal(msg)
-	if err != nil {
-		uc.log.Errorf("Marshal MomentMessage error: %v", err)
-		return NewErr(ErrCodeMomentFailed, MOMENT_FAILED, "Marshal moment message failed")
+	if err := uc.sendMomentMessage(msg); err != nil {
+		return err
 	}
-	kafka.Send(body)
-	uc.log.Infof("Send MomentMessage to Kafka: %s", body)
+
+	if err := publishCounterEvent(kafka.EVENT_MOMENT_DELETED, moment.UserID, moment.UserID, moment.ID, moment.ID); err != nil {
+		uc.log.Errorf("publish counter event error: %v", err)
+	}
+
+	uc.removeFromSquare(ctx, moment.ID)
+	uc.removeMomentMedia(ctx, moment)
 
 	return nil
 }
 
-func (uc *MomentUsecase) GetMoment(ctx context.Context, momentID uint32) (*bizMoment.MomentTB, error) {
-	moment, err := uc.mr.GetMoment(ctx, momentID)
+func (uc *MomentUsecase) GetMoment(ctx context.Context, viewerID uint32, momentID uint32) (*bizMoment.MomentTB, error) {
+	moment, err := uc.getMoment(ctx, momentID)
 	if err != nil {
-		uc.log.Errorf("GetMoment error: %v", err)
+		return nil, err
+	}
+	// 不在这条动态可见范围内的人当作动态不存在
+	visible, err := uc.canViewMoment(ctx, moment, viewerID)
+	if err != nil {
+		uc.log.Errorf("canViewMoment error: %v", err)
+		return nil, NewErr(ErrCodeMomentFailed, MOMENT_FAILED, "Get moment failed")
+	}
+	if !visible {
+		return nil, NewErr(ErrCodeMomentNotFound, MOMENT_NOT_FOUND, "moment not found")
+	}
+	blocked, err := uc.isBlockedBy(ctx, moment.UserID, viewerID)
+	if err != nil {
+		uc.log.Errorf("CheckBlock error: %v", err)
 		return nil, NewErr(ErrCodeMomentFailed, MOMENT_FAILED, "Get moment failed")
 	}
+	if blocked {
+		return nil, NewErr(ErrCodeUserBlocked, USER_BLOCKED, "you have been blocked by this user")
+	}
+	if err := uc.pp.CheckCanViewMoments(ctx, moment.UserID, viewerID); err != nil {
+		return nil, err
+	}
 	return moment, nil
 }
 
@@ -90,55 +248,114 @@ func (uc *MomentUsecase) GetMomentMeta(ctx context.Context, momentID uint32) (*b
 	return momentMeta, nil
 }
 
-func (uc *MomentUsecase) CreateComment(ctx context.Context, comment *bizMoment.CommentsTB) error {
-	err := uc.mr.CreateComment(ctx, comment)
+// CreateComment 评论者取当前登录用户，看不到这条动态的人也不能评论
+// replyToCommentID 不为 0 时是回复评论，回复挂在被回复评论所属的一级评论下
+func (uc *MomentUsecase) CreateComment(ctx context.Context, comment *bizMoment.CommentsTB, replyToCommentID uint32) error {
+	comment.UserID = uint32(auth.FromContext(ctx).UserID)
+	comment.Comment = strings.TrimSpace(comment.Comment)
+	if comment.Comment == "" {
+		return NewErr(ErrCodeInvalidParams, INVALID_PARAMS, "comment is empty")
+	}
+	if utf8.RuneCountInString(comment.Comment) > maxCommentLen {
+		return NewErr(ErrCodeInvalidParams, INVALID_PARAMS, "comment is too long")
+	}
+
+	moment, err := uc.GetMoment(ctx, comment.UserID, comment.MomentID)
+	if err != nil {
+		return err
+	}
+	comment.ParentID = 0
+	comment.ReplyToUserID = 0
+	if replyToCommentID != 0 {
+		target, err := uc.getVisibleComment(ctx, moment, comment.UserID, replyToCommentID)
+		if err != nil {
+			return err
+		}
+		comment.ReplyToUserID = target.UserID
+		comment.ParentID = target.ID
+		if target.ParentID != 0 {
+			comment.ParentID = target.ParentID
+		}
+	}
+
+	err = uc.mr.CreateComment(ctx, comment)
 	if err != nil {
 		uc.log.Errorf("CreateComment error: %v", err)
 		return NewErr(ErrCodeMomentFailed, MOMENT_FAILED, "Create comment failed")
 	}
+
+	if err := publishCounterEvent(kafka.EVENT_COMMENT_ADDED, moment.UserID, comment.UserID, moment.ID, comment.ID); err != nil {
+		uc.log.Errorf("publish counter event error: %v", err)
+	}
+	uc.bumpSquare(ctx, moment.ID, engagementComment)
 	return nil
 }
 
-func (uc *MomentUsecase) DeleteComment(ctx context.Context, commentID uint32) error {
-	err := uc.mr.DeleteComment(ctx, commentID)
+// DeleteComment 评论者本人和动态作者可以删除，删除一级评论时它下面的回复一起删除
+func (uc *MomentUsecase) DeleteComment(ctx context.Context, momentID uint32, commentID uint32) error {
+	userID := uint32(auth.FromContext(ctx).UserID)
+	moment, err := uc.getMoment(ctx, momentID)
+	if err != nil {
+		return err
+	}
+	comment, err := uc.getComment(ctx, momentID, commentID)
+	if err != nil {
+		return err
+	}
+	if comment.UserID != userID && moment.UserID != userID {
+		return NewErr(ErrCodeMomentPermission, MOMENT_PERMISSION_DENIED, "only the commenter or the author can delete this comment")
+	}
+
+	deletedIDs, err := uc.mr.DeleteComment(ctx, commentID)
 	if err != nil {
 		uc.log.Errorf("DeleteComment error: %v", err)
 		return NewErr(ErrCodeMomentFailed, MOMENT_FAILED, "Delete comment failed")
 	}
+	for _, id := range deletedIDs {
+		if err := publishCounterEvent(kafka.EVENT_COMMENT_REMOVED, moment.UserID, userID, moment.ID, id); err != nil {
+			uc.log.Errorf("publish counter event error: %v", err)
+		}
+	}
+	if len(deletedIDs) > 0 {
+		uc.bumpSquare(ctx, moment.ID, -engagementComment*int64(len(deletedIDs)))
+	}
 	return nil
 }
 
 func (uc *MomentUsecase) UpdateMomentBlackList(ctx context.Context, momentID uint32, newBlackListIDs []uint32) ([]uint32, []uint32, error) {
+	moment, err := uc.getOwnMoment(ctx, momentID)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	addedIDs, removedIDs, err := uc.mr.UpdateMomentBlackList(ctx, momentID, newBlackListIDs)
 	if err != nil {
 		uc.log.Errorf("UpdateMomentBlackList error: %v", err)
 		return nil, nil, NewErr(ErrCodeMomentFailed, MOMENT_FAILED, "Update moment black list failed")
 	}
+	// 有黑名单的动态不在广场展示，黑名单清空后重新放回
+	moment.BlackListIDs = newBlackListIDs
+	if len(newBlackListIDs) > 0 {
+		uc.removeFromSquare(ctx, momentID)
+	} else if len(removedIDs) > 0 {
+		uc.addToSquare(ctx, moment)
+	}
 	// 发送动态黑名单更新消息到 Kafka
-	msg := &kafka.MomentMessage{
+	err = uc.sendMomentMessage(&kafka.MomentMessage{
 		MomentID: momentID,
-		Action:   "blacklist_update_added",
+		Action:   kafka.MOMENT_ACTION_BLACKLIST_ADDED,
 		PushIDs:  addedIDs,
-	}
-	body, err := json.Marshal(msg)
+	})
 	if err != nil {
-		uc.log.Errorf("Marshal MomentMessage error: %v", err)
-		return nil, nil, NewErr(ErrCodeMomentFailed, MOMENT_FAILED, "Marshal moment message failed")
+		return nil, nil, err
 	}
-	kafka.Send(body)
-	uc.log.Infof("Send MomentMessage to Kafka: %s", body)
-	// 发送动态黑名单更新消息到 Kafka
-	msg = &kafka.MomentMessage{
+	err = uc.sendMomentMessage(&kafka.MomentMessage{
 		MomentID: momentID,
-		Action:   "blacklist_update_removed",
+		Action:   kafka.MOMENT_ACTION_BLACKLIST_REMOVED,
 		PushIDs:  removedIDs,
-	}
-	body, err = json.Marshal(msg)
+	})
 	if err != nil {
-		uc.log.Errorf("Marshal MomentMessage error: %v", err)
-		return nil, nil, NewErr(ErrCodeMomentFailed, MOMENT_FAILED, "Marshal moment message failed")
+		return nil, nil, err
 	}
-	kafka.Send(body)
-	uc.log.Infof("Send MomentMessage to Kafka: %s", body)
 	return addedIDs, removedIDs, nil
 }
@@ -0,0 +1,281 @@
+package biz
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+
+	bizMoment "kratos-realworld/internal/biz/moments"
+	"kratos-realworld/internal/pkg/util"
+)
+
+// fakeMomentStore 内存里的动态、评论和接收盒，ID 从 1 开始递增
+type fakeMomentStore struct {
+	bizMoment.MomentRepo
+	moments  map[uint32]*bizMoment.MomentTB
+	comments map[uint32]*bizMoment.CommentsTB
+	boxes    map[uint32][]uint32  // 用户ID -> 接收盒里的动态ID
+	likes    map[[2]uint32]uint32 // (动态ID, 用户ID) -> 点赞ID
+	nextID   uint32
+}
+
+func newFakeMomentStore() *fakeMomentStore {
+	return &fakeMomentStore{
+		moments:  make(map[uint32]*bizMoment.MomentTB),
+		comments: make(map[uint32]*bizMoment.CommentsTB),
+		boxes:    make(map[uint32][]uint32),
+		likes:    make(map[[2]uint32]uint32),
+	}
+}
+
+func (r *fakeMomentStore) id() uint32 {
+	r.nextID++
+	return r.nextID
+}
+
+// add 直接放一条已经发布的动态，返回动态ID
+func (r *fakeMomentStore) add(m *bizMoment.MomentTB) uint32 {
+	m.ID = r.id()
+	if m.Visibility == "" {
+		m.Visibility = bizMoment.VisibilityPublic
+	}
+	r.moments[m.ID] = m
+	return m.ID
+}
+
+func (r *fakeMomentStore) addComment(c *bizMoment.CommentsTB) uint32 {
+	c.ID = r.id()
+	r.comments[c.ID] = c
+	return c.ID
+}
+
+func (r *fakeMomentStore) CreateMoment(ctx context.Context, moment *bizMoment.MomentTB, uploadIDs []uint32) error {
+	moment.ID = r.id()
+	copied := *moment
+	r.moments[moment.ID] = &copied
+	return nil
+}
+
+func (r *fakeMomentStore) GetMoment(ctx context.Context, momentID uint32) (*bizMoment.MomentTB, error) {
+	m, ok := r.moments[momentID]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	copied := *m
+	return &copied, nil
+}
+
+func (r *fakeMomentStore) DeleteMoment(ctx context.Context, momentID uint32) (*bizMoment.MomentTB, error) {
+	m, ok := r.moments[momentID]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	delete(r.moments, momentID)
+	return m, nil
+}
+
+func (r *fakeMomentStore) GetUploads(ctx context.Context, userID uint32, ids []uint32) (map[uint32]*bizMoment.MediaUploadTB, error) {
+	return map[uint32]*bizMoment.MediaUploadTB{}, nil
+}
+
+func (r *fakeMomentStore) CreateComment(ctx context.Context, comment *bizMoment.CommentsTB) error {
+	comment.ID = r.id()
+	copied := *comment
+	r.comments[comment.ID] = &copied
+	return nil
+}
+
+func (r *fakeMomentStore) GetComment(ctx context.Context, commentID uint32) (*bizMoment.CommentsTB, error) {
+	c, ok := r.comments[commentID]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	copied := *c
+	return &copied, nil
+}
+
+func (r *fakeMomentStore) DeleteComment(ctx context.Context, commentID uint32) ([]uint32, error) {
+	var ids []uint32
+	for id, c := range r.comments {
+		if id == commentID || c.ParentID == commentID {
+			ids = append(ids, id)
+			delete(r.comments, id)
+		}
+	}
+	return ids, nil
+}
+
+func (r *fakeMomentStore) UpdateMomentBlackList(ctx context.Context, momentID uint32, blackListIDs []uint32) ([]uint32, []uint32, error) {
+	m := r.moments[momentID]
+	added, removed := util.DiffIDs(m.BlackListIDs, blackListIDs)
+	m.BlackListIDs = blackListIDs
+	return added, removed, nil
+}
+
+func (r *fakeMomentStore) UpdateMomentVisibility(ctx context.Context, momentID uint32, visibility string, scopeIDs []uint32) error {
+	m := r.moments[momentID]
+	m.Visibility = visibility
+	m.ScopeIDs = scopeIDs
+	return nil
+}
+
+func (r *fakeMomentStore) PushToReceiveBoxes(ctx context.Context, momentID uint32, authorID uint32, userIDs []uint32, batchSize int) error {
+	for _, id := range userIDs {
+		if !inIDs(r.boxes[id], momentID) {
+			r.boxes[id] = append(r.boxes[id], momentID)
+		}
+	}
+	return nil
+}
+
+func (r *fakeMomentStore) RetractFromReceiveBoxes(ctx context.Context, momentID uint32, userIDs []uint32) error {
+	for _, id := range userIDs {
+		r.boxes[id] = excludeIDs(r.boxes[id], []uint32{momentID})
+	}
+	return nil
+}
+
+func (r *fakeMomentStore) RetractMoment(ctx context.Context, momentID uint32) error {
+	for id := range r.boxes {
+		r.boxes[id] = excludeIDs(r.boxes[id], []uint32{momentID})
+	}
+	return nil
+}
+
+// listMetas 按动态ID倒序返回满足条件的，cursor 为 0 表示第一页
+func (r *fakeMomentStore) listMetas(match func(*bizMoment.MomentTB) bool, cursor uint32, limit int) []*bizMoment.MomentsMetaTB {
+	ids := make([]uint32, 0, len(r.moments))
+	for id, m := range r.moments {
+		if (cursor == 0 || id < cursor) && match(m) {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] > ids[j] })
+	if len(ids) > limit {
+		ids = ids[:limit]
+	}
+	metas := make([]*bizMoment.MomentsMetaTB, 0, len(ids))
+	for _, id := range ids {
+		metas = append(metas, &bizMoment.MomentsMetaTB{ID: id, MomentID: id, UserID: r.moments[id].UserID})
+	}
+	return metas
+}
+
+func (r *fakeMomentStore) ListBoxMoments(ctx context.Context, userID uint32, cursor uint32, limit int) ([]*bizMoment.MomentsMetaTB, error) {
+	return r.listMetas(func(m *bizMoment.MomentTB) bool { return inIDs(r.boxes[userID], m.ID) }, cursor, limit), nil
+}
+
+func (r *fakeMomentStore) ListPullMoments(ctx context.Context, viewerID uint32, authorIDs []uint32, cursor uint32, limit int) ([]*bizMoment.MomentsMetaTB, error) {
+	return r.listMetas(func(m *bizMoment.MomentTB) bool { return m.FanoutPull && inIDs(authorIDs, m.UserID) }, cursor, limit), nil
+}
+
+// fakeSquareRepo 只记录进出广场的动态
+type fakeSquareRepo struct {
+	bizMoment.SquareRepo
+	pool map[uint32]bool
+}
+
+func newFakeSquareRepo() *fakeSquareRepo {
+	return &fakeSquareRepo{pool: make(map[uint32]bool)}
+}
+
+func (r *fakeSquareRepo) AddToSquare(ctx context.Context, meta *bizMoment.MomentsMetaTB, hotScore float64, poolSize int) error {
+	r.pool[meta.MomentID] = true
+	return nil
+}
+
+func (r *fakeSquareRepo) RemoveFromSquare(ctx context.Context, momentIDs ...uint32) error {
+	for _, id := range momentIDs {
+		delete(r.pool, id)
+	}
+	return nil
+}
+
+func (r *fakeSquareRepo) IncrSquareEngagement(ctx context.Context, momentID uint32, delta int64) (int64, bool, error) {
+	return 0, false, nil
+}
+
+func newTestMomentUsecase(mr bizMoment.MomentRepo, pr *fakeSocialRepo) *MomentUsecase {
+	return NewMomentUsecase(mr, newFakeSquareRepo(), pr, NewPrivacyPolicy(pr, log.DefaultLogger), nil, newFakeMediaStorage(), nil, log.DefaultLogger)
+}
+
+func TestCreateMomentUsesCurrentUser(t *testing.T) {
+	mr := newFakeMomentStore()
+	uc := newTestMomentUsecase(mr, newFakeSocialRepo())
+
+	moment := &bizMoment.MomentTB{UserID: 99, Message: "  hello  "}
+	assert.NoError(t, uc.CreateMoment(userCtx(1), moment, nil))
+	stored := mr.moments[moment.ID]
+	assert.Equal(t, uint32(1), stored.UserID)
+	assert.Equal(t, "hello", stored.Message)
+	assert.Equal(t, bizMoment.VisibilityPublic, stored.Visibility)
+
+	err := uc.CreateMoment(userCtx(1), &bizMoment.MomentTB{Message: "   "}, nil)
+	assert.Equal(t, INVALID_PARAMS, errors.FromError(err).Reason)
+}
+
+func TestDeleteMomentOnlyByAuthor(t *testing.T) {
+	mr := newFakeMomentStore()
+	sq := newFakeSquareRepo()
+	pr := newFakeSocialRepo()
+	uc := NewMomentUsecase(mr, sq, pr, NewPrivacyPolicy(pr, log.DefaultLogger), nil, newFakeMediaStorage(), nil, log.DefaultLogger)
+	id := mr.add(&bizMoment.MomentTB{UserID: 1, Message: "hi"})
+	sq.pool[id] = true
+
+	err := uc.DeleteMoment(userCtx(2), id)
+	assert.Equal(t, MOMENT_PERMISSION_DENIED, errors.FromError(err).Reason)
+	assert.Contains(t, mr.moments, id)
+
+	assert.NoError(t, uc.DeleteMoment(userCtx(1), id))
+	assert.NotContains(t, mr.moments, id)
+	assert.False(t, sq.pool[id])
+
+	err = uc.DeleteMoment(userCtx(1), id)
+	assert.Equal(t, MOMENT_NOT_FOUND, errors.FromError(err).Reason)
+}
+
+func TestUpdateMomentBlackListOnlyByAuthor(t *testing.T) {
+	mr := newFakeMomentStore()
+	uc := newTestMomentUsecase(mr, newFakeSocialRepo())
+	id := mr.add(&bizMoment.MomentTB{UserID: 1, BlackListIDs: []uint32{3}})
+
+	_, _, err := uc.UpdateMomentBlackList(userCtx(2), id, nil)
+	assert.Equal(t, MOMENT_PERMISSION_DENIED, errors.FromError(err).Reason)
+	assert.Equal(t, []uint32{3}, mr.moments[id].BlackListIDs)
+
+	added, removed, err := uc.UpdateMomentBlackList(userCtx(1), id, []uint32{4})
+	assert.NoError(t, err)
+	assert.Equal(t, []uint32{4}, added)
+	assert.Equal(t, []uint32{3}, removed)
+}
+
+func TestDeleteCommentByCommenterOrAuthor(t *testing.T) {
+	mr := newFakeMomentStore()
+	uc := newTestMomentUsecase(mr, newFakeSocialRepo())
+	momentID := mr.add(&bizMoment.MomentTB{UserID: 1})
+	first := mr.addComment(&bizMoment.CommentsTB{MomentID: momentID, UserID: 2})
+	reply := mr.addComment(&bizMoment.CommentsTB{MomentID: momentID, UserID: 3, ParentID: first})
+	other := mr.addComment(&bizMoment.CommentsTB{MomentID: momentID, UserID: 3})
+	otherMoment := mr.add(&bizMoment.MomentTB{UserID: 3})
+
+	// 既不是评论者也不是作者
+	err := uc.DeleteComment(userCtx(4), momentID, other)
+	assert.Equal(t, MOMENT_PERMISSION_DENIED, errors.FromError(err).Reason)
+
+	// 评论ID和动态对不上的当作不存在
+	err = uc.DeleteComment(userCtx(3), otherMoment, other)
+	assert.Equal(t, MOMENT_NOT_FOUND, errors.FromError(err).Reason)
+
+	assert.NoError(t, uc.DeleteComment(userCtx(3), momentID, other))
+	assert.NotContains(t, mr.comments, other)
+
+	// 作者删除一级评论时回复一起删除
+	assert.NoError(t, uc.DeleteComment(userCtx(1), momentID, first))
+	assert.NotContains(t, mr.comments, first)
+	assert.NotContains(t, mr.comments, reply)
+}
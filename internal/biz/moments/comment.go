@@ -4,11 +4,15 @@ import (
 	"time"
 )
 
+// CommentsTB 评论只有两层，回复楼中楼时挂在同一条一级评论下，用 ReplyToUserID 区分回复对象
 type CommentsTB struct {
-	ID       uint32 `gorm:"primarykey"`
-	MomentID uint32 `gorm:"column:moment_id;comment:动态ID"`
-	UserID   uint32 `gorm:"column:user_id;comment:发送评论的用户ID"`
-	Comment  string `gorm:"type:varchar(500);column:comment;comment:评论内容"`
+	ID            uint32 `gorm:"primarykey"`
+	MomentID      uint32 `gorm:"column:moment_id;index:idx_moment_parent,priority:1;comment:动态ID"`
+	ParentID      uint32 `gorm:"column:parent_id;not null;default:0;index:idx_moment_parent,priority:2;comment:所属一级评论ID，0表示一级评论"`
+	UserID        uint32 `gorm:"column:user_id;comment:发送评论的用户ID"`
+	ReplyToUserID uint32 `gorm:"column:reply_to_user_id;not null;default:0;comment:被回复的用户ID，0表示直接评论动态"`
+	Comment       string `gorm:"type:varchar(500);column:comment;comment:评论内容"`
+	LikeCount     uint32 `gorm:"column:like_count;not null;default:0;comment:点赞数"`
 
 	SysCreated *time.Time `gorm:"autoCreateTime;column:sys_created;type:datetime;not null;comment:创建时间" json:"sys_created"`
 	SysUpdated *time.Time `gorm:"autoUpdateTime;column:sys_updated;type:datetime;not null;comment:更新时间" json:"sys_updated"`
@@ -18,3 +22,14 @@ type CommentsTB struct {
 func (c *CommentsTB) TableName() string {
 	return "t_comments"
 }
+
+// CommentQuery 分页查询一条动态下的评论
+type CommentQuery struct {
+	MomentID uint32
+	ParentID uint32 // 0 查一级评论，否则查这条一级评论下的回复
+	// FriendsOf 不为 0 时只返回 FriendsOf 的好友和 VisibleIDs 里的用户发的评论
+	FriendsOf  uint32
+	VisibleIDs []uint32
+	Cursor     uint32 // 按ID升序，返回ID大于 Cursor 的评论
+	Limit      int
+}
@@ -0,0 +1,31 @@
+package moment
+
+import (
+	"time"
+)
+
+// MomentLikeTB 点赞记录，取消点赞时直接删除
+type MomentLikeTB struct {
+	ID       uint32 `gorm:"primarykey"`
+	MomentID uint32 `gorm:"column:moment_id;not null;uniqueIndex:uk_moment_user,priority:1;comment:动态ID"`
+	UserID   uint32 `gorm:"column:user_id;not null;uniqueIndex:uk_moment_user,priority:2;index;comment:点赞的用户ID"`
+
+	SysCreated *time.Time `gorm:"autoCreateTime;column:sys_created;type:datetime;not null;comment:创建时间" json:"sys_created"`
+}
+
+func (m *MomentLikeTB) TableName() string {
+	return "t_moment_likes"
+}
+
+// CommentLikeTB 评论点赞记录，取消点赞时直接删除
+type CommentLikeTB struct {
+	ID        uint32 `gorm:"primarykey"`
+	CommentID uint32 `gorm:"column:comment_id;not null;uniqueIndex:uk_comment_user,priority:1;comment:评论ID"`
+	UserID    uint32 `gorm:"column:user_id;not null;uniqueIndex:uk_comment_user,priority:2;comment:点赞的用户ID"`
+
+	SysCreated *time.Time `gorm:"autoCreateTime;column:sys_created;type:datetime;not null;comment:创建时间" json:"sys_created"`
+}
+
+func (c *CommentLikeTB) TableName() string {
+	return "t_comment_likes"
+}
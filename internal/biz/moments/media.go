@@ -0,0 +1,66 @@
+package moment
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+)
+
+// 动态附件类型：最多 9 张图片，或者 1 个带封面的视频
+const (
+	MediaImage = "image"
+	MediaVideo = "video"
+)
+
+// MediaUploadTB 上传到静态目录的文件，发布动态时引用，引用过的不能再用
+type MediaUploadTB struct {
+	ID           uint32 `gorm:"primarykey"`
+	UserID       uint32 `gorm:"column:user_id;not null;index;comment:上传的用户ID"`
+	Kind         string `gorm:"column:kind;type:varchar(10);not null;comment:image/video"`
+	URL          string `gorm:"column:url;type:varchar(500);not null;comment:文件地址"`
+	ThumbnailURL string `gorm:"column:thumbnail_url;type:varchar(500);comment:缩略图地址，视频没有"`
+	Width        uint32 `gorm:"column:width;comment:宽度，视频没有"`
+	Height       uint32 `gorm:"column:height;comment:高度，视频没有"`
+	BlurHash     string `gorm:"column:blur_hash;type:varchar(64);comment:blurhash 占位符，视频没有"`
+	Size         int64  `gorm:"column:size;comment:文件大小，字节"`
+	Used         bool   `gorm:"column:used;not null;comment:是否已经被动态引用"`
+
+	SysCreated *time.Time `gorm:"autoCreateTime;column:sys_created;type:datetime;not null;comment:创建时间" json:"sys_created"`
+}
+
+func (m *MediaUploadTB) TableName() string {
+	return "t_media_uploads"
+}
+
+// ErrUploadUsed 发布时引用的上传记录已经被别的动态引用
+var ErrUploadUsed = errors.New("upload already used")
+
+// MediaStorage 附件文件的存储，name 是随机生成的文件名，也是对外的 url
+type MediaStorage interface {
+	// Save 写完才可见，中途失败不会留下文件，返回写入的字节数
+	Save(ctx context.Context, name string, r io.Reader) (int64, error)
+	// Remove 文件不存在时不报错
+	Remove(ctx context.Context, name string) error
+}
+
+// MomentMediaTB 动态的附件，视频的宽高、缩略图和 blurhash 取自封面
+type MomentMediaTB struct {
+	ID           uint32 `gorm:"primarykey"`
+	MomentID     uint32 `gorm:"column:moment_id;not null;index:idx_moment_sort,priority:1;comment:动态ID"`
+	UploadID     uint32 `gorm:"column:upload_id;not null;comment:上传记录ID"`
+	Kind         string `gorm:"column:kind;type:varchar(10);not null;comment:image/video"`
+	URL          string `gorm:"column:url;type:varchar(500);not null;comment:文件地址"`
+	CoverURL     string `gorm:"column:cover_url;type:varchar(500);comment:视频封面地址"`
+	ThumbnailURL string `gorm:"column:thumbnail_url;type:varchar(500);comment:缩略图地址"`
+	Width        uint32 `gorm:"column:width;comment:宽度"`
+	Height       uint32 `gorm:"column:height;comment:高度"`
+	BlurHash     string `gorm:"column:blur_hash;type:varchar(64);comment:blurhash 占位符"`
+	Sort         int    `gorm:"column:sort;not null;index:idx_moment_sort,priority:2;comment:展示顺序，从0开始"`
+
+	SysCreated *time.Time `gorm:"autoCreateTime;column:sys_created;type:datetime;not null;comment:创建时间" json:"sys_created"`
+}
+
+func (m *MomentMediaTB) TableName() string {
+	return "t_moment_media"
+}
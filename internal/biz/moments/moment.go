@@ -5,16 +5,38 @@ import (
 	"time"
 )
 
+// 动态可见范围，作者自己总是可见，BlackListIDs 里的人总是不可见
+const (
+	VisibilityPublic    = "public"
+	VisibilityFollowers = "followers" // 关注了作者的人
+	VisibilityFriends   = "friends"
+	VisibilityPrivate   = "private"
+	VisibilityAllow     = "allow" // 只有 ScopeIDs 里的人可见
+	VisibilityHide      = "hide"  // ScopeIDs 里的人不可见
+)
+
+func IsValidVisibility(visibility string) bool {
+	switch visibility {
+	case VisibilityPublic, VisibilityFollowers, VisibilityFriends, VisibilityPrivate, VisibilityAllow, VisibilityHide:
+		return true
+	}
+	return false
+}
+
 type MomentTB struct {
-	ID           uint32       `gorm:"primarykey"`
-	UserID       uint32       `gorm:"column:user_id;comment:发送动态的用户ID"`
-	Message      string       `gorm:"type:varchar(500);column:message;comment:动态内容"`
-	MediaURL     string       `gorm:"type:varchar(500);column:media_url;comment:动态媒体URL"`
-	PushIDs      []uint32     `gorm:"column:push_ids;comment:需要推送的接收盒IDs;type:json"`
-	BlackListIDs []uint32     `gorm:"column:black_list_ids;comment:黑名单用户IDs;type:json"`
-	Comments     []CommentsTB `gorm:"foreignKey:MomentID;references:ID"`
-	LikeCount    int          `gorm:"column:like_count;comment:点赞数"`
-	LikeIDs      []uint32     `gorm:"column:like_ids;comment:点赞用户IDs;type:json"`
+	ID           uint32          `gorm:"primarykey"`
+	UserID       uint32          `gorm:"column:user_id;index:idx_user_pull,priority:1;comment:发送动态的用户ID"`
+	Message      string          `gorm:"type:varchar(500);column:message;comment:动态内容"`
+	MediaURL     string          `gorm:"type:varchar(500);column:media_url;comment:动态媒体URL，旧数据使用，新发布的附件在 Media 里"`
+	Media        []MomentMediaTB `gorm:"foreignKey:MomentID;references:ID"`
+	Tags         []string        `gorm:"column:tags;comment:话题标签;type:json;serializer:json"`
+	PushIDs      []uint32        `gorm:"column:push_ids;comment:需要推送的接收盒IDs;type:json;serializer:json"`
+	BlackListIDs []uint32        `gorm:"column:black_list_ids;comment:黑名单用户IDs;type:json;serializer:json"`
+	Visibility   string          `gorm:"column:visibility;type:varchar(16);not null;default:'public';comment:可见范围"`
+	ScopeIDs     []uint32        `gorm:"column:scope_ids;comment:allow/hide 可见范围的用户IDs;type:json;serializer:json"`
+	Comments     []CommentsTB    `gorm:"foreignKey:MomentID;references:ID"`
+	LikeCount    int             `gorm:"column:like_count;comment:点赞数，由 redis 计数定时刷回"`
+	FanoutPull   bool            `gorm:"column:fanout_pull;not null;index:idx_user_pull,priority:2;comment:粉丝数超过阈值时不写接收盒，由粉丝读时拉取"`
 
 	SysCreated *time.Time `gorm:"autoCreateTime;column:sys_created;type:datetime;not null;comment:创建时间" json:"sys_created"`
 	SysUpdated *time.Time `gorm:"autoUpdateTime;column:sys_updated;type:datetime;not null;comment:更新时间" json:"sys_updated"`
@@ -26,12 +48,47 @@ func (m *MomentTB) TableName() string {
 }
 
 type MomentRepo interface {
-	CreateMoment(ctx context.Context, moment *MomentTB) error
+	// CreateMoment 同时写入 moment.Media，并把 uploadIDs 标记为已引用，已经被引用过的返回 ErrUploadUsed
+	CreateMoment(ctx context.Context, moment *MomentTB, uploadIDs []uint32) error
 	DeleteMoment(ctx context.Context, momentID uint32) (*MomentTB, error)
 	GetMoment(ctx context.Context, momentID uint32) (*MomentTB, error)
 	CreateComment(ctx context.Context, comment *CommentsTB) error
-	DeleteComment(ctx context.Context, commentID uint32) error
+	GetComment(ctx context.Context, commentID uint32) (*CommentsTB, error)
+	// DeleteComment 一级评论连同它下面的回复一起删除，返回删除的评论ID
+	DeleteComment(ctx context.Context, commentID uint32) ([]uint32, error)
 	GetMomentMeta(ctx context.Context, momentID uint32) (*MomentsMetaTB, error)
-	GetComments(ctx context.Context, momentID uint32) ([]*CommentsTB, error)
+	ListComments(ctx context.Context, query *CommentQuery) ([]*CommentsTB, error)
 	UpdateMomentBlackList(ctx context.Context, momentID uint32, blackListIDs []uint32) ([]uint32, []uint32, error)
+	UpdateMomentVisibility(ctx context.Context, momentID uint32, visibility string, scopeIDs []uint32) error
+
+	// 信箱
+	PushToReceiveBoxes(ctx context.Context, momentID uint32, authorID uint32, userIDs []uint32, batchSize int) error
+	RetractMoment(ctx context.Context, momentID uint32) error
+	RetractFromReceiveBoxes(ctx context.Context, momentID uint32, userIDs []uint32) error
+	ListBoxMoments(ctx context.Context, userID uint32, cursor uint32, limit int) ([]*MomentsMetaTB, error)
+	ListPullMoments(ctx context.Context, viewerID uint32, authorIDs []uint32, cursor uint32, limit int) ([]*MomentsMetaTB, error)
+
+	// 附件
+	CreateUpload(ctx context.Context, upload *MediaUploadTB) error
+	// GetUploads 只返回 userID 上传的、还没被引用的
+	GetUploads(ctx context.Context, userID uint32, ids []uint32) (map[uint32]*MediaUploadTB, error)
+	// ListOrphanUploads 在 before 之前上传、一直没被引用的
+	ListOrphanUploads(ctx context.Context, before time.Time, limit int) ([]*MediaUploadTB, error)
+	// DeleteOrphanUpload 只在还没被引用时删除，返回是否删除了
+	DeleteOrphanUpload(ctx context.Context, id uint32) (bool, error)
+
+	// 点赞
+	CreateLike(ctx context.Context, like *MomentLikeTB) (bool, error)
+	// DeleteLike 没有点过赞时返回 gorm.ErrRecordNotFound
+	DeleteLike(ctx context.Context, momentID uint32, userID uint32) (*MomentLikeTB, error)
+	HasLiked(ctx context.Context, momentID uint32, userID uint32) (bool, error)
+	GetLikeCounts(ctx context.Context, momentIDs []uint32) (map[uint32]int64, error)
+	PopPendingLikeCounts(ctx context.Context, limit int) ([]uint32, error)
+	AddPendingLikeCounts(ctx context.Context, momentIDs []uint32) error
+	UpdateLikeCounts(ctx context.Context, counts map[uint32]int64) error
+
+	// 评论点赞
+	CreateCommentLike(ctx context.Context, like *CommentLikeTB) (bool, error)
+	DeleteCommentLike(ctx context.Context, commentID uint32, userID uint32) (bool, error)
+	FilterLikedComments(ctx context.Context, userID uint32, commentIDs []uint32) ([]uint32, error)
 }
@@ -4,15 +4,21 @@ import (
 	"time"
 )
 
+// 信箱类型：发送盒是作者自己发的动态，接收盒是推送给粉丝的动态
+const (
+	BoxSend    = "send"
+	BoxReceive = "receive"
+)
+
+// MomentsBoxTB 用户信箱，每行是一条指向 MomentsMetaTB 的引用，撤回时直接删除
 type MomentsBoxTB struct {
-	ID         uint32          `gorm:"primarykey"`
-	UserID     uint32          `gorm:"column:user_id;comment:用户ID"`
-	SendBox    []MomentsMetaTB `gorm:"foreignKey:UserID;references:UserID;comment:发送盒;type:json"`
-	ReceiveBox []MomentsMetaTB `gorm:"foreignKey:UserID;references:UserID;comment:接收盒;type:json"`
+	ID       uint32 `gorm:"primarykey"`
+	UserID   uint32 `gorm:"column:user_id;not null;uniqueIndex:uk_user_box_moment,priority:1;comment:信箱所属用户ID"`
+	Box      string `gorm:"column:box;type:varchar(10);not null;uniqueIndex:uk_user_box_moment,priority:2;comment:send发送盒/receive接收盒"`
+	MomentID uint32 `gorm:"column:moment_id;not null;uniqueIndex:uk_user_box_moment,priority:3;index;comment:动态ID"`
+	AuthorID uint32 `gorm:"column:author_id;not null;comment:动态作者ID"`
 
 	SysCreated *time.Time `gorm:"autoCreateTime;column:sys_created;type:datetime;not null;comment:创建时间" json:"sys_created"`
-	SysUpdated *time.Time `gorm:"autoUpdateTime;column:sys_updated;type:datetime;not null;comment:更新时间" json:"sys_updated"`
-	DeletedAt  *uint64    `gorm:"column:deleted_at;type:bigint unsigned;default:null;comment:删除时间戳" json:"deleted_at"` // 删除时间戳
 }
 
 func (m *MomentsBoxTB) TableName() string {
@@ -5,11 +5,14 @@ import (
 )
 
 type MomentsMetaTB struct {
-	ID       uint32 `gorm:"primarykey"`
-	UserID   uint32 `gorm:"column:user_id"`
-	MomentID uint32 `gorm:"column:moment_id"`
-	Message  string `gorm:"type:varchar(500);column:message"`
-	MediaURL string `gorm:"type:varchar(500);column:media_url"`
+	ID       uint32   `gorm:"primarykey"`
+	UserID   uint32   `gorm:"column:user_id"`
+	MomentID uint32   `gorm:"column:moment_id"`
+	Message  string   `gorm:"type:varchar(500);column:message"`
+	MediaURL string   `gorm:"type:varchar(500);column:media_url"`
+	Tags     []string `gorm:"column:tags;type:json;serializer:json"`
+	// Thumbnail 第一个附件的缩略图，列表里只展示这一张
+	Thumbnail string `gorm:"type:varchar(500);column:thumbnail"`
 
 	SysCreated *time.Time `gorm:"autoCreateTime;column:sys_created;type:datetime;not null;comment:创建时间" json:"sys_created"`
 	SysUpdated *time.Time `gorm:"autoUpdateTime;column:sys_updated;type:datetime;not null;comment:更新时间" json:"sys_updated"`
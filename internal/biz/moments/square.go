@@ -0,0 +1,22 @@
+package moment
+
+import (
+	"context"
+	"time"
+)
+
+// SquareRepo 广场动态池，保存在 redis，只收录所有人可见的动态的缩略数据
+type SquareRepo interface {
+	AddToSquare(ctx context.Context, meta *MomentsMetaTB, hotScore float64, poolSize int) error
+	RemoveFromSquare(ctx context.Context, momentIDs ...uint32) error
+	RemoveUserFromSquare(ctx context.Context, userID uint32) error
+	// IncrSquareEngagement 动态不在池子里时返回 false
+	IncrSquareEngagement(ctx context.Context, momentID uint32, delta int64) (int64, bool, error)
+	SetSquareHotScore(ctx context.Context, momentID uint32, hotScore float64) error
+	ListSquareHot(ctx context.Context, limit int) ([]uint32, error)
+	// ListSquareLatest tag 为空时查全部，cursor 为 0 表示第一页
+	ListSquareLatest(ctx context.Context, tag string, cursor uint32, limit int) ([]uint32, error)
+	GetSquareMetas(ctx context.Context, momentIDs []uint32) (map[uint32]*MomentsMetaTB, error)
+	GetSquareSeen(ctx context.Context, viewerID uint32, sessionID string) ([]uint32, error)
+	MarkSquareSeen(ctx context.Context, viewerID uint32, sessionID string, momentIDs []uint32, ttl time.Duration) error
+}
@@ -0,0 +1,30 @@
+package biz
+
+import (
+	"encoding/json"
+	v1 "kratos-realworld/api/conduit/v1"
+	"kratos-realworld/internal/common"
+	kafka "kratos-realworld/internal/kafka"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// pushSystemNotify 通过消息队列把系统通知推给指定用户，websocket 服务端按 To 投递给在线连接
+func pushSystemNotify(to string, notifyType string, payload interface{}) error {
+	content, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	msg := &v1.Message{
+		From:    common.SYSTEM,
+		To:      to,
+		Type:    notifyType,
+		Content: string(content),
+	}
+	body, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	kafka.Send(body)
+	return nil
+}
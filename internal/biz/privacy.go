@@ -0,0 +1,223 @@
+package biz
+
+import (
+	"context"
+	bizProfile "kratos-realworld/internal/biz/profile"
+	"kratos-realworld/internal/pkg/middleware/auth"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// PrivacyPolicy 所有隐私相关的判断都走这里，设置由 repo 按用户缓存在 redis
+type PrivacyPolicy struct {
+	pr  bizProfile.ProfileRepo
+	log *log.Helper
+}
+
+func NewPrivacyPolicy(pr bizProfile.ProfileRepo, logger log.Logger) *PrivacyPolicy {
+	return &PrivacyPolicy{
+		pr:  pr,
+		log: log.NewHelper(logger),
+	}
+}
+
+func (pp *PrivacyPolicy) Settings(ctx context.Context, userID uint32) (*bizProfile.PrivacySettingTB, error) {
+	settings, err := pp.pr.GetPrivacySettings(ctx, []uint32{userID})
+	if err != nil {
+		return nil, NewErr(ErrCodeDBQueryFailed, DB_QUERY_FAILED, "failed to query privacy settings")
+	}
+	return settings[userID], nil
+}
+
+// filterAudience 返回 viewerIDs 中在 ownerID 设置的 audience 范围内的人，owner 自己总是可见
+func (pp *PrivacyPolicy) filterAudience(ctx context.Context, audience string, ownerID uint32, viewerIDs []uint32) ([]uint32, error) {
+	var allowed []uint32
+	var err error
+	switch audience {
+	case bizProfile.AudienceFollowers:
+		allowed, err = pp.pr.FilterFollowers(ctx, ownerID, viewerIDs)
+	case bizProfile.AudienceFriends:
+		allowed, err = pp.pr.FilterFriends(ctx, ownerID, viewerIDs)
+	case bizProfile.AudienceNobody:
+	default:
+		return viewerIDs, nil
+	}
+	if err != nil {
+		return nil, NewErr(ErrCodeDBQueryFailed, DB_QUERY_FAILED, "failed to query relationship")
+	}
+	for _, id := range viewerIDs {
+		if id == ownerID {
+			allowed = append(allowed, id)
+		}
+	}
+	return allowed, nil
+}
+
+func (pp *PrivacyPolicy) allows(ctx context.Context, audience string, ownerID uint32, viewerID uint32) (bool, error) {
+	if ownerID == viewerID || audience == bizProfile.AudienceEveryone {
+		return true, nil
+	}
+	allowed, err := pp.filterAudience(ctx, audience, ownerID, []uint32{viewerID})
+	if err != nil {
+		return false, err
+	}
+	return len(allowed) > 0, nil
+}
+
+// CheckCanMessage 单聊发送前校验接收者的私信设置
+func (pp *PrivacyPolicy) CheckCanMessage(ctx context.Context, senderID uint32, receiverID uint32) error {
+	setting, err := pp.Settings(ctx, receiverID)
+	if err != nil {
+		return err
+	}
+	ok, err := pp.allows(ctx, setting.WhoCanMessage, receiverID, senderID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return NewErr(ErrCodePrivacyRestricted, PRIVACY_RESTRICTED, "this user does not accept messages from you")
+	}
+	return nil
+}
+
+// CheckCanViewFollowList 查看别人的关注、粉丝列表前校验
+func (pp *PrivacyPolicy) CheckCanViewFollowList(ctx context.Context, ownerID uint32, viewerID uint32) error {
+	setting, err := pp.Settings(ctx, ownerID)
+	if err != nil {
+		return err
+	}
+	ok, err := pp.allows(ctx, setting.FollowListVisibility, ownerID, viewerID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return NewErr(ErrCodePrivacyRestricted, PRIVACY_RESTRICTED, "this user's follow list is private")
+	}
+	return nil
+}
+
+// CheckCanViewMoments 查看别人的动态前校验作者的动态默认可见范围
+func (pp *PrivacyPolicy) CheckCanViewMoments(ctx context.Context, authorID uint32, viewerID uint32) error {
+	setting, err := pp.Settings(ctx, authorID)
+	if err != nil {
+		return err
+	}
+	ok, err := pp.allows(ctx, setting.MomentVisibility, authorID, viewerID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return NewErr(ErrCodePrivacyRestricted, PRIVACY_RESTRICTED, "you are not allowed to view this user's moments")
+	}
+	return nil
+}
+
+// FilterMomentAudience 发布动态时只推送给作者可见范围内的人
+func (pp *PrivacyPolicy) FilterMomentAudience(ctx context.Context, authorID uint32, viewerIDs []uint32) ([]uint32, error) {
+	setting, err := pp.Settings(ctx, authorID)
+	if err != nil {
+		return nil, err
+	}
+	return pp.filterAudience(ctx, setting.MomentVisibility, authorID, viewerIDs)
+}
+
+// CanSeeLastSeen 查询失败时按不可见处理
+func (pp *PrivacyPolicy) CanSeeLastSeen(ctx context.Context, ownerID uint32, viewerID uint32) bool {
+	setting, err := pp.Settings(ctx, ownerID)
+	if err != nil {
+		pp.log.Warnf("failed to query privacy settings of %d: %v", ownerID, err)
+		return false
+	}
+	ok, err := pp.allows(ctx, setting.LastSeenVisibility, ownerID, viewerID)
+	if err != nil {
+		pp.log.Warnf("failed to check last seen visibility of %d: %v", ownerID, err)
+		return false
+	}
+	return ok
+}
+
+func (pp *PrivacyPolicy) DiscoverableByPhone(ctx context.Context, userID uint32) (bool, error) {
+	setting, err := pp.Settings(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	return setting.DiscoverableByPhone, nil
+}
+
+// FilterUndiscoverable 去掉关闭了搜索和推荐的人，viewer 自己保留
+func (pp *PrivacyPolicy) FilterUndiscoverable(ctx context.Context, viewerID uint32, userIDs []uint32) (map[uint32]struct{}, error) {
+	settings, err := pp.pr.GetPrivacySettings(ctx, userIDs)
+	if err != nil {
+		return nil, NewErr(ErrCodeDBQueryFailed, DB_QUERY_FAILED, "failed to query privacy settings")
+	}
+	hidden := make(map[uint32]struct{})
+	for id, s := range settings {
+		if id != viewerID && !s.DiscoverableBySearch {
+			hidden[id] = struct{}{}
+		}
+	}
+	return hidden, nil
+}
+
+// PrivacySettingsUpdate 为 nil 的字段保持不变
+type PrivacySettingsUpdate struct {
+	WhoCanMessage        *string
+	MomentVisibility     *string
+	FollowListVisibility *string
+	LastSeenVisibility   *string
+	DiscoverableByPhone  *bool
+	DiscoverableBySearch *bool
+}
+
+// GetPrivacySettings 当前用户的隐私设置
+func (pc *ProfileUsecase) GetPrivacySettings(ctx context.Context) (*bizProfile.PrivacySettingTB, error) {
+	userID := uint32(auth.FromContext(ctx).UserID)
+	return pc.privacy.Settings(ctx, userID)
+}
+
+// UpdatePrivacySettings 只修改传了的字段，返回修改后的完整设置
+func (pc *ProfileUsecase) UpdatePrivacySettings(ctx context.Context, update *PrivacySettingsUpdate) (*bizProfile.PrivacySettingTB, error) {
+	userID := uint32(auth.FromContext(ctx).UserID)
+	setting, err := pc.privacy.Settings(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	wasPublic := setting.MomentVisibility == bizProfile.AudienceEveryone
+
+	audiences := []struct {
+		value *string
+		field *string
+	}{
+		{update.WhoCanMessage, &setting.WhoCanMessage},
+		{update.MomentVisibility, &setting.MomentVisibility},
+		{update.FollowListVisibility, &setting.FollowListVisibility},
+		{update.LastSeenVisibility, &setting.LastSeenVisibility},
+	}
+	for _, a := range audiences {
+		if a.value == nil {
+			continue
+		}
+		if !bizProfile.IsValidAudience(*a.value) {
+			return nil, NewErr(ErrCodeInvalidParams, INVALID_PARAMS, "audience must be one of everyone, followers, friends, nobody")
+		}
+		*a.field = *a.value
+	}
+	if update.DiscoverableByPhone != nil {
+		setting.DiscoverableByPhone = *update.DiscoverableByPhone
+	}
+	if update.DiscoverableBySearch != nil {
+		setting.DiscoverableBySearch = *update.DiscoverableBySearch
+	}
+
+	if err := pc.pr.SavePrivacySetting(ctx, setting); err != nil {
+		return nil, NewErr(ErrCodeDBQueryFailed, DB_QUERY_FAILED, "failed to save privacy settings")
+	}
+
+	// 不再公开动态的作者，已经在广场里的动态全部移出
+	if wasPublic && setting.MomentVisibility != bizProfile.AudienceEveryone {
+		if err := pc.sq.RemoveUserFromSquare(ctx, userID); err != nil {
+			pc.log.Warnf("failed to remove user %d from square: %v", userID, err)
+		}
+	}
+	return setting, nil
+}
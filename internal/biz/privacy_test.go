@@ -0,0 +1,56 @@
+package biz
+
+import (
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+
+	bizProfile "kratos-realworld/internal/biz/profile"
+)
+
+func TestUpdatePrivacySettingsOnlyChangesGivenFields(t *testing.T) {
+	pr := newFakeSocialRepo()
+	pc := newSocialUsecase(pr, newFakeUserRepo(1))
+
+	friends := bizProfile.AudienceFriends
+	_, err := pc.UpdatePrivacySettings(userCtx(1), &PrivacySettingsUpdate{FollowListVisibility: &friends})
+	assert.NoError(t, err)
+
+	// 老接口只传手机号搜索开关，不能把其它设置重置成默认值
+	off := false
+	setting, err := pc.UpdatePrivacySettings(userCtx(1), &PrivacySettingsUpdate{DiscoverableByPhone: &off})
+	assert.NoError(t, err)
+	assert.False(t, setting.DiscoverableByPhone)
+	assert.Equal(t, bizProfile.AudienceFriends, setting.FollowListVisibility)
+	assert.True(t, setting.DiscoverableBySearch)
+
+	discoverable, err := pc.privacy.DiscoverableByPhone(userCtx(1), 1)
+	assert.NoError(t, err)
+	assert.False(t, discoverable)
+}
+
+func TestUpdatePrivacySettingsRejectsInvalidAudience(t *testing.T) {
+	pr := newFakeSocialRepo()
+	pc := newSocialUsecase(pr, newFakeUserRepo(1))
+
+	bad := "strangers"
+	_, err := pc.UpdatePrivacySettings(userCtx(1), &PrivacySettingsUpdate{WhoCanMessage: &bad})
+	assert.Equal(t, INVALID_PARAMS, errors.FromError(err).Reason)
+	assert.Empty(t, pr.privacy)
+}
+
+func TestFilterUndiscoverableKeepsViewer(t *testing.T) {
+	pr := newFakeSocialRepo()
+	for _, id := range []uint32{1, 2} {
+		s := bizProfile.DefaultPrivacySetting(id)
+		s.DiscoverableBySearch = false
+		pr.privacy[id] = s
+	}
+	pp := NewPrivacyPolicy(pr, log.DefaultLogger)
+
+	hidden, err := pp.FilterUndiscoverable(userCtx(1), 1, []uint32{1, 2, 3})
+	assert.NoError(t, err)
+	assert.Equal(t, map[uint32]struct{}{2: {}}, hidden)
+}
@@ -3,7 +3,9 @@ package biz
 import (
 	"context"
 	"errors"
+	bizMoment "kratos-realworld/internal/biz/moments"
 	bizProfile "kratos-realworld/internal/biz/profile"
+	bizUser "kratos-realworld/internal/biz/user"
 	"kratos-realworld/internal/conf"
 	"kratos-realworld/internal/model"
 	"kratos-realworld/internal/pkg/middleware/auth"
@@ -14,18 +16,27 @@ import (
 
 type ProfileUsecase struct {
 	pr bizProfile.ProfileRepo
+	ur bizUser.UserRepo
 	tx model.Transaction
+	lk model.Locker
+
+	privacy *PrivacyPolicy
+	sq      bizMoment.SquareRepo
 
 	jwtc *conf.JWT
 	log  *log.Helper
 }
 
-func NewProfileUsecase(pr bizProfile.ProfileRepo, tx model.Transaction, jwtc *conf.JWT, logger log.Logger) *ProfileUsecase {
+func NewProfileUsecase(pr bizProfile.ProfileRepo, ur bizUser.UserRepo, tx model.Transaction, lk model.Locker, privacy *PrivacyPolicy, sq bizMoment.SquareRepo, jwtc *conf.JWT, logger log.Logger) *ProfileUsecase {
 	return &ProfileUsecase{
 		pr:   pr,
+		ur:   ur,
 		tx:   tx,
-		jwtc: jwtc,
-		log:  log.NewHelper(logger),
+		lk:      lk,
+		privacy: privacy,
+		sq:      sq,
+		jwtc:    jwtc,
+		log:     log.NewHelper(logger),
 	}
 }
 
@@ -35,21 +46,29 @@ func (pc *ProfileUsecase) GetProfile(ctx context.Context, userID string) (*UserP
 	if err != nil {
 		return nil, NewErr(ErrCodeDBQueryFailed, DB_QUERY_FAILED, "failed to query profile by UserID")
 	}
+	viewerID := uint32(auth.FromContext(ctx).UserID)
+	pc.recordProfileView(ctx, res.UserID, viewerID)
 
-	return &UserProfileReply{
+	reply := &UserProfileReply{
 		UserID:            res.UserID,
 		Tags:              res.Tags,
 		FollowCount:       res.FollowCount,
 		FanCount:          res.FanCount,
-		ViewCount:         res.ViewCount,
+		ViewCount:         res.ViewCount + pc.pendingViewCount(ctx, res.UserID),
 		NoteCount:         res.NoteCount,
 		ReceivedLikeCount: res.ReceivedLikeCount,
 		CollectedCount:    res.CollectedCount,
 		CommentCount:      res.CommentCount,
-		LastLoginIP:       res.LastLoginIP,
-		LastActive:        res.LastActive,
 		Status:            res.Status,
-	}, nil
+	}
+	// 登录IP只有自己能看到，最后活跃时间按对方的隐私设置
+	if viewerID == res.UserID {
+		reply.LastLoginIP = res.LastLoginIP
+	}
+	if pc.privacy.CanSeeLastSeen(ctx, res.UserID, viewerID) {
+		reply.LastActive = res.LastActive
+	}
+	return reply, nil
 }
 
 func (pc *ProfileUsecase) FollowUser(ctx context.Context, targetID string) (*UserFollowFanReply, error) {
@@ -64,6 +83,11 @@ func (pc *ProfileUsecase) FollowUser(ctx context.Context, targetID string) (*Use
 		return nil, NewErr(ErrCodeFollowFailed, FOLLOW_USER_FAILED, "user cannot follow themselves")
 	}
 
+	// 双方有拉黑关系时不能关注
+	if err := checkBlocked(ctx, pc.pr, uint32(userID), uint32(tID)); err != nil {
+		return nil, err
+	}
+
 	// 先判断是否已经关注了
 	isFollow, _ := pc.pr.CheckFollow(ctx, uint32(userID), uint32(tID))
 	if isFollow {
@@ -91,6 +115,7 @@ func (pc *ProfileUsecase) FollowUser(ctx context.Context, targetID string) (*Use
 	if err != nil {
 		return nil, err
 	}
+	pc.removeSuggestion(ctx, uint32(userID), uint32(tID))
 
 	return &UserFollowFanReply{
 		SelfID:      uint32(userID),
@@ -186,21 +211,3 @@ func (pc *ProfileUsecase) GetRelationship(ctx context.Context, targetID string)
 		IsFriend:     isFriend,
 	}, nil
 }
-
-func (pc *ProfileUsecase) CanAddFriend(ctx context.Context, targetID string) (bool, error) {
-	//userID := auth.FromContext(ctx).UserID
-	//// 参数：字符串, 进制(10), 位数(32)
-	//tID, err := strconv.ParseUint(targetID, 10, 32)
-	//if err != nil {
-	//	return nil, errors.New("string convert error")
-	//}
-	//
-	//res, err := pc.pr.CanAddFriend(ctx, userID, tID)
-	//if err != nil {
-	//	return false, err
-	//}
-	//
-	//return res, nil
-
-	return true, nil
-}
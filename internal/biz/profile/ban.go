@@ -0,0 +1,37 @@
+package profile
+
+import "time"
+
+// ProfileTB.Status 的取值
+const (
+	ProfileStatusActive = "active"
+	ProfileStatusBanned = "ban"
+)
+
+// BanAuditTB.Action 的取值
+const (
+	BanActionBan   = "ban"
+	BanActionUnban = "unban"
+)
+
+// BanAuditTB 封禁、解封的操作记录，只追加不修改
+type BanAuditTB struct {
+	ID          uint32     `gorm:"column:id;type:int(10) unsigned;primary_key;AUTO_INCREMENT" json:"id"`
+	UserID      uint32     `gorm:"column:user_id;type:int(10) unsigned;not null;index;comment:被封禁的用户ID" json:"user_id"`
+	OperatorID  uint32     `gorm:"column:operator_id;type:int(10) unsigned;not null;comment:操作的管理员ID" json:"operator_id"`
+	Action      string     `gorm:"column:action;type:varchar(16);not null;comment:操作 ban/unban" json:"action"`
+	Reason      string     `gorm:"column:reason;type:varchar(255);comment:原因" json:"reason"`
+	BannedUntil *time.Time `gorm:"column:banned_until;type:datetime;default null;comment:封禁截止时间" json:"banned_until"`
+
+	SysCreated *time.Time `gorm:"autoCreateTime;column:sys_created;type:datetime;default null;comment:创建时间;NOT NULL" json:"sys_created"`
+}
+
+func (b *BanAuditTB) TableName() string {
+	return "t_user_ban_audit"
+}
+
+// BanInfo 当前的封禁状态，按用户缓存在 redis
+type BanInfo struct {
+	Until  time.Time `json:"until"`
+	Reason string    `json:"reason"`
+}
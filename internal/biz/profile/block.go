@@ -0,0 +1,17 @@
+package profile
+
+import "time"
+
+// BlockTB 拉黑关系，UserID 拉黑了 BlockedID
+type BlockTB struct {
+	ID        uint32 `gorm:"column:id;type:int(10) unsigned;primary_key;AUTO_INCREMENT" json:"id"`
+	UserID    uint32 `gorm:"column:user_id;type:int(10) unsigned;not null;uniqueIndex:idx_user_blocked;comment:拉黑操作人ID" json:"user_id"`
+	BlockedID uint32 `gorm:"column:blocked_id;type:int(10) unsigned;not null;uniqueIndex:idx_user_blocked;comment:被拉黑的用户ID" json:"blocked_id"`
+
+	SysCreated *time.Time `gorm:"autoCreateTime;column:sys_created;type:datetime;default null;comment:创建时间;NOT NULL" json:"sys_created"`
+	SysUpdated *time.Time `gorm:"autoUpdateTime;column:sys_updated;type:datetime;default null;comment:修改时间;NOT NULL" json:"sys_updated"`
+}
+
+func (b *BlockTB) TableName() string {
+	return "t_user_block"
+}
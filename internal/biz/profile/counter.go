@@ -0,0 +1,24 @@
+package profile
+
+import "time"
+
+// CounterEventTB 已经应用过的计数事件，和计数更新在同一个事务里写入，重复投递时靠唯一索引跳过
+type CounterEventTB struct {
+	ID        uint32 `gorm:"column:id;type:int(10) unsigned;primary_key;AUTO_INCREMENT" json:"id"`
+	EventID   string `gorm:"column:event_id;type:varchar(128);not null;uniqueIndex;comment:事件ID" json:"event_id"`
+	EventType string `gorm:"column:event_type;type:varchar(32);not null;comment:事件类型" json:"event_type"`
+	UserID    uint32 `gorm:"column:user_id;type:int(10) unsigned;not null;comment:计数归属的用户ID" json:"user_id"`
+
+	SysCreated *time.Time `gorm:"autoCreateTime;column:sys_created;type:datetime;default null;comment:创建时间;NOT NULL;index" json:"sys_created"`
+}
+
+func (c *CounterEventTB) TableName() string {
+	return "t_user_counter_event"
+}
+
+// 可以由事件驱动更新的 profile 统计字段，收藏功能还没有，collected_count 暂时不由事件驱动
+const (
+	CounterNote         = "note_count"
+	CounterReceivedLike = "received_like_count"
+	CounterComment      = "comment_count"
+)
@@ -0,0 +1,46 @@
+package profile
+
+import "time"
+
+// 好友申请状态
+const (
+	FriendRequestPending  uint16 = 0 // 待处理
+	FriendRequestAccepted uint16 = 1 // 已同意
+	FriendRequestDeclined uint16 = 2 // 已拒绝
+	FriendRequestIgnored  uint16 = 3 // 已忽略，不通知申请人
+	FriendRequestCanceled uint16 = 4 // 拉黑后作废
+)
+
+// FriendRequestTB 好友申请，同一对用户同时只保留一条待处理的申请
+type FriendRequestTB struct {
+	ID       uint32 `gorm:"column:id;type:int(10) unsigned;primary_key;AUTO_INCREMENT" json:"id"`
+	FromID   uint32 `gorm:"column:from_id;type:int(10) unsigned;not null;index:idx_from_status;comment:申请人ID" json:"from_id"`
+	ToID     uint32 `gorm:"column:to_id;type:int(10) unsigned;not null;index:idx_to_status;comment:被申请人ID" json:"to_id"`
+	Greeting string `gorm:"column:greeting;type:varchar(255);comment:打招呼内容" json:"greeting"`
+	Status   uint16 `gorm:"column:status;type:smallint unsigned;not null;default:0;index:idx_from_status;index:idx_to_status;comment:状态：0待处理 1已同意 2已拒绝 3已忽略" json:"status"`
+	// 待处理时为 "小ID_大ID"，处理后置空；唯一索引保证两人之间同时只有一条待处理申请，双方同时申请也只会插入一条
+	PendingPair *string `gorm:"column:pending_pair;type:varchar(32);default:null;uniqueIndex:idx_pending_pair;comment:待处理申请的双方" json:"-"`
+
+	HandledAt *time.Time `gorm:"column:handled_at;type:datetime;default:null;comment:处理时间" json:"handled_at"`
+
+	SysCreated *time.Time `gorm:"autoCreateTime;column:sys_created;type:datetime;default null;comment:创建时间;NOT NULL" json:"sys_created"`
+	SysUpdated *time.Time `gorm:"autoUpdateTime;column:sys_updated;type:datetime;default null;comment:修改时间;NOT NULL" json:"sys_updated"`
+}
+
+func (f *FriendRequestTB) TableName() string {
+	return "t_user_friend_request"
+}
+
+// FriendTB 好友关系，双向各存一条，方便按用户分页查询
+type FriendTB struct {
+	ID       uint32 `gorm:"column:id;type:int(10) unsigned;primary_key;AUTO_INCREMENT" json:"id"`
+	UserID   uint32 `gorm:"column:user_id;type:int(10) unsigned;not null;uniqueIndex:idx_user_friend;comment:用户ID" json:"user_id"`
+	FriendID uint32 `gorm:"column:friend_id;type:int(10) unsigned;not null;uniqueIndex:idx_user_friend;comment:好友ID" json:"friend_id"`
+
+	SysCreated *time.Time `gorm:"autoCreateTime;column:sys_created;type:datetime;default null;comment:创建时间;NOT NULL" json:"sys_created"`
+	SysUpdated *time.Time `gorm:"autoUpdateTime;column:sys_updated;type:datetime;default null;comment:修改时间;NOT NULL" json:"sys_updated"`
+}
+
+func (f *FriendTB) TableName() string {
+	return "t_user_friend"
+}
@@ -0,0 +1,26 @@
+package profile
+
+import "time"
+
+// LoginHistoryTB.Source 的取值
+const (
+	LoginSourcePassword  = "password"
+	LoginSourceSms       = "sms"
+	LoginSourceWebsocket = "websocket"
+)
+
+// LoginHistoryTB 每次登录和建立 websocket 连接的记录，只追加不修改
+type LoginHistoryTB struct {
+	ID        uint32 `gorm:"column:id;type:int(10) unsigned;primary_key;AUTO_INCREMENT" json:"id"`
+	UserID    uint32 `gorm:"column:user_id;type:int(10) unsigned;not null;index;comment:用户ID" json:"user_id"`
+	Source    string `gorm:"column:source;type:varchar(16);not null;comment:来源 password/sms/websocket" json:"source"`
+	IP        string `gorm:"column:ip;type:varchar(45);comment:客户端IP;default:null" json:"ip"`
+	UserAgent string `gorm:"column:user_agent;type:varchar(255);comment:客户端User-Agent;default:null" json:"user_agent"`
+	Platform  string `gorm:"column:platform;type:varchar(20);comment:客户端平台 ios/android/windows等;default:null" json:"platform"`
+
+	SysCreated *time.Time `gorm:"autoCreateTime;column:sys_created;type:datetime;default null;comment:创建时间;NOT NULL" json:"sys_created"`
+}
+
+func (l *LoginHistoryTB) TableName() string {
+	return "t_user_login_history"
+}
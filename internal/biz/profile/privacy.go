@@ -0,0 +1,50 @@
+package profile
+
+import "time"
+
+// 隐私设置里可见范围、可发消息范围的取值
+const (
+	AudienceEveryone  = "everyone"
+	AudienceFollowers = "followers" // 关注了我的人
+	AudienceFriends   = "friends"
+	AudienceNobody    = "nobody"
+)
+
+// PrivacySettingTB 每个用户最多一行，没有保存过时使用 DefaultPrivacySetting
+// 布尔字段不设置数据库默认值，否则 gorm 插入 false 时会被默认值覆盖
+type PrivacySettingTB struct {
+	UserID               uint32 `gorm:"column:user_id;type:int(10) unsigned;primary_key;comment:用户ID" json:"user_id"`
+	WhoCanMessage        string `gorm:"column:who_can_message;type:varchar(16);not null;comment:谁可以给我发私信" json:"who_can_message"`
+	MomentVisibility     string `gorm:"column:moment_visibility;type:varchar(16);not null;comment:动态默认可见范围" json:"moment_visibility"`
+	FollowListVisibility string `gorm:"column:follow_list_visibility;type:varchar(16);not null;comment:谁可以查看我的关注、粉丝列表" json:"follow_list_visibility"`
+	LastSeenVisibility   string `gorm:"column:last_seen_visibility;type:varchar(16);not null;comment:谁可以看到我的最后活跃时间" json:"last_seen_visibility"`
+	DiscoverableByPhone  bool   `gorm:"column:discoverable_by_phone;type:tinyint(1);not null;comment:允许别人通过手机号搜索到我" json:"discoverable_by_phone"`
+	DiscoverableBySearch bool   `gorm:"column:discoverable_by_search;type:tinyint(1);not null;comment:允许出现在用户名搜索和推荐里" json:"discoverable_by_search"`
+
+	SysCreated *time.Time `gorm:"autoCreateTime;column:sys_created;type:datetime;default null;comment:创建时间;NOT NULL" json:"sys_created"`
+	SysUpdated *time.Time `gorm:"autoUpdateTime;column:sys_updated;type:datetime;default null;comment:修改时间;NOT NULL" json:"sys_updated"`
+}
+
+func (p *PrivacySettingTB) TableName() string {
+	return "t_user_privacy_setting"
+}
+
+func DefaultPrivacySetting(userID uint32) *PrivacySettingTB {
+	return &PrivacySettingTB{
+		UserID:               userID,
+		WhoCanMessage:        AudienceEveryone,
+		MomentVisibility:     AudienceEveryone,
+		FollowListVisibility: AudienceEveryone,
+		LastSeenVisibility:   AudienceEveryone,
+		DiscoverableByPhone:  true,
+		DiscoverableBySearch: true,
+	}
+}
+
+func IsValidAudience(audience string) bool {
+	switch audience {
+	case AudienceEveryone, AudienceFollowers, AudienceFriends, AudienceNobody:
+		return true
+	}
+	return false
+}
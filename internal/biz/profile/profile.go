@@ -24,6 +24,11 @@ type ProfileTB struct {
 	LastLoginIP string     `gorm:"column:last_login_ip;type:varchar(45);comment:最后登录IP;default:null" json:"last_login_ip"`
 	LastActive  *time.Time `gorm:"column:last_active;type:datetime;default null;comment:最后活跃时间" json:"last_active"`
 	Status      string     `gorm:"column:status;type:varchar(20);default:'active';comment:用户状态 active/ban/etc" json:"status"`
+	BannedUntil *time.Time `gorm:"column:banned_until;type:datetime;default null;comment:封禁截止时间" json:"banned_until"`
+	BanReason   string     `gorm:"column:ban_reason;type:varchar(255);comment:封禁原因;default:null" json:"ban_reason"`
+
+	// 隐私设置
+	HideVisits bool `gorm:"column:hide_visits;type:tinyint(1);default:0;comment:浏览别人主页时不出现在对方的访客列表" json:"hide_visits"`
 
 	SysCreated *time.Time `gorm:"autoCreateTime;column:sys_created;type:datetime;default null;comment:创建时间;NOT NULL" json:"sys_created"`
 	SysUpdated *time.Time `gorm:"autoUpdateTime;column:sys_updated;type:datetime;default null;comment:修改时间;NOT NULL" json:"sys_updated"`
@@ -61,21 +66,91 @@ type ProfileRepo interface {
 	FollowUser(ctx context.Context, followerID uint32, followeeID uint32) error
 	UnfollowUser(ctx context.Context, followerID uint32, followeeID uint32) error
 
-	CanAddFriendCache(ctx context.Context, userID uint32, followerID uint32) (bool, error)
-	CanAddFriendSql(ctx context.Context, userID uint32, followerID uint32) (bool, error)
+	// 关注、粉丝列表，按关注时间倒序的游标分页
+	ListFollowing(ctx context.Context, userID uint32, cursor uint32, limit int) ([]*FollowFanTB, error)
+	ListFollowers(ctx context.Context, userID uint32, cursor uint32, limit int) ([]*FollowFanTB, error)
+
+	// 批量判断关系，返回 targetIDs 中满足条件的子集
+	FilterFollowing(ctx context.Context, userID uint32, targetIDs []uint32) ([]uint32, error)
+	FilterFollowers(ctx context.Context, userID uint32, targetIDs []uint32) ([]uint32, error)
+	FilterFriends(ctx context.Context, userID uint32, targetIDs []uint32) ([]uint32, error)
+
+	CanAddFriendCache(ctx context.Context, userID uint32, targetID uint32) (bool, error)
+	CanAddFriendSql(ctx context.Context, userID uint32, targetID uint32) (bool, error)
+
+	// 好友申请和好友关系
+	CreateFriendRequest(ctx context.Context, req *FriendRequestTB) error
+	GetFriendRequest(ctx context.Context, id uint32) (*FriendRequestTB, error)
+	GetPendingFriendRequest(ctx context.Context, fromID uint32, toID uint32) (*FriendRequestTB, error)
+	ListPendingFriendRequests(ctx context.Context, userID uint32, incoming bool) ([]*FriendRequestTB, error)
+	HandleFriendRequest(ctx context.Context, id uint32, status uint16) (bool, error)
+	CancelPendingFriendRequests(ctx context.Context, userID uint32, targetID uint32) error
+	AddFriend(ctx context.Context, userID uint32, friendID uint32) error
+	DeleteFriend(ctx context.Context, userID uint32, friendID uint32) (bool, error)
+	ListFriends(ctx context.Context, userID uint32, page int, pageSize int) ([]*FriendTB, int64, error)
+
+	// 黑名单
+	BlockUser(ctx context.Context, userID uint32, targetID uint32) error
+	UnblockUser(ctx context.Context, userID uint32, targetID uint32) (bool, error)
+	ListBlocks(ctx context.Context, userID uint32, page int, pageSize int) ([]*BlockTB, int64, error)
+	ListBlockedIDs(ctx context.Context, userID uint32) ([]uint32, error)
+	FilterBlocked(ctx context.Context, userID uint32, targetIDs []uint32) ([]uint32, error)
+
+	// 关注推荐，候选人来自 redis 关注集合，结果预计算后按用户缓存
+	ListActiveUserIDs(ctx context.Context, since time.Time, afterUserID uint32, limit int) ([]uint32, error)
+	GetProfilesByUserIDs(ctx context.Context, userIDs []uint32) ([]*ProfileTB, error)
+	GetFollowingIDs(ctx context.Context, userID uint32) ([]uint32, error)
+	GetFollowerIDs(ctx context.Context, userID uint32) ([]uint32, error)
+	UnionFollowing(ctx context.Context, userIDs []uint32) ([]uint32, error)
+	CountMutualFollows(ctx context.Context, userID uint32, targetID uint32) (int, error)
+	AddSuggestionDismiss(ctx context.Context, userID uint32, targetID uint32) error
+	ListDismissedIDs(ctx context.Context, userID uint32) ([]uint32, error)
+	SaveSuggestions(ctx context.Context, userID uint32, items []*SuggestionItem, ttl time.Duration) error
+	GetSuggestions(ctx context.Context, userID uint32) ([]*SuggestionItem, error)
 
 	// 获取两个用户之间的全部关系，包括是否关注、是否是好友、是否拉黑等
 	CheckFollow(ctx context.Context, userID uint32, targetID uint32) (bool, error)
 	CheckBlock(ctx context.Context, userID uint32, targetID uint32) (bool, error)
 	CheckFriend(ctx context.Context, userID uint32, targetID uint32) (bool, error)
 
+	// 主页浏览，按天去重后先累加在 redis，由后台任务定期刷回 view_count
+	RecordProfileView(ctx context.Context, userID uint32, viewerID uint32, day string, recordVisitor bool) (bool, error)
+	GetPendingViewCount(ctx context.Context, userID uint32) (uint32, error)
+	ListPendingViewCounts(ctx context.Context) (map[uint32]int64, error)
+	AckPendingViewCount(ctx context.Context, userID uint32, flushed int64) error
+	ListProfileVisitors(ctx context.Context, userID uint32, cursor int64, limit int) ([]*ProfileVisit, error)
+	UpdateHideVisits(ctx context.Context, userID uint32, hide bool) error
+
+	// 封禁，SetBan、ClearBan 同时更新 redis 里的封禁状态
+	SetBan(ctx context.Context, userID uint32, until time.Time, reason string) error
+	ClearBan(ctx context.Context, userID uint32) error
+	GetBan(ctx context.Context, userID uint32) (*BanInfo, error)
+	CreateBanAudit(ctx context.Context, audit *BanAuditTB) error
+	ListBanAudits(ctx context.Context, userID uint32, page int, pageSize int) ([]*BanAuditTB, int64, error)
+
+	// 隐私设置，没有保存过的用户返回默认设置
+	GetPrivacySettings(ctx context.Context, userIDs []uint32) (map[uint32]*PrivacySettingTB, error)
+	SavePrivacySetting(ctx context.Context, setting *PrivacySettingTB) error
+
+	// 登录记录，RecordLogin 同时更新 profile 里的 last_login_ip、last_active
+	RecordLogin(ctx context.Context, history *LoginHistoryTB) error
+	ListLoginHistory(ctx context.Context, userID uint32, cursor uint32, limit int) ([]*LoginHistoryTB, error)
+
+	// 按 user_id 分批用关注关系表重算 follow_count、fan_count，并重建 redis 关注、粉丝集合
+	RepairFollowCache(ctx context.Context, afterUserID uint32, limit int) (*FollowRepairResult, error)
+
 	// 增量更新统计字段
 	IncrementFollowCount(ctx context.Context, userID uint32, delta int) (uint32, error)
 	IncrementFanCount(ctx context.Context, userID uint32, delta int) (uint32, error)
 
-	//IncrementViewCount(ctx context.Context, userID uint32, delta int) error
-	//IncrementNoteCount(ctx context.Context, userID uint32, delta int) error
-	//IncrementReceivedLikeCount(ctx context.Context, userID uint32, delta int) error
-	//IncrementCollectedCount(ctx context.Context, userID uint32, delta int) error
-	//IncrementCommentCount(ctx context.Context, userID uint32, delta int) error
+	IncrementViewCount(ctx context.Context, userID uint32, delta int) error
+	IncrementNoteCount(ctx context.Context, userID uint32, delta int) error
+	IncrementReceivedLikeCount(ctx context.Context, userID uint32, delta int) error
+	IncrementCommentCount(ctx context.Context, userID uint32, delta int) error
+
+	// 计数事件去重，事件已经处理过时返回 false
+	CreateCounterEvent(ctx context.Context, event *CounterEventTB) (bool, error)
+	DeleteCounterEventsBefore(ctx context.Context, before time.Time) (int64, error)
+	// 按 user_id 分批用动态、评论表重算 note_count、received_like_count、comment_count，返回本批最后一个用户ID和处理数量
+	RebuildProfileCounters(ctx context.Context, afterUserID uint32, limit int) (uint32, int, error)
 }
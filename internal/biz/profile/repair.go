@@ -0,0 +1,17 @@
+package profile
+
+// FollowCountDrift t_user_profile 里的计数和关注关系表实际条数不一致的用户
+type FollowCountDrift struct {
+	UserID         uint32
+	OldFollowCount uint32
+	NewFollowCount uint32
+	OldFanCount    uint32
+	NewFanCount    uint32
+}
+
+// FollowRepairResult 一批用户的修复结果
+type FollowRepairResult struct {
+	LastUserID uint32 // 本批最后一个用户ID，作为下一批的起点
+	Scanned    int
+	Drifts     []*FollowCountDrift
+}
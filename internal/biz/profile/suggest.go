@@ -0,0 +1,27 @@
+package profile
+
+import "time"
+
+// SuggestionDismissTB 用户在推荐列表里点了“不感兴趣”的人，之后不再推荐
+type SuggestionDismissTB struct {
+	ID       uint32 `gorm:"column:id;type:int(10) unsigned;primary_key;AUTO_INCREMENT" json:"id"`
+	UserID   uint32 `gorm:"column:user_id;type:int(10) unsigned;not null;uniqueIndex:idx_user_target;comment:用户ID" json:"user_id"`
+	TargetID uint32 `gorm:"column:target_id;type:int(10) unsigned;not null;uniqueIndex:idx_user_target;comment:不再推荐的用户ID" json:"target_id"`
+
+	SysCreated *time.Time `gorm:"autoCreateTime;column:sys_created;type:datetime;default null;comment:创建时间;NOT NULL" json:"sys_created"`
+	SysUpdated *time.Time `gorm:"autoUpdateTime;column:sys_updated;type:datetime;default null;comment:修改时间;NOT NULL" json:"sys_updated"`
+}
+
+func (s *SuggestionDismissTB) TableName() string {
+	return "t_user_suggestion_dismiss"
+}
+
+// SuggestionItem 预计算好的推荐结果，整体序列化后缓存在 redis
+type SuggestionItem struct {
+	UserID      uint32  `json:"user_id"`
+	UserName    string  `json:"username"`
+	HeadImage   string  `json:"image"`
+	MutualCount int     `json:"mutual_count"` // 共同关注数：我关注的人里有多少关注了对方
+	SharedTags  int     `json:"shared_tags"`  // 相同标签数
+	Score       float64 `json:"score"`
+}
@@ -0,0 +1,7 @@
+package profile
+
+// ProfileVisit 最近访客，只保存在 redis 里，VisitedAt 为毫秒时间戳
+type ProfileVisit struct {
+	VisitorID uint32
+	VisitedAt int64
+}
@@ -0,0 +1,50 @@
+package biz
+
+import (
+	"context"
+	bizProfile "kratos-realworld/internal/biz/profile"
+	"time"
+)
+
+// 多实例部署时只允许一个实例执行关注缓存校正
+const repairFollowCacheLockKey = "job:lock:repairFollowCache"
+
+// FollowRepairReport 一次完整校正的汇总
+type FollowRepairReport struct {
+	Scanned int
+	Drifts  []*bizProfile.FollowCountDrift
+}
+
+// RepairFollowCache 按 user_id 分批全量校正关注计数和 redis 关注、粉丝集合
+// 没抢到锁说明别的实例正在执行，返回 false
+func (pc *ProfileUsecase) RepairFollowCache(ctx context.Context, batchSize int, lockTTL time.Duration) (*FollowRepairReport, bool, error) {
+	token, ok, err := pc.lk.TryLock(ctx, repairFollowCacheLockKey, lockTTL)
+	if err != nil || !ok {
+		return nil, false, err
+	}
+	defer func() {
+		if err := pc.lk.Unlock(context.Background(), repairFollowCacheLockKey, token); err != nil {
+			pc.log.Warnf("failed to release lock %s: %v", repairFollowCacheLockKey, err)
+		}
+	}()
+
+	report := &FollowRepairReport{}
+	var lastID uint32
+	for {
+		res, err := pc.pr.RepairFollowCache(ctx, lastID, batchSize)
+		if err != nil {
+			return report, true, err
+		}
+		report.Scanned += res.Scanned
+		for _, d := range res.Drifts {
+			pc.log.Infof("repaired follow counts of user %d: follow %d -> %d, fan %d -> %d",
+				d.UserID, d.OldFollowCount, d.NewFollowCount, d.OldFanCount, d.NewFanCount)
+		}
+		report.Drifts = append(report.Drifts, res.Drifts...)
+		if res.Scanned < batchSize {
+			break
+		}
+		lastID = res.LastUserID
+	}
+	return report, true, nil
+}
@@ -0,0 +1,103 @@
+package biz
+
+import (
+	"context"
+	bizProfile "kratos-realworld/internal/biz/profile"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeLocker 内存里的锁，held 记录当前被持有的 key
+type fakeLocker struct {
+	held map[string]string
+}
+
+func newFakeLocker() *fakeLocker {
+	return &fakeLocker{held: make(map[string]string)}
+}
+
+func (l *fakeLocker) TryLock(ctx context.Context, key string, ttl time.Duration) (string, bool, error) {
+	if _, ok := l.held[key]; ok {
+		return "", false, nil
+	}
+	l.held[key] = key + "-token"
+	return l.held[key], true, nil
+}
+
+func (l *fakeLocker) Unlock(ctx context.Context, key string, token string) error {
+	if l.held[key] == token {
+		delete(l.held, key)
+	}
+	return nil
+}
+
+type fakeRepairRepo struct {
+	bizProfile.ProfileRepo
+	userIDs []uint32
+	drifts  map[uint32]*bizProfile.FollowCountDrift
+	cursors []uint32
+	err     error
+}
+
+func (r *fakeRepairRepo) RepairFollowCache(ctx context.Context, afterUserID uint32, limit int) (*bizProfile.FollowRepairResult, error) {
+	r.cursors = append(r.cursors, afterUserID)
+	if r.err != nil {
+		return nil, r.err
+	}
+	res := &bizProfile.FollowRepairResult{}
+	for _, id := range r.userIDs {
+		if id <= afterUserID || res.Scanned == limit {
+			continue
+		}
+		res.Scanned++
+		res.LastUserID = id
+		if d, ok := r.drifts[id]; ok {
+			res.Drifts = append(res.Drifts, d)
+		}
+	}
+	return res, nil
+}
+
+// 分批扫描直到不满一批，汇总所有批次的修正
+func TestRepairFollowCacheBatches(t *testing.T) {
+	pr := &fakeRepairRepo{
+		userIDs: []uint32{1, 2, 4, 7, 9},
+		drifts: map[uint32]*bizProfile.FollowCountDrift{
+			2: {UserID: 2, OldFollowCount: 3, NewFollowCount: 1},
+			9: {UserID: 9, OldFanCount: 0, NewFanCount: 2},
+		},
+	}
+	lk := newFakeLocker()
+	pc := NewProfileUsecase(pr, nil, fakeTx{}, lk, nil, nil, nil, log.DefaultLogger)
+
+	report, ok, err := pc.RepairFollowCache(context.Background(), 2, time.Minute)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, 5, report.Scanned)
+	assert.Len(t, report.Drifts, 2)
+	assert.Equal(t, []uint32{0, 2, 7}, pr.cursors)
+	assert.Empty(t, lk.held)
+}
+
+// 别的实例正在执行时直接跳过；出错时也要释放锁
+func TestRepairFollowCacheLock(t *testing.T) {
+	pr := &fakeRepairRepo{userIDs: []uint32{1}}
+	lk := newFakeLocker()
+	pc := NewProfileUsecase(pr, nil, fakeTx{}, lk, nil, nil, nil, log.DefaultLogger)
+
+	lk.held[repairFollowCacheLockKey] = "other"
+	_, ok, err := pc.RepairFollowCache(context.Background(), 10, time.Minute)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	assert.Empty(t, pr.cursors)
+
+	delete(lk.held, repairFollowCacheLockKey)
+	pr.err = errFakeDB
+	_, ok, err = pc.RepairFollowCache(context.Background(), 10, time.Minute)
+	assert.ErrorIs(t, err, errFakeDB)
+	assert.True(t, ok)
+	assert.Empty(t, lk.held)
+}
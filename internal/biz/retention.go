@@ -0,0 +1,254 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	bizChat "kratos-realworld/internal/biz/messageGroup"
+	"kratos-realworld/internal/common"
+	"kratos-realworld/internal/pkg/middleware/auth"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const purgeExpiredMessagesLockKey = "job:lock:purgeExpiredMessages"
+
+// conversationKey 会话在阅后即焚设置里的唯一标识，单聊双方算出来的是同一个
+func conversationKey(conversationType uint16, fromID string, toID string) string {
+	if conversationType == common.MESSAGE_TYPE_GROUP {
+		return "g:" + toID
+	}
+	swap := fromID > toID
+	a, errA := strconv.ParseUint(fromID, 10, 32)
+	b, errB := strconv.ParseUint(toID, 10, 32)
+	if errA == nil && errB == nil {
+		swap = a > b
+	}
+	if swap {
+		fromID, toID = toID, fromID
+	}
+	return "u:" + fromID + ":" + toID
+}
+
+func validTimerSeconds(seconds uint32) bool {
+	switch seconds {
+	case bizChat.ConversationTimerOff, bizChat.ConversationTimerDay, bizChat.ConversationTimerWeek, bizChat.ConversationTimerMonth:
+		return true
+	}
+	return false
+}
+
+// retentionTTL 全局消息保留时长，0表示不限
+func (mc *MessageUseCase) retentionTTL() time.Duration {
+	if mc.dc == nil || mc.dc.Retention == nil || mc.dc.Retention.MessageTtl == nil {
+		return 0
+	}
+	return mc.dc.Retention.MessageTtl.AsDuration()
+}
+
+// applyMessageExpire 按会话的阅后即焚设置给消息打上过期时间，查询失败时不过期
+func (mc *MessageUseCase) applyMessageExpire(ctx context.Context, message *bizChat.MessageTB) {
+	if message == nil {
+		return
+	}
+	policy, err := mc.cr.GetConversationPolicy(ctx, conversationKey(message.MessageType, message.FromUserID, message.ToUserID))
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			mc.log.Errorf("GetConversationPolicy error: %v", err)
+		}
+		return
+	}
+	if policy.TimerSeconds == bizChat.ConversationTimerOff {
+		return
+	}
+	expireAt := time.Now().Add(time.Duration(policy.TimerSeconds) * time.Second)
+	message.ExpireAt = &expireAt
+}
+
+func (mc *MessageUseCase) GetConversationTimer(ctx context.Context, targetID string, conversationType uint16) (*ConversationTimerReply, error) {
+	userID := strconv.Itoa(int(auth.FromContext(ctx).UserID))
+	res := &ConversationTimerReply{
+		TargetID:         targetID,
+		ConversationType: conversationType,
+	}
+	policy, err := mc.cr.GetConversationPolicy(ctx, conversationKey(conversationType, userID, targetID))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return res, nil
+		}
+		return nil, NewErr(ErrCodeDBQueryFailed, DB_QUERY_FAILED, "failed to query conversation timer")
+	}
+	res.TimerSeconds = policy.TimerSeconds
+	return res, nil
+}
+
+// SetConversationTimer 设置阅后即焚时长，只对之后发送的消息生效
+// 单聊双方都可以设置，群聊只有群主和管理员可以设置
+func (mc *MessageUseCase) SetConversationTimer(ctx context.Context, targetID string, conversationType uint16, timerSeconds uint32) (*ConversationTimerReply, error) {
+	if !validTimerSeconds(timerSeconds) {
+		return nil, NewErr(ErrCodeInvalidParams, INVALID_PARAMS, "timer must be off, 1 day, 7 days or 30 days")
+	}
+
+	uid := uint32(auth.FromContext(ctx).UserID)
+	userID := strconv.Itoa(int(uid))
+	group, member, err := mc.checkConversationTarget(ctx, uid, targetID, conversationType)
+	if err != nil {
+		return nil, err
+	}
+	if group != nil && !isGroupManager(group, member) {
+		return nil, NewErr(ErrCodeGroupPermission, GROUP_PERMISSION, "only group managers can change the message timer")
+	}
+
+	policy := &bizChat.ConversationPolicyTB{
+		ConversationKey:  conversationKey(conversationType, userID, targetID),
+		ConversationType: conversationType,
+		TimerSeconds:     timerSeconds,
+		UpdatedBy:        uid,
+	}
+	if err := mc.cr.SaveConversationPolicy(ctx, policy); err != nil {
+		return nil, NewErr(ErrCodeMessageFailed, MESSAGE_FAILED, "failed to update conversation timer")
+	}
+
+	mc.notifyConversationMembers(ctx, conversationType, userID, targetID, common.CONVERSATION_TIMER, map[string]interface{}{
+		"timerSeconds": timerSeconds,
+		"updatedBy":    uid,
+	})
+	return &ConversationTimerReply{
+		TargetID:         targetID,
+		ConversationType: conversationType,
+		TimerSeconds:     timerSeconds,
+	}, nil
+}
+
+// PurgeExpiredMessages 持有分布式锁时分批删除过期消息，最多 maxBatches 批，批之间停顿 batchPause
+// 返回删除的条数，没有抢到锁时第二个返回值为 false
+func (mc *MessageUseCase) PurgeExpiredMessages(ctx context.Context, batchSize int, maxBatches int, batchPause time.Duration, lockTTL time.Duration) (int, bool, error) {
+	token, ok, err := mc.lk.TryLock(ctx, purgeExpiredMessagesLockKey, lockTTL)
+	if err != nil || !ok {
+		return 0, false, err
+	}
+	defer func() {
+		if err := mc.lk.Unlock(context.Background(), purgeExpiredMessagesLockKey, token); err != nil {
+			mc.log.Warnf("failed to release lock %s: %v", purgeExpiredMessagesLockKey, err)
+		}
+	}()
+
+	total := 0
+	for i := 0; i < maxBatches; i++ {
+		n, err := mc.purgeExpiredBatch(ctx, batchSize)
+		if err != nil {
+			return total, true, err
+		}
+		total += n
+		// 不满一批说明已经删完了
+		if n < batchSize {
+			break
+		}
+		time.Sleep(batchPause)
+	}
+	return total, true, nil
+}
+
+// purgeExpiredBatch 删除一批过期消息及其附件，返回删除的条数
+// 过期包括会话的阅后即焚和全局保留时长两种
+func (mc *MessageUseCase) purgeExpiredBatch(ctx context.Context, batchSize int) (int, error) {
+	now := time.Now()
+	var createdBefore *time.Time
+	if ttl := mc.retentionTTL(); ttl > 0 {
+		t := now.Add(-ttl)
+		createdBefore = &t
+	}
+
+	messages, err := mc.mr.ListExpiredMessages(ctx, now, createdBefore, batchSize)
+	if err != nil {
+		return 0, NewErr(ErrCodeDBQueryFailed, DB_QUERY_FAILED, "failed to query expired messages")
+	}
+	if len(messages) == 0 {
+		return 0, nil
+	}
+
+	ids := make([]uint32, 0, len(messages))
+	for _, m := range messages {
+		ids = append(ids, m.ID)
+	}
+	// 先删数据库再删文件，删除失败时不会留下指向不存在文件的消息
+	if err := mc.mr.DeleteMessages(ctx, ids); err != nil {
+		return 0, NewErr(ErrCodeMessageFailed, MESSAGE_FAILED, "failed to delete expired messages")
+	}
+	for _, m := range messages {
+		mc.removeAttachment(m.Url)
+	}
+
+	// 按会话汇总，每个会话只通知一次
+	type expired struct {
+		message      *bizChat.MessageTB
+		maxMessageID uint32
+	}
+	conversations := make(map[string]*expired)
+	for _, m := range messages {
+		key := conversationKey(m.MessageType, m.FromUserID, m.ToUserID)
+		if e, ok := conversations[key]; ok {
+			if m.ID > e.maxMessageID {
+				e.maxMessageID = m.ID
+			}
+			continue
+		}
+		conversations[key] = &expired{message: m, maxMessageID: m.ID}
+	}
+	for _, e := range conversations {
+		mc.notifyConversationMembers(ctx, e.message.MessageType, e.message.FromUserID, e.message.ToUserID, common.MESSAGES_EXPIRED, map[string]interface{}{
+			"maxMessageId": e.maxMessageID,
+		})
+	}
+
+	return len(messages), nil
+}
+
+// removeAttachment 删除静态目录下的附件，只取文件名防止越界删除
+func (mc *MessageUseCase) removeAttachment(url string) {
+	if url == "" || mc.dc == nil || mc.dc.Storage == nil || mc.dc.Storage.StaticDir == "" {
+		return
+	}
+	path := filepath.Join(mc.dc.Storage.StaticDir, filepath.Base(url))
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		mc.log.Errorf("remove attachment %s error: %v", path, err)
+	}
+}
+
+// notifyConversationMembers 给会话的所有参与者推送系统通知，targetId 按接收者的视角填写
+func (mc *MessageUseCase) notifyConversationMembers(ctx context.Context, conversationType uint16, fromID string, toID string, notifyType string, payload map[string]interface{}) {
+	push := func(to string, targetID string) {
+		data := make(map[string]interface{}, len(payload)+2)
+		for k, v := range payload {
+			data[k] = v
+		}
+		data["targetId"] = targetID
+		data["conversationType"] = conversationType
+		if err := pushSystemNotify(to, notifyType, data); err != nil {
+			mc.log.Errorf("push %s to %s error: %v", notifyType, to, err)
+		}
+	}
+
+	if conversationType != common.MESSAGE_TYPE_GROUP {
+		push(fromID, toID)
+		push(toID, fromID)
+		return
+	}
+
+	group, err := mc.gr.GetGroupByUuid(ctx, toID)
+	if err != nil {
+		mc.log.Errorf("GetGroupByUuid %s error: %v", toID, err)
+		return
+	}
+	members, err := mc.gr.ListGroupMembers(ctx, group.ID)
+	if err != nil {
+		mc.log.Errorf("ListGroupMembers %s error: %v", toID, err)
+		return
+	}
+	for _, m := range members {
+		push(strconv.Itoa(int(m.UserID)), toID)
+	}
+}
@@ -0,0 +1,120 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	bizUser "kratos-realworld/internal/biz/user"
+	"kratos-realworld/internal/pkg/middleware/auth"
+	"strings"
+	"unicode/utf8"
+
+	"gorm.io/gorm"
+)
+
+const maxSearchKeywordLen = 50
+
+// SearchUsers 按@用户名前缀、用户名前缀搜索；关键字是完整手机号时，允许通过手机号被搜索的用户排在第一页最前面
+// 和当前用户之间有拉黑关系的人、关闭了搜索的人不出现在结果里
+func (pc *ProfileUsecase) SearchUsers(ctx context.Context, keyword string, page int, pageSize int) (*SearchUsersReply, error) {
+	viewerID := uint32(auth.FromContext(ctx).UserID)
+	keyword = strings.TrimPrefix(strings.TrimSpace(keyword), "@")
+	if keyword == "" || utf8.RuneCountInString(keyword) > maxSearchKeywordLen {
+		return nil, NewErr(ErrCodeInvalidParams, INVALID_PARAMS, "search keyword must be 1-50 characters")
+	}
+	page, pageSize = normalizePage(page, pageSize)
+
+	var users []*bizUser.UserTB
+	if page == 1 && IsValidPhone(keyword) {
+		user, err := pc.searchByPhone(ctx, keyword)
+		if err != nil {
+			return nil, err
+		}
+		if user != nil {
+			users = append(users, user)
+		}
+	}
+
+	matched, err := pc.ur.SearchUsers(ctx, keyword, (page-1)*pageSize, pageSize+1)
+	if err != nil {
+		return nil, NewErr(ErrCodeDBQueryFailed, DB_QUERY_FAILED, "failed to search users")
+	}
+	reply := &SearchUsersReply{Users: make([]*FollowUserItem, 0, len(matched)+1)}
+	if len(matched) > pageSize {
+		matched = matched[:pageSize]
+		reply.HasMore = true
+	}
+	hidden, err := pc.privacy.FilterUndiscoverable(ctx, viewerID, userIDsOf(matched))
+	if err != nil {
+		return nil, err
+	}
+	for _, u := range matched {
+		if _, ok := hidden[u.ID]; !ok {
+			users = append(users, u)
+		}
+	}
+
+	ids := make([]uint32, 0, len(users))
+	items := make(map[uint32]*FollowUserItem, len(users))
+	for _, u := range users {
+		if _, ok := items[u.ID]; ok {
+			continue
+		}
+		ids = append(ids, u.ID)
+		items[u.ID] = &FollowUserItem{
+			UserID:    u.ID,
+			UserName:  u.UserName,
+			Handle:    u.Handle,
+			HeadImage: u.HeadImage,
+			Bio:       u.Bio,
+		}
+	}
+	if len(ids) == 0 {
+		return reply, nil
+	}
+
+	blocked, err := pc.pr.FilterBlocked(ctx, viewerID, ids)
+	if err != nil {
+		return nil, NewErr(ErrCodeDBQueryFailed, DB_QUERY_FAILED, "failed to query block relationship")
+	}
+	for _, id := range blocked {
+		delete(items, id)
+	}
+	if err := pc.fillRelationFlags(ctx, viewerID, items, ids); err != nil {
+		return nil, err
+	}
+
+	for _, id := range ids {
+		if item, ok := items[id]; ok {
+			reply.Users = append(reply.Users, item)
+		}
+	}
+	return reply, nil
+}
+
+// searchByPhone 手机号必须完全匹配，并且对方允许通过手机号被搜索到
+func (pc *ProfileUsecase) searchByPhone(ctx context.Context, phone string) (*bizUser.UserTB, error) {
+	user, err := pc.ur.GetUserByPhone(ctx, phone)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, NewErr(ErrCodeDBQueryFailed, DB_QUERY_FAILED, "failed to query user by phone")
+	}
+
+	discoverable, err := pc.privacy.DiscoverableByPhone(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !discoverable {
+		return nil, nil
+	}
+	return user, nil
+}
+
+func userIDsOf(users []*bizUser.UserTB) []uint32 {
+	ids := make([]uint32, 0, len(users))
+	for _, u := range users {
+		ids = append(ids, u.ID)
+	}
+	return ids
+}
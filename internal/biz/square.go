@@ -0,0 +1,320 @@
+package biz
+
+import (
+	"context"
+	bizMoment "kratos-realworld/internal/biz/moments"
+	bizProfile "kratos-realworld/internal/biz/profile"
+	"kratos-realworld/internal/pkg/middleware/auth"
+	"math"
+	"math/rand"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// 广场拉取模式：random 从热门动态里随机抽取，同一个会话内不重复；latest 按发布时间倒序翻页
+const (
+	SquareModeRandom = "random"
+	SquareModeLatest = "latest"
+)
+
+const (
+	defaultSquarePoolSize     = 10000
+	defaultSquareSampleWindow = 500
+	defaultSquareSessionTTL   = 30 * time.Minute
+
+	// 互动数每增加10倍，相当于晚发布这么多秒
+	hotScoreDecaySeconds = 45000
+
+	maxMomentTags   = 5
+	maxMomentTagLen = 20
+)
+
+// 每种互动在热度里的权重
+const (
+	engagementComment = 2
+)
+
+func (uc *MomentUsecase) squarePoolSize() int {
+	if uc.dc == nil || uc.dc.Square == nil || uc.dc.Square.PoolSize <= 0 {
+		return defaultSquarePoolSize
+	}
+	return int(uc.dc.Square.PoolSize)
+}
+
+func (uc *MomentUsecase) squareSampleWindow() int {
+	if uc.dc == nil || uc.dc.Square == nil || uc.dc.Square.SampleWindow <= 0 {
+		return defaultSquareSampleWindow
+	}
+	return int(uc.dc.Square.SampleWindow)
+}
+
+func (uc *MomentUsecase) squareSessionTTL() time.Duration {
+	if uc.dc == nil || uc.dc.Square == nil || uc.dc.Square.SessionTtl == nil || uc.dc.Square.SessionTtl.AsDuration() <= 0 {
+		return defaultSquareSessionTTL
+	}
+	return uc.dc.Square.SessionTtl.AsDuration()
+}
+
+// hotScore 互动越多越靠前，同样的互动数新发布的靠前
+func hotScore(engagement int64, created time.Time) float64 {
+	return math.Log10(math.Max(float64(engagement), 1)) + float64(created.Unix())/hotScoreDecaySeconds
+}
+
+// normalizeTag 去掉#前缀并转成小写
+func normalizeTag(tag string) (string, error) {
+	tag = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(tag), "#"))
+	if tag == "" || utf8.RuneCountInString(tag) > maxMomentTagLen || strings.ContainsAny(tag, " \t\n#") {
+		return "", NewErr(ErrCodeInvalidParams, INVALID_PARAMS, "tag must be 1-20 characters without spaces")
+	}
+	return tag, nil
+}
+
+func normalizeTags(tags []string) ([]string, error) {
+	res := make([]string, 0, len(tags))
+	seen := make(map[string]struct{}, len(tags))
+	for _, t := range tags {
+		tag, err := normalizeTag(t)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := seen[tag]; ok {
+			continue
+		}
+		seen[tag] = struct{}{}
+		res = append(res, tag)
+	}
+	if len(res) > maxMomentTags {
+		return nil, NewErr(ErrCodeInvalidParams, INVALID_PARAMS, "a moment can have at most 5 tags")
+	}
+	return res, nil
+}
+
+// addToSquare 只收录公开、没有黑名单并且作者允许所有人看动态的，失败不影响发布
+func (uc *MomentUsecase) addToSquare(ctx context.Context, moment *bizMoment.MomentTB) {
+	if moment.Visibility != bizMoment.VisibilityPublic || len(moment.BlackListIDs) > 0 {
+		return
+	}
+	setting, err := uc.pp.Settings(ctx, moment.UserID)
+	if err != nil {
+		uc.log.Warnf("failed to query privacy settings of %d: %v", moment.UserID, err)
+		return
+	}
+	if setting.MomentVisibility != bizProfile.AudienceEveryone {
+		return
+	}
+
+	created := time.Now()
+	if moment.SysCreated != nil {
+		created = *moment.SysCreated
+	}
+	meta := &bizMoment.MomentsMetaTB{
+		ID:         moment.ID,
+		UserID:     moment.UserID,
+		MomentID:   moment.ID,
+		Message:    moment.Message,
+		MediaURL:   moment.MediaURL,
+		Tags:       moment.Tags,
+		SysCreated: &created,
+	}
+	if len(moment.Media) > 0 {
+		meta.Thumbnail = moment.Media[0].ThumbnailURL
+	}
+	if err := uc.sq.AddToSquare(ctx, meta, hotScore(0, created), uc.squarePoolSize()); err != nil {
+		uc.log.Warnf("failed to add moment %d to square: %v", moment.ID, err)
+	}
+}
+
+func (uc *MomentUsecase) removeFromSquare(ctx context.Context, momentID uint32) {
+	if err := uc.sq.RemoveFromSquare(ctx, momentID); err != nil {
+		uc.log.Warnf("failed to remove moment %d from square: %v", momentID, err)
+	}
+}
+
+// bumpSquare 动态有新的互动时更新热度，不在池子里的忽略
+func (uc *MomentUsecase) bumpSquare(ctx context.Context, momentID uint32, delta int64) {
+	engagement, ok, err := uc.sq.IncrSquareEngagement(ctx, momentID, delta)
+	if err != nil || !ok {
+		if err != nil {
+			uc.log.Warnf("failed to update square engagement of moment %d: %v", momentID, err)
+		}
+		return
+	}
+	metas, err := uc.sq.GetSquareMetas(ctx, []uint32{momentID})
+	if err != nil {
+		uc.log.Warnf("failed to get square meta of moment %d: %v", momentID, err)
+		return
+	}
+	meta, ok := metas[momentID]
+	if !ok || meta.SysCreated == nil {
+		return
+	}
+	if err := uc.sq.SetSquareHotScore(ctx, momentID, hotScore(engagement, *meta.SysCreated)); err != nil {
+		uc.log.Warnf("failed to update square hot score of moment %d: %v", momentID, err)
+	}
+}
+
+// GetSquare 广场动态，tag 为空时不按话题过滤；random 模式 sessionID 为空时开始新的会话
+func (uc *MomentUsecase) GetSquare(ctx context.Context, mode string, tag string, sessionID string, cursor uint32, limit int) (*SquareReply, error) {
+	viewerID := uint32(auth.FromContext(ctx).UserID)
+	_, limit = normalizePage(1, limit)
+	if tag != "" {
+		var err error
+		if tag, err = normalizeTag(tag); err != nil {
+			return nil, err
+		}
+	}
+
+	switch mode {
+	case "", SquareModeRandom:
+		return uc.sampleSquare(ctx, viewerID, tag, sessionID, limit)
+	case SquareModeLatest:
+		return uc.latestSquare(ctx, viewerID, tag, cursor, limit)
+	}
+	return nil, NewErr(ErrCodeInvalidParams, INVALID_PARAMS, "mode must be random or latest")
+}
+
+func (uc *MomentUsecase) latestSquare(ctx context.Context, viewerID uint32, tag string, cursor uint32, limit int) (*SquareReply, error) {
+	ids, err := uc.sq.ListSquareLatest(ctx, tag, cursor, limit+1)
+	if err != nil {
+		uc.log.Errorf("ListSquareLatest error: %v", err)
+		return nil, NewErr(ErrCodeMomentFailed, MOMENT_FAILED, "Get square failed")
+	}
+	reply := &SquareReply{}
+	if len(ids) > limit {
+		ids = ids[:limit]
+		reply.HasMore = true
+	}
+	if len(ids) > 0 {
+		reply.NextCursor = ids[len(ids)-1]
+	}
+	if reply.Moments, err = uc.visibleSquareMoments(ctx, viewerID, ids); err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (uc *MomentUsecase) sampleSquare(ctx context.Context, viewerID uint32, tag string, sessionID string, limit int) (*SquareReply, error) {
+	if sessionID == "" {
+		token, err := newRandomToken()
+		if err != nil {
+			return nil, NewErr(ErrCodeMomentFailed, MOMENT_FAILED, "Get square failed")
+		}
+		sessionID = token
+	}
+
+	var candidates []uint32
+	var err error
+	if tag == "" {
+		candidates, err = uc.sq.ListSquareHot(ctx, uc.squareSampleWindow())
+	} else {
+		candidates, err = uc.sq.ListSquareLatest(ctx, tag, 0, uc.squareSampleWindow())
+	}
+	if err != nil {
+		uc.log.Errorf("list square candidates error: %v", err)
+		return nil, NewErr(ErrCodeMomentFailed, MOMENT_FAILED, "Get square failed")
+	}
+	seen, err := uc.sq.GetSquareSeen(ctx, viewerID, sessionID)
+	if err != nil {
+		uc.log.Errorf("GetSquareSeen error: %v", err)
+		return nil, NewErr(ErrCodeMomentFailed, MOMENT_FAILED, "Get square failed")
+	}
+
+	picked, remaining := pickUnseen(candidates, seen, limit)
+	if err := uc.sq.MarkSquareSeen(ctx, viewerID, sessionID, picked, uc.squareSessionTTL()); err != nil {
+		uc.log.Warnf("MarkSquareSeen error: %v", err)
+	}
+
+	reply := &SquareReply{SessionID: sessionID, HasMore: remaining > 0}
+	if reply.Moments, err = uc.visibleSquareMoments(ctx, viewerID, picked); err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+// pickUnseen 从没看过的候选里随机取 limit 条，同时返回剩下没看过的数量
+func pickUnseen(candidates []uint32, seen []uint32, limit int) ([]uint32, int) {
+	skip := make(map[uint32]struct{}, len(seen))
+	for _, id := range seen {
+		skip[id] = struct{}{}
+	}
+	unseen := make([]uint32, 0, len(candidates))
+	for _, id := range candidates {
+		if _, ok := skip[id]; !ok {
+			unseen = append(unseen, id)
+		}
+	}
+	rand.Shuffle(len(unseen), func(i, j int) {
+		unseen[i], unseen[j] = unseen[j], unseen[i]
+	})
+	if len(unseen) <= limit {
+		return unseen, 0
+	}
+	return unseen[:limit], len(unseen) - limit
+}
+
+// visibleSquareMoments 按 ids 的顺序返回缩略数据，去掉和 viewer 互相拉黑的作者
+// 作者已经不再公开动态、缩略数据已经丢失的顺便移出池子
+func (uc *MomentUsecase) visibleSquareMoments(ctx context.Context, viewerID uint32, ids []uint32) ([]*bizMoment.MomentsMetaTB, error) {
+	moments := make([]*bizMoment.MomentsMetaTB, 0, len(ids))
+	if len(ids) == 0 {
+		return moments, nil
+	}
+	metas, err := uc.sq.GetSquareMetas(ctx, ids)
+	if err != nil {
+		uc.log.Errorf("GetSquareMetas error: %v", err)
+		return nil, NewErr(ErrCodeMomentFailed, MOMENT_FAILED, "Get square failed")
+	}
+
+	var missing, authorIDs []uint32
+	authors := make(map[uint32]struct{})
+	for _, id := range ids {
+		meta, ok := metas[id]
+		if !ok {
+			missing = append(missing, id)
+			continue
+		}
+		if _, ok := authors[meta.UserID]; !ok {
+			authors[meta.UserID] = struct{}{}
+			authorIDs = append(authorIDs, meta.UserID)
+		}
+	}
+	if len(missing) > 0 {
+		if err := uc.sq.RemoveFromSquare(ctx, missing...); err != nil {
+			uc.log.Warnf("failed to remove missing moments from square: %v", err)
+		}
+	}
+
+	hidden := make(map[uint32]struct{})
+	blocked, err := uc.pr.FilterBlocked(ctx, viewerID, authorIDs)
+	if err != nil {
+		return nil, NewErr(ErrCodeDBQueryFailed, DB_QUERY_FAILED, "failed to query block relationship")
+	}
+	for _, id := range blocked {
+		hidden[id] = struct{}{}
+	}
+	settings, err := uc.pr.GetPrivacySettings(ctx, authorIDs)
+	if err != nil {
+		return nil, NewErr(ErrCodeDBQueryFailed, DB_QUERY_FAILED, "failed to query privacy settings")
+	}
+	for id, s := range settings {
+		if s.MomentVisibility == bizProfile.AudienceEveryone {
+			continue
+		}
+		hidden[id] = struct{}{}
+		if err := uc.sq.RemoveUserFromSquare(ctx, id); err != nil {
+			uc.log.Warnf("failed to remove user %d from square: %v", id, err)
+		}
+	}
+
+	for _, id := range ids {
+		meta, ok := metas[id]
+		if !ok {
+			continue
+		}
+		if _, ok := hidden[meta.UserID]; !ok {
+			moments = append(moments, meta)
+		}
+	}
+	return moments, nil
+}
@@ -0,0 +1,39 @@
+package biz
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPickUnseen(t *testing.T) {
+	candidates := []uint32{1, 2, 3, 4, 5}
+
+	picked, remaining := pickUnseen(candidates, []uint32{2, 4}, 2)
+	assert.Len(t, picked, 2)
+	assert.Equal(t, 1, remaining)
+	assert.Subset(t, []uint32{1, 3, 5}, picked)
+	assert.Equal(t, []uint32{1, 2, 3, 4, 5}, candidates)
+
+	picked, remaining = pickUnseen(candidates, candidates, 2)
+	assert.Empty(t, picked)
+	assert.Equal(t, 0, remaining)
+}
+
+func TestHotScore(t *testing.T) {
+	now := time.Now()
+	assert.Greater(t, hotScore(0, now), hotScore(0, now.Add(-time.Hour)))
+	assert.Greater(t, hotScore(100, now.Add(-time.Hour)), hotScore(0, now))
+}
+
+func TestNormalizeTags(t *testing.T) {
+	tags, err := normalizeTags([]string{"#Travel", " travel ", "摄影"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"travel", "摄影"}, tags)
+
+	_, err = normalizeTags([]string{"two words"})
+	assert.Error(t, err)
+	_, err = normalizeTags([]string{"a", "b", "c", "d", "e", "f"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,266 @@
+package biz
+
+import (
+	"context"
+	bizProfile "kratos-realworld/internal/biz/profile"
+	"kratos-realworld/internal/pkg/middleware/auth"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// 推荐结果缓存时长，正常情况下会被后台任务提前刷新
+	suggestionCacheTTL = 24 * time.Hour
+	// 计算二度关系时最多取多少个关注的人，避免大V的计算量失控
+	maxSuggestFollowing = 200
+	// 参与打分的候选人上限
+	maxSuggestCandidates = 1000
+
+	suggestMutualWeight = 10.0
+	suggestTagWeight    = 3.0
+)
+
+// splitTags 标签以逗号分隔，兼容中文逗号和空格
+func splitTags(tags string) []string {
+	return strings.FieldsFunc(tags, func(r rune) bool {
+		return r == ',' || r == '，' || r == ' '
+	})
+}
+
+// sharedTagCount 两个用户相同标签的数量
+func sharedTagCount(a string, b string) int {
+	mine := make(map[string]struct{})
+	for _, t := range splitTags(a) {
+		mine[strings.ToLower(t)] = struct{}{}
+	}
+	cnt := 0
+	for _, t := range splitTags(b) {
+		key := strings.ToLower(t)
+		if _, ok := mine[key]; ok {
+			cnt++
+			delete(mine, key)
+		}
+	}
+	return cnt
+}
+
+// activityBonus 最近活跃的用户加分，越近加得越多
+func activityBonus(lastActive *time.Time, now time.Time) float64 {
+	if lastActive == nil {
+		return 0
+	}
+	since := now.Sub(*lastActive)
+	switch {
+	case since <= 24*time.Hour:
+		return 5
+	case since <= 7*24*time.Hour:
+		return 3
+	case since <= 30*24*time.Hour:
+		return 1
+	}
+	return 0
+}
+
+func suggestionScore(mutual int, sharedTags int, lastActive *time.Time, now time.Time) float64 {
+	return float64(mutual)*suggestMutualWeight + float64(sharedTags)*suggestTagWeight + activityBonus(lastActive, now)
+}
+
+// RefreshSuggestions 为 userID 重新计算关注推荐并写入缓存，由后台任务调用
+// 候选人是我关注的人所关注的人加上我的粉丝，排除自己、已关注、拉黑和不感兴趣的用户
+func (pc *ProfileUsecase) RefreshSuggestions(ctx context.Context, userID uint32, maxResults int) error {
+	following, err := pc.pr.GetFollowingIDs(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	exclude := map[uint32]struct{}{userID: {}}
+	for _, id := range following {
+		exclude[id] = struct{}{}
+	}
+	blocked, err := pc.pr.ListBlockedIDs(ctx, userID)
+	if err != nil {
+		return err
+	}
+	dismissed, err := pc.pr.ListDismissedIDs(ctx, userID)
+	if err != nil {
+		return err
+	}
+	for _, id := range append(blocked, dismissed...) {
+		exclude[id] = struct{}{}
+	}
+
+	if len(following) > maxSuggestFollowing {
+		following = following[:maxSuggestFollowing]
+	}
+	secondDegree, err := pc.pr.UnionFollowing(ctx, following)
+	if err != nil {
+		return err
+	}
+	fans, err := pc.pr.ListFollowers(ctx, userID, 0, maxSuggestFollowing)
+	if err != nil {
+		return err
+	}
+	for _, f := range fans {
+		secondDegree = append(secondDegree, f.FollowerID)
+	}
+
+	candidates := make([]uint32, 0, len(secondDegree))
+	for _, id := range secondDegree {
+		if _, ok := exclude[id]; ok {
+			continue
+		}
+		// 对方拉黑了我也不推荐
+		if blockedBy, _ := pc.pr.CheckBlock(ctx, id, userID); blockedBy {
+			continue
+		}
+		exclude[id] = struct{}{}
+		candidates = append(candidates, id)
+		if len(candidates) >= maxSuggestCandidates {
+			break
+		}
+	}
+
+	items := make([]*bizProfile.SuggestionItem, 0, len(candidates))
+	if len(candidates) > 0 {
+		profiles, err := pc.pr.GetProfilesByUserIDs(ctx, append(candidates, userID))
+		if err != nil {
+			return err
+		}
+		profileMap := make(map[uint32]*bizProfile.ProfileTB, len(profiles))
+		for _, p := range profiles {
+			profileMap[p.UserID] = p
+		}
+		var myTags string
+		if me, ok := profileMap[userID]; ok {
+			myTags = me.Tags
+		}
+
+		now := time.Now()
+		for _, id := range candidates {
+			p, ok := profileMap[id]
+			if !ok {
+				continue
+			}
+			mutual, err := pc.pr.CountMutualFollows(ctx, userID, id)
+			if err != nil {
+				return err
+			}
+			shared := sharedTagCount(myTags, p.Tags)
+			items = append(items, &bizProfile.SuggestionItem{
+				UserID:      id,
+				MutualCount: mutual,
+				SharedTags:  shared,
+				Score:       suggestionScore(mutual, shared, p.LastActive, now),
+			})
+		}
+		sort.SliceStable(items, func(i, j int) bool { return items[i].Score > items[j].Score })
+		if len(items) > maxResults {
+			items = items[:maxResults]
+		}
+
+		ids := make([]uint32, 0, len(items))
+		for _, item := range items {
+			ids = append(ids, item.UserID)
+		}
+		users, err := pc.ur.GetUsersByIDs(ctx, ids)
+		if err != nil {
+			return err
+		}
+		itemMap := make(map[uint32]*bizProfile.SuggestionItem, len(items))
+		for _, item := range items {
+			itemMap[item.UserID] = item
+		}
+		for _, u := range users {
+			if item, ok := itemMap[u.ID]; ok {
+				item.UserName = u.UserName
+				item.HeadImage = u.HeadImage
+			}
+		}
+	}
+
+	return pc.pr.SaveSuggestions(ctx, userID, items, suggestionCacheTTL)
+}
+
+// RefreshActiveSuggestions 为 since 之后活跃过的用户分批刷新推荐，返回本批最后一个用户ID和处理数量
+func (pc *ProfileUsecase) RefreshActiveSuggestions(ctx context.Context, since time.Time, afterUserID uint32, batchSize int, maxResults int) (uint32, int, error) {
+	ids, err := pc.pr.ListActiveUserIDs(ctx, since, afterUserID, batchSize)
+	if err != nil {
+		return afterUserID, 0, err
+	}
+	for _, id := range ids {
+		if err := pc.RefreshSuggestions(ctx, id, maxResults); err != nil {
+			pc.log.Errorf("refresh suggestions for %d error: %v", id, err)
+		}
+	}
+	if len(ids) == 0 {
+		return afterUserID, 0, nil
+	}
+	return ids[len(ids)-1], len(ids), nil
+}
+
+// SuggestUsers 直接返回后台任务预计算好的推荐结果
+func (pc *ProfileUsecase) SuggestUsers(ctx context.Context, limit int) ([]*bizProfile.SuggestionItem, error) {
+	userID := uint32(auth.FromContext(ctx).UserID)
+	_, limit = normalizePage(1, limit)
+
+	items, err := pc.pr.GetSuggestions(ctx, userID)
+	if err != nil {
+		return nil, NewErr(ErrCodeDBQueryFailed, DB_QUERY_FAILED, "failed to query suggestions")
+	}
+
+	// 预计算之后才关闭推荐的人，读取时再过滤一次
+	ids := make([]uint32, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, item.UserID)
+	}
+	hidden, err := pc.privacy.FilterUndiscoverable(ctx, userID, ids)
+	if err != nil {
+		return nil, err
+	}
+	kept := make([]*bizProfile.SuggestionItem, 0, len(items))
+	for _, item := range items {
+		if _, ok := hidden[item.UserID]; !ok {
+			kept = append(kept, item)
+		}
+	}
+	if len(kept) > limit {
+		kept = kept[:limit]
+	}
+	return kept, nil
+}
+
+// DismissSuggestion 不再推荐某人，同时从已缓存的结果里去掉
+func (pc *ProfileUsecase) DismissSuggestion(ctx context.Context, targetID string) error {
+	userID := uint32(auth.FromContext(ctx).UserID)
+	tID, err := parseTargetID(targetID)
+	if err != nil {
+		return err
+	}
+
+	if err := pc.pr.AddSuggestionDismiss(ctx, userID, tID); err != nil {
+		return NewErr(ErrCodeDBQueryFailed, DB_QUERY_FAILED, "failed to dismiss suggestion")
+	}
+	pc.removeSuggestion(ctx, userID, tID)
+	return nil
+}
+
+// removeSuggestion 关注、拉黑或者不感兴趣之后，把对方从缓存的推荐结果里去掉
+func (pc *ProfileUsecase) removeSuggestion(ctx context.Context, userID uint32, targetID uint32) {
+	items, err := pc.pr.GetSuggestions(ctx, userID)
+	if err != nil || items == nil {
+		return
+	}
+	kept := make([]*bizProfile.SuggestionItem, 0, len(items))
+	for _, item := range items {
+		if item.UserID != targetID {
+			kept = append(kept, item)
+		}
+	}
+	if len(kept) == len(items) {
+		return
+	}
+	if err := pc.pr.SaveSuggestions(ctx, userID, kept, suggestionCacheTTL); err != nil {
+		pc.log.Warnf("failed to update suggestions cache for %d: %v", userID, err)
+	}
+}
@@ -0,0 +1,20 @@
+package biz
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSuggestionScore(t *testing.T) {
+	assert.Equal(t, 2, sharedTagCount("旅行,摄影, Music", "music，摄影，美食"))
+	assert.Equal(t, 0, sharedTagCount("", "摄影"))
+
+	now := time.Now()
+	recent := now.Add(-time.Hour)
+	stale := now.Add(-60 * 24 * time.Hour)
+	assert.Equal(t, 2*suggestMutualWeight+suggestTagWeight+5, suggestionScore(2, 1, &recent, now))
+	assert.Equal(t, suggestMutualWeight, suggestionScore(1, 0, &stale, now))
+	assert.Equal(t, 0.0, suggestionScore(0, 0, nil, now))
+}
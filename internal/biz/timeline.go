@@ -0,0 +1,192 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	bizMoment "kratos-realworld/internal/biz/moments"
+	kafka "kratos-realworld/internal/kafka"
+	"kratos-realworld/internal/pkg/middleware/auth"
+	"sort"
+
+	"gorm.io/gorm"
+)
+
+const (
+	defaultPullThreshold   = 5000
+	defaultFanoutBatchSize = 500
+)
+
+func (uc *MomentUsecase) pullThreshold() int {
+	if uc.dc == nil || uc.dc.Timeline == nil || uc.dc.Timeline.PullThreshold <= 0 {
+		return defaultPullThreshold
+	}
+	return int(uc.dc.Timeline.PullThreshold)
+}
+
+func (uc *MomentUsecase) fanoutBatchSize() int {
+	if uc.dc == nil || uc.dc.Timeline == nil || uc.dc.Timeline.FanoutBatchSize <= 0 {
+		return defaultFanoutBatchSize
+	}
+	return int(uc.dc.Timeline.FanoutBatchSize)
+}
+
+// ConsumeMomentMessage kafka 消费回调，维护粉丝的接收盒
+func (uc *MomentUsecase) ConsumeMomentMessage(data []byte) {
+	var msg kafka.MomentMessage
+	if err := json.Unmarshal(data, &msg); err != nil {
+		uc.log.Errorf("unmarshal moment message error: %v", err)
+		return
+	}
+
+	ctx := context.Background()
+	var err error
+	switch msg.Action {
+	case kafka.MOMENT_ACTION_CREATE:
+		err = uc.fanoutMoment(ctx, msg.MomentID, msg.PushIDs)
+	case kafka.MOMENT_ACTION_DELETE:
+		err = uc.mr.RetractMoment(ctx, msg.MomentID)
+	case kafka.MOMENT_ACTION_BLACKLIST_ADDED, kafka.MOMENT_ACTION_AUDIENCE_REMOVED:
+		err = uc.mr.RetractFromReceiveBoxes(ctx, msg.MomentID, msg.PushIDs)
+	case kafka.MOMENT_ACTION_BLACKLIST_REMOVED, kafka.MOMENT_ACTION_AUDIENCE_ADDED:
+		err = uc.redeliverMoment(ctx, msg.MomentID, msg.PushIDs)
+	default:
+		uc.log.Warnf("ignore moment message with unknown action: %s", msg.Action)
+	}
+	if err != nil {
+		uc.log.Errorf("handle moment message %s of moment %d error: %v", msg.Action, msg.MomentID, err)
+	}
+}
+
+// fanoutMoment 以当前的动态为准，已经删除的不再推送，推送前黑名单、可见范围又有变化的以最新的为准
+func (uc *MomentUsecase) fanoutMoment(ctx context.Context, momentID uint32, pushIDs []uint32) error {
+	moment, err := uc.mr.GetMoment(ctx, momentID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if moment.FanoutPull {
+		return nil
+	}
+	pushIDs, err = uc.filterMomentScope(ctx, moment, pushIDs)
+	if err != nil {
+		return err
+	}
+	return uc.mr.PushToReceiveBoxes(ctx, moment.ID, moment.UserID, pushIDs, uc.fanoutBatchSize())
+}
+
+// redeliverMoment 移出黑名单、新获得可见权限的人如果仍然是粉丝并且在可见范围内，重新写回接收盒
+func (uc *MomentUsecase) redeliverMoment(ctx context.Context, momentID uint32, userIDs []uint32) error {
+	moment, err := uc.mr.GetMoment(ctx, momentID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if moment.FanoutPull || len(userIDs) == 0 {
+		return nil
+	}
+
+	followers, err := uc.pr.FilterFollowers(ctx, moment.UserID, userIDs)
+	if err != nil {
+		return err
+	}
+	pushIDs, err := uc.pushAudience(ctx, moment, followers)
+	if err != nil {
+		return err
+	}
+	return uc.mr.PushToReceiveBoxes(ctx, moment.ID, moment.UserID, pushIDs, uc.fanoutBatchSize())
+}
+
+// GetTimeline 关注的人的动态：接收盒里推送的和大V读时拉取的合并，按动态ID倒序，cursor 为 0 表示第一页
+func (uc *MomentUsecase) GetTimeline(ctx context.Context, cursor uint32, limit int) (*TimelineReply, error) {
+	viewerID := uint32(auth.FromContext(ctx).UserID)
+	_, limit = normalizePage(1, limit)
+
+	pushed, err := uc.mr.ListBoxMoments(ctx, viewerID, cursor, limit+1)
+	if err != nil {
+		uc.log.Errorf("ListBoxMoments error: %v", err)
+		return nil, NewErr(ErrCodeMomentFailed, MOMENT_FAILED, "Get timeline failed")
+	}
+	followingIDs, err := uc.pr.GetFollowingIDs(ctx, viewerID)
+	if err != nil {
+		uc.log.Errorf("GetFollowingIDs error: %v", err)
+		return nil, NewErr(ErrCodeMomentFailed, MOMENT_FAILED, "Get timeline failed")
+	}
+	pulled, err := uc.mr.ListPullMoments(ctx, viewerID, followingIDs, cursor, limit+1)
+	if err != nil {
+		uc.log.Errorf("ListPullMoments error: %v", err)
+		return nil, NewErr(ErrCodeMomentFailed, MOMENT_FAILED, "Get timeline failed")
+	}
+
+	merged := make([]*bizMoment.MomentsMetaTB, 0, len(pushed)+len(pulled))
+	seen := make(map[uint32]struct{}, len(pushed)+len(pulled))
+	for _, m := range append(pushed, pulled...) {
+		if _, ok := seen[m.MomentID]; ok {
+			continue
+		}
+		seen[m.MomentID] = struct{}{}
+		merged = append(merged, m)
+	}
+	sort.Slice(merged, func(i, j int) bool {
+		return merged[i].MomentID > merged[j].MomentID
+	})
+
+	reply := &TimelineReply{Moments: make([]*bizMoment.MomentsMetaTB, 0, len(merged))}
+	if len(merged) > limit {
+		merged = merged[:limit]
+		reply.HasMore = true
+	}
+	if len(merged) > 0 {
+		reply.NextCursor = merged[len(merged)-1].MomentID
+	}
+
+	// 推送之后才拉黑、收紧可见范围的，读的时候再过滤一遍
+	visible := make(map[uint32]bool)
+	for _, m := range merged {
+		ok, checked := visible[m.UserID]
+		if !checked {
+			ok = uc.canViewMoments(ctx, m.UserID, viewerID)
+			visible[m.UserID] = ok
+		}
+		if ok {
+			reply.Moments = append(reply.Moments, m)
+		}
+	}
+	return reply, nil
+}
+
+func (uc *MomentUsecase) canViewMoments(ctx context.Context, authorID uint32, viewerID uint32) bool {
+	if authorID == viewerID {
+		return true
+	}
+	blocked, err := uc.isBlockedBy(ctx, authorID, viewerID)
+	if err != nil {
+		uc.log.Errorf("CheckBlock error: %v", err)
+		return false
+	}
+	if blocked {
+		return false
+	}
+	return uc.pp.CheckCanViewMoments(ctx, authorID, viewerID) == nil
+}
+
+func excludeIDs(ids []uint32, excluded []uint32) []uint32 {
+	if len(excluded) == 0 {
+		return ids
+	}
+	skip := make(map[uint32]struct{}, len(excluded))
+	for _, id := range excluded {
+		skip[id] = struct{}{}
+	}
+	res := make([]uint32, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := skip[id]; !ok {
+			res = append(res, id)
+		}
+	}
+	return res
+}
@@ -0,0 +1,111 @@
+package biz
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+
+	bizMoment "kratos-realworld/internal/biz/moments"
+	"kratos-realworld/internal/conf"
+	kafka "kratos-realworld/internal/kafka"
+)
+
+func consumeMoment(t *testing.T, uc *MomentUsecase, msg *kafka.MomentMessage) {
+	body, err := json.Marshal(msg)
+	assert.NoError(t, err)
+	uc.ConsumeMomentMessage(body)
+}
+
+func timelineIDs(reply *TimelineReply) []uint32 {
+	ids := make([]uint32, 0, len(reply.Moments))
+	for _, m := range reply.Moments {
+		ids = append(ids, m.MomentID)
+	}
+	return ids
+}
+
+func TestCreateMomentSwitchesToPullAboveThreshold(t *testing.T) {
+	pr := newFakeSocialRepo()
+	pr.follow(2, 1)
+	pr.follow(3, 1)
+	pr.follow(4, 1)
+	pr.blocked[[2]uint32{1, 4}] = true
+	pr.follow(2, 5)
+	pr.follow(3, 5)
+	pr.follow(4, 5)
+	pr.follow(6, 5)
+	mr := newFakeMomentStore()
+	dc := &conf.Data{Timeline: &conf.Data_Timeline{PullThreshold: 3}}
+	uc := NewMomentUsecase(mr, newFakeSquareRepo(), pr, NewPrivacyPolicy(pr, log.DefaultLogger), nil, newFakeMediaStorage(), dc, log.DefaultLogger)
+
+	// 被作者拉黑的粉丝不推送
+	small := &bizMoment.MomentTB{Message: "small"}
+	assert.NoError(t, uc.CreateMoment(userCtx(1), small, nil))
+	assert.False(t, small.FanoutPull)
+	assert.ElementsMatch(t, []uint32{2, 3}, small.PushIDs)
+
+	big := &bizMoment.MomentTB{Message: "big"}
+	assert.NoError(t, uc.CreateMoment(userCtx(5), big, nil))
+	assert.True(t, big.FanoutPull)
+	assert.Empty(t, big.PushIDs)
+}
+
+func TestConsumeMomentMessageMaintainsBoxes(t *testing.T) {
+	pr := newFakeSocialRepo()
+	pr.follow(2, 1)
+	pr.follow(3, 1)
+	mr := newFakeMomentStore()
+	uc := newTestMomentUsecase(mr, pr)
+
+	// 推送前作者把 3 加进了黑名单，以最新的动态为准
+	id := mr.add(&bizMoment.MomentTB{UserID: 1, PushIDs: []uint32{2, 3}, BlackListIDs: []uint32{3}})
+	consumeMoment(t, uc, &kafka.MomentMessage{UserID: 1, MomentID: id, Action: kafka.MOMENT_ACTION_CREATE, PushIDs: []uint32{2, 3}})
+	assert.Equal(t, []uint32{id}, mr.boxes[2])
+	assert.Empty(t, mr.boxes[3])
+
+	// 移出黑名单后补推，加入黑名单后撤回
+	mr.moments[id].BlackListIDs = []uint32{2}
+	consumeMoment(t, uc, &kafka.MomentMessage{MomentID: id, Action: kafka.MOMENT_ACTION_BLACKLIST_REMOVED, PushIDs: []uint32{3}})
+	consumeMoment(t, uc, &kafka.MomentMessage{MomentID: id, Action: kafka.MOMENT_ACTION_BLACKLIST_ADDED, PushIDs: []uint32{2}})
+	assert.Empty(t, mr.boxes[2])
+	assert.Equal(t, []uint32{id}, mr.boxes[3])
+
+	// 读时拉取的动态不写接收盒
+	pull := mr.add(&bizMoment.MomentTB{UserID: 1, FanoutPull: true})
+	consumeMoment(t, uc, &kafka.MomentMessage{UserID: 1, MomentID: pull, Action: kafka.MOMENT_ACTION_CREATE, PushIDs: []uint32{2}})
+	assert.Empty(t, mr.boxes[2])
+
+	consumeMoment(t, uc, &kafka.MomentMessage{UserID: 1, MomentID: id, Action: kafka.MOMENT_ACTION_DELETE})
+	assert.Empty(t, mr.boxes[3])
+}
+
+func TestGetTimelineMergesPushedAndPulled(t *testing.T) {
+	pr := newFakeSocialRepo()
+	pr.follow(9, 1)
+	pr.follow(9, 2)
+	pr.follow(9, 3)
+	pr.blocked[[2]uint32{3, 9}] = true
+	mr := newFakeMomentStore()
+	uc := newTestMomentUsecase(mr, pr)
+
+	pushed1 := mr.add(&bizMoment.MomentTB{UserID: 1})
+	pulled1 := mr.add(&bizMoment.MomentTB{UserID: 2, FanoutPull: true})
+	blocked := mr.add(&bizMoment.MomentTB{UserID: 3})
+	pushed2 := mr.add(&bizMoment.MomentTB{UserID: 1})
+	pulled2 := mr.add(&bizMoment.MomentTB{UserID: 2, FanoutPull: true})
+	mr.boxes[9] = []uint32{pushed1, blocked, pushed2}
+
+	first, err := uc.GetTimeline(userCtx(9), 0, 3)
+	assert.NoError(t, err)
+	assert.True(t, first.HasMore)
+	// 推送之后才拉黑的作者读时过滤掉，但游标仍然往后走
+	assert.Equal(t, []uint32{pulled2, pushed2}, timelineIDs(first))
+	assert.Equal(t, blocked, first.NextCursor)
+
+	second, err := uc.GetTimeline(userCtx(9), first.NextCursor, 3)
+	assert.NoError(t, err)
+	assert.False(t, second.HasMore)
+	assert.Equal(t, []uint32{pulled1, pushed1}, timelineIDs(second))
+}
@@ -0,0 +1,17 @@
+package user
+
+import "time"
+
+// HandleHistoryTB @用户名的修改记录，用来限制修改频率
+type HandleHistoryTB struct {
+	ID        uint32 `gorm:"column:id;type:int(10) unsigned;primary_key;AUTO_INCREMENT" json:"id"`
+	UserID    uint32 `gorm:"column:user_id;type:int(10) unsigned;not null;index;comment:用户ID" json:"user_id"`
+	OldHandle string `gorm:"column:old_handle;type:varchar(20);comment:修改前的用户名;default:null" json:"old_handle"`
+	NewHandle string `gorm:"column:new_handle;type:varchar(20);not null;comment:修改后的用户名" json:"new_handle"`
+
+	SysCreated *time.Time `gorm:"autoCreateTime;column:sys_created;type:datetime;default null;comment:创建时间;NOT NULL" json:"sys_created"`
+}
+
+func (h *HandleHistoryTB) TableName() string {
+	return "t_user_handle_history"
+}
@@ -9,6 +9,7 @@ import (
 type UserTB struct {
 	ID           uint32 `gorm:"column:id;type:int(10) unsigned;primary_key;AUTO_INCREMENT" json:"id"`
 	UserName     string `gorm:"column:UserName;type:varchar(50);comment:账号;NOT NULL" json:"UserName"`
+	Handle       string `gorm:"column:Handle;type:varchar(20);uniqueIndex;comment:唯一的@用户名，全部小写;default:null" json:"Handle"`
 	Phone        string `gorm:"column:Phone;type:varchar(20);comment:手机号码;NOT NULL" json:"Phone"`
 	PasswordHash string `gorm:"column:PassWord;type:text;comment:密码;NOT NULL" json:"PassWord"`
 
@@ -33,8 +34,17 @@ type UserRepo interface {
 	GetUserByPhone(ctx context.Context, phone string) (*UserTB, error)
 	GetPasswordByPhone(ctx context.Context, phone string) (string, error)
 	GetUserByUserID(ctx context.Context, userID uint32) (*UserTB, error)
+	GetUsersByIDs(ctx context.Context, userIDs []uint32) ([]*UserTB, error)
 	UpdateUserPassword(ctx context.Context, phone string, newPasswordHash string) error
 	UpdateUserInfo(ctx context.Context, userID uint32, userInfo *UpdateUserInfoFields) error
+
+	// @用户名，UpdateHandle 遇到重复时返回 gorm.ErrDuplicatedKey
+	UpdateHandle(ctx context.Context, userID uint32, handle string) error
+	CreateHandleHistory(ctx context.Context, history *HandleHistoryTB) error
+	CountHandleChanges(ctx context.Context, userID uint32, since time.Time) (int64, error)
+
+	// 搜索，handle 前缀或者用户名前缀匹配
+	SearchUsers(ctx context.Context, keyword string, offset int, limit int) ([]*UserTB, error)
 }
 
 type UpdateUserInfoFields struct {
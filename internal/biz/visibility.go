@@ -0,0 +1,192 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	bizMoment "kratos-realworld/internal/biz/moments"
+	bizProfile "kratos-realworld/internal/biz/profile"
+	kafka "kratos-realworld/internal/kafka"
+	"kratos-realworld/internal/pkg/util"
+)
+
+// allow/hide 可见范围最多能选的人数
+const maxMomentScopeIDs = 1000
+
+// defaultMomentVisibility 发布时没有指定可见范围的，按作者隐私设置里的动态默认可见范围
+func defaultMomentVisibility(audience string) string {
+	switch audience {
+	case bizProfile.AudienceFollowers:
+		return bizMoment.VisibilityFollowers
+	case bizProfile.AudienceFriends:
+		return bizMoment.VisibilityFriends
+	case bizProfile.AudienceNobody:
+		return bizMoment.VisibilityPrivate
+	}
+	return bizMoment.VisibilityPublic
+}
+
+// normalizeMomentScope 校验可见范围，只有 allow/hide 需要用户列表，其他范围的列表丢弃
+func normalizeMomentScope(visibility string, scopeIDs []uint32) (string, []uint32, error) {
+	if !bizMoment.IsValidVisibility(visibility) {
+		return "", nil, NewErr(ErrCodeInvalidParams, INVALID_PARAMS, "invalid moment visibility")
+	}
+	if visibility != bizMoment.VisibilityAllow && visibility != bizMoment.VisibilityHide {
+		return visibility, nil, nil
+	}
+	ids := make([]uint32, 0, len(scopeIDs))
+	for _, id := range scopeIDs {
+		if id != 0 && !inIDs(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return "", nil, NewErr(ErrCodeInvalidParams, INVALID_PARAMS, "scope users are required for this visibility")
+	}
+	if len(ids) > maxMomentScopeIDs {
+		return "", nil, NewErr(ErrCodeInvalidParams, INVALID_PARAMS, "too many scope users")
+	}
+	return visibility, ids, nil
+}
+
+// filterMomentScope 返回 viewerIDs 中在这条动态可见范围内的人，动态黑名单里的人除外，作者自己总是可见
+func (uc *MomentUsecase) filterMomentScope(ctx context.Context, moment *bizMoment.MomentTB, viewerIDs []uint32) ([]uint32, error) {
+	ids := excludeIDs(viewerIDs, moment.BlackListIDs)
+	var allowed []uint32
+	var err error
+	switch moment.Visibility {
+	case bizMoment.VisibilityFollowers:
+		allowed, err = uc.pr.FilterFollowers(ctx, moment.UserID, ids)
+	case bizMoment.VisibilityFriends:
+		allowed, err = uc.pr.FilterFriends(ctx, moment.UserID, ids)
+	case bizMoment.VisibilityPrivate:
+	case bizMoment.VisibilityAllow:
+		for _, id := range ids {
+			if inIDs(moment.ScopeIDs, id) {
+				allowed = append(allowed, id)
+			}
+		}
+	case bizMoment.VisibilityHide:
+		allowed = excludeIDs(ids, moment.ScopeIDs)
+	default:
+		allowed = ids
+	}
+	if err != nil {
+		return nil, err
+	}
+	if inIDs(viewerIDs, moment.UserID) && !inIDs(allowed, moment.UserID) {
+		allowed = append(allowed, moment.UserID)
+	}
+	return allowed, nil
+}
+
+func (uc *MomentUsecase) canViewMoment(ctx context.Context, moment *bizMoment.MomentTB, viewerID uint32) (bool, error) {
+	if moment.UserID == viewerID {
+		return true, nil
+	}
+	allowed, err := uc.filterMomentScope(ctx, moment, []uint32{viewerID})
+	if err != nil {
+		return false, err
+	}
+	return len(allowed) > 0, nil
+}
+
+// pushAudience 去掉被作者拉黑的、不在作者动态默认可见范围内的、不在这条动态可见范围内的
+func (uc *MomentUsecase) pushAudience(ctx context.Context, moment *bizMoment.MomentTB, userIDs []uint32) ([]uint32, error) {
+	if len(userIDs) == 0 {
+		return userIDs, nil
+	}
+	blockedIDs, err := uc.pr.ListBlockedIDs(ctx, moment.UserID)
+	if err != nil {
+		return nil, err
+	}
+	ids := excludeIDs(userIDs, blockedIDs)
+	if len(ids) == 0 {
+		return ids, nil
+	}
+	ids, err = uc.pp.FilterMomentAudience(ctx, moment.UserID, ids)
+	if err != nil {
+		return nil, err
+	}
+	return uc.filterMomentScope(ctx, moment, ids)
+}
+
+func (uc *MomentUsecase) sendMomentMessage(msg *kafka.MomentMessage) error {
+	body, err := json.Marshal(msg)
+	if err != nil {
+		uc.log.Errorf("Marshal MomentMessage error: %v", err)
+		return NewErr(ErrCodeMomentFailed, MOMENT_FAILED, "Marshal moment message failed")
+	}
+	kafka.SendTopic(kafka.MOMENT_TOPIC, body)
+	uc.log.Infof("Send MomentMessage to Kafka: %s", body)
+	return nil
+}
+
+// UpdateMomentVisibility 修改已发布动态的可见范围，失去权限的粉丝从接收盒撤回，新获得权限的补推
+// 返回新增和失去可见权限的粉丝，读时拉取的动态不用维护接收盒
+func (uc *MomentUsecase) UpdateMomentVisibility(ctx context.Context, momentID uint32, visibility string, scopeIDs []uint32) ([]uint32, []uint32, error) {
+	moment, err := uc.getOwnMoment(ctx, momentID)
+	if err != nil {
+		return nil, nil, err
+	}
+	visibility, scopeIDs, err = normalizeMomentScope(visibility, scopeIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+	updated := *moment
+	updated.Visibility = visibility
+	updated.ScopeIDs = scopeIDs
+
+	var addedIDs, removedIDs []uint32
+	if !moment.FanoutPull {
+		followerIDs, err := uc.pr.GetFollowerIDs(ctx, moment.UserID)
+		if err != nil {
+			uc.log.Errorf("GetFollowerIDs error: %v", err)
+			return nil, nil, NewErr(ErrCodeMomentFailed, MOMENT_FAILED, "Update moment visibility failed")
+		}
+		oldAudience, err := uc.pushAudience(ctx, moment, followerIDs)
+		if err != nil {
+			uc.log.Errorf("pushAudience error: %v", err)
+			return nil, nil, NewErr(ErrCodeMomentFailed, MOMENT_FAILED, "Update moment visibility failed")
+		}
+		newAudience, err := uc.pushAudience(ctx, &updated, followerIDs)
+		if err != nil {
+			uc.log.Errorf("pushAudience error: %v", err)
+			return nil, nil, NewErr(ErrCodeMomentFailed, MOMENT_FAILED, "Update moment visibility failed")
+		}
+		addedIDs, removedIDs = util.DiffIDs(oldAudience, newAudience)
+	}
+
+	err = uc.mr.UpdateMomentVisibility(ctx, momentID, visibility, scopeIDs)
+	if err != nil {
+		uc.log.Errorf("UpdateMomentVisibility error: %v", err)
+		return nil, nil, NewErr(ErrCodeMomentFailed, MOMENT_FAILED, "Update moment visibility failed")
+	}
+	// 只有公开的动态在广场展示
+	if visibility == bizMoment.VisibilityPublic {
+		uc.addToSquare(ctx, &updated)
+	} else if moment.Visibility == bizMoment.VisibilityPublic {
+		uc.removeFromSquare(ctx, momentID)
+	}
+
+	if len(removedIDs) > 0 {
+		err := uc.sendMomentMessage(&kafka.MomentMessage{
+			MomentID: momentID,
+			Action:   kafka.MOMENT_ACTION_AUDIENCE_REMOVED,
+			PushIDs:  removedIDs,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	if len(addedIDs) > 0 {
+		err := uc.sendMomentMessage(&kafka.MomentMessage{
+			MomentID: momentID,
+			Action:   kafka.MOMENT_ACTION_AUDIENCE_ADDED,
+			PushIDs:  addedIDs,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return addedIDs, removedIDs, nil
+}
@@ -0,0 +1,105 @@
+package biz
+
+import (
+	"context"
+	"testing"
+
+	bizMoment "kratos-realworld/internal/biz/moments"
+	bizProfile "kratos-realworld/internal/biz/profile"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeMomentScope(t *testing.T) {
+	assert.Equal(t, bizMoment.VisibilityPrivate, defaultMomentVisibility(bizProfile.AudienceNobody))
+	assert.Equal(t, bizMoment.VisibilityPublic, defaultMomentVisibility(bizProfile.AudienceEveryone))
+
+	visibility, ids, err := normalizeMomentScope(bizMoment.VisibilityAllow, []uint32{3, 0, 3, 5})
+	assert.NoError(t, err)
+	assert.Equal(t, bizMoment.VisibilityAllow, visibility)
+	assert.Equal(t, []uint32{3, 5}, ids)
+
+	_, ids, err = normalizeMomentScope(bizMoment.VisibilityFriends, []uint32{3})
+	assert.NoError(t, err)
+	assert.Nil(t, ids)
+
+	_, _, err = normalizeMomentScope(bizMoment.VisibilityHide, nil)
+	assert.Error(t, err)
+	_, _, err = normalizeMomentScope("everyone", nil)
+	assert.Error(t, err)
+}
+
+func TestFilterMomentScope(t *testing.T) {
+	pr := newFakeSocialRepo()
+	pr.follow(2, 1)
+	pr.follow(3, 1)
+	pr.follow(5, 1)
+	pr.befriend(1, 3)
+	uc := newTestMomentUsecase(newFakeMomentStore(), pr)
+	viewers := []uint32{1, 2, 3, 4, 5}
+
+	// 2 是粉丝，3 是好友，4 是陌生人，5 是在动态黑名单里的粉丝，作者自己总是可见
+	tests := []struct {
+		visibility string
+		scopeIDs   []uint32
+		want       []uint32
+	}{
+		{bizMoment.VisibilityPublic, nil, []uint32{1, 2, 3, 4}},
+		{bizMoment.VisibilityFollowers, nil, []uint32{1, 2, 3}},
+		{bizMoment.VisibilityFriends, nil, []uint32{1, 3}},
+		{bizMoment.VisibilityPrivate, nil, []uint32{1}},
+		{bizMoment.VisibilityAllow, []uint32{4, 5}, []uint32{1, 4}},
+		{bizMoment.VisibilityHide, []uint32{2}, []uint32{1, 3, 4}},
+	}
+	for _, tt := range tests {
+		moment := &bizMoment.MomentTB{UserID: 1, Visibility: tt.visibility, ScopeIDs: tt.scopeIDs, BlackListIDs: []uint32{5}}
+		got, err := uc.filterMomentScope(context.Background(), moment, viewers)
+		assert.NoError(t, err, tt.visibility)
+		assert.ElementsMatch(t, tt.want, got, tt.visibility)
+	}
+}
+
+func TestUpdateMomentVisibilityDiff(t *testing.T) {
+	pr := newFakeSocialRepo()
+	pr.follow(2, 1)
+	pr.follow(3, 1)
+	pr.follow(4, 1)
+	pr.befriend(1, 3)
+	mr := newFakeMomentStore()
+	sq := newFakeSquareRepo()
+	uc := NewMomentUsecase(mr, sq, pr, NewPrivacyPolicy(pr, log.DefaultLogger), nil, newFakeMediaStorage(), nil, log.DefaultLogger)
+	id := mr.add(&bizMoment.MomentTB{UserID: 1, Visibility: bizMoment.VisibilityPublic})
+	sq.pool[id] = true
+
+	_, _, err := uc.UpdateMomentVisibility(userCtx(2), id, bizMoment.VisibilityPrivate, nil)
+	assert.Equal(t, MOMENT_PERMISSION_DENIED, errors.FromError(err).Reason)
+
+	added, removed, err := uc.UpdateMomentVisibility(userCtx(1), id, bizMoment.VisibilityFriends, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, added)
+	assert.ElementsMatch(t, []uint32{2, 4}, removed)
+	assert.Equal(t, bizMoment.VisibilityFriends, mr.moments[id].Visibility)
+	assert.False(t, sq.pool[id])
+
+	added, removed, err = uc.UpdateMomentVisibility(userCtx(1), id, bizMoment.VisibilityAllow, []uint32{2, 2, 9})
+	assert.NoError(t, err)
+	assert.Equal(t, []uint32{2}, added)
+	assert.Equal(t, []uint32{3}, removed)
+	assert.Equal(t, []uint32{2, 9}, mr.moments[id].ScopeIDs)
+
+	added, removed, err = uc.UpdateMomentVisibility(userCtx(1), id, bizMoment.VisibilityPublic, nil)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []uint32{3, 4}, added)
+	assert.Empty(t, removed)
+	assert.True(t, sq.pool[id])
+
+	// 读时拉取的动态不维护接收盒
+	pull := mr.add(&bizMoment.MomentTB{UserID: 1, Visibility: bizMoment.VisibilityPublic, FanoutPull: true})
+	added, removed, err = uc.UpdateMomentVisibility(userCtx(1), pull, bizMoment.VisibilityPrivate, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, added)
+	assert.Empty(t, removed)
+	assert.Equal(t, bizMoment.VisibilityPrivate, mr.moments[pull].Visibility)
+}
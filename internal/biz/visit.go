@@ -0,0 +1,151 @@
+package biz
+
+import (
+	"context"
+	"kratos-realworld/internal/pkg/middleware/auth"
+	"time"
+)
+
+const (
+	flushProfileViewsLockKey = "job:lock:flushProfileViews"
+	profileViewDayLayout     = "20060102"
+)
+
+// recordProfileView 记录一次主页浏览，自己看自己不算；失败只打日志，不影响查看主页
+func (pc *ProfileUsecase) recordProfileView(ctx context.Context, userID uint32, viewerID uint32) {
+	if userID == viewerID {
+		return
+	}
+
+	// 开启了隐藏足迹的人照常计数，但不出现在对方的访客列表里
+	recordVisitor := true
+	if viewer, err := pc.pr.GetProfileByUserID(ctx, viewerID); err == nil && viewer.HideVisits {
+		recordVisitor = false
+	}
+
+	day := time.Now().Format(profileViewDayLayout)
+	if _, err := pc.pr.RecordProfileView(ctx, userID, viewerID, day, recordVisitor); err != nil {
+		pc.log.Warnf("failed to record profile view, user=%d viewer=%d err=%v", userID, viewerID, err)
+	}
+}
+
+// FlushProfileViews 把 redis 里累加的浏览次数刷回 mysql，返回刷新的用户数
+// 没抢到锁说明别的实例正在执行，返回 false
+func (pc *ProfileUsecase) FlushProfileViews(ctx context.Context, lockTTL time.Duration) (int, bool, error) {
+	token, ok, err := pc.lk.TryLock(ctx, flushProfileViewsLockKey, lockTTL)
+	if err != nil || !ok {
+		return 0, false, err
+	}
+	defer func() {
+		if err := pc.lk.Unlock(context.Background(), flushProfileViewsLockKey, token); err != nil {
+			pc.log.Warnf("failed to release lock %s: %v", flushProfileViewsLockKey, err)
+		}
+	}()
+
+	pending, err := pc.pr.ListPendingViewCounts(ctx)
+	if err != nil {
+		return 0, true, err
+	}
+	flushed := 0
+	for userID, cnt := range pending {
+		if err := pc.pr.IncrementViewCount(ctx, userID, int(cnt)); err != nil {
+			pc.log.Errorf("flush view count of user %d error: %v", userID, err)
+			continue
+		}
+		// 扣减失败会在下一轮重复累加，只能打日志提醒
+		if err := pc.pr.AckPendingViewCount(ctx, userID, cnt); err != nil {
+			pc.log.Errorf("ack pending view count of user %d error: %v", userID, err)
+		}
+		flushed++
+	}
+	return flushed, true, nil
+}
+
+// ListProfileVisitors 查询最近访问过我主页的人，cursor 为上一页返回的 next_cursor，0 表示第一页
+func (pc *ProfileUsecase) ListProfileVisitors(ctx context.Context, cursor int64, limit int) (*VisitorListReply, error) {
+	userID := uint32(auth.FromContext(ctx).UserID)
+	_, limit = normalizePage(1, limit)
+
+	visits, err := pc.pr.ListProfileVisitors(ctx, userID, cursor, limit+1)
+	if err != nil {
+		return nil, NewErr(ErrCodeDBQueryFailed, DB_QUERY_FAILED, "failed to query profile visitors")
+	}
+	reply := &VisitorListReply{Visitors: make([]*VisitorItem, 0, len(visits))}
+	if len(visits) > limit {
+		visits = visits[:limit]
+		reply.HasMore = true
+	}
+	if len(visits) == 0 {
+		return reply, nil
+	}
+	reply.NextCursor = visits[len(visits)-1].VisitedAt
+
+	ids := make([]uint32, 0, len(visits))
+	for _, v := range visits {
+		ids = append(ids, v.VisitorID)
+	}
+
+	// 访问之后才开启隐藏足迹的人，以及被我拉黑的人都不展示
+	hidden := make(map[uint32]struct{})
+	profiles, err := pc.pr.GetProfilesByUserIDs(ctx, ids)
+	if err != nil {
+		return nil, NewErr(ErrCodeDBQueryFailed, DB_QUERY_FAILED, "failed to query profiles")
+	}
+	for _, p := range profiles {
+		if p.HideVisits {
+			hidden[p.UserID] = struct{}{}
+		}
+	}
+	blocked, err := pc.pr.ListBlockedIDs(ctx, userID)
+	if err != nil {
+		return nil, NewErr(ErrCodeDBQueryFailed, DB_QUERY_FAILED, "failed to query block list")
+	}
+	for _, id := range blocked {
+		hidden[id] = struct{}{}
+	}
+
+	users, err := pc.ur.GetUsersByIDs(ctx, ids)
+	if err != nil {
+		return nil, NewErr(ErrCodeDBQueryFailed, DB_QUERY_FAILED, "failed to query users")
+	}
+	items := make(map[uint32]*VisitorItem, len(users))
+	for _, u := range users {
+		items[u.ID] = &VisitorItem{
+			UserID:    u.ID,
+			UserName:  u.UserName,
+			HeadImage: u.HeadImage,
+		}
+	}
+
+	for _, v := range visits {
+		if _, ok := hidden[v.VisitorID]; ok {
+			continue
+		}
+		item, ok := items[v.VisitorID]
+		if !ok {
+			continue
+		}
+		item.VisitedAt = time.UnixMilli(v.VisitedAt)
+		reply.Visitors = append(reply.Visitors, item)
+	}
+	return reply, nil
+}
+
+// UpdateVisitPrivacy 设置浏览别人主页时是否隐藏足迹
+func (pc *ProfileUsecase) UpdateVisitPrivacy(ctx context.Context, hideVisits bool) error {
+	userID := uint32(auth.FromContext(ctx).UserID)
+	if err := pc.pr.UpdateHideVisits(ctx, userID, hideVisits); err != nil {
+		return NewErr(ErrCodeDBQueryFailed, DB_QUERY_FAILED, "failed to update visit privacy")
+	}
+	return nil
+}
+
+// 返回给前端的浏览量要加上还没刷回 mysql 的部分
+func (pc *ProfileUsecase) pendingViewCount(ctx context.Context, userID uint32) uint32 {
+	cnt, err := pc.pr.GetPendingViewCount(ctx, userID)
+	if err != nil {
+		pc.log.Warnf("failed to get pending view count of user %d: %v", userID, err)
+		return 0
+	}
+	return cnt
+}
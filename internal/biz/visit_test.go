@@ -0,0 +1,148 @@
+package biz
+
+import (
+	"context"
+	bizProfile "kratos-realworld/internal/biz/profile"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+type recordedView struct {
+	userID        uint32
+	viewerID      uint32
+	recordVisitor bool
+}
+
+type fakeVisitRepo struct {
+	*fakeSocialRepo
+	views   []recordedView
+	visits  []*bizProfile.ProfileVisit // 按访问时间倒序
+	pending map[uint32]int64
+	incrErr map[uint32]error
+	counts  map[uint32]int
+	acked   map[uint32]int64
+}
+
+func newFakeVisitRepo() *fakeVisitRepo {
+	return &fakeVisitRepo{
+		fakeSocialRepo: newFakeSocialRepo(),
+		pending:        make(map[uint32]int64),
+		incrErr:        make(map[uint32]error),
+		counts:         make(map[uint32]int),
+		acked:          make(map[uint32]int64),
+	}
+}
+
+func (r *fakeVisitRepo) GetProfileByUserID(ctx context.Context, userID uint32) (*bizProfile.ProfileTB, error) {
+	if p, ok := r.profiles[userID]; ok {
+		return p, nil
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (r *fakeVisitRepo) GetProfilesByUserIDs(ctx context.Context, userIDs []uint32) ([]*bizProfile.ProfileTB, error) {
+	var res []*bizProfile.ProfileTB
+	for _, id := range userIDs {
+		if p, ok := r.profiles[id]; ok {
+			res = append(res, p)
+		}
+	}
+	return res, nil
+}
+
+func (r *fakeVisitRepo) RecordProfileView(ctx context.Context, userID uint32, viewerID uint32, day string, recordVisitor bool) (bool, error) {
+	r.views = append(r.views, recordedView{userID, viewerID, recordVisitor})
+	return true, nil
+}
+
+func (r *fakeVisitRepo) ListProfileVisitors(ctx context.Context, userID uint32, cursor int64, limit int) ([]*bizProfile.ProfileVisit, error) {
+	var res []*bizProfile.ProfileVisit
+	for _, v := range r.visits {
+		if (cursor == 0 || v.VisitedAt < cursor) && len(res) < limit {
+			res = append(res, v)
+		}
+	}
+	return res, nil
+}
+
+func (r *fakeVisitRepo) ListPendingViewCounts(ctx context.Context) (map[uint32]int64, error) {
+	return r.pending, nil
+}
+
+func (r *fakeVisitRepo) IncrementViewCount(ctx context.Context, userID uint32, delta int) error {
+	if err := r.incrErr[userID]; err != nil {
+		return err
+	}
+	r.counts[userID] += delta
+	return nil
+}
+
+func (r *fakeVisitRepo) AckPendingViewCount(ctx context.Context, userID uint32, flushed int64) error {
+	r.acked[userID] += flushed
+	return nil
+}
+
+// 自己看自己不计数，隐藏足迹的人计数但不进访客列表
+func TestRecordProfileView(t *testing.T) {
+	pr := newFakeVisitRepo()
+	pr.profiles[3] = &bizProfile.ProfileTB{UserID: 3, HideVisits: true}
+	pc := NewProfileUsecase(pr, nil, fakeTx{}, nil, nil, nil, nil, log.DefaultLogger)
+
+	pc.recordProfileView(context.Background(), 1, 1)
+	pc.recordProfileView(context.Background(), 1, 2)
+	pc.recordProfileView(context.Background(), 1, 3)
+	assert.Equal(t, []recordedView{{1, 2, true}, {1, 3, false}}, pr.views)
+}
+
+// 刷回失败的不扣减 redis 里的计数，下一轮重试
+func TestFlushProfileViews(t *testing.T) {
+	pr := newFakeVisitRepo()
+	pr.pending[1] = 5
+	pr.pending[2] = 3
+	pr.incrErr[2] = errFakeDB
+	lk := newFakeLocker()
+	pc := NewProfileUsecase(pr, nil, fakeTx{}, lk, nil, nil, nil, log.DefaultLogger)
+
+	n, ok, err := pc.FlushProfileViews(context.Background(), time.Minute)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, 5, pr.counts[1])
+	assert.Equal(t, map[uint32]int64{1: 5}, pr.acked)
+	assert.Empty(t, lk.held)
+
+	lk.held[flushProfileViewsLockKey] = "other"
+	_, ok, err = pc.FlushProfileViews(context.Background(), time.Minute)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+}
+
+// 访客列表去掉之后开启隐藏足迹的人和被我拉黑的人，分页游标是访问时间
+func TestListProfileVisitors(t *testing.T) {
+	pr := newFakeVisitRepo()
+	now := time.Now().UnixMilli()
+	for i, id := range []uint32{2, 3, 4, 5} {
+		pr.visits = append(pr.visits, &bizProfile.ProfileVisit{VisitorID: id, VisitedAt: now - int64(i)*1000})
+	}
+	pr.profiles[3] = &bizProfile.ProfileTB{UserID: 3, HideVisits: true}
+	pr.blocked[[2]uint32{1, 4}] = true
+	pc := NewProfileUsecase(pr, newFakeUserRepo(2, 3, 4, 5), fakeTx{}, nil, nil, nil, nil, log.DefaultLogger)
+
+	page, err := pc.ListProfileVisitors(userCtx(1), 0, 3)
+	assert.NoError(t, err)
+	assert.True(t, page.HasMore)
+	assert.Equal(t, now-2000, page.NextCursor)
+	assert.Len(t, page.Visitors, 1)
+	assert.Equal(t, uint32(2), page.Visitors[0].UserID)
+	assert.Equal(t, now, page.Visitors[0].VisitedAt.UnixMilli())
+
+	page, err = pc.ListProfileVisitors(userCtx(1), page.NextCursor, 3)
+	assert.NoError(t, err)
+	assert.False(t, page.HasMore)
+	assert.Len(t, page.Visitors, 1)
+	assert.Equal(t, uint32(5), page.Visitors[0].UserID)
+}
@@ -4,6 +4,27 @@ const (
 	HEAT_BEAT = "heatbeat"
 	PONG      = "pong"
 
+	// 服务端下发的通知类型，放在消息的 type 字段
+	MENTION = "mention" // 有人@我
+	ERROR   = "error"   // 发送失败，content 为失败原因
+
+	// 系统通知，from 固定为 SYSTEM，content 为 json
+	SYSTEM             = "System"
+	GROUP_JOIN_RESULT  = "group_join_result"  // 入群申请审批结果
+	CONVERSATION_SYNC  = "conversation_sync"  // 会话设置变更，同步到用户的其他设备
+	CONVERSATION_TIMER = "conversation_timer" // 会话的阅后即焚时长变更
+	MESSAGES_EXPIRED   = "messages_expired"   // 会话中的消息已过期删除
+	EXPORT_READY       = "export_ready"       // 聊天记录导出完成，content 里有下载链接
+	EXPORT_FAILED      = "export_failed"      // 聊天记录导出失败
+	FRIEND_REQUEST     = "friend_request"     // 收到新的好友申请
+	FRIEND_UPDATE      = "friend_update"      // 好友申请被处理或者好友关系解除
+	ACCOUNT_BANNED     = "account_banned"     // 账号被封禁，下发原因后服务端断开连接
+	MOMENT_LIKED       = "moment_liked"       // 自己的动态被点赞
+	MOMENT_PUBLISHED   = "moment_published"   // 关注的人发布了新动态
+
+	// 群聊中@所有人
+	MENTION_ALL = "all"
+
 	// 消息类型，单聊或者群聊
 	MESSAGE_TYPE_USER  = 1
 	MESSAGE_TYPE_GROUP = 2
@@ -29,6 +29,7 @@ type Bootstrap struct {
 	Jwt           *JWT                   `protobuf:"bytes,3,opt,name=jwt,proto3" json:"jwt,omitempty"`
 	Log           *Log                   `protobuf:"bytes,4,opt,name=log,proto3" json:"log,omitempty"`
 	Sms           *Sms                   `protobuf:"bytes,5,opt,name=sms,proto3" json:"sms,omitempty"`
+	Admin         *Admin                 `protobuf:"bytes,6,opt,name=admin,proto3" json:"admin,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -98,12 +99,20 @@ func (x *Bootstrap) GetSms() *Sms {
 	return nil
 }
 
+func (x *Bootstrap) GetAdmin() *Admin {
+	if x != nil {
+		return x.Admin
+	}
+	return nil
+}
+
 type Server struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Http          *Server_HTTP           `protobuf:"bytes,1,opt,name=http,proto3" json:"http,omitempty"`
-	Grpc          *Server_GRPC           `protobuf:"bytes,2,opt,name=grpc,proto3" json:"grpc,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Http           *Server_HTTP           `protobuf:"bytes,1,opt,name=http,proto3" json:"http,omitempty"`
+	Grpc           *Server_GRPC           `protobuf:"bytes,2,opt,name=grpc,proto3" json:"grpc,omitempty"`
+	TrustedProxies []string               `protobuf:"bytes,3,rep,name=trusted_proxies,json=trustedProxies,proto3" json:"trusted_proxies,omitempty"` // 受信任的反向代理IP或CIDR，只有来自这些地址的 X-Forwarded-For 才会被采信
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *Server) Reset() {
@@ -150,6 +159,13 @@ func (x *Server) GetGrpc() *Server_GRPC {
 	return nil
 }
 
+func (x *Server) GetTrustedProxies() []string {
+	if x != nil {
+		return x.TrustedProxies
+	}
+	return nil
+}
+
 type Data struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Database      *Data_Database         `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
@@ -157,6 +173,14 @@ type Data struct {
 	Dsn           string                 `protobuf:"bytes,3,opt,name=dsn,proto3" json:"dsn,omitempty"`
 	Kafka         *Data_Kafka            `protobuf:"bytes,4,opt,name=kafka,proto3" json:"kafka,omitempty"`
 	Storage       *Data_Storage          `protobuf:"bytes,5,opt,name=storage,proto3" json:"storage,omitempty"`
+	Retention     *Data_Retention        `protobuf:"bytes,6,opt,name=retention,proto3" json:"retention,omitempty"`
+	Suggestion    *Data_Suggestion       `protobuf:"bytes,7,opt,name=suggestion,proto3" json:"suggestion,omitempty"`
+	FollowRepair  *Data_FollowRepair     `protobuf:"bytes,8,opt,name=follow_repair,json=followRepair,proto3" json:"follow_repair,omitempty"`
+	ProfileView   *Data_ProfileView      `protobuf:"bytes,9,opt,name=profile_view,json=profileView,proto3" json:"profile_view,omitempty"`
+	CounterRepair *Data_CounterRepair    `protobuf:"bytes,10,opt,name=counter_repair,json=counterRepair,proto3" json:"counter_repair,omitempty"`
+	Timeline      *Data_Timeline         `protobuf:"bytes,11,opt,name=timeline,proto3" json:"timeline,omitempty"`
+	Square        *Data_Square           `protobuf:"bytes,12,opt,name=square,proto3" json:"square,omitempty"`
+	LikeFlush     *Data_LikeFlush        `protobuf:"bytes,13,opt,name=like_flush,json=likeFlush,proto3" json:"like_flush,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -226,6 +250,106 @@ func (x *Data) GetStorage() *Data_Storage {
 	return nil
 }
 
+func (x *Data) GetRetention() *Data_Retention {
+	if x != nil {
+		return x.Retention
+	}
+	return nil
+}
+
+func (x *Data) GetSuggestion() *Data_Suggestion {
+	if x != nil {
+		return x.Suggestion
+	}
+	return nil
+}
+
+func (x *Data) GetFollowRepair() *Data_FollowRepair {
+	if x != nil {
+		return x.FollowRepair
+	}
+	return nil
+}
+
+func (x *Data) GetProfileView() *Data_ProfileView {
+	if x != nil {
+		return x.ProfileView
+	}
+	return nil
+}
+
+func (x *Data) GetCounterRepair() *Data_CounterRepair {
+	if x != nil {
+		return x.CounterRepair
+	}
+	return nil
+}
+
+func (x *Data) GetTimeline() *Data_Timeline {
+	if x != nil {
+		return x.Timeline
+	}
+	return nil
+}
+
+func (x *Data) GetSquare() *Data_Square {
+	if x != nil {
+		return x.Square
+	}
+	return nil
+}
+
+func (x *Data) GetLikeFlush() *Data_LikeFlush {
+	if x != nil {
+		return x.LikeFlush
+	}
+	return nil
+}
+
+type Admin struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserIds       []uint32               `protobuf:"varint,1,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"` // 可以封禁、解封用户的管理员
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Admin) Reset() {
+	*x = Admin{}
+	mi := &file_internal_conf_conf_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Admin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Admin) ProtoMessage() {}
+
+func (x *Admin) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Admin.ProtoReflect.Descriptor instead.
+func (*Admin) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Admin) GetUserIds() []uint32 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
 type JWT struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Secret        string                 `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
@@ -236,7 +360,7 @@ type JWT struct {
 
 func (x *JWT) Reset() {
 	*x = JWT{}
-	mi := &file_internal_conf_conf_proto_msgTypes[3]
+	mi := &file_internal_conf_conf_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -248,7 +372,7 @@ func (x *JWT) String() string {
 func (*JWT) ProtoMessage() {}
 
 func (x *JWT) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_conf_conf_proto_msgTypes[3]
+	mi := &file_internal_conf_conf_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -261,7 +385,7 @@ func (x *JWT) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JWT.ProtoReflect.Descriptor instead.
 func (*JWT) Descriptor() ([]byte, []int) {
-	return file_internal_conf_conf_proto_rawDescGZIP(), []int{3}
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *JWT) GetSecret() string {
@@ -293,7 +417,7 @@ type Log struct {
 
 func (x *Log) Reset() {
 	*x = Log{}
-	mi := &file_internal_conf_conf_proto_msgTypes[4]
+	mi := &file_internal_conf_conf_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -305,7 +429,7 @@ func (x *Log) String() string {
 func (*Log) ProtoMessage() {}
 
 func (x *Log) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_conf_conf_proto_msgTypes[4]
+	mi := &file_internal_conf_conf_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -318,7 +442,7 @@ func (x *Log) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Log.ProtoReflect.Descriptor instead.
 func (*Log) Descriptor() ([]byte, []int) {
-	return file_internal_conf_conf_proto_rawDescGZIP(), []int{4}
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Log) GetDirector() string {
@@ -386,7 +510,7 @@ type Sms struct {
 
 func (x *Sms) Reset() {
 	*x = Sms{}
-	mi := &file_internal_conf_conf_proto_msgTypes[5]
+	mi := &file_internal_conf_conf_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -398,7 +522,7 @@ func (x *Sms) String() string {
 func (*Sms) ProtoMessage() {}
 
 func (x *Sms) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_conf_conf_proto_msgTypes[5]
+	mi := &file_internal_conf_conf_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -411,7 +535,7 @@ func (x *Sms) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Sms.ProtoReflect.Descriptor instead.
 func (*Sms) Descriptor() ([]byte, []int) {
-	return file_internal_conf_conf_proto_rawDescGZIP(), []int{5}
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *Sms) GetAccessKeyId() string {
@@ -481,7 +605,7 @@ type Server_HTTP struct {
 
 func (x *Server_HTTP) Reset() {
 	*x = Server_HTTP{}
-	mi := &file_internal_conf_conf_proto_msgTypes[6]
+	mi := &file_internal_conf_conf_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -493,7 +617,7 @@ func (x *Server_HTTP) String() string {
 func (*Server_HTTP) ProtoMessage() {}
 
 func (x *Server_HTTP) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_conf_conf_proto_msgTypes[6]
+	mi := &file_internal_conf_conf_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -541,7 +665,7 @@ type Server_GRPC struct {
 
 func (x *Server_GRPC) Reset() {
 	*x = Server_GRPC{}
-	mi := &file_internal_conf_conf_proto_msgTypes[7]
+	mi := &file_internal_conf_conf_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -553,7 +677,7 @@ func (x *Server_GRPC) String() string {
 func (*Server_GRPC) ProtoMessage() {}
 
 func (x *Server_GRPC) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_conf_conf_proto_msgTypes[7]
+	mi := &file_internal_conf_conf_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -606,7 +730,7 @@ type Data_Database struct {
 
 func (x *Data_Database) Reset() {
 	*x = Data_Database{}
-	mi := &file_internal_conf_conf_proto_msgTypes[8]
+	mi := &file_internal_conf_conf_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -618,7 +742,7 @@ func (x *Data_Database) String() string {
 func (*Data_Database) ProtoMessage() {}
 
 func (x *Data_Database) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_conf_conf_proto_msgTypes[8]
+	mi := &file_internal_conf_conf_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -702,7 +826,7 @@ type Data_Redis struct {
 
 func (x *Data_Redis) Reset() {
 	*x = Data_Redis{}
-	mi := &file_internal_conf_conf_proto_msgTypes[9]
+	mi := &file_internal_conf_conf_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -714,7 +838,7 @@ func (x *Data_Redis) String() string {
 func (*Data_Redis) ProtoMessage() {}
 
 func (x *Data_Redis) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_conf_conf_proto_msgTypes[9]
+	mi := &file_internal_conf_conf_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -760,16 +884,17 @@ func (x *Data_Redis) GetPoolSize() int32 {
 
 type Data_Kafka struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Hosts         string                 `protobuf:"bytes,1,opt,name=hosts,proto3" json:"hosts,omitempty"`      // 多个地址用逗号分隔
-	Topic         string                 `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`      // 默认 topic 名称
-	Enabled       bool                   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"` // 是否启用 kafka
+	Hosts         string                 `protobuf:"bytes,1,opt,name=hosts,proto3" json:"hosts,omitempty"`                                      // 多个地址用逗号分隔
+	Topic         string                 `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`                                      // 默认 topic 名称
+	Enabled       bool                   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`                                 // 是否启用 kafka
+	ConsumerGroup string                 `protobuf:"bytes,4,opt,name=consumer_group,json=consumerGroup,proto3" json:"consumer_group,omitempty"` // 只需要集群处理一次的 topic 使用的消费组，例如动态推送到接收盒
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Data_Kafka) Reset() {
 	*x = Data_Kafka{}
-	mi := &file_internal_conf_conf_proto_msgTypes[10]
+	mi := &file_internal_conf_conf_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -781,7 +906,7 @@ func (x *Data_Kafka) String() string {
 func (*Data_Kafka) ProtoMessage() {}
 
 func (x *Data_Kafka) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_conf_conf_proto_msgTypes[10]
+	mi := &file_internal_conf_conf_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -818,16 +943,29 @@ func (x *Data_Kafka) GetEnabled() bool {
 	return false
 }
 
+func (x *Data_Kafka) GetConsumerGroup() string {
+	if x != nil {
+		return x.ConsumerGroup
+	}
+	return ""
+}
+
 type Data_Storage struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	StaticDir     string                 `protobuf:"bytes,1,opt,name=static_dir,json=staticDir,proto3" json:"static_dir,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	StaticDir           string                 `protobuf:"bytes,1,opt,name=static_dir,json=staticDir,proto3" json:"static_dir,omitempty"`
+	ExportDir           string                 `protobuf:"bytes,2,opt,name=export_dir,json=exportDir,proto3" json:"export_dir,omitempty"`                                 // 聊天记录导出文件目录，不对外直接暴露
+	ExportLinkTtl       *durationpb.Duration   `protobuf:"bytes,3,opt,name=export_link_ttl,json=exportLinkTtl,proto3" json:"export_link_ttl,omitempty"`                   // 导出下载链接有效期
+	MomentImageMaxSize  int64                  `protobuf:"varint,4,opt,name=moment_image_max_size,json=momentImageMaxSize,proto3" json:"moment_image_max_size,omitempty"` // 动态图片大小上限，字节
+	MomentVideoMaxSize  int64                  `protobuf:"varint,5,opt,name=moment_video_max_size,json=momentVideoMaxSize,proto3" json:"moment_video_max_size,omitempty"` // 动态视频大小上限，字节
+	OrphanUploadTtl     *durationpb.Duration   `protobuf:"bytes,6,opt,name=orphan_upload_ttl,json=orphanUploadTtl,proto3" json:"orphan_upload_ttl,omitempty"`             // 上传后超过这么久还没被动态引用的附件会被清理
+	OrphanCleanInterval *durationpb.Duration   `protobuf:"bytes,7,opt,name=orphan_clean_interval,json=orphanCleanInterval,proto3" json:"orphan_clean_interval,omitempty"` // 清理未引用附件的执行间隔
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *Data_Storage) Reset() {
 	*x = Data_Storage{}
-	mi := &file_internal_conf_conf_proto_msgTypes[11]
+	mi := &file_internal_conf_conf_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -839,7 +977,7 @@ func (x *Data_Storage) String() string {
 func (*Data_Storage) ProtoMessage() {}
 
 func (x *Data_Storage) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_conf_conf_proto_msgTypes[11]
+	mi := &file_internal_conf_conf_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -862,6 +1000,544 @@ func (x *Data_Storage) GetStaticDir() string {
 	return ""
 }
 
+func (x *Data_Storage) GetExportDir() string {
+	if x != nil {
+		return x.ExportDir
+	}
+	return ""
+}
+
+func (x *Data_Storage) GetExportLinkTtl() *durationpb.Duration {
+	if x != nil {
+		return x.ExportLinkTtl
+	}
+	return nil
+}
+
+func (x *Data_Storage) GetMomentImageMaxSize() int64 {
+	if x != nil {
+		return x.MomentImageMaxSize
+	}
+	return 0
+}
+
+func (x *Data_Storage) GetMomentVideoMaxSize() int64 {
+	if x != nil {
+		return x.MomentVideoMaxSize
+	}
+	return 0
+}
+
+func (x *Data_Storage) GetOrphanUploadTtl() *durationpb.Duration {
+	if x != nil {
+		return x.OrphanUploadTtl
+	}
+	return nil
+}
+
+func (x *Data_Storage) GetOrphanCleanInterval() *durationpb.Duration {
+	if x != nil {
+		return x.OrphanCleanInterval
+	}
+	return nil
+}
+
+type Data_Retention struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	MessageTtl      *durationpb.Duration   `protobuf:"bytes,1,opt,name=message_ttl,json=messageTtl,proto3" json:"message_ttl,omitempty"`                   // 全局消息保留时长，超过后硬删除，0表示不限
+	PurgeInterval   *durationpb.Duration   `protobuf:"bytes,2,opt,name=purge_interval,json=purgeInterval,proto3" json:"purge_interval,omitempty"`          // 清理任务执行间隔
+	PurgeBatchSize  int32                  `protobuf:"varint,3,opt,name=purge_batch_size,json=purgeBatchSize,proto3" json:"purge_batch_size,omitempty"`    // 每批删除的消息数
+	PurgeMaxBatches int32                  `protobuf:"varint,4,opt,name=purge_max_batches,json=purgeMaxBatches,proto3" json:"purge_max_batches,omitempty"` // 每次执行最多删除的批数
+	PurgeBatchPause *durationpb.Duration   `protobuf:"bytes,5,opt,name=purge_batch_pause,json=purgeBatchPause,proto3" json:"purge_batch_pause,omitempty"`  // 两批之间的间隔，避免给数据库太大压力
+	PurgeLockTtl    *durationpb.Duration   `protobuf:"bytes,6,opt,name=purge_lock_ttl,json=purgeLockTtl,proto3" json:"purge_lock_ttl,omitempty"`           // 分布式锁过期时间，需要大于一次清理的耗时
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Data_Retention) Reset() {
+	*x = Data_Retention{}
+	mi := &file_internal_conf_conf_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Retention) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Retention) ProtoMessage() {}
+
+func (x *Data_Retention) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Retention.ProtoReflect.Descriptor instead.
+func (*Data_Retention) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{2, 4}
+}
+
+func (x *Data_Retention) GetMessageTtl() *durationpb.Duration {
+	if x != nil {
+		return x.MessageTtl
+	}
+	return nil
+}
+
+func (x *Data_Retention) GetPurgeInterval() *durationpb.Duration {
+	if x != nil {
+		return x.PurgeInterval
+	}
+	return nil
+}
+
+func (x *Data_Retention) GetPurgeBatchSize() int32 {
+	if x != nil {
+		return x.PurgeBatchSize
+	}
+	return 0
+}
+
+func (x *Data_Retention) GetPurgeMaxBatches() int32 {
+	if x != nil {
+		return x.PurgeMaxBatches
+	}
+	return 0
+}
+
+func (x *Data_Retention) GetPurgeBatchPause() *durationpb.Duration {
+	if x != nil {
+		return x.PurgeBatchPause
+	}
+	return nil
+}
+
+func (x *Data_Retention) GetPurgeLockTtl() *durationpb.Duration {
+	if x != nil {
+		return x.PurgeLockTtl
+	}
+	return nil
+}
+
+type Data_Suggestion struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	RefreshInterval *durationpb.Duration   `protobuf:"bytes,1,opt,name=refresh_interval,json=refreshInterval,proto3" json:"refresh_interval,omitempty"` // 关注推荐预计算间隔
+	ActiveWithin    *durationpb.Duration   `protobuf:"bytes,2,opt,name=active_within,json=activeWithin,proto3" json:"active_within,omitempty"`          // 只为这段时间内活跃过的用户计算
+	MaxResults      int32                  `protobuf:"varint,3,opt,name=max_results,json=maxResults,proto3" json:"max_results,omitempty"`               // 每个用户缓存的推荐人数
+	BatchSize       int32                  `protobuf:"varint,4,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`                  // 每批处理的用户数
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Data_Suggestion) Reset() {
+	*x = Data_Suggestion{}
+	mi := &file_internal_conf_conf_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Suggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Suggestion) ProtoMessage() {}
+
+func (x *Data_Suggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Suggestion.ProtoReflect.Descriptor instead.
+func (*Data_Suggestion) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{2, 5}
+}
+
+func (x *Data_Suggestion) GetRefreshInterval() *durationpb.Duration {
+	if x != nil {
+		return x.RefreshInterval
+	}
+	return nil
+}
+
+func (x *Data_Suggestion) GetActiveWithin() *durationpb.Duration {
+	if x != nil {
+		return x.ActiveWithin
+	}
+	return nil
+}
+
+func (x *Data_Suggestion) GetMaxResults() int32 {
+	if x != nil {
+		return x.MaxResults
+	}
+	return 0
+}
+
+func (x *Data_Suggestion) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+type Data_FollowRepair struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Interval      *durationpb.Duration   `protobuf:"bytes,1,opt,name=interval,proto3" json:"interval,omitempty"`                     // 关注计数和缓存校正间隔
+	LockTtl       *durationpb.Duration   `protobuf:"bytes,2,opt,name=lock_ttl,json=lockTtl,proto3" json:"lock_ttl,omitempty"`        // 分布式锁过期时间，需要大于一次校正的耗时
+	BatchSize     int32                  `protobuf:"varint,3,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"` // 每批处理的用户数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_FollowRepair) Reset() {
+	*x = Data_FollowRepair{}
+	mi := &file_internal_conf_conf_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_FollowRepair) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_FollowRepair) ProtoMessage() {}
+
+func (x *Data_FollowRepair) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_FollowRepair.ProtoReflect.Descriptor instead.
+func (*Data_FollowRepair) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{2, 6}
+}
+
+func (x *Data_FollowRepair) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *Data_FollowRepair) GetLockTtl() *durationpb.Duration {
+	if x != nil {
+		return x.LockTtl
+	}
+	return nil
+}
+
+func (x *Data_FollowRepair) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+type Data_ProfileView struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FlushInterval *durationpb.Duration   `protobuf:"bytes,1,opt,name=flush_interval,json=flushInterval,proto3" json:"flush_interval,omitempty"` // 主页浏览次数从 redis 刷回 mysql 的间隔
+	LockTtl       *durationpb.Duration   `protobuf:"bytes,2,opt,name=lock_ttl,json=lockTtl,proto3" json:"lock_ttl,omitempty"`                   // 分布式锁过期时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_ProfileView) Reset() {
+	*x = Data_ProfileView{}
+	mi := &file_internal_conf_conf_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_ProfileView) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_ProfileView) ProtoMessage() {}
+
+func (x *Data_ProfileView) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_ProfileView.ProtoReflect.Descriptor instead.
+func (*Data_ProfileView) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{2, 7}
+}
+
+func (x *Data_ProfileView) GetFlushInterval() *durationpb.Duration {
+	if x != nil {
+		return x.FlushInterval
+	}
+	return nil
+}
+
+func (x *Data_ProfileView) GetLockTtl() *durationpb.Duration {
+	if x != nil {
+		return x.LockTtl
+	}
+	return nil
+}
+
+type Data_CounterRepair struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Interval      *durationpb.Duration   `protobuf:"bytes,1,opt,name=interval,proto3" json:"interval,omitempty"`                     // 用动态、评论表重算主页统计字段的间隔
+	LockTtl       *durationpb.Duration   `protobuf:"bytes,2,opt,name=lock_ttl,json=lockTtl,proto3" json:"lock_ttl,omitempty"`        // 分布式锁过期时间，需要大于一次重算的耗时
+	BatchSize     int32                  `protobuf:"varint,3,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"` // 每批处理的用户数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_CounterRepair) Reset() {
+	*x = Data_CounterRepair{}
+	mi := &file_internal_conf_conf_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_CounterRepair) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_CounterRepair) ProtoMessage() {}
+
+func (x *Data_CounterRepair) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_CounterRepair.ProtoReflect.Descriptor instead.
+func (*Data_CounterRepair) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{2, 8}
+}
+
+func (x *Data_CounterRepair) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *Data_CounterRepair) GetLockTtl() *durationpb.Duration {
+	if x != nil {
+		return x.LockTtl
+	}
+	return nil
+}
+
+func (x *Data_CounterRepair) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+type Data_Timeline struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PullThreshold   int32                  `protobuf:"varint,1,opt,name=pull_threshold,json=pullThreshold,proto3" json:"pull_threshold,omitempty"`         // 粉丝数超过这个值的作者发动态不写粉丝接收盒，改为粉丝读时拉取
+	FanoutBatchSize int32                  `protobuf:"varint,2,opt,name=fanout_batch_size,json=fanoutBatchSize,proto3" json:"fanout_batch_size,omitempty"` // 写接收盒时每批插入的行数
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Data_Timeline) Reset() {
+	*x = Data_Timeline{}
+	mi := &file_internal_conf_conf_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Timeline) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Timeline) ProtoMessage() {}
+
+func (x *Data_Timeline) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Timeline.ProtoReflect.Descriptor instead.
+func (*Data_Timeline) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{2, 9}
+}
+
+func (x *Data_Timeline) GetPullThreshold() int32 {
+	if x != nil {
+		return x.PullThreshold
+	}
+	return 0
+}
+
+func (x *Data_Timeline) GetFanoutBatchSize() int32 {
+	if x != nil {
+		return x.FanoutBatchSize
+	}
+	return 0
+}
+
+type Data_Square struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PoolSize      int32                  `protobuf:"varint,1,opt,name=pool_size,json=poolSize,proto3" json:"pool_size,omitempty"`             // 广场池子最多保留的动态数，超过后淘汰最早的
+	SampleWindow  int32                  `protobuf:"varint,2,opt,name=sample_window,json=sampleWindow,proto3" json:"sample_window,omitempty"` // 随机模式从热度最高的这么多条里抽取
+	SessionTtl    *durationpb.Duration   `protobuf:"bytes,3,opt,name=session_ttl,json=sessionTtl,proto3" json:"session_ttl,omitempty"`        // 随机模式同一个会话内不重复，会话闲置超过这个时间后重新开始
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Square) Reset() {
+	*x = Data_Square{}
+	mi := &file_internal_conf_conf_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Square) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Square) ProtoMessage() {}
+
+func (x *Data_Square) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Square.ProtoReflect.Descriptor instead.
+func (*Data_Square) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{2, 10}
+}
+
+func (x *Data_Square) GetPoolSize() int32 {
+	if x != nil {
+		return x.PoolSize
+	}
+	return 0
+}
+
+func (x *Data_Square) GetSampleWindow() int32 {
+	if x != nil {
+		return x.SampleWindow
+	}
+	return 0
+}
+
+func (x *Data_Square) GetSessionTtl() *durationpb.Duration {
+	if x != nil {
+		return x.SessionTtl
+	}
+	return nil
+}
+
+type Data_LikeFlush struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Interval      *durationpb.Duration   `protobuf:"bytes,1,opt,name=interval,proto3" json:"interval,omitempty"`                     // 动态点赞数从 redis 刷回 mysql 的间隔
+	LockTtl       *durationpb.Duration   `protobuf:"bytes,2,opt,name=lock_ttl,json=lockTtl,proto3" json:"lock_ttl,omitempty"`        // 分布式锁过期时间
+	BatchSize     int32                  `protobuf:"varint,3,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"` // 每批刷回的动态数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_LikeFlush) Reset() {
+	*x = Data_LikeFlush{}
+	mi := &file_internal_conf_conf_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_LikeFlush) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_LikeFlush) ProtoMessage() {}
+
+func (x *Data_LikeFlush) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_LikeFlush.ProtoReflect.Descriptor instead.
+func (*Data_LikeFlush) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{2, 11}
+}
+
+func (x *Data_LikeFlush) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *Data_LikeFlush) GetLockTtl() *durationpb.Duration {
+	if x != nil {
+		return x.LockTtl
+	}
+	return nil
+}
+
+func (x *Data_LikeFlush) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
 // 验证码配置
 type Sms_VerificationCode struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -875,7 +1551,7 @@ type Sms_VerificationCode struct {
 
 func (x *Sms_VerificationCode) Reset() {
 	*x = Sms_VerificationCode{}
-	mi := &file_internal_conf_conf_proto_msgTypes[12]
+	mi := &file_internal_conf_conf_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -887,7 +1563,7 @@ func (x *Sms_VerificationCode) String() string {
 func (*Sms_VerificationCode) ProtoMessage() {}
 
 func (x *Sms_VerificationCode) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_conf_conf_proto_msgTypes[12]
+	mi := &file_internal_conf_conf_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -900,7 +1576,7 @@ func (x *Sms_VerificationCode) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Sms_VerificationCode.ProtoReflect.Descriptor instead.
 func (*Sms_VerificationCode) Descriptor() ([]byte, []int) {
-	return file_internal_conf_conf_proto_rawDescGZIP(), []int{5, 0}
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{6, 0}
 }
 
 func (x *Sms_VerificationCode) GetLength() int32 {
@@ -943,7 +1619,7 @@ type Sms_RateLimit struct {
 
 func (x *Sms_RateLimit) Reset() {
 	*x = Sms_RateLimit{}
-	mi := &file_internal_conf_conf_proto_msgTypes[13]
+	mi := &file_internal_conf_conf_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -955,7 +1631,7 @@ func (x *Sms_RateLimit) String() string {
 func (*Sms_RateLimit) ProtoMessage() {}
 
 func (x *Sms_RateLimit) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_conf_conf_proto_msgTypes[13]
+	mi := &file_internal_conf_conf_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -968,7 +1644,7 @@ func (x *Sms_RateLimit) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Sms_RateLimit.ProtoReflect.Descriptor instead.
 func (*Sms_RateLimit) Descriptor() ([]byte, []int) {
-	return file_internal_conf_conf_proto_rawDescGZIP(), []int{5, 1}
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{6, 1}
 }
 
 func (x *Sms_RateLimit) GetMaxRequestsPerMinute() int32 {
@@ -1003,7 +1679,7 @@ type Sms_Retry struct {
 
 func (x *Sms_Retry) Reset() {
 	*x = Sms_Retry{}
-	mi := &file_internal_conf_conf_proto_msgTypes[14]
+	mi := &file_internal_conf_conf_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1015,7 +1691,7 @@ func (x *Sms_Retry) String() string {
 func (*Sms_Retry) ProtoMessage() {}
 
 func (x *Sms_Retry) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_conf_conf_proto_msgTypes[14]
+	mi := &file_internal_conf_conf_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1028,7 +1704,7 @@ func (x *Sms_Retry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Sms_Retry.ProtoReflect.Descriptor instead.
 func (*Sms_Retry) Descriptor() ([]byte, []int) {
-	return file_internal_conf_conf_proto_rawDescGZIP(), []int{5, 2}
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{6, 2}
 }
 
 func (x *Sms_Retry) GetMaxAttempts() int32 {
@@ -1050,16 +1726,18 @@ var File_internal_conf_conf_proto protoreflect.FileDescriptor
 const file_internal_conf_conf_proto_rawDesc = "" +
 	"\n" +
 	"\x18internal/conf/conf.proto\x12\n" +
-	"kratos.api\x1a\x1egoogle/protobuf/duration.proto\"\xc6\x01\n" +
+	"kratos.api\x1a\x1egoogle/protobuf/duration.proto\"\xef\x01\n" +
 	"\tBootstrap\x12*\n" +
 	"\x06server\x18\x01 \x01(\v2\x12.kratos.api.ServerR\x06server\x12$\n" +
 	"\x04data\x18\x02 \x01(\v2\x10.kratos.api.DataR\x04data\x12!\n" +
 	"\x03jwt\x18\x03 \x01(\v2\x0f.kratos.api.JWTR\x03jwt\x12!\n" +
 	"\x03log\x18\x04 \x01(\v2\x0f.kratos.api.LogR\x03log\x12!\n" +
-	"\x03sms\x18\x05 \x01(\v2\x0f.kratos.api.SmsR\x03sms\"\xb8\x02\n" +
+	"\x03sms\x18\x05 \x01(\v2\x0f.kratos.api.SmsR\x03sms\x12'\n" +
+	"\x05admin\x18\x06 \x01(\v2\x11.kratos.api.AdminR\x05admin\"\xe1\x02\n" +
 	"\x06Server\x12+\n" +
 	"\x04http\x18\x01 \x01(\v2\x17.kratos.api.Server.HTTPR\x04http\x12+\n" +
-	"\x04grpc\x18\x02 \x01(\v2\x17.kratos.api.Server.GRPCR\x04grpc\x1ai\n" +
+	"\x04grpc\x18\x02 \x01(\v2\x17.kratos.api.Server.GRPCR\x04grpc\x12'\n" +
+	"\x0ftrusted_proxies\x18\x03 \x03(\tR\x0etrustedProxies\x1ai\n" +
 	"\x04HTTP\x12\x18\n" +
 	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
 	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
@@ -1067,13 +1745,25 @@ const file_internal_conf_conf_proto_rawDesc = "" +
 	"\x04GRPC\x12\x18\n" +
 	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
 	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
-	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"\xd5\x05\n" +
+	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"\xc5\x17\n" +
 	"\x04Data\x125\n" +
 	"\bdatabase\x18\x01 \x01(\v2\x19.kratos.api.Data.DatabaseR\bdatabase\x12,\n" +
 	"\x05redis\x18\x02 \x01(\v2\x16.kratos.api.Data.RedisR\x05redis\x12\x10\n" +
 	"\x03dsn\x18\x03 \x01(\tR\x03dsn\x12,\n" +
 	"\x05kafka\x18\x04 \x01(\v2\x16.kratos.api.Data.KafkaR\x05kafka\x122\n" +
-	"\astorage\x18\x05 \x01(\v2\x18.kratos.api.Data.StorageR\astorage\x1a\x94\x02\n" +
+	"\astorage\x18\x05 \x01(\v2\x18.kratos.api.Data.StorageR\astorage\x128\n" +
+	"\tretention\x18\x06 \x01(\v2\x1a.kratos.api.Data.RetentionR\tretention\x12;\n" +
+	"\n" +
+	"suggestion\x18\a \x01(\v2\x1b.kratos.api.Data.SuggestionR\n" +
+	"suggestion\x12B\n" +
+	"\rfollow_repair\x18\b \x01(\v2\x1d.kratos.api.Data.FollowRepairR\ffollowRepair\x12?\n" +
+	"\fprofile_view\x18\t \x01(\v2\x1c.kratos.api.Data.ProfileViewR\vprofileView\x12E\n" +
+	"\x0ecounter_repair\x18\n" +
+	" \x01(\v2\x1e.kratos.api.Data.CounterRepairR\rcounterRepair\x125\n" +
+	"\btimeline\x18\v \x01(\v2\x19.kratos.api.Data.TimelineR\btimeline\x12/\n" +
+	"\x06square\x18\f \x01(\v2\x17.kratos.api.Data.SquareR\x06square\x129\n" +
+	"\n" +
+	"like_flush\x18\r \x01(\v2\x1a.kratos.api.Data.LikeFlushR\tlikeFlush\x1a\x94\x02\n" +
 	"\bDatabase\x12\x12\n" +
 	"\x04addr\x18\x01 \x01(\tR\x04addr\x12\x12\n" +
 	"\x04user\x18\x02 \x01(\tR\x04user\x12\x1a\n" +
@@ -1087,14 +1777,66 @@ const file_internal_conf_conf_proto_rawDesc = "" +
 	"\x04addr\x18\x01 \x01(\tR\x04addr\x12\x1a\n" +
 	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x0e\n" +
 	"\x02db\x18\x03 \x01(\x05R\x02db\x12\x1b\n" +
-	"\tpool_size\x18\x04 \x01(\x05R\bpoolSize\x1aM\n" +
+	"\tpool_size\x18\x04 \x01(\x05R\bpoolSize\x1at\n" +
 	"\x05Kafka\x12\x14\n" +
 	"\x05hosts\x18\x01 \x01(\tR\x05hosts\x12\x14\n" +
 	"\x05topic\x18\x02 \x01(\tR\x05topic\x12\x18\n" +
-	"\aenabled\x18\x03 \x01(\bR\aenabled\x1a(\n" +
+	"\aenabled\x18\x03 \x01(\bR\aenabled\x12%\n" +
+	"\x0econsumer_group\x18\x04 \x01(\tR\rconsumerGroup\x1a\x86\x03\n" +
 	"\aStorage\x12\x1d\n" +
 	"\n" +
-	"static_dir\x18\x01 \x01(\tR\tstaticDir\"5\n" +
+	"static_dir\x18\x01 \x01(\tR\tstaticDir\x12\x1d\n" +
+	"\n" +
+	"export_dir\x18\x02 \x01(\tR\texportDir\x12A\n" +
+	"\x0fexport_link_ttl\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\rexportLinkTtl\x121\n" +
+	"\x15moment_image_max_size\x18\x04 \x01(\x03R\x12momentImageMaxSize\x121\n" +
+	"\x15moment_video_max_size\x18\x05 \x01(\x03R\x12momentVideoMaxSize\x12E\n" +
+	"\x11orphan_upload_ttl\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\x0forphanUploadTtl\x12M\n" +
+	"\x15orphan_clean_interval\x18\a \x01(\v2\x19.google.protobuf.DurationR\x13orphanCleanInterval\x1a\xe7\x02\n" +
+	"\tRetention\x12:\n" +
+	"\vmessage_ttl\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"messageTtl\x12@\n" +
+	"\x0epurge_interval\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\rpurgeInterval\x12(\n" +
+	"\x10purge_batch_size\x18\x03 \x01(\x05R\x0epurgeBatchSize\x12*\n" +
+	"\x11purge_max_batches\x18\x04 \x01(\x05R\x0fpurgeMaxBatches\x12E\n" +
+	"\x11purge_batch_pause\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\x0fpurgeBatchPause\x12?\n" +
+	"\x0epurge_lock_ttl\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\fpurgeLockTtl\x1a\xd2\x01\n" +
+	"\n" +
+	"Suggestion\x12D\n" +
+	"\x10refresh_interval\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\x0frefreshInterval\x12>\n" +
+	"\ractive_within\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\factiveWithin\x12\x1f\n" +
+	"\vmax_results\x18\x03 \x01(\x05R\n" +
+	"maxResults\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x04 \x01(\x05R\tbatchSize\x1a\x9a\x01\n" +
+	"\fFollowRepair\x125\n" +
+	"\binterval\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\binterval\x124\n" +
+	"\block_ttl\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\alockTtl\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x03 \x01(\x05R\tbatchSize\x1a\x85\x01\n" +
+	"\vProfileView\x12@\n" +
+	"\x0eflush_interval\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\rflushInterval\x124\n" +
+	"\block_ttl\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\alockTtl\x1a\x9b\x01\n" +
+	"\rCounterRepair\x125\n" +
+	"\binterval\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\binterval\x124\n" +
+	"\block_ttl\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\alockTtl\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x03 \x01(\x05R\tbatchSize\x1a]\n" +
+	"\bTimeline\x12%\n" +
+	"\x0epull_threshold\x18\x01 \x01(\x05R\rpullThreshold\x12*\n" +
+	"\x11fanout_batch_size\x18\x02 \x01(\x05R\x0ffanoutBatchSize\x1a\x86\x01\n" +
+	"\x06Square\x12\x1b\n" +
+	"\tpool_size\x18\x01 \x01(\x05R\bpoolSize\x12#\n" +
+	"\rsample_window\x18\x02 \x01(\x05R\fsampleWindow\x12:\n" +
+	"\vsession_ttl\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"sessionTtl\x1a\x97\x01\n" +
+	"\tLikeFlush\x125\n" +
+	"\binterval\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\binterval\x124\n" +
+	"\block_ttl\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\alockTtl\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x03 \x01(\x05R\tbatchSize\"\"\n" +
+	"\x05Admin\x12\x19\n" +
+	"\buser_ids\x18\x01 \x03(\rR\auserIds\"5\n" +
 	"\x03JWT\x12\x16\n" +
 	"\x06secret\x18\x01 \x01(\tR\x06secret\x12\x16\n" +
 	"\x06expire\x18\x02 \x01(\tR\x06expire\"\xdc\x01\n" +
@@ -1141,47 +1883,83 @@ func file_internal_conf_conf_proto_rawDescGZIP() []byte {
 	return file_internal_conf_conf_proto_rawDescData
 }
 
-var file_internal_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_internal_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_internal_conf_conf_proto_goTypes = []any{
 	(*Bootstrap)(nil),            // 0: kratos.api.Bootstrap
 	(*Server)(nil),               // 1: kratos.api.Server
 	(*Data)(nil),                 // 2: kratos.api.Data
-	(*JWT)(nil),                  // 3: kratos.api.JWT
-	(*Log)(nil),                  // 4: kratos.api.Log
-	(*Sms)(nil),                  // 5: kratos.api.Sms
-	(*Server_HTTP)(nil),          // 6: kratos.api.Server.HTTP
-	(*Server_GRPC)(nil),          // 7: kratos.api.Server.GRPC
-	(*Data_Database)(nil),        // 8: kratos.api.Data.Database
-	(*Data_Redis)(nil),           // 9: kratos.api.Data.Redis
-	(*Data_Kafka)(nil),           // 10: kratos.api.Data.Kafka
-	(*Data_Storage)(nil),         // 11: kratos.api.Data.Storage
-	(*Sms_VerificationCode)(nil), // 12: kratos.api.Sms.VerificationCode
-	(*Sms_RateLimit)(nil),        // 13: kratos.api.Sms.RateLimit
-	(*Sms_Retry)(nil),            // 14: kratos.api.Sms.Retry
-	(*durationpb.Duration)(nil),  // 15: google.protobuf.Duration
+	(*Admin)(nil),                // 3: kratos.api.Admin
+	(*JWT)(nil),                  // 4: kratos.api.JWT
+	(*Log)(nil),                  // 5: kratos.api.Log
+	(*Sms)(nil),                  // 6: kratos.api.Sms
+	(*Server_HTTP)(nil),          // 7: kratos.api.Server.HTTP
+	(*Server_GRPC)(nil),          // 8: kratos.api.Server.GRPC
+	(*Data_Database)(nil),        // 9: kratos.api.Data.Database
+	(*Data_Redis)(nil),           // 10: kratos.api.Data.Redis
+	(*Data_Kafka)(nil),           // 11: kratos.api.Data.Kafka
+	(*Data_Storage)(nil),         // 12: kratos.api.Data.Storage
+	(*Data_Retention)(nil),       // 13: kratos.api.Data.Retention
+	(*Data_Suggestion)(nil),      // 14: kratos.api.Data.Suggestion
+	(*Data_FollowRepair)(nil),    // 15: kratos.api.Data.FollowRepair
+	(*Data_ProfileView)(nil),     // 16: kratos.api.Data.ProfileView
+	(*Data_CounterRepair)(nil),   // 17: kratos.api.Data.CounterRepair
+	(*Data_Timeline)(nil),        // 18: kratos.api.Data.Timeline
+	(*Data_Square)(nil),          // 19: kratos.api.Data.Square
+	(*Data_LikeFlush)(nil),       // 20: kratos.api.Data.LikeFlush
+	(*Sms_VerificationCode)(nil), // 21: kratos.api.Sms.VerificationCode
+	(*Sms_RateLimit)(nil),        // 22: kratos.api.Sms.RateLimit
+	(*Sms_Retry)(nil),            // 23: kratos.api.Sms.Retry
+	(*durationpb.Duration)(nil),  // 24: google.protobuf.Duration
 }
 var file_internal_conf_conf_proto_depIdxs = []int32{
 	1,  // 0: kratos.api.Bootstrap.server:type_name -> kratos.api.Server
 	2,  // 1: kratos.api.Bootstrap.data:type_name -> kratos.api.Data
-	3,  // 2: kratos.api.Bootstrap.jwt:type_name -> kratos.api.JWT
-	4,  // 3: kratos.api.Bootstrap.log:type_name -> kratos.api.Log
-	5,  // 4: kratos.api.Bootstrap.sms:type_name -> kratos.api.Sms
-	6,  // 5: kratos.api.Server.http:type_name -> kratos.api.Server.HTTP
-	7,  // 6: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
-	8,  // 7: kratos.api.Data.database:type_name -> kratos.api.Data.Database
-	9,  // 8: kratos.api.Data.redis:type_name -> kratos.api.Data.Redis
-	10, // 9: kratos.api.Data.kafka:type_name -> kratos.api.Data.Kafka
-	11, // 10: kratos.api.Data.storage:type_name -> kratos.api.Data.Storage
-	12, // 11: kratos.api.Sms.verification_code:type_name -> kratos.api.Sms.VerificationCode
-	13, // 12: kratos.api.Sms.rate_limit:type_name -> kratos.api.Sms.RateLimit
-	14, // 13: kratos.api.Sms.retry:type_name -> kratos.api.Sms.Retry
-	15, // 14: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
-	15, // 15: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
-	16, // [16:16] is the sub-list for method output_type
-	16, // [16:16] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	4,  // 2: kratos.api.Bootstrap.jwt:type_name -> kratos.api.JWT
+	5,  // 3: kratos.api.Bootstrap.log:type_name -> kratos.api.Log
+	6,  // 4: kratos.api.Bootstrap.sms:type_name -> kratos.api.Sms
+	3,  // 5: kratos.api.Bootstrap.admin:type_name -> kratos.api.Admin
+	7,  // 6: kratos.api.Server.http:type_name -> kratos.api.Server.HTTP
+	8,  // 7: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
+	9,  // 8: kratos.api.Data.database:type_name -> kratos.api.Data.Database
+	10, // 9: kratos.api.Data.redis:type_name -> kratos.api.Data.Redis
+	11, // 10: kratos.api.Data.kafka:type_name -> kratos.api.Data.Kafka
+	12, // 11: kratos.api.Data.storage:type_name -> kratos.api.Data.Storage
+	13, // 12: kratos.api.Data.retention:type_name -> kratos.api.Data.Retention
+	14, // 13: kratos.api.Data.suggestion:type_name -> kratos.api.Data.Suggestion
+	15, // 14: kratos.api.Data.follow_repair:type_name -> kratos.api.Data.FollowRepair
+	16, // 15: kratos.api.Data.profile_view:type_name -> kratos.api.Data.ProfileView
+	17, // 16: kratos.api.Data.counter_repair:type_name -> kratos.api.Data.CounterRepair
+	18, // 17: kratos.api.Data.timeline:type_name -> kratos.api.Data.Timeline
+	19, // 18: kratos.api.Data.square:type_name -> kratos.api.Data.Square
+	20, // 19: kratos.api.Data.like_flush:type_name -> kratos.api.Data.LikeFlush
+	21, // 20: kratos.api.Sms.verification_code:type_name -> kratos.api.Sms.VerificationCode
+	22, // 21: kratos.api.Sms.rate_limit:type_name -> kratos.api.Sms.RateLimit
+	23, // 22: kratos.api.Sms.retry:type_name -> kratos.api.Sms.Retry
+	24, // 23: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
+	24, // 24: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
+	24, // 25: kratos.api.Data.Storage.export_link_ttl:type_name -> google.protobuf.Duration
+	24, // 26: kratos.api.Data.Storage.orphan_upload_ttl:type_name -> google.protobuf.Duration
+	24, // 27: kratos.api.Data.Storage.orphan_clean_interval:type_name -> google.protobuf.Duration
+	24, // 28: kratos.api.Data.Retention.message_ttl:type_name -> google.protobuf.Duration
+	24, // 29: kratos.api.Data.Retention.purge_interval:type_name -> google.protobuf.Duration
+	24, // 30: kratos.api.Data.Retention.purge_batch_pause:type_name -> google.protobuf.Duration
+	24, // 31: kratos.api.Data.Retention.purge_lock_ttl:type_name -> google.protobuf.Duration
+	24, // 32: kratos.api.Data.Suggestion.refresh_interval:type_name -> google.protobuf.Duration
+	24, // 33: kratos.api.Data.Suggestion.active_within:type_name -> google.protobuf.Duration
+	24, // 34: kratos.api.Data.FollowRepair.interval:type_name -> google.protobuf.Duration
+	24, // 35: kratos.api.Data.FollowRepair.lock_ttl:type_name -> google.protobuf.Duration
+	24, // 36: kratos.api.Data.ProfileView.flush_interval:type_name -> google.protobuf.Duration
+	24, // 37: kratos.api.Data.ProfileView.lock_ttl:type_name -> google.protobuf.Duration
+	24, // 38: kratos.api.Data.CounterRepair.interval:type_name -> google.protobuf.Duration
+	24, // 39: kratos.api.Data.CounterRepair.lock_ttl:type_name -> google.protobuf.Duration
+	24, // 40: kratos.api.Data.Square.session_ttl:type_name -> google.protobuf.Duration
+	24, // 41: kratos.api.Data.LikeFlush.interval:type_name -> google.protobuf.Duration
+	24, // 42: kratos.api.Data.LikeFlush.lock_ttl:type_name -> google.protobuf.Duration
+	43, // [43:43] is the sub-list for method output_type
+	43, // [43:43] is the sub-list for method input_type
+	43, // [43:43] is the sub-list for extension type_name
+	43, // [43:43] is the sub-list for extension extendee
+	0,  // [0:43] is the sub-list for field type_name
 }
 
 func init() { file_internal_conf_conf_proto_init() }
@@ -1195,7 +1973,7 @@ func file_internal_conf_conf_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_conf_conf_proto_rawDesc), len(file_internal_conf_conf_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   15,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
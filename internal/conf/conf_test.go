@@ -0,0 +1,45 @@
+package conf
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/config/file"
+)
+
+// 启动时用同样的方式加载，Duration 字段写成 1m、200ms 这种格式会扫描失败
+func TestLoadConfig(t *testing.T) {
+	c := config.New(config.WithSource(file.NewSource("../../configs/config.yaml")))
+	defer c.Close()
+	if err := c.Load(); err != nil {
+		t.Fatalf("load config: %v", err)
+	}
+
+	var bc Bootstrap
+	if err := c.Scan(&bc); err != nil {
+		t.Fatalf("scan config: %v", err)
+	}
+
+	d := bc.Data
+	cases := []struct {
+		name string
+		got  time.Duration
+		want time.Duration
+	}{
+		{"retention.purge_interval", d.Retention.PurgeInterval.AsDuration(), time.Minute},
+		{"retention.purge_batch_pause", d.Retention.PurgeBatchPause.AsDuration(), 200 * time.Millisecond},
+		{"storage.export_link_ttl", d.Storage.ExportLinkTtl.AsDuration(), 24 * time.Hour},
+		{"suggestion.active_within", d.Suggestion.ActiveWithin.AsDuration(), 30 * 24 * time.Hour},
+		{"follow_repair.lock_ttl", d.FollowRepair.LockTtl.AsDuration(), 30 * time.Minute},
+		{"profile_view.flush_interval", d.ProfileView.FlushInterval.AsDuration(), time.Minute},
+		{"counter_repair.interval", d.CounterRepair.Interval.AsDuration(), 24 * time.Hour},
+		{"square.session_ttl", d.Square.SessionTtl.AsDuration(), 30 * time.Minute},
+		{"like_flush.lock_ttl", d.LikeFlush.LockTtl.AsDuration(), 5 * time.Minute},
+	}
+	for _, c := range cases {
+		if c.got != c.want {
+			t.Errorf("%s = %v, want %v", c.name, c.got, c.want)
+		}
+	}
+}
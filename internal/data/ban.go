@@ -0,0 +1,142 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	bizProfile "kratos-realworld/internal/biz/profile"
+	"kratos-realworld/internal/model"
+)
+
+// 封禁状态每次请求都要检查，单独缓存；过期时间短一些，缓存和数据库偶尔不一致也能很快恢复
+const banCacheTTL = 5 * time.Minute
+
+// 没有被封禁时缓存的占位值
+const banCacheNone = "0"
+
+func (r *ProfileRepo) SetBan(ctx context.Context, userID uint32, until time.Time, reason string) error {
+	res := r.getDB(ctx).Model(&bizProfile.ProfileTB{}).
+		Where("user_id = ?", userID).
+		Updates(map[string]interface{}{
+			"status":       bizProfile.ProfileStatusBanned,
+			"banned_until": until,
+			"ban_reason":   reason,
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	r.setBanCacheAfterCommit(ctx, userID, &bizProfile.BanInfo{Until: until, Reason: reason})
+	return nil
+}
+
+func (r *ProfileRepo) ClearBan(ctx context.Context, userID uint32) error {
+	err := r.getDB(ctx).Model(&bizProfile.ProfileTB{}).
+		Where("user_id = ?", userID).
+		Updates(map[string]interface{}{
+			"status":       bizProfile.ProfileStatusActive,
+			"banned_until": nil,
+			"ban_reason":   nil,
+		}).Error
+	if err != nil {
+		return err
+	}
+
+	r.setBanCacheAfterCommit(ctx, userID, nil)
+	return nil
+}
+
+// GetBan 没有被封禁时返回 nil，是否已经过期由调用方判断
+func (r *ProfileRepo) GetBan(ctx context.Context, userID uint32) (*bizProfile.BanInfo, error) {
+	redisKey := UserRedisKey(UserCachePrefix, "Ban", userID)
+	body, ok, err := r.data.Cache().Get(ctx, redisKey)
+	if err != nil {
+		r.log.Warnf("failed to get ban state from cache, fallback to DB: %v", err)
+	}
+	if err == nil && ok {
+		if body == banCacheNone {
+			return nil, nil
+		}
+		ban := &bizProfile.BanInfo{}
+		if err := json.Unmarshal([]byte(body), ban); err == nil {
+			return ban, nil
+		}
+	}
+
+	profile := &bizProfile.ProfileTB{}
+	err = r.data.DB().WithContext(ctx).
+		Select("user_id", "status", "banned_until", "ban_reason").
+		Where("user_id = ?", userID).
+		First(profile).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	var ban *bizProfile.BanInfo
+	if profile.Status == bizProfile.ProfileStatusBanned && profile.BannedUntil != nil {
+		ban = &bizProfile.BanInfo{Until: *profile.BannedUntil, Reason: profile.BanReason}
+	}
+	r.setBanCacheAfterCommit(ctx, userID, ban)
+	return ban, nil
+}
+
+// setBanCacheAfterCommit 在事务里调用时等提交之后再写缓存，避免回滚后缓存里留下没生效的状态
+func (r *ProfileRepo) setBanCacheAfterCommit(ctx context.Context, userID uint32, ban *bizProfile.BanInfo) {
+	model.AfterCommit(ctx, func() {
+		r.setBanCache(ctx, userID, ban)
+	})
+}
+
+func (r *ProfileRepo) setBanCache(ctx context.Context, userID uint32, ban *bizProfile.BanInfo) {
+	redisKey := UserRedisKey(UserCachePrefix, "Ban", userID)
+	value := banCacheNone
+	if ban != nil {
+		body, err := json.Marshal(ban)
+		if err != nil {
+			return
+		}
+		value = string(body)
+	}
+	if err := r.data.Cache().Set(ctx, redisKey, value, banCacheTTL); err != nil {
+		r.log.Warnf("failed to cache ban state %s: %v", redisKey, err)
+	}
+
+	// profile 缓存里也有 status 字段，直接删掉
+	if err := r.data.Cache().Delete(ctx, UserRedisKey(UserCachePrefix, "Profile", userID)); err != nil {
+		r.log.Warnf("failed to delete profile cache of %d: %v", userID, err)
+	}
+}
+
+func (r *ProfileRepo) CreateBanAudit(ctx context.Context, audit *bizProfile.BanAuditTB) error {
+	return r.getDB(ctx).Create(audit).Error
+}
+
+// ListBanAudits userID 为 0 时查询全部记录
+func (r *ProfileRepo) ListBanAudits(ctx context.Context, userID uint32, page int, pageSize int) ([]*bizProfile.BanAuditTB, int64, error) {
+	db := r.data.DB().WithContext(ctx).Model(&bizProfile.BanAuditTB{})
+	if userID > 0 {
+		db = db.Where("user_id = ?", userID)
+	}
+
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var audits []*bizProfile.BanAuditTB
+	err := db.Order("id DESC").
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		Find(&audits).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	return audits, total, nil
+}
@@ -0,0 +1,28 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// 事务回滚时不写封禁缓存；测试数据没有 redis，写缓存会直接 panic
+func TestSetBanRollbackSkipsCache(t *testing.T) {
+	d := newTestData(t, newDupConn())
+	repo := NewProfileRepo(d, testLogger)
+	rollback := errors.New("rollback")
+
+	err := d.InTx(context.Background(), func(ctx context.Context) error {
+		if err := repo.SetBan(ctx, 1, time.Now().Add(time.Hour), "spam"); err != nil {
+			return err
+		}
+		if err := repo.ClearBan(ctx, 2); err != nil {
+			return err
+		}
+		return rollback
+	})
+	assert.ErrorIs(t, err, rollback)
+}
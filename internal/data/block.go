@@ -0,0 +1,162 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+
+	bizProfile "kratos-realworld/internal/biz/profile"
+	"kratos-realworld/internal/model"
+)
+
+// 黑名单集合里固定放一个占位成员，区分“集合已加载但为空”和“缓存未命中”
+const blockListPlaceholder = "0"
+
+func (r *ProfileRepo) BlockUser(ctx context.Context, userID uint32, targetID uint32) error {
+	block := &bizProfile.BlockTB{UserID: userID, BlockedID: targetID}
+	if err := r.getDB(ctx).WithContext(ctx).Create(block).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil
+		}
+		return err
+	}
+
+	// 只有集合已经加载过才追加，否则下次查询时从数据库完整加载；事务提交之后再写
+	keyBlockList := UserRedisKey(UserCachePrefix, "BlockList", userID)
+	model.AfterCommit(ctx, func() {
+		if !r.data.Cache().Exists(ctx, keyBlockList) {
+			return
+		}
+		if _, err := r.data.Cache().SAdd(ctx, keyBlockList, fmt.Sprintf("%d", targetID)); err != nil {
+			r.log.Errorf("Block relationship Redis add failed. user=%d target=%d err=%v", userID, targetID, err)
+		}
+	})
+	return nil
+}
+
+func (r *ProfileRepo) UnblockUser(ctx context.Context, userID uint32, targetID uint32) (bool, error) {
+	res := r.getDB(ctx).WithContext(ctx).
+		Where("user_id = ? AND blocked_id = ?", userID, targetID).
+		Delete(&bizProfile.BlockTB{})
+	if res.Error != nil {
+		return false, res.Error
+	}
+
+	keyBlockList := UserRedisKey(UserCachePrefix, "BlockList", userID)
+	model.AfterCommit(ctx, func() {
+		if _, err := r.data.Cache().SRem(ctx, keyBlockList, fmt.Sprintf("%d", targetID)); err != nil {
+			r.log.Errorf("Block relationship Redis delete failed. user=%d target=%d err=%v", userID, targetID, err)
+		}
+	})
+	return res.RowsAffected > 0, nil
+}
+
+// ListBlocks 按拉黑时间倒序分页，page 从 1 开始
+func (r *ProfileRepo) ListBlocks(ctx context.Context, userID uint32, page int, pageSize int) ([]*bizProfile.BlockTB, int64, error) {
+	var total int64
+	err := r.getDB(ctx).WithContext(ctx).Model(&bizProfile.BlockTB{}).
+		Where("user_id = ?", userID).
+		Count(&total).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var blocks []*bizProfile.BlockTB
+	err = r.getDB(ctx).WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("id DESC").
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		Find(&blocks).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	return blocks, total, nil
+}
+
+// ListBlockedIDs 某人拉黑的全部用户，优先读 redis 集合
+func (r *ProfileRepo) ListBlockedIDs(ctx context.Context, userID uint32) ([]uint32, error) {
+	keyBlockList := UserRedisKey(UserCachePrefix, "BlockList", userID)
+	if err := r.loadBlockCache(ctx, userID); err != nil {
+		r.log.Errorf("load block list cache error: %v, fallback to DB", err)
+		return r.listBlockedIDsSql(ctx, userID)
+	}
+
+	members, err := r.data.Cache().SMembers(ctx, keyBlockList)
+	if err != nil {
+		r.log.Errorf("Redis SMembers error: %v, fallback to DB", err)
+		return r.listBlockedIDsSql(ctx, userID)
+	}
+	ids := make([]uint32, 0, len(members))
+	for _, m := range members {
+		if m == blockListPlaceholder {
+			continue
+		}
+		id, err := strconv.ParseUint(m, 10, 32)
+		if err != nil {
+			continue
+		}
+		ids = append(ids, uint32(id))
+	}
+	return ids, nil
+}
+
+func (r *ProfileRepo) listBlockedIDsSql(ctx context.Context, userID uint32) ([]uint32, error) {
+	var ids []uint32
+	err := r.data.DB().WithContext(ctx).Model(&bizProfile.BlockTB{}).
+		Where("user_id = ?", userID).
+		Pluck("blocked_id", &ids).Error
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// loadBlockCache 集合不存在时从数据库加载完整的黑名单
+func (r *ProfileRepo) loadBlockCache(ctx context.Context, userID uint32) error {
+	keyBlockList := UserRedisKey(UserCachePrefix, "BlockList", userID)
+	if r.data.Cache().Exists(ctx, keyBlockList) {
+		r.data.Cache().Expire(ctx, keyBlockList, UserCacheTTL)
+		return nil
+	}
+
+	ids, err := r.listBlockedIDsSql(ctx, userID)
+	if err != nil {
+		return err
+	}
+	return r.data.Cache().Pipeline(ctx, func(pipe redis.Pipeliner) error {
+		members := make([]interface{}, 0, len(ids)+1)
+		members = append(members, blockListPlaceholder)
+		for _, id := range ids {
+			members = append(members, fmt.Sprintf("%d", id))
+		}
+		pipe.SAdd(ctx, keyBlockList, members...)
+		pipe.Expire(ctx, keyBlockList, UserCacheTTL)
+		return nil
+	})
+}
+
+// FilterBlocked 返回 targetIDs 中和 userID 之间有拉黑关系的人，不区分谁拉黑了谁
+func (r *ProfileRepo) FilterBlocked(ctx context.Context, userID uint32, targetIDs []uint32) ([]uint32, error) {
+	if len(targetIDs) == 0 {
+		return nil, nil
+	}
+	var blocked, blockedBy []uint32
+	err := r.data.DB().WithContext(ctx).Model(&bizProfile.BlockTB{}).
+		Where("user_id = ? AND blocked_id IN ?", userID, targetIDs).
+		Pluck("blocked_id", &blocked).Error
+	if err != nil {
+		return nil, err
+	}
+	err = r.data.DB().WithContext(ctx).Model(&bizProfile.BlockTB{}).
+		Where("blocked_id = ? AND user_id IN ?", userID, targetIDs).
+		Pluck("user_id", &blockedBy).Error
+	if err != nil {
+		return nil, err
+	}
+	return append(blocked, blockedBy...), nil
+}
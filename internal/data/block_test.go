@@ -0,0 +1,31 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	bizProfile "kratos-realworld/internal/biz/profile"
+)
+
+// 重复拉黑同一个人不报错
+func TestBlockUserDuplicate(t *testing.T) {
+	d := newTestData(t, newDupConn())
+	repo := NewProfileRepo(d, testLogger)
+
+	// 第一次直接写库，避开没有 redis 的缓存更新
+	assert.NoError(t, d.DB().Create(&bizProfile.BlockTB{UserID: 1, BlockedID: 2}).Error)
+	assert.NoError(t, repo.BlockUser(context.Background(), 1, 2))
+}
+
+// 拉黑时按 pending_pair 作废两人之间任一方向的待处理申请
+func TestCancelPendingFriendRequests(t *testing.T) {
+	d, rec := newDryRunData(t)
+	repo := NewProfileRepo(d, testLogger)
+
+	assert.NoError(t, repo.CancelPendingFriendRequests(context.Background(), 5, 2))
+	sql := rec.find("`pending_pair`=NULL")
+	assert.Contains(t, sql, "`status`=4")
+	assert.Contains(t, sql, "WHERE pending_pair = '2_5' AND status = 0")
+}
@@ -3,6 +3,7 @@ package data
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
 
@@ -63,3 +64,44 @@ func (mr *MessageRepo) SaveMessage(message *bizChat.MessageTB) error {
 	}
 	return nil
 }
+
+func (mr *MessageRepo) ListExpiredMessages(ctx context.Context, now time.Time, createdBefore *time.Time, limit int) ([]*bizChat.MessageTB, error) {
+	var messages []*bizChat.MessageTB
+	db := mr.data.DB().WithContext(ctx)
+	if createdBefore != nil {
+		db = db.Where("expire_at <= ? OR created_at < ?", now, *createdBefore)
+	} else {
+		db = db.Where("expire_at <= ?", now)
+	}
+	err := db.Order("id ASC").Limit(limit).Find(&messages).Error
+	if err != nil {
+		return nil, err
+	}
+	return messages, nil
+}
+
+func (mr *MessageRepo) DeleteMessages(ctx context.Context, ids []uint32) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return mr.data.DB().WithContext(ctx).Where("id IN ?", ids).Delete(&bizChat.MessageTB{}).Error
+}
+
+func (mr *MessageRepo) ListConversationMessages(ctx context.Context, conversationType uint16, userID string, targetID string, start time.Time, end time.Time, afterID uint32, limit int) ([]*bizChat.MessageTB, error) {
+	var messages []*bizChat.MessageTB
+	db := mr.data.DB().WithContext(ctx).
+		Where("id > ? AND created_at >= ? AND created_at < ? AND deleted_at IS NULL", afterID, start, end)
+
+	if conversationType == common.MESSAGE_TYPE_GROUP {
+		db = db.Where("message_type = ? AND to_user_id = ?", common.MESSAGE_TYPE_GROUP, targetID)
+	} else {
+		db = db.Where("message_type = ?", common.MESSAGE_TYPE_USER).
+			Where("(from_user_id = ? AND to_user_id = ?) OR (from_user_id = ? AND to_user_id = ?)", userID, targetID, targetID, userID)
+	}
+
+	err := db.Order("id ASC").Limit(limit).Find(&messages).Error
+	if err != nil {
+		return nil, err
+	}
+	return messages, nil
+}
@@ -0,0 +1,67 @@
+package data
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	bizMoment "kratos-realworld/internal/biz/moments"
+)
+
+// CreateCommentLike 点赞记录和评论的点赞数在同一个事务里更新，已经点过赞时返回 false
+func (r *MomentRepo) CreateCommentLike(ctx context.Context, like *bizMoment.CommentLikeTB) (bool, error) {
+	err := r.data.DB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(like).Error; err != nil {
+			return err
+		}
+		return tx.Model(&bizMoment.CommentsTB{}).
+			Where("id = ?", like.CommentID).
+			UpdateColumn("like_count", gorm.Expr("like_count + 1")).Error
+	})
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// DeleteCommentLike 没有点过赞时返回 false
+func (r *MomentRepo) DeleteCommentLike(ctx context.Context, commentID uint32, userID uint32) (bool, error) {
+	deleted := false
+	err := r.data.DB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Where("comment_id = ? AND user_id = ?", commentID, userID).Delete(&bizMoment.CommentLikeTB{})
+		if res.Error != nil {
+			return res.Error
+		}
+		// 并发取消时只有一个请求真正删除
+		if res.RowsAffected == 0 {
+			return nil
+		}
+		deleted = true
+		return tx.Model(&bizMoment.CommentsTB{}).
+			Where("id = ?", commentID).
+			UpdateColumn("like_count", gorm.Expr("GREATEST(like_count, 1) - 1")).Error
+	})
+	if err != nil {
+		return false, err
+	}
+	return deleted, nil
+}
+
+// FilterLikedComments 返回 commentIDs 中 userID 点过赞的评论
+func (r *MomentRepo) FilterLikedComments(ctx context.Context, userID uint32, commentIDs []uint32) ([]uint32, error) {
+	var ids []uint32
+	if len(commentIDs) == 0 {
+		return ids, nil
+	}
+	err := r.data.DB().WithContext(ctx).Model(&bizMoment.CommentLikeTB{}).
+		Where("user_id = ? AND comment_id IN ?", userID, commentIDs).
+		Pluck("comment_id", &ids).Error
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
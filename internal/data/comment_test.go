@@ -0,0 +1,62 @@
+package data
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	bizMoment "kratos-realworld/internal/biz/moments"
+)
+
+func TestCreateCommentLikeDuplicate(t *testing.T) {
+	d := newTestData(t, newDupConn())
+	repo := NewMomentRepo(d, testLogger)
+
+	created, err := repo.CreateCommentLike(context.Background(), &bizMoment.CommentLikeTB{CommentID: 3, UserID: 5})
+	assert.NoError(t, err)
+	assert.True(t, created)
+
+	// 重复点赞被唯一索引拦住，不报错也不再加点赞数
+	created, err = repo.CreateCommentLike(context.Background(), &bizMoment.CommentLikeTB{CommentID: 3, UserID: 5})
+	assert.NoError(t, err)
+	assert.False(t, created)
+}
+
+// 删除评论是软删除，一级评论下的回复一起删除
+func TestDeleteCommentSoftDeletesReplies(t *testing.T) {
+	d, rec := newRowsData(t, &rowsConnector{columns: []string{"id"}, rows: [][]driver.Value{{int64(10)}, {int64(11)}}})
+	repo := NewMomentRepo(d, testLogger)
+
+	ids, err := repo.DeleteComment(context.Background(), 10)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint32{10, 11}, ids)
+	assert.Equal(t, "SELECT `id` FROM `t_comments` WHERE (id = 10 OR parent_id = 10) AND deleted_at IS NULL", rec.find("SELECT `id`"))
+	assert.Regexp(t, "^UPDATE `t_comments` SET `deleted_at`=[0-9]+ WHERE id IN \\(10,11\\)$", rec.find("UPDATE `t_comments`"))
+}
+
+func TestListCommentsFriendsOnly(t *testing.T) {
+	d, rec := newDryRunData(t)
+	repo := NewMomentRepo(d, testLogger)
+
+	_, err := repo.ListComments(context.Background(), &bizMoment.CommentQuery{
+		MomentID:   7,
+		ParentID:   2,
+		FriendsOf:  3,
+		VisibleIDs: []uint32{3, 1},
+		Cursor:     20,
+		Limit:      11,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "SELECT * FROM `t_comments` WHERE (moment_id = 7 AND parent_id = 2 AND deleted_at IS NULL) AND id > 20 "+
+		"AND ((user_id IN (3,1) OR user_id IN (SELECT `friend_id` FROM `t_user_friend` WHERE user_id = 3))) ORDER BY id ASC LIMIT 11",
+		rec.find("FROM `t_comments`"))
+
+	// 动态作者看全部评论
+	rec.sqls = nil
+	_, err = repo.ListComments(context.Background(), &bizMoment.CommentQuery{MomentID: 7, Limit: 11})
+	assert.NoError(t, err)
+	assert.Equal(t, "SELECT * FROM `t_comments` WHERE moment_id = 7 AND parent_id = 0 AND deleted_at IS NULL ORDER BY id ASC LIMIT 11",
+		rec.find("FROM `t_comments`"))
+}
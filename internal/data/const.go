@@ -134,8 +134,11 @@ const (
 )
 
 const (
-	UserCachePrefix  = "user"
-	UserSMSPrefix    = "userSMS"
-	LoginCachePrefix = "login"
-	TokenCachePrefix = "token"
+	UserCachePrefix   = "user"
+	UserSMSPrefix     = "userSMS"
+	LoginCachePrefix  = "login"
+	TokenCachePrefix  = "token"
+	MomentCachePrefix = "moment"
+
+	ConversationCachePrefix = "conversation"
 )
@@ -0,0 +1,333 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	bizChat "kratos-realworld/internal/biz/messageGroup"
+	"kratos-realworld/internal/common"
+	"kratos-realworld/internal/model"
+)
+
+type ConversationRepo struct {
+	data *model.Data
+	log  *log.Helper
+}
+
+func NewConversationRepo(data *model.Data, logger log.Logger) bizChat.ConversationRepo {
+	return &ConversationRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *ConversationRepo) TouchSenderConversation(ctx context.Context, userID uint32, targetID string, conversationType uint16, messageID uint32) error {
+	conversation := &bizChat.ConversationTB{
+		UserID:            userID,
+		TargetID:          targetID,
+		ConversationType:  conversationType,
+		LastMessageID:     messageID,
+		LastReadMessageID: messageID,
+	}
+	return r.data.DB().WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "user_id"}, {Name: "target_id"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"last_message_id":      messageID,
+			"last_read_message_id": messageID,
+			"unread_count":         0,
+			"mention_message_id":   0,
+		}),
+	}).Create(conversation).Error
+}
+
+func (r *ConversationRepo) TouchReceiverConversations(ctx context.Context, userIDs []uint32, targetID string, conversationType uint16, messageID uint32, mentionedIDs []uint32) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	mentioned := make(map[uint32]struct{}, len(mentionedIDs))
+	for _, id := range mentionedIDs {
+		mentioned[id] = struct{}{}
+	}
+
+	var plain, mentions []*bizChat.ConversationTB
+	for _, id := range userIDs {
+		conversation := &bizChat.ConversationTB{
+			UserID:           id,
+			TargetID:         targetID,
+			ConversationType: conversationType,
+			LastMessageID:    messageID,
+			UnreadCount:      1,
+		}
+		if _, ok := mentioned[id]; ok {
+			conversation.MentionMessageID = messageID
+			conversation.LastMentionID = messageID
+			mentions = append(mentions, conversation)
+		} else {
+			plain = append(plain, conversation)
+		}
+	}
+
+	return r.data.DB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		updates := map[string]interface{}{
+			"last_message_id": messageID,
+			"unread_count":    gorm.Expr("unread_count + 1"),
+		}
+		if len(plain) > 0 {
+			err := tx.Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "user_id"}, {Name: "target_id"}},
+				DoUpdates: clause.Assignments(updates),
+			}).CreateInBatches(plain, 500).Error
+			if err != nil {
+				return err
+			}
+		}
+		if len(mentions) > 0 {
+			// 保留最早一条未读的@消息方便跳转，同时记录最近一条，读过最早那条后还有未读的@时标记不会消失
+			updates["mention_message_id"] = gorm.Expr("IF(mention_message_id = 0, ?, mention_message_id)", messageID)
+			updates["last_mention_message_id"] = messageID
+			err := tx.Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "user_id"}, {Name: "target_id"}},
+				DoUpdates: clause.Assignments(updates),
+			}).CreateInBatches(mentions, 500).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r *ConversationRepo) ListConversations(ctx context.Context, userID uint32, archived bool) ([]*bizChat.ConversationTB, error) {
+	var conversations []*bizChat.ConversationTB
+	archivedFlag := 0
+	if archived {
+		archivedFlag = 1
+	}
+	err := r.data.DB().WithContext(ctx).
+		Where("user_id = ? AND archived = ?", userID, archivedFlag).
+		Order("pinned_order DESC, last_message_id DESC").
+		Find(&conversations).Error
+	if err != nil {
+		return nil, err
+	}
+	return conversations, nil
+}
+
+func (r *ConversationRepo) GetConversation(ctx context.Context, userID uint32, targetID string) (*bizChat.ConversationTB, error) {
+	conversation := &bizChat.ConversationTB{}
+	err := r.data.DB().WithContext(ctx).
+		Where("user_id = ? AND target_id = ?", userID, targetID).
+		First(conversation).Error
+	if err != nil {
+		return nil, err
+	}
+	return conversation, nil
+}
+
+func (r *ConversationRepo) ReadConversation(ctx context.Context, conversation *bizChat.ConversationTB, lastReadMessageID uint32, unreadCount uint32) error {
+	updates := map[string]interface{}{
+		"last_read_message_id": lastReadMessageID,
+		"unread_count":         unreadCount,
+	}
+	// 和 ConversationTB.ReadMentionsTo 一致，在 sql 里按当前的值计算，读的同时又来了新的@也不会被清掉
+	updates["mention_message_id"] = gorm.Expr(
+		"CASE WHEN mention_message_id = 0 OR mention_message_id > ? THEN mention_message_id "+
+			"WHEN last_mention_message_id > ? THEN last_mention_message_id ELSE 0 END",
+		lastReadMessageID, lastReadMessageID)
+	return r.data.DB().WithContext(ctx).Model(&bizChat.ConversationTB{}).
+		Where("id = ?", conversation.ID).
+		Updates(updates).Error
+}
+
+func (r *ConversationRepo) CountUnread(ctx context.Context, conversation *bizChat.ConversationTB, afterMessageID uint32) (uint32, error) {
+	userID := strconv.Itoa(int(conversation.UserID))
+	db := r.data.DB().WithContext(ctx).Model(&bizChat.MessageTB{}).
+		Where("id > ? AND deleted_at IS NULL", afterMessageID)
+
+	if conversation.ConversationType == common.MESSAGE_TYPE_GROUP {
+		db = db.Where("to_user_id = ? AND from_user_id <> ?", conversation.TargetID, userID)
+	} else {
+		db = db.Where("from_user_id = ? AND to_user_id = ?", conversation.TargetID, userID)
+	}
+
+	var cnt int64
+	if err := db.Count(&cnt).Error; err != nil {
+		return 0, err
+	}
+	return uint32(cnt), nil
+}
+
+func (r *ConversationRepo) SaveConversationSettings(ctx context.Context, conversation *bizChat.ConversationTB, columns []string) error {
+	err := r.data.DB().WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "target_id"}},
+		DoUpdates: clause.AssignmentColumns(columns),
+	}).Create(conversation).Error
+	if err != nil {
+		return err
+	}
+
+	for _, column := range columns {
+		if column == "muted_until" {
+			key := mutedCacheKey(conversation.TargetID)
+			if err := r.data.Cache().Delete(ctx, key); err != nil {
+				r.log.Warnf("failed to delete muted cache %s: %v", key, err)
+			}
+			break
+		}
+	}
+	return nil
+}
+
+// mutedCacheKey 会话对象维度的免打扰缓存，field 为用户ID，value 为免打扰截止时间戳
+func mutedCacheKey(targetID string) string {
+	return UserRedisKey(ConversationCachePrefix, "muted", targetID)
+}
+
+// 没有人免打扰的会话也要缓存，用一个占位 field 区分缓存不存在
+const mutedCacheEmptyField = "-"
+
+const mutedCacheTTL = time.Hour
+
+func (r *ConversationRepo) MaxPinnedOrder(ctx context.Context, userID uint32) (uint32, error) {
+	var maxOrder uint32
+	err := r.data.DB().WithContext(ctx).Model(&bizChat.ConversationTB{}).
+		Where("user_id = ?", userID).
+		Select("COALESCE(MAX(pinned_order), 0)").
+		Scan(&maxOrder).Error
+	if err != nil {
+		return 0, err
+	}
+	return maxOrder, nil
+}
+
+func (r *ConversationRepo) ListMutedUserIDs(ctx context.Context, userIDs []uint32, targetID string, now time.Time) ([]uint32, error) {
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+	key := mutedCacheKey(targetID)
+	muted, err := r.data.Cache().HGetAll(ctx, key)
+	if err != nil || len(muted) == 0 {
+		if err != nil {
+			r.log.Warnf("failed to get muted cache %s, fallback to DB: %v", key, err)
+		}
+		muted, err = r.loadMutedCache(ctx, key, targetID, now)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var ids []uint32
+	for _, id := range userIDs {
+		until, ok := muted[strconv.Itoa(int(id))]
+		if !ok {
+			continue
+		}
+		if ts, err := strconv.ParseInt(until, 10, 64); err == nil && ts > now.Unix() {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+// loadMutedCache 从 mysql 加载对 targetID 开启了免打扰的所有用户并写入缓存
+func (r *ConversationRepo) loadMutedCache(ctx context.Context, key string, targetID string, now time.Time) (map[string]string, error) {
+	var rows []*bizChat.ConversationTB
+	err := r.data.DB().WithContext(ctx).
+		Select("user_id", "muted_until").
+		Where("target_id = ? AND muted_until > ?", targetID, now).
+		Find(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	muted := map[string]string{mutedCacheEmptyField: ""}
+	values := map[string]interface{}{mutedCacheEmptyField: ""}
+	for _, row := range rows {
+		until := strconv.FormatInt(row.MutedUntil.Unix(), 10)
+		muted[strconv.Itoa(int(row.UserID))] = until
+		values[strconv.Itoa(int(row.UserID))] = until
+	}
+	if _, err := r.data.Cache().HMSet(ctx, key, values); err != nil {
+		r.log.Warnf("failed to cache muted users %s: %v", key, err)
+		return muted, nil
+	}
+	r.data.Cache().Expire(ctx, key, mutedCacheTTL)
+	return muted, nil
+}
+
+// policyCacheKey 会话的阅后即焚设置缓存，每条消息入库前都要查一次
+func policyCacheKey(conversationKey string) string {
+	return UserRedisKey(ConversationCachePrefix, "policy", conversationKey)
+}
+
+// 没有设置过的会话也要缓存，避免每条消息都回源查库
+const policyCacheEmpty = "-"
+
+const policyCacheTTL = time.Hour
+
+// GetConversationPolicy 先查缓存，没有设置过时返回 gorm.ErrRecordNotFound
+func (r *ConversationRepo) GetConversationPolicy(ctx context.Context, conversationKey string) (*bizChat.ConversationPolicyTB, error) {
+	key := policyCacheKey(conversationKey)
+	body, ok, err := r.data.Cache().Get(ctx, key)
+	if err != nil {
+		r.log.Warnf("failed to get policy cache %s, fallback to DB: %v", key, err)
+	}
+	if ok {
+		if body == policyCacheEmpty {
+			return nil, gorm.ErrRecordNotFound
+		}
+		policy := &bizChat.ConversationPolicyTB{}
+		if err := json.Unmarshal([]byte(body), policy); err == nil {
+			return policy, nil
+		}
+	}
+
+	policy := &bizChat.ConversationPolicyTB{}
+	err = r.data.DB().WithContext(ctx).
+		Where("conversation_key = ?", conversationKey).
+		First(policy).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		r.setPolicyCache(ctx, key, policyCacheEmpty)
+		return nil, err
+	}
+	if err != nil {
+		return nil, err
+	}
+	if body, err := json.Marshal(policy); err == nil {
+		r.setPolicyCache(ctx, key, string(body))
+	}
+	return policy, nil
+}
+
+func (r *ConversationRepo) setPolicyCache(ctx context.Context, key string, body string) {
+	if err := r.data.Cache().Set(ctx, key, body, policyCacheTTL); err != nil {
+		r.log.Warnf("failed to cache policy %s: %v", key, err)
+	}
+}
+
+func (r *ConversationRepo) SaveConversationPolicy(ctx context.Context, policy *bizChat.ConversationPolicyTB) error {
+	err := r.data.DB().WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "conversation_key"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"timer_seconds": policy.TimerSeconds,
+			"updated_by":    policy.UpdatedBy,
+		}),
+	}).Create(policy).Error
+	if err != nil {
+		return err
+	}
+
+	key := policyCacheKey(policy.ConversationKey)
+	if err := r.data.Cache().Delete(ctx, key); err != nil {
+		r.log.Warnf("failed to delete policy cache %s: %v", key, err)
+	}
+	return nil
+}
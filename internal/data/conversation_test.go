@@ -0,0 +1,64 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+
+	bizChat "kratos-realworld/internal/biz/messageGroup"
+	"kratos-realworld/internal/common"
+)
+
+func TestTouchReceiverConversationsTracksLatestMention(t *testing.T) {
+	d, rec := newDryRunData(t)
+	repo := NewConversationRepo(d, testLogger)
+
+	err := repo.TouchReceiverConversations(context.Background(), []uint32{2, 3}, "g1", common.MESSAGE_TYPE_GROUP, 9, []uint32{3})
+	assert.NoError(t, err)
+	assert.Contains(t, rec.find("`mention_message_id`=IF"),
+		"`last_mention_message_id`=9,`last_message_id`=9,`mention_message_id`=IF(mention_message_id = 0, 9, mention_message_id)")
+}
+
+func TestReadConversationKeepsLaterMentions(t *testing.T) {
+	d, rec := newDryRunData(t)
+	repo := NewConversationRepo(d, testLogger)
+
+	err := repo.ReadConversation(context.Background(), &bizChat.ConversationTB{ID: 4}, 6, 3)
+	assert.NoError(t, err)
+	assert.Contains(t, rec.find("UPDATE `t_conversation`"),
+		"`mention_message_id`=CASE WHEN mention_message_id = 0 OR mention_message_id > 6 THEN mention_message_id "+
+			"WHEN last_mention_message_id > 6 THEN last_mention_message_id ELSE 0 END")
+}
+
+// 阅后即焚设置命中缓存时不查库，没有设置过的会话也走缓存
+func TestGetConversationPolicyCached(t *testing.T) {
+	d, ol := newOpLogData(t, newDupConn())
+	repo := NewConversationRepo(d, testLogger)
+	ol.values = map[string]string{
+		policyCacheKey("u:1:2"): `{"conversationKey":"u:1:2","timerSeconds":86400}`,
+		policyCacheKey("g:g1"):  policyCacheEmpty,
+	}
+
+	policy, err := repo.GetConversationPolicy(context.Background(), "u:1:2")
+	assert.NoError(t, err)
+	assert.Equal(t, bizChat.ConversationTimerDay, policy.TimerSeconds)
+
+	_, err = repo.GetConversationPolicy(context.Background(), "g:g1")
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	assert.Equal(t, []string{"get " + policyCacheKey("u:1:2"), "get " + policyCacheKey("g:g1")}, ol.ops)
+}
+
+// 修改设置之后删除缓存
+func TestSaveConversationPolicyDropsCache(t *testing.T) {
+	d, ol := newOpLogData(t, newDupConn())
+	repo := NewConversationRepo(d, testLogger)
+
+	err := repo.SaveConversationPolicy(context.Background(), &bizChat.ConversationPolicyTB{ConversationKey: "u:1:2", TimerSeconds: bizChat.ConversationTimerWeek})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"sql INSERT INTO `t_conversation_policy`",
+		"del " + policyCacheKey("u:1:2"),
+	}, trimSQL(ol.ops))
+}
@@ -0,0 +1,101 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	bizProfile "kratos-realworld/internal/biz/profile"
+)
+
+func (r *ProfileRepo) IncrementNoteCount(ctx context.Context, userID uint32, delta int) error {
+	return r.incrementProfileCounter(ctx, userID, bizProfile.CounterNote, delta)
+}
+
+func (r *ProfileRepo) IncrementReceivedLikeCount(ctx context.Context, userID uint32, delta int) error {
+	return r.incrementProfileCounter(ctx, userID, bizProfile.CounterReceivedLike, delta)
+}
+
+func (r *ProfileRepo) IncrementCommentCount(ctx context.Context, userID uint32, delta int) error {
+	return r.incrementProfileCounter(ctx, userID, bizProfile.CounterComment, delta)
+}
+
+// incrementProfileCounter 字段是无符号的，减到 0 为止
+func (r *ProfileRepo) incrementProfileCounter(ctx context.Context, userID uint32, column string, delta int) error {
+	err := r.getDB(ctx).Model(&bizProfile.ProfileTB{}).
+		Where("user_id = ?", userID).
+		UpdateColumn(column, gorm.Expr("GREATEST(CAST("+column+" AS SIGNED) + ?, 0)", delta)).
+		Error
+	if err != nil {
+		return err
+	}
+
+	// profile 已经缓存的话删掉，下次读取时从 mysql 加载，避免缓存里减成负数
+	redisKey := UserRedisKey(UserCachePrefix, "Profile", userID)
+	if err := r.data.Cache().Delete(ctx, redisKey); err != nil {
+		r.log.Warnf("failed to delete profile cache %s: %v", redisKey, err)
+	}
+	return nil
+}
+
+func (r *ProfileRepo) CreateCounterEvent(ctx context.Context, event *bizProfile.CounterEventTB) (bool, error) {
+	err := r.getDB(ctx).Create(event).Error
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// DeleteCounterEventsBefore 去重记录只需要覆盖 kafka 可能重复投递的时间窗口
+func (r *ProfileRepo) DeleteCounterEventsBefore(ctx context.Context, before time.Time) (int64, error) {
+	res := r.data.DB().WithContext(ctx).
+		Where("sys_created < ?", before).
+		Delete(&bizProfile.CounterEventTB{})
+	if res.Error != nil {
+		return 0, res.Error
+	}
+	return res.RowsAffected, nil
+}
+
+// RebuildProfileCounters 收藏还没有对应的表，collected_count 不参与重算
+func (r *ProfileRepo) RebuildProfileCounters(ctx context.Context, afterUserID uint32, limit int) (uint32, int, error) {
+	var ids []uint32
+	err := r.data.DB().WithContext(ctx).Model(&bizProfile.ProfileTB{}).
+		Where("user_id > ?", afterUserID).
+		Order("user_id ASC").
+		Limit(limit).
+		Pluck("user_id", &ids).Error
+	if err != nil {
+		return afterUserID, 0, err
+	}
+	if len(ids) == 0 {
+		return afterUserID, 0, nil
+	}
+
+	err = r.data.DB().WithContext(ctx).Model(&bizProfile.ProfileTB{}).
+		Where("user_id IN ?", ids).
+		Updates(map[string]interface{}{
+			bizProfile.CounterNote: gorm.Expr("(SELECT COUNT(*) FROM t_moments m " +
+				"WHERE m.user_id = t_user_profile.user_id AND m.deleted_at IS NULL)"),
+			bizProfile.CounterReceivedLike: gorm.Expr("(SELECT COUNT(*) FROM t_moment_likes l JOIN t_moments m ON l.moment_id = m.id " +
+				"WHERE m.user_id = t_user_profile.user_id AND m.deleted_at IS NULL)"),
+			bizProfile.CounterComment: gorm.Expr("(SELECT COUNT(*) FROM t_comments c JOIN t_moments m ON c.moment_id = m.id " +
+				"WHERE m.user_id = t_user_profile.user_id AND m.deleted_at IS NULL AND c.deleted_at IS NULL)"),
+		}).Error
+	if err != nil {
+		return afterUserID, 0, err
+	}
+
+	for _, id := range ids {
+		redisKey := UserRedisKey(UserCachePrefix, "Profile", id)
+		if err := r.data.Cache().Delete(ctx, redisKey); err != nil {
+			r.log.Warnf("failed to delete profile cache %s: %v", redisKey, err)
+		}
+	}
+	return ids[len(ids)-1], len(ids), nil
+}
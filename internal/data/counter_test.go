@@ -0,0 +1,24 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	bizProfile "kratos-realworld/internal/biz/profile"
+)
+
+// kafka 重复投递同一个事件时跳过，不当作失败
+func TestCreateCounterEventDuplicate(t *testing.T) {
+	repo := NewProfileRepo(newTestData(t, newDupConn()), testLogger)
+	ctx := context.Background()
+
+	created, err := repo.CreateCounterEvent(ctx, &bizProfile.CounterEventTB{EventID: "like:1:2", EventType: "like", UserID: 1})
+	assert.NoError(t, err)
+	assert.True(t, created)
+
+	created, err = repo.CreateCounterEvent(ctx, &bizProfile.CounterEventTB{EventID: "like:1:2", EventType: "like", UserID: 1})
+	assert.NoError(t, err)
+	assert.False(t, created)
+}
@@ -9,6 +9,12 @@ var ProviderSet = wire.NewSet(
 	NewUserRepo,
 	NewProfileRepo,
 	NewMessageRepo,
+	NewGroupRepo,
+	NewConversationRepo,
+	NewMomentRepo,
+	NewSquareRepo,
+	NewMediaStorage,
+	NewExportRepo,
 	NewSmsRepo,
 	sms.NewSmsService,
 )
@@ -0,0 +1,192 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	mysqlDriver "github.com/go-sql-driver/mysql"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"kratos-realworld/internal/model"
+	"kratos-realworld/internal/model/gormcli"
+)
+
+// dupConn 模拟 mysql 连接，同一张表插入同样的数据时返回唯一键冲突 1062
+// 时间参数不参与比较，相当于整行都在唯一索引里
+type dupConn struct {
+	inserted map[string]bool
+}
+
+func newDupConn() *dupConn {
+	return &dupConn{inserted: make(map[string]bool)}
+}
+
+type execResult int64
+
+func (r execResult) LastInsertId() (int64, error) { return int64(r), nil }
+func (r execResult) RowsAffected() (int64, error) { return 1, nil }
+
+func (c *dupConn) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	if !strings.HasPrefix(query, "INSERT") {
+		return execResult(0), nil
+	}
+	key := query
+	for _, arg := range args {
+		if _, ok := arg.(time.Time); ok {
+			continue
+		}
+		if t, ok := arg.(*time.Time); ok && t != nil {
+			continue
+		}
+		if v := reflect.ValueOf(arg); v.Kind() == reflect.Ptr && !v.IsNil() {
+			arg = v.Elem().Interface()
+		}
+		key += fmt.Sprintf("|%v", arg)
+	}
+	if c.inserted[key] {
+		return nil, &mysqlDriver.MySQLError{Number: 1062, Message: "Duplicate entry"}
+	}
+	c.inserted[key] = true
+	return execResult(len(c.inserted)), nil
+}
+
+// BeginTx 事务直接用同一个连接，提交和回滚都不做任何事
+func (c *dupConn) BeginTx(ctx context.Context, opts *sql.TxOptions) (gorm.ConnPool, error) {
+	return c, nil
+}
+
+func (c *dupConn) Commit() error   { return nil }
+func (c *dupConn) Rollback() error { return nil }
+
+func (c *dupConn) PrepareContext(ctx context.Context, query string) (*sql.Stmt, error) {
+	return nil, errors.New("dupConn: prepare is not supported")
+}
+
+func (c *dupConn) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	return nil, errors.New("dupConn: query is not supported")
+}
+
+func (c *dupConn) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	return nil
+}
+
+// newTestData 和线上一样用 gormcli 的配置打开，只是连接换成 dupConn，没有 redis
+func newTestData(t *testing.T, conn *dupConn) *model.Data {
+	config := gormcli.NewConfig()
+	config.Logger = logger.Discard
+	db, err := gorm.Open(mysql.New(mysql.Config{Conn: conn, SkipInitializeWithVersion: true}), config)
+	if err != nil {
+		t.Fatalf("open gorm: %v", err)
+	}
+	return model.NewData(db, nil)
+}
+
+// sqlRecorder 按顺序记录生成的 sql，参数已经代入
+type sqlRecorder struct {
+	logger.Interface
+	sqls []string
+}
+
+func (r *sqlRecorder) LogMode(logger.LogLevel) logger.Interface { return r }
+
+func (r *sqlRecorder) Trace(ctx context.Context, begin time.Time, fc func() (string, int64), err error) {
+	sql, _ := fc()
+	r.sqls = append(r.sqls, sql)
+}
+
+// find 返回第一条包含 substr 的 sql，没有时返回空串
+func (r *sqlRecorder) find(substr string) string {
+	for _, s := range r.sqls {
+		if strings.Contains(s, substr) {
+			return s
+		}
+	}
+	return ""
+}
+
+// newDryRunData 只生成 sql 不执行，用来检查查询条件；查询都返回空结果
+func newDryRunData(t *testing.T) (*model.Data, *sqlRecorder) {
+	rec := &sqlRecorder{Interface: logger.Discard}
+	config := gormcli.NewConfig()
+	config.Logger = rec
+	config.DryRun = true
+	db, err := gorm.Open(mysql.New(mysql.Config{Conn: newDupConn(), SkipInitializeWithVersion: true}), config)
+	if err != nil {
+		t.Fatalf("open gorm: %v", err)
+	}
+	return model.NewData(db, nil), rec
+}
+
+// rowsConnector 每个查询都返回同样的 rows，写入总是成功，事务提交、回滚都不做任何事
+type rowsConnector struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+func (c *rowsConnector) Connect(context.Context) (driver.Conn, error) { return rowsDriverConn{c}, nil }
+func (c *rowsConnector) Driver() driver.Driver                        { return nil }
+
+type rowsDriverConn struct {
+	c *rowsConnector
+}
+
+func (rowsDriverConn) Prepare(query string) (driver.Stmt, error) {
+	return nil, errors.New("rowsConnector: prepare is not supported")
+}
+func (rowsDriverConn) Close() error              { return nil }
+func (rowsDriverConn) Begin() (driver.Tx, error) { return rowsDriverTx{}, nil }
+
+func (conn rowsDriverConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return &fixedRows{columns: conn.c.columns, rows: conn.c.rows}, nil
+}
+
+func (rowsDriverConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return driver.RowsAffected(1), nil
+}
+
+type rowsDriverTx struct{}
+
+func (rowsDriverTx) Commit() error   { return nil }
+func (rowsDriverTx) Rollback() error { return nil }
+
+type fixedRows struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+func (r *fixedRows) Columns() []string { return r.columns }
+func (r *fixedRows) Close() error      { return nil }
+
+func (r *fixedRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.rows[0])
+	r.rows = r.rows[1:]
+	return nil
+}
+
+// newRowsData 查询都返回 c 里的 rows，sql 按顺序记录下来
+func newRowsData(t *testing.T, c *rowsConnector) (*model.Data, *sqlRecorder) {
+	rec := &sqlRecorder{Interface: logger.Discard}
+	config := gormcli.NewConfig()
+	config.Logger = rec
+	db, err := gorm.Open(mysql.New(mysql.Config{Conn: sql.OpenDB(c), SkipInitializeWithVersion: true}), config)
+	if err != nil {
+		t.Fatalf("open gorm: %v", err)
+	}
+	return model.NewData(db, nil), rec
+}
+
+var testLogger = log.DefaultLogger
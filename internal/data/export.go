@@ -0,0 +1,93 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+
+	bizChat "kratos-realworld/internal/biz/messageGroup"
+	"kratos-realworld/internal/model"
+)
+
+type ExportRepo struct {
+	data *model.Data
+	log  *log.Helper
+}
+
+func NewExportRepo(data *model.Data, logger log.Logger) bizChat.ExportRepo {
+	return &ExportRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *ExportRepo) CreateExportJob(ctx context.Context, job *bizChat.ExportJobTB) error {
+	return r.data.DB().WithContext(ctx).Create(job).Error
+}
+
+func (r *ExportRepo) GetExportJob(ctx context.Context, id uint32) (*bizChat.ExportJobTB, error) {
+	job := &bizChat.ExportJobTB{}
+	err := r.data.DB().WithContext(ctx).Where("id = ?", id).First(job).Error
+	if err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+func (r *ExportRepo) GetExportJobByToken(ctx context.Context, token string) (*bizChat.ExportJobTB, error) {
+	job := &bizChat.ExportJobTB{}
+	err := r.data.DB().WithContext(ctx).Where("token = ?", token).First(job).Error
+	if err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+func (r *ExportRepo) UpdateExportJob(ctx context.Context, id uint32, updates map[string]interface{}) error {
+	return r.data.DB().WithContext(ctx).Model(&bizChat.ExportJobTB{}).
+		Where("id = ?", id).
+		Updates(updates).Error
+}
+
+func (r *ExportRepo) ListExpiredExportJobs(ctx context.Context, before time.Time, limit int) ([]*bizChat.ExportJobTB, error) {
+	var jobs []*bizChat.ExportJobTB
+	err := r.data.DB().WithContext(ctx).
+		Where("status = ? AND expire_at < ? AND file_name <> ''", bizChat.ExportDone, before).
+		Order("id ASC").
+		Limit(limit).
+		Find(&jobs).Error
+	return jobs, err
+}
+
+func (r *ExportRepo) TouchExportJobs(ctx context.Context, ids []uint32) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return r.data.DB().WithContext(ctx).Model(&bizChat.ExportJobTB{}).
+		Where("id IN ?", ids).
+		Update("sys_updated", time.Now()).Error
+}
+
+func (r *ExportRepo) ListStaleExportJobs(ctx context.Context, before time.Time, limit int) ([]*bizChat.ExportJobTB, error) {
+	var jobs []*bizChat.ExportJobTB
+	err := r.data.DB().WithContext(ctx).
+		Where("status IN ? AND sys_updated < ?", []uint16{bizChat.ExportPending, bizChat.ExportRunning}, before).
+		Order("id ASC").
+		Limit(limit).
+		Find(&jobs).Error
+	return jobs, err
+}
+
+func (r *ExportRepo) ClaimExportJob(ctx context.Context, id uint32, before time.Time) (bool, error) {
+	res := r.data.DB().WithContext(ctx).Model(&bizChat.ExportJobTB{}).
+		Where("id = ? AND status IN ? AND sys_updated < ?", id, []uint16{bizChat.ExportPending, bizChat.ExportRunning}, before).
+		Updates(map[string]interface{}{
+			"status":      bizChat.ExportPending,
+			"sys_updated": time.Now(),
+		})
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected == 1, nil
+}
@@ -0,0 +1,198 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/redis/go-redis/v9"
+
+	bizProfile "kratos-realworld/internal/biz/profile"
+)
+
+// 关注、粉丝列表的有序集合缓存，member 为对方用户ID，score 为关注关系的行ID（按关注时间递增）
+// 集合里固定放一个 score 为 0 的占位成员，区分“列表为空”和“缓存未命中”
+const followZSetPlaceholder = "0"
+
+// followListQuery 关注列表按 follower_id 查，粉丝列表按 followee_id 查
+type followListQuery struct {
+	subPrefix string
+	column    string
+}
+
+var (
+	followingQuery = followListQuery{subPrefix: "FollowZSet", column: "follower_id"}
+	followerQuery  = followListQuery{subPrefix: "FanZSet", column: "followee_id"}
+)
+
+// ListFollowing userID 关注的人，cursor 为上一页最后一条的ID，0 表示第一页
+func (r *ProfileRepo) ListFollowing(ctx context.Context, userID uint32, cursor uint32, limit int) ([]*bizProfile.FollowFanTB, error) {
+	return r.listFollowEdges(ctx, followingQuery, userID, cursor, limit)
+}
+
+// ListFollowers 关注 userID 的人，cursor 为上一页最后一条的ID，0 表示第一页
+func (r *ProfileRepo) ListFollowers(ctx context.Context, userID uint32, cursor uint32, limit int) ([]*bizProfile.FollowFanTB, error) {
+	return r.listFollowEdges(ctx, followerQuery, userID, cursor, limit)
+}
+
+func (r *ProfileRepo) listFollowEdges(ctx context.Context, q followListQuery, userID uint32, cursor uint32, limit int) ([]*bizProfile.FollowFanTB, error) {
+	redisKey := UserRedisKey(UserCachePrefix, q.subPrefix, userID)
+	if err := r.loadFollowZSet(ctx, q, userID); err != nil {
+		r.log.Errorf("load follow list cache error: %v, fallback to DB", err)
+		return r.listFollowEdgesSql(ctx, q, userID, cursor, limit)
+	}
+
+	max := "+inf"
+	if cursor > 0 {
+		max = fmt.Sprintf("(%d", cursor)
+	}
+	var cmd *redis.ZSliceCmd
+	err := r.data.Cache().Pipeline(ctx, func(pipe redis.Pipeliner) error {
+		cmd = pipe.ZRevRangeByScoreWithScores(ctx, redisKey, &redis.ZRangeBy{
+			Max:   max,
+			Min:   "(0",
+			Count: int64(limit),
+		})
+		return nil
+	})
+	if err != nil {
+		r.log.Errorf("Redis ZRevRangeByScore error: %v, fallback to DB", err)
+		return r.listFollowEdgesSql(ctx, q, userID, cursor, limit)
+	}
+
+	edges := make([]*bizProfile.FollowFanTB, 0, len(cmd.Val()))
+	for _, z := range cmd.Val() {
+		member, _ := z.Member.(string)
+		otherID, err := strconv.ParseUint(member, 10, 32)
+		if err != nil {
+			continue
+		}
+		edge := &bizProfile.FollowFanTB{ID: uint32(z.Score)}
+		if q == followingQuery {
+			edge.FollowerID, edge.FolloweeID = userID, uint32(otherID)
+		} else {
+			edge.FollowerID, edge.FolloweeID = uint32(otherID), userID
+		}
+		edges = append(edges, edge)
+	}
+	return edges, nil
+}
+
+func (r *ProfileRepo) listFollowEdgesSql(ctx context.Context, q followListQuery, userID uint32, cursor uint32, limit int) ([]*bizProfile.FollowFanTB, error) {
+	db := r.data.DB().WithContext(ctx).Where(q.column+" = ?", userID)
+	if cursor > 0 {
+		db = db.Where("id < ?", cursor)
+	}
+
+	var edges []*bizProfile.FollowFanTB
+	if err := db.Order("id DESC").Limit(limit).Find(&edges).Error; err != nil {
+		return nil, err
+	}
+	return edges, nil
+}
+
+// loadFollowZSet 有序集合不存在时从数据库加载完整列表
+func (r *ProfileRepo) loadFollowZSet(ctx context.Context, q followListQuery, userID uint32) error {
+	redisKey := UserRedisKey(UserCachePrefix, q.subPrefix, userID)
+	if r.data.Cache().Exists(ctx, redisKey) {
+		r.data.Cache().Expire(ctx, redisKey, UserCacheTTL)
+		return nil
+	}
+
+	var edges []*bizProfile.FollowFanTB
+	err := r.data.DB().WithContext(ctx).
+		Select("id", "follower_id", "followee_id").
+		Where(q.column+" = ?", userID).
+		Find(&edges).Error
+	if err != nil {
+		return err
+	}
+
+	return r.data.Cache().Pipeline(ctx, func(pipe redis.Pipeliner) error {
+		members := make([]redis.Z, 0, len(edges)+1)
+		members = append(members, redis.Z{Score: 0, Member: followZSetPlaceholder})
+		for _, e := range edges {
+			otherID := e.FolloweeID
+			if q == followerQuery {
+				otherID = e.FollowerID
+			}
+			members = append(members, redis.Z{Score: float64(e.ID), Member: fmt.Sprintf("%d", otherID)})
+		}
+		pipe.ZAdd(ctx, redisKey, members...)
+		pipe.Expire(ctx, redisKey, UserCacheTTL)
+		return nil
+	})
+}
+
+// addFollowZSetCache 只更新已经加载过的有序集合，未加载的等下次查询时完整加载
+func (r *ProfileRepo) addFollowZSetCache(ctx context.Context, follow *bizProfile.FollowFanTB) {
+	keyFollowing := UserRedisKey(UserCachePrefix, followingQuery.subPrefix, follow.FollowerID)
+	if r.data.Cache().Exists(ctx, keyFollowing) {
+		if _, err := r.data.Cache().ZAdd(ctx, keyFollowing, float64(follow.ID), fmt.Sprintf("%d", follow.FolloweeID)); err != nil {
+			r.log.Errorf("Following list Redis add failed. follower=%d followee=%d err=%v", follow.FollowerID, follow.FolloweeID, err)
+		}
+	}
+
+	keyFollower := UserRedisKey(UserCachePrefix, followerQuery.subPrefix, follow.FolloweeID)
+	if r.data.Cache().Exists(ctx, keyFollower) {
+		if _, err := r.data.Cache().ZAdd(ctx, keyFollower, float64(follow.ID), fmt.Sprintf("%d", follow.FollowerID)); err != nil {
+			r.log.Errorf("Follower list Redis add failed. follower=%d followee=%d err=%v", follow.FollowerID, follow.FolloweeID, err)
+		}
+	}
+}
+
+func (r *ProfileRepo) deleteFollowZSetCache(ctx context.Context, followerID uint32, followeeID uint32) error {
+	keyFollowing := UserRedisKey(UserCachePrefix, followingQuery.subPrefix, followerID)
+	keyFollower := UserRedisKey(UserCachePrefix, followerQuery.subPrefix, followeeID)
+
+	return r.data.Cache().Pipeline(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZRem(ctx, keyFollowing, fmt.Sprintf("%d", followeeID))
+		pipe.ZRem(ctx, keyFollower, fmt.Sprintf("%d", followerID))
+		return nil
+	})
+}
+
+// FilterFollowing 返回 targetIDs 中 userID 已关注的用户
+func (r *ProfileRepo) FilterFollowing(ctx context.Context, userID uint32, targetIDs []uint32) ([]uint32, error) {
+	var ids []uint32
+	if len(targetIDs) == 0 {
+		return ids, nil
+	}
+	err := r.data.DB().WithContext(ctx).Model(&bizProfile.FollowFanTB{}).
+		Where("follower_id = ? AND followee_id IN ?", userID, targetIDs).
+		Pluck("followee_id", &ids).Error
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// FilterFollowers 返回 targetIDs 中关注了 userID 的用户
+func (r *ProfileRepo) FilterFollowers(ctx context.Context, userID uint32, targetIDs []uint32) ([]uint32, error) {
+	var ids []uint32
+	if len(targetIDs) == 0 {
+		return ids, nil
+	}
+	err := r.data.DB().WithContext(ctx).Model(&bizProfile.FollowFanTB{}).
+		Where("followee_id = ? AND follower_id IN ?", userID, targetIDs).
+		Pluck("follower_id", &ids).Error
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// FilterFriends 返回 targetIDs 中是 userID 好友的用户
+func (r *ProfileRepo) FilterFriends(ctx context.Context, userID uint32, targetIDs []uint32) ([]uint32, error) {
+	var ids []uint32
+	if len(targetIDs) == 0 {
+		return ids, nil
+	}
+	err := r.data.DB().WithContext(ctx).Model(&bizProfile.FriendTB{}).
+		Where("user_id = ? AND friend_id IN ?", userID, targetIDs).
+		Pluck("friend_id", &ids).Error
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
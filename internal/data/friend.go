@@ -0,0 +1,183 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+
+	bizProfile "kratos-realworld/internal/biz/profile"
+	"kratos-realworld/internal/model"
+)
+
+// CreateFriendRequest 两人之间已经有待处理的申请（任一方向）时返回 gorm.ErrDuplicatedKey
+func (r *ProfileRepo) CreateFriendRequest(ctx context.Context, req *bizProfile.FriendRequestTB) error {
+	if req.Status == bizProfile.FriendRequestPending {
+		pair := pendingPair(req.FromID, req.ToID)
+		req.PendingPair = &pair
+	}
+	return r.getDB(ctx).WithContext(ctx).Create(req).Error
+}
+
+// pendingPair 两人之间待处理申请的唯一键，小的 ID 在前，和方向无关
+func pendingPair(userID uint32, targetID uint32) string {
+	if userID > targetID {
+		userID, targetID = targetID, userID
+	}
+	return fmt.Sprintf("%d_%d", userID, targetID)
+}
+
+func (r *ProfileRepo) GetFriendRequest(ctx context.Context, id uint32) (*bizProfile.FriendRequestTB, error) {
+	req := &bizProfile.FriendRequestTB{}
+	err := r.getDB(ctx).WithContext(ctx).Where("id = ?", id).First(req).Error
+	if err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func (r *ProfileRepo) GetPendingFriendRequest(ctx context.Context, fromID uint32, toID uint32) (*bizProfile.FriendRequestTB, error) {
+	req := &bizProfile.FriendRequestTB{}
+	err := r.getDB(ctx).WithContext(ctx).
+		Where("from_id = ? AND to_id = ? AND status = ?", fromID, toID, bizProfile.FriendRequestPending).
+		First(req).Error
+	if err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// ListPendingFriendRequests incoming 为 true 查收到的申请，否则查发出的申请，新的在前
+func (r *ProfileRepo) ListPendingFriendRequests(ctx context.Context, userID uint32, incoming bool) ([]*bizProfile.FriendRequestTB, error) {
+	column := "from_id"
+	if incoming {
+		column = "to_id"
+	}
+
+	var reqs []*bizProfile.FriendRequestTB
+	err := r.getDB(ctx).WithContext(ctx).
+		Where(column+" = ? AND status = ?", userID, bizProfile.FriendRequestPending).
+		Order("id DESC").
+		Find(&reqs).Error
+	if err != nil {
+		return nil, err
+	}
+	return reqs, nil
+}
+
+// HandleFriendRequest 只更新待处理的申请，返回 false 表示已经被处理过
+func (r *ProfileRepo) HandleFriendRequest(ctx context.Context, id uint32, status uint16) (bool, error) {
+	res := r.getDB(ctx).WithContext(ctx).Model(&bizProfile.FriendRequestTB{}).
+		Where("id = ? AND status = ?", id, bizProfile.FriendRequestPending).
+		Updates(map[string]interface{}{
+			"status":       status,
+			"handled_at":   time.Now(),
+			"pending_pair": nil,
+		})
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected > 0, nil
+}
+
+// CancelPendingFriendRequests 作废两人之间任一方向的待处理申请，拉黑时在事务中调用
+func (r *ProfileRepo) CancelPendingFriendRequests(ctx context.Context, userID uint32, targetID uint32) error {
+	return r.getDB(ctx).WithContext(ctx).Model(&bizProfile.FriendRequestTB{}).
+		Where("pending_pair = ? AND status = ?", pendingPair(userID, targetID), bizProfile.FriendRequestPending).
+		Updates(map[string]interface{}{
+			"status":       bizProfile.FriendRequestCanceled,
+			"handled_at":   time.Now(),
+			"pending_pair": nil,
+		}).Error
+}
+
+// AddFriend 双向写入好友关系，已经存在的方向忽略，缓存在事务提交之后再写
+func (r *ProfileRepo) AddFriend(ctx context.Context, userID uint32, friendID uint32) error {
+	rows := []*bizProfile.FriendTB{
+		{UserID: userID, FriendID: friendID},
+		{UserID: friendID, FriendID: userID},
+	}
+	for _, row := range rows {
+		err := r.getDB(ctx).WithContext(ctx).
+			Where("user_id = ? AND friend_id = ?", row.UserID, row.FriendID).
+			FirstOrCreate(row).Error
+		// 并发写入同一方向时唯一索引冲突，说明已经存在
+		if err != nil && !errors.Is(err, gorm.ErrDuplicatedKey) {
+			return err
+		}
+	}
+
+	// Redis 写入
+	model.AfterCommit(ctx, func() {
+		if err := r.addFriendCache(ctx, userID, friendID); err != nil {
+			r.log.Errorf("Friend relationship Redis add failed. user=%d friend=%d err=%v", userID, friendID, err)
+		}
+	})
+	return nil
+}
+
+func (r *ProfileRepo) addFriendCache(ctx context.Context, userID uint32, friendID uint32) error {
+	keyUser := UserRedisKey(UserCachePrefix, "FriendList", userID)
+	keyFriend := UserRedisKey(UserCachePrefix, "FriendList", friendID)
+
+	return r.data.Cache().Pipeline(ctx, func(pipe redis.Pipeliner) error {
+		pipe.SAdd(ctx, keyUser, fmt.Sprintf("%d", friendID))
+		pipe.SAdd(ctx, keyFriend, fmt.Sprintf("%d", userID))
+		pipe.Expire(ctx, keyUser, UserCacheTTL)
+		pipe.Expire(ctx, keyFriend, UserCacheTTL)
+		return nil
+	})
+}
+
+// DeleteFriend 双向删除好友关系，返回 false 表示本来就不是好友
+func (r *ProfileRepo) DeleteFriend(ctx context.Context, userID uint32, friendID uint32) (bool, error) {
+	res := r.getDB(ctx).WithContext(ctx).
+		Where("(user_id = ? AND friend_id = ?) OR (user_id = ? AND friend_id = ?)", userID, friendID, friendID, userID).
+		Delete(&bizProfile.FriendTB{})
+	if res.Error != nil {
+		return false, res.Error
+	}
+
+	// Redis 更新
+	model.AfterCommit(ctx, func() {
+		if err := r.deleteFriendCache(ctx, userID, friendID); err != nil {
+			r.log.Errorf("Friend relationship Redis delete failed. user=%d friend=%d err=%v", userID, friendID, err)
+		}
+	})
+	return res.RowsAffected > 0, nil
+}
+
+func (r *ProfileRepo) deleteFriendCache(ctx context.Context, userID uint32, friendID uint32) error {
+	keyUser := UserRedisKey(UserCachePrefix, "FriendList", userID)
+	keyFriend := UserRedisKey(UserCachePrefix, "FriendList", friendID)
+
+	return r.data.Cache().Pipeline(ctx, func(pipe redis.Pipeliner) error {
+		pipe.SRem(ctx, keyUser, fmt.Sprintf("%d", friendID))
+		pipe.SRem(ctx, keyFriend, fmt.Sprintf("%d", userID))
+		return nil
+	})
+}
+
+// ListFriends 按成为好友的时间倒序分页，page 从 1 开始
+func (r *ProfileRepo) ListFriends(ctx context.Context, userID uint32, page int, pageSize int) ([]*bizProfile.FriendTB, int64, error) {
+	var total int64
+	db := r.getDB(ctx).WithContext(ctx).Model(&bizProfile.FriendTB{}).Where("user_id = ?", userID)
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var friends []*bizProfile.FriendTB
+	err := r.getDB(ctx).WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("id DESC").
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		Find(&friends).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	return friends, total, nil
+}
@@ -0,0 +1,180 @@
+package data
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+
+	bizChat "kratos-realworld/internal/biz/messageGroup"
+	"kratos-realworld/internal/model"
+)
+
+type GroupRepo struct {
+	data *model.Data
+	log  *log.Helper
+}
+
+func NewGroupRepo(data *model.Data, logger log.Logger) bizChat.GroupRepo {
+	return &GroupRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *GroupRepo) getDB(ctx context.Context) *gorm.DB {
+	if tx, ok := ctx.Value(model.TxKey).(*gorm.DB); ok {
+		return tx
+	}
+	return r.data.DB()
+}
+
+func (r *GroupRepo) GetGroupByUuid(ctx context.Context, uuid string) (*bizChat.GroupTB, error) {
+	group := &bizChat.GroupTB{}
+	err := r.getDB(ctx).WithContext(ctx).
+		Where("uuid = ? AND deleted_at IS NULL", uuid).
+		First(group).Error
+	if err != nil {
+		return nil, err
+	}
+	return group, nil
+}
+
+func (r *GroupRepo) GetGroupByID(ctx context.Context, id uint32) (*bizChat.GroupTB, error) {
+	group := &bizChat.GroupTB{}
+	err := r.getDB(ctx).WithContext(ctx).
+		Where("id = ? AND deleted_at IS NULL", id).
+		First(group).Error
+	if err != nil {
+		return nil, err
+	}
+	return group, nil
+}
+
+func (r *GroupRepo) GetGroupMember(ctx context.Context, groupID uint32, userID uint32) (*bizChat.GroupMemberTB, error) {
+	member := &bizChat.GroupMemberTB{}
+	err := r.getDB(ctx).WithContext(ctx).
+		Where("group_id = ? AND user_id = ? AND deleted_at IS NULL", groupID, userID).
+		First(member).Error
+	if err != nil {
+		return nil, err
+	}
+	return member, nil
+}
+
+func (r *GroupRepo) ListGroupMembers(ctx context.Context, groupID uint32) ([]*bizChat.GroupMemberTB, error) {
+	var members []*bizChat.GroupMemberTB
+	err := r.getDB(ctx).WithContext(ctx).
+		Where("group_id = ? AND deleted_at IS NULL", groupID).
+		Find(&members).Error
+	if err != nil {
+		return nil, err
+	}
+	return members, nil
+}
+
+func (r *GroupRepo) UpdateGroup(ctx context.Context, groupID uint32, updates map[string]interface{}) error {
+	return r.getDB(ctx).WithContext(ctx).Model(&bizChat.GroupTB{}).
+		Where("id = ? AND deleted_at IS NULL", groupID).
+		Updates(updates).Error
+}
+
+func (r *GroupRepo) UpdateGroupMember(ctx context.Context, memberID uint32, updates map[string]interface{}) error {
+	return r.getDB(ctx).WithContext(ctx).Model(&bizChat.GroupMemberTB{}).
+		Where("id = ? AND deleted_at IS NULL", memberID).
+		Updates(updates).Error
+}
+
+func (r *GroupRepo) AddGroupMember(ctx context.Context, member *bizChat.GroupMemberTB) error {
+	return r.getDB(ctx).WithContext(ctx).Create(member).Error
+}
+
+func (r *GroupRepo) CreateInvite(ctx context.Context, invite *bizChat.GroupInviteTB) error {
+	return r.getDB(ctx).WithContext(ctx).Create(invite).Error
+}
+
+func (r *GroupRepo) GetInviteByToken(ctx context.Context, token string) (*bizChat.GroupInviteTB, error) {
+	invite := &bizChat.GroupInviteTB{}
+	err := r.getDB(ctx).WithContext(ctx).Where("token = ?", token).First(invite).Error
+	if err != nil {
+		return nil, err
+	}
+	return invite, nil
+}
+
+func (r *GroupRepo) RevokeInvite(ctx context.Context, inviteID uint32) error {
+	return r.getDB(ctx).WithContext(ctx).Model(&bizChat.GroupInviteTB{}).
+		Where("id = ? AND revoked_at IS NULL", inviteID).
+		Update("revoked_at", gorm.Expr("NOW()")).Error
+}
+
+func (r *GroupRepo) UseInvite(ctx context.Context, inviteID uint32) (bool, error) {
+	// 条件更新保证并发使用时不会超过最大次数，检查之后才过期的邀请也不能再用
+	res := r.getDB(ctx).WithContext(ctx).Model(&bizChat.GroupInviteTB{}).
+		Where("id = ? AND revoked_at IS NULL AND (expire_at IS NULL OR expire_at > NOW()) AND (max_uses = 0 OR used_count < max_uses)", inviteID).
+		Update("used_count", gorm.Expr("used_count + 1"))
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected > 0, nil
+}
+
+func (r *GroupRepo) ReleaseInvite(ctx context.Context, inviteID uint32) error {
+	return r.getDB(ctx).WithContext(ctx).Model(&bizChat.GroupInviteTB{}).
+		Where("id = ? AND used_count > 0", inviteID).
+		Update("used_count", gorm.Expr("used_count - 1")).Error
+}
+
+func (r *GroupRepo) CreateJoinRequest(ctx context.Context, req *bizChat.GroupJoinRequestTB) error {
+	if req.Status == bizChat.JoinRequestPending {
+		pending := uint16(1)
+		req.Pending = &pending
+	}
+	return r.getDB(ctx).WithContext(ctx).Create(req).Error
+}
+
+func (r *GroupRepo) GetJoinRequest(ctx context.Context, id uint32) (*bizChat.GroupJoinRequestTB, error) {
+	req := &bizChat.GroupJoinRequestTB{}
+	err := r.getDB(ctx).WithContext(ctx).Where("id = ?", id).First(req).Error
+	if err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func (r *GroupRepo) GetPendingJoinRequest(ctx context.Context, groupID uint32, userID uint32) (*bizChat.GroupJoinRequestTB, error) {
+	req := &bizChat.GroupJoinRequestTB{}
+	err := r.getDB(ctx).WithContext(ctx).
+		Where("group_id = ? AND user_id = ? AND status = ?", groupID, userID, bizChat.JoinRequestPending).
+		First(req).Error
+	if err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func (r *GroupRepo) ListJoinRequests(ctx context.Context, groupID uint32, status uint16) ([]*bizChat.GroupJoinRequestTB, error) {
+	var reqs []*bizChat.GroupJoinRequestTB
+	err := r.getDB(ctx).WithContext(ctx).
+		Where("group_id = ? AND status = ?", groupID, status).
+		Order("id ASC").
+		Find(&reqs).Error
+	if err != nil {
+		return nil, err
+	}
+	return reqs, nil
+}
+
+func (r *GroupRepo) HandleJoinRequest(ctx context.Context, id uint32, status uint16, handlerID uint32) (bool, error) {
+	res := r.getDB(ctx).WithContext(ctx).Model(&bizChat.GroupJoinRequestTB{}).
+		Where("id = ? AND status = ?", id, bizChat.JoinRequestPending).
+		Updates(map[string]interface{}{
+			"status":     status,
+			"handler_id": handlerID,
+			"pending":    nil,
+		})
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected > 0, nil
+}
@@ -0,0 +1,55 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+
+	bizChat "kratos-realworld/internal/biz/messageGroup"
+	"kratos-realworld/internal/data/migrate"
+)
+
+// 同一个人重复提交待审批的申请，由唯一索引拦住
+func TestCreateJoinRequestDuplicate(t *testing.T) {
+	repo := NewGroupRepo(newTestData(t, newDupConn()), testLogger)
+	ctx := context.Background()
+
+	req := &bizChat.GroupJoinRequestTB{GroupID: 1, UserID: 2, Status: bizChat.JoinRequestPending}
+	assert.NoError(t, repo.CreateJoinRequest(ctx, req))
+	assert.NotNil(t, req.Pending)
+
+	err := repo.CreateJoinRequest(ctx, &bizChat.GroupJoinRequestTB{GroupID: 1, UserID: 2, Status: bizChat.JoinRequestPending})
+	assert.True(t, errors.Is(err, gorm.ErrDuplicatedKey))
+}
+
+// 并发入群时重复插入成员由唯一索引拦住
+func TestAddGroupMemberDuplicate(t *testing.T) {
+	repo := NewGroupRepo(newTestData(t, newDupConn()), testLogger)
+	ctx := context.Background()
+
+	assert.NoError(t, repo.AddGroupMember(ctx, &bizChat.GroupMemberTB{GroupID: 1, UserID: 2, Role: bizChat.GroupRoleMember}))
+	err := repo.AddGroupMember(ctx, &bizChat.GroupMemberTB{GroupID: 1, UserID: 2, Role: bizChat.GroupRoleMember})
+	assert.True(t, errors.Is(err, gorm.ErrDuplicatedKey))
+}
+
+// 使用邀请时再检查一次过期时间，检查之后才过期的邀请不能再用
+func TestUseInviteChecksExpiry(t *testing.T) {
+	d, rec := newDryRunData(t)
+	repo := NewGroupRepo(d, testLogger)
+
+	_, err := repo.UseInvite(context.Background(), 7)
+	assert.NoError(t, err)
+	assert.Contains(t, rec.find("UPDATE `t_group_invite`"),
+		"WHERE id = 7 AND revoked_at IS NULL AND (expire_at IS NULL OR expire_at > NOW()) AND (max_uses = 0 OR used_count < max_uses)")
+}
+
+func TestDedupeGroupMembersKeepsEarliest(t *testing.T) {
+	d, rec := newDryRunData(t)
+
+	assert.NoError(t, migrate.DedupeGroupMembers(d.DB()))
+	assert.Equal(t, "DELETE a FROM `t_groupMember` a JOIN `t_groupMember` b ON a.group_id = b.group_id AND a.user_id = b.user_id AND a.id > b.id",
+		rec.find("DELETE a FROM"))
+}
@@ -0,0 +1,68 @@
+package data
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	bizUser "kratos-realworld/internal/biz/user"
+)
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func (r *UserRepo) UpdateHandle(ctx context.Context, userID uint32, handle string) error {
+	user := &bizUser.UserTB{}
+	if err := r.getDB(ctx).Select("id", "Phone").Where("id = ?", userID).First(user).Error; err != nil {
+		return err
+	}
+
+	err := r.getDB(ctx).Model(&bizUser.UserTB{}).
+		Where("id = ?", userID).
+		UpdateColumn("Handle", handle).Error
+	if err != nil {
+		return err
+	}
+
+	// 按ID、手机号缓存的用户信息里都有 Handle，直接删掉
+	for _, redisKey := range []string{
+		UserRedisKey(UserCachePrefix, "ID", userID),
+		UserRedisKey(UserCachePrefix, "Phone", user.Phone),
+	} {
+		if err := r.data.Cache().Delete(ctx, redisKey); err != nil {
+			r.log.Warnf("failed to delete user cache %s: %v", redisKey, err)
+		}
+	}
+	return nil
+}
+
+func (r *UserRepo) CreateHandleHistory(ctx context.Context, history *bizUser.HandleHistoryTB) error {
+	return r.getDB(ctx).Create(history).Error
+}
+
+func (r *UserRepo) CountHandleChanges(ctx context.Context, userID uint32, since time.Time) (int64, error) {
+	var cnt int64
+	err := r.getDB(ctx).Model(&bizUser.HandleHistoryTB{}).
+		Where("user_id = ? AND sys_created >= ?", userID, since).
+		Count(&cnt).Error
+	return cnt, err
+}
+
+// SearchUsers 完全匹配 handle 的排在最前面，其余按注册先后排序
+func (r *UserRepo) SearchUsers(ctx context.Context, keyword string, offset int, limit int) ([]*bizUser.UserTB, error) {
+	handle := strings.ToLower(keyword)
+	var users []*bizUser.UserTB
+	err := r.data.DB().WithContext(ctx).
+		Select("id", "UserName", "Handle", "Image", "Bio").
+		Where("Handle LIKE ? OR UserName LIKE ?", likeEscaper.Replace(handle)+"%", likeEscaper.Replace(keyword)+"%").
+		Order(gorm.Expr("Handle = ? DESC", handle)).
+		Order("id ASC").
+		Offset(offset).
+		Limit(limit).
+		Find(&users).Error
+	if err != nil {
+		return nil, err
+	}
+	return users, nil
+}
@@ -0,0 +1,17 @@
+package data
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"kratos-realworld/internal/data/migrate"
+)
+
+func TestBackfillHandlesOnlyFillsMissing(t *testing.T) {
+	d, rec := newDryRunData(t)
+
+	assert.NoError(t, migrate.BackfillHandles(d.DB()))
+	assert.Equal(t, "UPDATE `t_user` SET `Handle`=CONCAT('u', id) WHERE Handle IS NULL OR Handle = ''",
+		rec.find("UPDATE `t_user`"))
+}
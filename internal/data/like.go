@@ -0,0 +1,342 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+
+	bizMoment "kratos-realworld/internal/biz/moments"
+)
+
+const (
+	// 点赞数有变化、还没刷回 mysql 的动态ID
+	momentLikePendingKey = MomentCachePrefix + ":LikePending"
+	momentLikeCacheTTL   = 24 * time.Hour
+	// 写点赞表到更新缓存之间的标记，进程挂掉没有清除时到期自动失效
+	likeWriteGuardTTL = 30 * time.Second
+)
+
+// 开始写点赞表前标记有写入进行中，缓存回填看到标记时放弃回填
+const beginLikeWriteScript = `
+redis.call('INCR', KEYS[1])
+redis.call('EXPIRE', KEYS[1], ARGV[1])
+return 1
+`
+
+// 计数和点赞集合已经缓存的才更新，没缓存的下次读取时从 mysql 加载；记录待刷回的动态
+// 最后清除写入标记并递增版本号，统计期间有写入完成的回填会因为版本号变化而放弃
+const adjustLikeCacheScript = `
+if redis.call('EXISTS', KEYS[1]) == 1 then
+	redis.call('INCRBY', KEYS[1], ARGV[1])
+end
+if redis.call('EXISTS', KEYS[2]) == 1 then
+	if tonumber(ARGV[1]) > 0 then
+		redis.call('SADD', KEYS[2], ARGV[2])
+	else
+		redis.call('SREM', KEYS[2], ARGV[2])
+	end
+end
+redis.call('SADD', KEYS[3], ARGV[3])
+if tonumber(redis.call('GET', KEYS[4]) or '0') > 0 then
+	redis.call('DECR', KEYS[4])
+end
+redis.call('INCR', KEYS[5])
+redis.call('EXPIRE', KEYS[5], ARGV[4])
+return 1
+`
+
+// 点赞表没有变化时只清除写入标记
+const endLikeWriteScript = `
+if tonumber(redis.call('GET', KEYS[1]) or '0') > 0 then
+	redis.call('DECR', KEYS[1])
+end
+return 1
+`
+
+// 回填点赞数：没有进行中的写入，并且版本号和统计前一致，才说明统计结果是最新的
+const fillLikeCountScript = `
+if tonumber(redis.call('GET', KEYS[2]) or '0') > 0 then
+	return 0
+end
+if (redis.call('GET', KEYS[3]) or '0') ~= ARGV[2] then
+	return 0
+end
+redis.call('SET', KEYS[1], ARGV[1], 'EX', ARGV[3], 'NX')
+return 1
+`
+
+// 回填点赞集合，条件和 fillLikeCountScript 一样；集合先分批写进临时 key KEYS[4]，满足条件时改名
+const fillLikersScript = `
+if tonumber(redis.call('GET', KEYS[2]) or '0') > 0 or (redis.call('GET', KEYS[3]) or '0') ~= ARGV[2]
+	or redis.call('EXISTS', KEYS[1]) == 1 then
+	redis.call('DEL', KEYS[4])
+	return 0
+end
+redis.call('RENAME', KEYS[4], KEYS[1])
+redis.call('EXPIRE', KEYS[1], ARGV[1])
+return 1
+`
+
+// 临时点赞集合每次 SADD 的成员数
+const fillLikersBatchSize = 1000
+
+func likeCountKey(momentID uint32) string {
+	return UserRedisKey(MomentCachePrefix, "LikeCount", momentID)
+}
+
+func likersKey(momentID uint32) string {
+	return UserRedisKey(MomentCachePrefix, "Likers", momentID)
+}
+
+func likeWritingKey(momentID uint32) string {
+	return UserRedisKey(MomentCachePrefix, "LikeWriting", momentID)
+}
+
+func likeVersionKey(momentID uint32) string {
+	return UserRedisKey(MomentCachePrefix, "LikeVersion", momentID)
+}
+
+// CreateLike 已经点过赞时返回 false
+func (r *MomentRepo) CreateLike(ctx context.Context, like *bizMoment.MomentLikeTB) (bool, error) {
+	r.beginLikeWrite(ctx, like.MomentID)
+	err := r.data.DB().WithContext(ctx).Create(like).Error
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		r.endLikeWrite(ctx, like.MomentID)
+		return false, nil
+	}
+	if err != nil {
+		r.endLikeWrite(ctx, like.MomentID)
+		return false, err
+	}
+	r.adjustLikeCache(ctx, like.MomentID, like.UserID, 1)
+	return true, nil
+}
+
+func (r *MomentRepo) DeleteLike(ctx context.Context, momentID uint32, userID uint32) (*bizMoment.MomentLikeTB, error) {
+	var like bizMoment.MomentLikeTB
+	err := r.data.DB().WithContext(ctx).
+		Where("moment_id = ? AND user_id = ?", momentID, userID).
+		First(&like).Error
+	if err != nil {
+		return nil, err
+	}
+	r.beginLikeWrite(ctx, momentID)
+	res := r.data.DB().WithContext(ctx).Delete(&like)
+	if res.Error != nil {
+		r.endLikeWrite(ctx, momentID)
+		return nil, res.Error
+	}
+	// 并发取消时只有一个请求真正删除
+	if res.RowsAffected == 0 {
+		r.endLikeWrite(ctx, momentID)
+		return nil, gorm.ErrRecordNotFound
+	}
+	r.adjustLikeCache(ctx, momentID, userID, -1)
+	return &like, nil
+}
+
+func (r *MomentRepo) beginLikeWrite(ctx context.Context, momentID uint32) {
+	keys := []string{likeWritingKey(momentID)}
+	if _, err := r.data.Cache().EvalResults(ctx, beginLikeWriteScript, keys, int(likeWriteGuardTTL.Seconds())); err != nil {
+		r.log.Warnf("failed to mark like write of moment %d: %v", momentID, err)
+	}
+}
+
+func (r *MomentRepo) endLikeWrite(ctx context.Context, momentID uint32) {
+	keys := []string{likeWritingKey(momentID)}
+	if _, err := r.data.Cache().EvalResults(ctx, endLikeWriteScript, keys); err != nil {
+		r.log.Warnf("failed to clear like write of moment %d: %v", momentID, err)
+	}
+}
+
+func (r *MomentRepo) adjustLikeCache(ctx context.Context, momentID uint32, userID uint32, delta int) {
+	keys := []string{likeCountKey(momentID), likersKey(momentID), momentLikePendingKey, likeWritingKey(momentID), likeVersionKey(momentID)}
+	_, err := r.data.Cache().EvalResults(ctx, adjustLikeCacheScript, keys, delta, userID, momentID, int(momentLikeCacheTTL.Seconds()))
+	if err != nil {
+		// 缓存可能和 mysql 不一致了，删掉让下次读取时重新加载
+		r.log.Warnf("failed to adjust like cache of moment %d: %v", momentID, err)
+		for _, key := range keys[:2] {
+			if err := r.data.Cache().Delete(ctx, key); err != nil {
+				r.log.Warnf("failed to delete like cache %s: %v", key, err)
+			}
+		}
+	}
+}
+
+// likeVersions 统计之前先取版本号，回填时用来判断统计期间有没有写入；取不到的当作 0
+func (r *MomentRepo) likeVersions(ctx context.Context, momentIDs []uint32) map[uint32]string {
+	cmds := make(map[uint32]*redis.StringCmd, len(momentIDs))
+	err := r.data.Cache().Pipeline(ctx, func(pipe redis.Pipeliner) error {
+		for _, id := range momentIDs {
+			cmds[id] = pipe.Get(ctx, likeVersionKey(id))
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, redis.Nil) {
+		r.log.Warnf("failed to get like versions: %v", err)
+	}
+	versions := make(map[uint32]string, len(momentIDs))
+	for id, cmd := range cmds {
+		versions[id] = "0"
+		if v, err := cmd.Result(); err == nil {
+			versions[id] = v
+		}
+	}
+	return versions
+}
+
+// HasLiked 点赞集合没缓存时从 mysql 加载整个集合，没人点赞的动态直接查 mysql
+func (r *MomentRepo) HasLiked(ctx context.Context, momentID uint32, userID uint32) (bool, error) {
+	key := likersKey(momentID)
+	member := strconv.FormatUint(uint64(userID), 10)
+	if r.data.Cache().Exists(ctx, key) {
+		liked, err := r.data.Cache().SIsMember(ctx, key, member)
+		if err == nil {
+			return liked, nil
+		}
+		r.log.Warnf("failed to check likers cache %s, fallback to DB: %v", key, err)
+	}
+
+	version := r.likeVersions(ctx, []uint32{momentID})[momentID]
+	var userIDs []uint32
+	err := r.data.DB().WithContext(ctx).Model(&bizMoment.MomentLikeTB{}).
+		Where("moment_id = ?", momentID).
+		Pluck("user_id", &userIDs).Error
+	if err != nil {
+		return false, err
+	}
+	if len(userIDs) == 0 {
+		return false, nil
+	}
+
+	liked := false
+	members := make([]interface{}, 0, len(userIDs))
+	for _, id := range userIDs {
+		members = append(members, strconv.FormatUint(uint64(id), 10))
+		if id == userID {
+			liked = true
+		}
+	}
+	tmpKey := key + ":" + uuid.NewString()
+	err = r.data.Cache().Pipeline(ctx, func(pipe redis.Pipeliner) error {
+		for start := 0; start < len(members); start += fillLikersBatchSize {
+			end := min(start+fillLikersBatchSize, len(members))
+			pipe.SAdd(ctx, tmpKey, members[start:end]...)
+		}
+		pipe.Expire(ctx, tmpKey, likeWriteGuardTTL)
+		return nil
+	})
+	if err != nil {
+		r.log.Warnf("failed to fill likers cache %s: %v", key, err)
+		return liked, nil
+	}
+	keys := []string{key, likeWritingKey(momentID), likeVersionKey(momentID), tmpKey}
+	if _, err := r.data.Cache().EvalResults(ctx, fillLikersScript, keys, int(momentLikeCacheTTL.Seconds()), version); err != nil {
+		r.log.Warnf("failed to fill likers cache %s: %v", key, err)
+	}
+	return liked, nil
+}
+
+// GetLikeCounts 先批量查 redis，没命中的用点赞表统计后回写
+func (r *MomentRepo) GetLikeCounts(ctx context.Context, momentIDs []uint32) (map[uint32]int64, error) {
+	counts := make(map[uint32]int64, len(momentIDs))
+	if len(momentIDs) == 0 {
+		return counts, nil
+	}
+
+	cmds := make(map[uint32]*redis.StringCmd, len(momentIDs))
+	err := r.data.Cache().Pipeline(ctx, func(pipe redis.Pipeliner) error {
+		for _, id := range momentIDs {
+			cmds[id] = pipe.Get(ctx, likeCountKey(id))
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, redis.Nil) {
+		r.log.Warnf("failed to get like counts from cache, fallback to DB: %v", err)
+	}
+
+	missed := make([]uint32, 0)
+	for id, cmd := range cmds {
+		cnt, err := cmd.Int64()
+		if err != nil {
+			missed = append(missed, id)
+			continue
+		}
+		counts[id] = cnt
+	}
+	if len(missed) == 0 {
+		return counts, nil
+	}
+
+	versions := r.likeVersions(ctx, missed)
+	var rows []struct {
+		MomentID uint32
+		Cnt      int64
+	}
+	err = r.data.DB().WithContext(ctx).Model(&bizMoment.MomentLikeTB{}).
+		Select("moment_id, COUNT(*) AS cnt").
+		Where("moment_id IN ?", missed).
+		Group("moment_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		counts[row.MomentID] = row.Cnt
+	}
+	for _, id := range missed {
+		// 统计期间有点赞、取消的不回填，下次读取时重新统计，否则这次写入的增量会丢失
+		keys := []string{likeCountKey(id), likeWritingKey(id), likeVersionKey(id)}
+		_, err := r.data.Cache().EvalResults(ctx, fillLikeCountScript, keys, counts[id], versions[id], int(momentLikeCacheTTL.Seconds()))
+		if err != nil {
+			r.log.Warnf("failed to cache like count of moment %d: %v", id, err)
+		}
+	}
+	return counts, nil
+}
+
+// PopPendingLikeCounts 取出一批待刷回的动态ID
+func (r *MomentRepo) PopPendingLikeCounts(ctx context.Context, limit int) ([]uint32, error) {
+	var cmd *redis.StringSliceCmd
+	err := r.data.Cache().Pipeline(ctx, func(pipe redis.Pipeliner) error {
+		cmd = pipe.SPopN(ctx, momentLikePendingKey, int64(limit))
+		return nil
+	})
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return nil, err
+	}
+	return parseIDs(cmd.Val()), nil
+}
+
+// AddPendingLikeCounts 刷回失败的放回去，下一轮重试
+func (r *MomentRepo) AddPendingLikeCounts(ctx context.Context, momentIDs []uint32) error {
+	if len(momentIDs) == 0 {
+		return nil
+	}
+	members := make([]string, 0, len(momentIDs))
+	for _, id := range momentIDs {
+		members = append(members, strconv.FormatUint(uint64(id), 10))
+	}
+	_, err := r.data.Cache().SAdd(ctx, momentLikePendingKey, members...)
+	return err
+}
+
+// UpdateLikeCounts 直接写入最新的点赞数，重复刷回不会多算
+func (r *MomentRepo) UpdateLikeCounts(ctx context.Context, counts map[uint32]int64) error {
+	return r.data.DB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for id, cnt := range counts {
+			err := tx.Model(&bizMoment.MomentTB{}).
+				Where("id = ?", id).
+				UpdateColumn("like_count", cnt).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
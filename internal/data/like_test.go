@@ -0,0 +1,224 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	bizMoment "kratos-realworld/internal/biz/moments"
+	"kratos-realworld/internal/model"
+	"kratos-realworld/internal/model/cache"
+	"kratos-realworld/internal/model/gormcli"
+)
+
+var likeScriptNames = map[string]string{
+	beginLikeWriteScript:  "begin",
+	adjustLikeCacheScript: "adjust",
+	endLikeWriteScript:    "end",
+	fillLikeCountScript:   "fillCount",
+	fillLikersScript:      "fillLikers",
+}
+
+// opLog 按顺序记录 sql 和 redis 命令，用来检查两边的先后顺序
+// redis 命令不会真的发出去：GET 版本号返回 version，values 里有的 key 返回对应的值，其他 GET 当作不存在，脚本返回 1
+type opLog struct {
+	logger.Interface
+	version string
+	values  map[string]string
+	ops     []string
+}
+
+func (l *opLog) LogMode(logger.LogLevel) logger.Interface { return l }
+
+func (l *opLog) Trace(ctx context.Context, begin time.Time, fc func() (string, int64), err error) {
+	sql, _ := fc()
+	l.ops = append(l.ops, "sql "+sql)
+}
+
+func (l *opLog) process(cmd redis.Cmder) {
+	args := cmd.Args()
+	name := strings.ToLower(fmt.Sprint(args[0]))
+	switch c := cmd.(type) {
+	case *redis.StringCmd:
+		key := fmt.Sprint(args[1])
+		l.ops = append(l.ops, name+" "+key)
+		if v, ok := l.values[key]; ok {
+			c.SetVal(v)
+		} else if strings.Contains(key, ":LikeVersion:") && l.version != "" {
+			c.SetVal(l.version)
+		} else {
+			c.SetErr(redis.Nil)
+		}
+	case *redis.Cmd:
+		// eval script numkeys keys... args...
+		line := name + " " + likeScriptNames[fmt.Sprint(args[1])]
+		for _, a := range args[3:] {
+			line += fmt.Sprintf(" %v", a)
+		}
+		l.ops = append(l.ops, line)
+		c.SetVal(int64(1))
+	default:
+		l.ops = append(l.ops, name+" "+fmt.Sprint(args[1:]...))
+	}
+}
+
+func (l *opLog) DialHook(next redis.DialHook) redis.DialHook {
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		return nil, errors.New("opLog: dial is not supported")
+	}
+}
+
+func (l *opLog) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
+	return func(ctx context.Context, cmd redis.Cmder) error {
+		l.process(cmd)
+		return nil
+	}
+}
+
+func (l *opLog) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return func(ctx context.Context, cmds []redis.Cmder) error {
+		for _, cmd := range cmds {
+			l.process(cmd)
+		}
+		return nil
+	}
+}
+
+// index 返回第一条以 prefix 开头的记录的位置，没有时返回 -1
+func (l *opLog) index(prefix string) int {
+	for i, op := range l.ops {
+		if strings.HasPrefix(op, prefix) {
+			return i
+		}
+	}
+	return -1
+}
+
+// newOpLogData 用 conn 执行 sql，redis 命令记录到 opLog
+func newOpLogData(t *testing.T, conn gorm.ConnPool) (*model.Data, *opLog) {
+	ol := &opLog{Interface: logger.Discard}
+	config := gormcli.NewConfig()
+	config.Logger = ol
+	db, err := gorm.Open(mysql.New(mysql.Config{Conn: conn, SkipInitializeWithVersion: true}), config)
+	if err != nil {
+		t.Fatalf("open gorm: %v", err)
+	}
+	rc := redis.NewClient(&redis.Options{Addr: "opLog"})
+	rc.AddHook(ol)
+	cache.UseConn(rc)
+	t.Cleanup(func() { cache.UseConn(nil) })
+	return model.NewData(db, cache.GetRedisCli()), ol
+}
+
+func TestCreateLikeDuplicate(t *testing.T) {
+	d, ol := newOpLogData(t, newDupConn())
+	repo := NewMomentRepo(d, testLogger)
+
+	created, err := repo.CreateLike(context.Background(), &bizMoment.MomentLikeTB{MomentID: 3, UserID: 5})
+	assert.NoError(t, err)
+	assert.True(t, created)
+	assert.Equal(t, []string{
+		"eval begin moment:LikeWriting:3 30",
+		"sql INSERT INTO `t_moment_likes`",
+		"eval adjust moment:LikeCount:3 moment:Likers:3 moment:LikePending moment:LikeWriting:3 moment:LikeVersion:3 1 5 3 86400",
+	}, trimSQL(ol.ops))
+
+	// 重复点赞不报错，只清除写入标记，不动计数
+	ol.ops = nil
+	created, err = repo.CreateLike(context.Background(), &bizMoment.MomentLikeTB{MomentID: 3, UserID: 5})
+	assert.NoError(t, err)
+	assert.False(t, created)
+	assert.Equal(t, []string{
+		"eval begin moment:LikeWriting:3 30",
+		"sql INSERT INTO `t_moment_likes`",
+		"eval end moment:LikeWriting:3",
+	}, trimSQL(ol.ops))
+}
+
+// 统计前先取版本号，回填时带上，统计期间有写入完成的不会回填旧的计数
+func TestGetLikeCountsReadsVersionBeforeCounting(t *testing.T) {
+	d, ol := newOpLogData(t, sql.OpenDB(&rowsConnector{}))
+	ol.version = "7"
+	repo := NewMomentRepo(d, testLogger)
+
+	counts, err := repo.GetLikeCounts(context.Background(), []uint32{3})
+	assert.NoError(t, err)
+	assert.Zero(t, counts[3])
+
+	version := ol.index("get moment:LikeVersion:3")
+	count := ol.index("sql SELECT moment_id, COUNT(*)")
+	fill := ol.index("eval fillCount moment:LikeCount:3 moment:LikeWriting:3 moment:LikeVersion:3 0 7 86400")
+	assert.True(t, version >= 0 && count > version && fill > count, "%v", ol.ops)
+	assert.Equal(t, -1, ol.index("setnx"))
+}
+
+// trimSQL sql 只保留到表名，方便比较
+func trimSQL(ops []string) []string {
+	res := make([]string, 0, len(ops))
+	for _, op := range ops {
+		if strings.HasPrefix(op, "sql ") {
+			if i := strings.Index(op, "` "); i > 0 {
+				op = op[:i+1]
+			}
+		}
+		res = append(res, op)
+	}
+	return res
+}
+
+// TestLikeCacheScripts 在真实的 redis 上执行回填脚本，设置 TEST_REDIS_ADDR 时才运行
+func TestLikeCacheScripts(t *testing.T) {
+	addr := os.Getenv("TEST_REDIS_ADDR")
+	if addr == "" {
+		t.Skip("TEST_REDIS_ADDR is not set")
+	}
+	cache.Init(cache.WithAddr(addr))
+	t.Cleanup(func() { cache.UseConn(nil) })
+	ctx := context.Background()
+	repo := NewMomentRepo(model.NewData(nil, cache.GetRedisCli()), testLogger).(*MomentRepo)
+	momentID := uint32(time.Now().UnixNano() % 1000000000)
+	keys := []string{likeCountKey(momentID), likeWritingKey(momentID), likeVersionKey(momentID)}
+	t.Cleanup(func() {
+		for _, key := range append(keys, likersKey(momentID), momentLikePendingKey) {
+			_ = cache.GetRedisCli().Delete(ctx, key)
+		}
+	})
+	fill := func(count int64, version string) bool {
+		_, err := cache.GetRedisCli().EvalResults(ctx, fillLikeCountScript, keys, count, version, 60)
+		assert.NoError(t, err)
+		_, exists, err := cache.GetRedisCli().Get(ctx, keys[0])
+		assert.NoError(t, err)
+		return exists
+	}
+
+	// 统计之后、回填之前有一次点赞完成：缓存还没建立所以没有加一，回填必须放弃
+	version := repo.likeVersions(ctx, []uint32{momentID})[momentID]
+	repo.beginLikeWrite(ctx, momentID)
+	repo.adjustLikeCache(ctx, momentID, 5, 1)
+	assert.False(t, fill(5, version))
+
+	// 点赞写入进行中时也不回填
+	version = repo.likeVersions(ctx, []uint32{momentID})[momentID]
+	repo.beginLikeWrite(ctx, momentID)
+	assert.False(t, fill(6, version))
+	repo.endLikeWrite(ctx, momentID)
+
+	assert.True(t, fill(6, version))
+	repo.beginLikeWrite(ctx, momentID)
+	repo.adjustLikeCache(ctx, momentID, 6, 1)
+	cnt, _, err := cache.GetRedisCli().Get(ctx, keys[0])
+	assert.NoError(t, err)
+	assert.Equal(t, "7", cnt)
+}
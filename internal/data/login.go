@@ -0,0 +1,46 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	bizProfile "kratos-realworld/internal/biz/profile"
+)
+
+func (r *ProfileRepo) RecordLogin(ctx context.Context, history *bizProfile.LoginHistoryTB) error {
+	if err := r.getDB(ctx).Create(history).Error; err != nil {
+		return err
+	}
+
+	err := r.getDB(ctx).Model(&bizProfile.ProfileTB{}).
+		Where("user_id = ?", history.UserID).
+		Updates(map[string]interface{}{
+			"last_login_ip": history.IP,
+			"last_active":   time.Now(),
+		}).Error
+	if err != nil {
+		return err
+	}
+
+	// profile 缓存里也有这两个字段，直接删掉
+	redisKey := UserRedisKey(UserCachePrefix, "Profile", history.UserID)
+	if err := r.data.Cache().Delete(ctx, redisKey); err != nil {
+		r.log.Warnf("failed to delete profile cache %s: %v", redisKey, err)
+	}
+	return nil
+}
+
+// ListLoginHistory 按时间倒序，cursor 为上一页最后一条的ID，0 表示第一页
+func (r *ProfileRepo) ListLoginHistory(ctx context.Context, userID uint32, cursor uint32, limit int) ([]*bizProfile.LoginHistoryTB, error) {
+	db := r.data.DB().WithContext(ctx).Where("user_id = ?", userID)
+	if cursor > 0 {
+		db = db.Where("id < ?", cursor)
+	}
+
+	var histories []*bizProfile.LoginHistoryTB
+	err := db.Order("id DESC").Limit(limit).Find(&histories).Error
+	if err != nil {
+		return nil, err
+	}
+	return histories, nil
+}
@@ -0,0 +1,48 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	bizMoment "kratos-realworld/internal/biz/moments"
+)
+
+func (r *MomentRepo) CreateUpload(ctx context.Context, upload *bizMoment.MediaUploadTB) error {
+	return r.data.DB().WithContext(ctx).Create(upload).Error
+}
+
+func (r *MomentRepo) GetUploads(ctx context.Context, userID uint32, ids []uint32) (map[uint32]*bizMoment.MediaUploadTB, error) {
+	uploads := make(map[uint32]*bizMoment.MediaUploadTB, len(ids))
+	if len(ids) == 0 {
+		return uploads, nil
+	}
+	var rows []*bizMoment.MediaUploadTB
+	err := r.data.DB().WithContext(ctx).
+		Where("id IN ? AND user_id = ? AND used = ?", ids, userID, false).
+		Find(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, u := range rows {
+		uploads[u.ID] = u
+	}
+	return uploads, nil
+}
+
+func (r *MomentRepo) ListOrphanUploads(ctx context.Context, before time.Time, limit int) ([]*bizMoment.MediaUploadTB, error) {
+	var uploads []*bizMoment.MediaUploadTB
+	err := r.data.DB().WithContext(ctx).
+		Where("used = ? AND sys_created < ?", false, before).
+		Order("id ASC").
+		Limit(limit).
+		Find(&uploads).Error
+	return uploads, err
+}
+
+// DeleteOrphanUpload 带上 used 条件，和发布时的引用并发也不会删掉已经被引用的上传
+func (r *MomentRepo) DeleteOrphanUpload(ctx context.Context, id uint32) (bool, error) {
+	res := r.data.DB().WithContext(ctx).
+		Where("id = ? AND used = ?", id, false).
+		Delete(&bizMoment.MediaUploadTB{})
+	return res.RowsAffected > 0, res.Error
+}
@@ -3,20 +3,82 @@ package migrate
 import (
 	"gorm.io/gorm"
 	"kratos-realworld/internal/biz/messageGroup"
+	moment "kratos-realworld/internal/biz/moments"
 	"kratos-realworld/internal/biz/profile"
 	"kratos-realworld/internal/biz/user"
 )
 
 func InitDBTable(db *gorm.DB) error {
+	// 群成员加了唯一索引，老数据里并发入群留下的重复记录要先删掉，否则建索引失败
+	if db.Migrator().HasTable(&messageGroup.GroupMemberTB{}) {
+		if err := DedupeGroupMembers(db); err != nil {
+			return err
+		}
+	}
 	if err := db.AutoMigrate(
 		&user.UserTB{},
+		&user.HandleHistoryTB{},
 		&profile.ProfileTB{},
 		&profile.FollowFanTB{},
+		&profile.FriendRequestTB{},
+		&profile.FriendTB{},
+		&profile.BlockTB{},
+		&profile.SuggestionDismissTB{},
+		&profile.CounterEventTB{},
+		&profile.BanAuditTB{},
+		&profile.LoginHistoryTB{},
+		&profile.PrivacySettingTB{},
 		&messageGroup.MessageTB{},
 		&messageGroup.GroupTB{},
 		&messageGroup.GroupMemberTB{},
+		&messageGroup.ConversationTB{},
+		&messageGroup.ConversationPolicyTB{},
+		&messageGroup.ExportJobTB{},
+		&messageGroup.GroupInviteTB{},
+		&messageGroup.GroupJoinRequestTB{},
+		&moment.MomentTB{},
+		&moment.MomentsMetaTB{},
+		&moment.CommentsTB{},
+		&moment.MomentsBoxTB{},
+		&moment.MomentLikeTB{},
+		&moment.CommentLikeTB{},
+		&moment.MediaUploadTB{},
+		&moment.MomentMediaTB{},
 	); err != nil {
 		return err
 	}
+	if err := BackfillHandles(db); err != nil {
+		return err
+	}
+	// 老版本的手机号搜索开关存在 profile 表里，新表默认允许，不迁移的话关掉的人会重新被搜到
+	if db.Migrator().HasColumn(&profile.ProfileTB{}, "discoverable_by_phone") {
+		if err := MigrateDiscoverableByPhone(db); err != nil {
+			return err
+		}
+	}
 	return nil
 }
+
+// BackfillHandles 给还没有@用户名的老用户补上注册时的默认用户名 u+ID
+// u+数字 不允许用户自己改成，所以不会和已有的用户名冲突
+func BackfillHandles(db *gorm.DB) error {
+	return db.Model(&user.UserTB{}).
+		Where("Handle IS NULL OR Handle = ''").
+		UpdateColumn("Handle", gorm.Expr("CONCAT('u', id)")).Error
+}
+
+// DedupeGroupMembers 同一个人在同一个群的多条成员记录只保留最早的一条
+func DedupeGroupMembers(db *gorm.DB) error {
+	return db.Exec("DELETE a FROM `t_groupMember` a JOIN `t_groupMember` b " +
+		"ON a.group_id = b.group_id AND a.user_id = b.user_id AND a.id > b.id").Error
+}
+
+// MigrateDiscoverableByPhone 把 profile 表里关掉手机号搜索的用户写进隐私设置表，其余字段用默认值
+// 已经有隐私设置的用户以新表为准，可以重复执行
+func MigrateDiscoverableByPhone(db *gorm.DB) error {
+	def := profile.DefaultPrivacySetting(0)
+	return db.Exec("INSERT IGNORE INTO `t_user_privacy_setting` "+
+		"(user_id, who_can_message, moment_visibility, follow_list_visibility, last_seen_visibility, discoverable_by_phone, discoverable_by_search, sys_created, sys_updated) "+
+		"SELECT user_id, ?, ?, ?, ?, 0, ?, NOW(), NOW() FROM `t_user_profile` WHERE discoverable_by_phone = 0",
+		def.WhoCanMessage, def.MomentVisibility, def.FollowListVisibility, def.LastSeenVisibility, def.DiscoverableBySearch).Error
+}
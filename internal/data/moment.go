@@ -3,6 +3,7 @@ package data
 import (
 	"context"
 	bizMoment "kratos-realworld/internal/biz/moments"
+	bizProfile "kratos-realworld/internal/biz/profile"
 	"kratos-realworld/internal/model"
 	"kratos-realworld/internal/pkg/util"
 	"time"
@@ -16,14 +17,14 @@ type MomentRepo struct {
 	log  *log.Helper
 }
 
-func NewMomentRepo(data *model.Data, log *log.Helper) *MomentRepo {
+func NewMomentRepo(data *model.Data, logger log.Logger) bizMoment.MomentRepo {
 	return &MomentRepo{
 		data: data,
-		log:  log,
+		log:  log.NewHelper(logger),
 	}
 }
 
-func (r *MomentRepo) CreateMoment(ctx context.Context, moment *bizMoment.MomentTB) error {
+func (r *MomentRepo) CreateMoment(ctx context.Context, moment *bizMoment.MomentTB, uploadIDs []uint32) error {
 	// 如果PushIDs中有黑名单用户ID，从PushIDs中移除
 	blackMap := make(map[uint32]struct{}, len(moment.BlackListIDs))
 	for _, id := range moment.BlackListIDs {
@@ -38,6 +39,19 @@ func (r *MomentRepo) CreateMoment(ctx context.Context, moment *bizMoment.MomentT
 	moment.PushIDs = filtered
 
 	err := r.data.DB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 并发发布时同一个上传只能被一条动态引用
+		if len(uploadIDs) > 0 {
+			res := tx.Model(&bizMoment.MediaUploadTB{}).
+				Where("id IN ? AND user_id = ? AND used = ?", uploadIDs, moment.UserID, false).
+				UpdateColumn("used", true)
+			if res.Error != nil {
+				return res.Error
+			}
+			if res.RowsAffected != int64(len(uploadIDs)) {
+				return bizMoment.ErrUploadUsed
+			}
+		}
+		// moment.Media 随动态一起写入
 		err := tx.Create(moment).Error
 		if err != nil {
 			return err
@@ -48,12 +62,23 @@ func (r *MomentRepo) CreateMoment(ctx context.Context, moment *bizMoment.MomentT
 			MomentID: moment.ID,
 			Message:  moment.Message,
 			MediaURL: moment.MediaURL,
+			Tags:     moment.Tags,
+		}
+		if len(moment.Media) > 0 {
+			momentMeta.Thumbnail = moment.Media[0].ThumbnailURL
 		}
 		err = tx.Create(momentMeta).Error
 		if err != nil {
 			return err
 		}
-		return nil
+		// 作者自己的发送盒同步写入，粉丝的接收盒由 kafka 消费端异步写入
+		sendBox := &bizMoment.MomentsBoxTB{
+			UserID:   moment.UserID,
+			Box:      bizMoment.BoxSend,
+			MomentID: moment.ID,
+			AuthorID: moment.UserID,
+		}
+		return tx.Create(sendBox).Error
 	})
 	if err != nil {
 		return err
@@ -63,7 +88,12 @@ func (r *MomentRepo) CreateMoment(ctx context.Context, moment *bizMoment.MomentT
 
 func (r *MomentRepo) GetMoment(ctx context.Context, momentID uint32) (*bizMoment.MomentTB, error) {
 	var moment bizMoment.MomentTB
-	err := r.data.DB().WithContext(ctx).Model(&bizMoment.MomentTB{}).Preload("Comments").Where("id = ?", momentID).First(&moment).Error
+	err := r.data.DB().WithContext(ctx).Model(&bizMoment.MomentTB{}).
+		Preload("Media", func(db *gorm.DB) *gorm.DB {
+			return db.Order("sort ASC")
+		}).
+		Where("id = ? AND deleted_at IS NULL", momentID).
+		First(&moment).Error
 	if err != nil {
 		return nil, err
 	}
@@ -76,15 +106,14 @@ func (r *MomentRepo) DeleteMoment(ctx context.Context, momentID uint32) (*bizMom
 	if err != nil {
 		return nil, err
 	}
+	deletedAt := time.Now().Unix()
 	err = r.data.DB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		tx = tx.Model(&bizMoment.MomentTB{})
 		//执行软删除
-		err = tx.Where("id = ?", momentID).Set("deleted_at", time.Now()).Error
+		err := tx.Model(&bizMoment.MomentTB{}).Where("id = ?", momentID).UpdateColumn("deleted_at", deletedAt).Error
 		if err != nil {
 			return err
 		}
-		tx = tx.Model(&bizMoment.MomentsMetaTB{})
-		err = tx.Where("moment_id = ?", momentID).Set("deleted_at", time.Now()).Error
+		err = tx.Model(&bizMoment.MomentsMetaTB{}).Where("moment_id = ?", momentID).UpdateColumn("deleted_at", deletedAt).Error
 		if err != nil {
 			return err
 		}
@@ -104,29 +133,54 @@ func (r *MomentRepo) CreateComment(ctx context.Context, comment *bizMoment.Comme
 	return nil
 }
 
-func (r *MomentRepo) GetComments(ctx context.Context, momentID uint32) ([]*bizMoment.CommentsTB, error) {
+func (r *MomentRepo) GetComment(ctx context.Context, commentID uint32) (*bizMoment.CommentsTB, error) {
+	var comment bizMoment.CommentsTB
+	err := r.data.DB().WithContext(ctx).Where("id = ? AND deleted_at IS NULL", commentID).First(&comment).Error
+	if err != nil {
+		return nil, err
+	}
+	return &comment, nil
+}
+
+func (r *MomentRepo) ListComments(ctx context.Context, query *bizMoment.CommentQuery) ([]*bizMoment.CommentsTB, error) {
 	var comments []*bizMoment.CommentsTB
-	err := r.data.DB().WithContext(ctx).Where("moment_id = ?", momentID).Find(&comments).Error
+	db := r.data.DB().WithContext(ctx).
+		Where("moment_id = ? AND parent_id = ? AND deleted_at IS NULL", query.MomentID, query.ParentID)
+	if query.Cursor > 0 {
+		db = db.Where("id > ?", query.Cursor)
+	}
+	if query.FriendsOf > 0 {
+		friends := r.data.DB().Model(&bizProfile.FriendTB{}).Select("friend_id").Where("user_id = ?", query.FriendsOf)
+		db = db.Where("(user_id IN ? OR user_id IN (?))", query.VisibleIDs, friends)
+	}
+	err := db.Order("id ASC").Limit(query.Limit).Find(&comments).Error
 	if err != nil {
 		return nil, err
 	}
 	return comments, nil
 }
 
-func (r *MomentRepo) DeleteComment(ctx context.Context, commentID uint32) error {
+func (r *MomentRepo) DeleteComment(ctx context.Context, commentID uint32) ([]uint32, error) {
+	var ids []uint32
 	err := r.data.DB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		tx = tx.Model(&bizMoment.CommentsTB{})
-		//执行软删除
-		err := tx.Where("id = ?", commentID).Set("deleted_at", time.Now()).Error
+		err := tx.Model(&bizMoment.CommentsTB{}).
+			Where("(id = ? OR parent_id = ?) AND deleted_at IS NULL", commentID, commentID).
+			Pluck("id", &ids).Error
 		if err != nil {
 			return err
 		}
-		return nil
+		if len(ids) == 0 {
+			return nil
+		}
+		//执行软删除
+		return tx.Model(&bizMoment.CommentsTB{}).
+			Where("id IN ?", ids).
+			UpdateColumn("deleted_at", time.Now().Unix()).Error
 	})
 	if err != nil {
-		return err
+		return nil, err
 	}
-	return nil
+	return ids, nil
 }
 
 func (r *MomentRepo) GetMomentMeta(ctx context.Context, momentID uint32) (*bizMoment.MomentsMetaTB, error) {
@@ -146,9 +200,10 @@ func (r *MomentRepo) UpdateMomentBlackList(ctx context.Context, momentID uint32,
 			return err
 		}
 		addedIDs, removedIDs = util.DiffIDs(old.BlackListIDs, newBlackListIDs)
-		old.BlackListIDs = newBlackListIDs
-		// 更新moment的黑名单ID
-		err = tx.Save(old).Error
+		// 更新moment的黑名单ID，只更新这一列，避免把预加载的评论一起保存
+		err = tx.Model(&bizMoment.MomentTB{ID: momentID}).
+			Select("BlackListIDs").
+			Updates(&bizMoment.MomentTB{BlackListIDs: newBlackListIDs}).Error
 		if err != nil {
 			return err
 		}
@@ -159,3 +214,9 @@ func (r *MomentRepo) UpdateMomentBlackList(ctx context.Context, momentID uint32,
 	}
 	return addedIDs, removedIDs, nil
 }
+
+func (r *MomentRepo) UpdateMomentVisibility(ctx context.Context, momentID uint32, visibility string, scopeIDs []uint32) error {
+	return r.data.DB().WithContext(ctx).Model(&bizMoment.MomentTB{ID: momentID}).
+		Select("Visibility", "ScopeIDs").
+		Updates(&bizMoment.MomentTB{Visibility: visibility, ScopeIDs: scopeIDs}).Error
+}
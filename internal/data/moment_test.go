@@ -0,0 +1,20 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// 删除动态是软删除，动态和 meta 都要写 deleted_at，之后按 deleted_at IS NULL 查询就查不到了
+func TestDeleteMomentSoftDelete(t *testing.T) {
+	d, rec := newDryRunData(t)
+	repo := NewMomentRepo(d, testLogger)
+
+	_, err := repo.DeleteMoment(context.Background(), 7)
+	assert.NoError(t, err)
+
+	assert.Regexp(t, "^UPDATE `t_moments` SET `deleted_at`=[0-9]+ WHERE id = 7", rec.find("UPDATE `t_moments`"))
+	assert.Regexp(t, "SET `deleted_at`=[0-9]+ WHERE moment_id = 7", rec.find("UPDATE `t_moments_meta`"))
+}
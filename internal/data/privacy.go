@@ -0,0 +1,89 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm/clause"
+
+	bizProfile "kratos-realworld/internal/biz/profile"
+)
+
+// GetPrivacySettings 先批量查 redis，没命中的再查数据库并回写；没有保存过的用户缓存默认设置
+func (r *ProfileRepo) GetPrivacySettings(ctx context.Context, userIDs []uint32) (map[uint32]*bizProfile.PrivacySettingTB, error) {
+	settings := make(map[uint32]*bizProfile.PrivacySettingTB, len(userIDs))
+	if len(userIDs) == 0 {
+		return settings, nil
+	}
+
+	cmds := make(map[uint32]*redis.StringCmd, len(userIDs))
+	err := r.data.Cache().Pipeline(ctx, func(pipe redis.Pipeliner) error {
+		for _, id := range userIDs {
+			cmds[id] = pipe.Get(ctx, UserRedisKey(UserCachePrefix, "Privacy", id))
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, redis.Nil) {
+		r.log.Warnf("failed to get privacy settings from cache, fallback to DB: %v", err)
+	}
+
+	missed := make([]uint32, 0)
+	for id, cmd := range cmds {
+		body, err := cmd.Result()
+		if err != nil {
+			missed = append(missed, id)
+			continue
+		}
+		setting := &bizProfile.PrivacySettingTB{}
+		if err := json.Unmarshal([]byte(body), setting); err != nil {
+			missed = append(missed, id)
+			continue
+		}
+		settings[id] = setting
+	}
+	if len(missed) == 0 {
+		return settings, nil
+	}
+
+	var rows []*bizProfile.PrivacySettingTB
+	err = r.data.DB().WithContext(ctx).
+		Where("user_id IN ?", missed).
+		Find(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		settings[row.UserID] = row
+	}
+	for _, id := range missed {
+		if _, ok := settings[id]; !ok {
+			settings[id] = bizProfile.DefaultPrivacySetting(id)
+		}
+		r.setPrivacyCache(ctx, settings[id])
+	}
+	return settings, nil
+}
+
+func (r *ProfileRepo) SavePrivacySetting(ctx context.Context, setting *bizProfile.PrivacySettingTB) error {
+	err := r.data.DB().WithContext(ctx).
+		Clauses(clause.OnConflict{UpdateAll: true}).
+		Create(setting).Error
+	if err != nil {
+		return err
+	}
+	r.setPrivacyCache(ctx, setting)
+	return nil
+}
+
+func (r *ProfileRepo) setPrivacyCache(ctx context.Context, setting *bizProfile.PrivacySettingTB) {
+	redisKey := UserRedisKey(UserCachePrefix, "Privacy", setting.UserID)
+	body, err := json.Marshal(setting)
+	if err != nil {
+		return
+	}
+	if err := r.data.Cache().Set(ctx, redisKey, string(body), UserCacheTTL); err != nil {
+		r.log.Warnf("failed to cache privacy setting %s: %v", redisKey, err)
+	}
+}
@@ -0,0 +1,19 @@
+package data
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"kratos-realworld/internal/data/migrate"
+)
+
+func TestMigrateDiscoverableByPhoneCopiesOptOuts(t *testing.T) {
+	d, rec := newDryRunData(t)
+
+	assert.NoError(t, migrate.MigrateDiscoverableByPhone(d.DB()))
+	assert.Equal(t, "INSERT IGNORE INTO `t_user_privacy_setting` "+
+		"(user_id, who_can_message, moment_visibility, follow_list_visibility, last_seen_visibility, discoverable_by_phone, discoverable_by_search, sys_created, sys_updated) "+
+		"SELECT user_id, 'everyone', 'everyone', 'everyone', 'everyone', 0, true, NOW(), NOW() FROM `t_user_profile` WHERE discoverable_by_phone = 0",
+		rec.find("INSERT IGNORE"))
+}
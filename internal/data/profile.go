@@ -98,6 +98,7 @@ func (r *ProfileRepo) FollowUser(ctx context.Context, followerID uint32, followe
 	if err := r.addFollowFanCache(ctx, followerID, followeeID); err != nil {
 		r.log.Errorf("Follow and Fan relationship Redis add failed. follower=%d followee=%d err=%v", followerID, followeeID, err)
 	}
+	r.addFollowZSetCache(ctx, &follow)
 
 	return nil
 }
@@ -139,6 +140,9 @@ func (r *ProfileRepo) UnfollowUser(ctx context.Context, followerID uint32, follo
 	if err := r.deleteFollowFanCache(ctx, followerID, followeeID); err != nil {
 		r.log.Errorf("Follow and Fan relationship Redis delete failed. follower=%d followee=%d err=%v", followerID, followeeID, err)
 	}
+	if err := r.deleteFollowZSetCache(ctx, followerID, followeeID); err != nil {
+		r.log.Errorf("Follow and Fan list Redis delete failed. follower=%d followee=%d err=%v", followerID, followeeID, err)
+	}
 
 	return nil
 }
@@ -154,35 +158,29 @@ func (r *ProfileRepo) deleteFollowFanCache(ctx context.Context, followerID uint3
 	})
 }
 
-func (r *ProfileRepo) CanAddFriendCache(ctx context.Context, userID uint32, followerID uint32) (bool, error) {
-	//redis := r.data.Cache()
-	//keyA := UserRedisKey(UserCachePrefix, "following", userID)
-	//keyB := UserRedisKey(UserCachePrefix, "following", followerID)
-	//
-	//isAFollowsB, err := redis.SIsMember(ctx, keyA, strconv.Itoa(int(userID))).Result()
-	//if err != nil {
-	//	return false, err
-	//}
-	//
-	//isBFollowsA, err := redis.SIsMember(ctx, keyB, strconv.Itoa(int(followerID))).Result()
-	//if err != nil {
-	//	return false, err
-	//}
-	//
-	//return isAFollowsB && isBFollowsA, nil
-
-	return true, nil
+// CanAddFriendCache 只查 redis 好友集合，集合里有对方说明已经是好友，不能再加
+// 集合不在或者不是成员时无法确定，需要调用方回源 CanAddFriendSql
+func (r *ProfileRepo) CanAddFriendCache(ctx context.Context, userID uint32, targetID uint32) (bool, error) {
+	keyFriendList := UserRedisKey(UserCachePrefix, "FriendList", userID) // 某人的好友列表
+	isFriend, err := r.data.Cache().SIsMember(ctx, keyFriendList, fmt.Sprintf("%d", targetID))
+	if err != nil {
+		return false, err
+	}
+	if isFriend {
+		r.data.Cache().Expire(ctx, keyFriendList, UserCacheTTL)
+	}
+	return !isFriend, nil
 }
 
-func (r *ProfileRepo) CanAddFriendSql(ctx context.Context, userID uint32, followerID uint32) (bool, error) {
+func (r *ProfileRepo) CanAddFriendSql(ctx context.Context, userID uint32, targetID uint32) (bool, error) {
 	var cnt int64
-	r.data.DB().Model(&bizProfile.FollowFanTB{}).
-		Where("follower_id = ? AND followee_id = ?", followerID, userID).
-		Count(&cnt)
-	if cnt == 0 {
-		return false, nil
+	err := r.getDB(ctx).Model(&bizProfile.FriendTB{}).
+		Where("user_id = ? AND friend_id = ?", userID, targetID).
+		Count(&cnt).Error
+	if err != nil {
+		return false, err
 	}
-	return true, nil
+	return cnt == 0, nil
 }
 
 func (r *ProfileRepo) CheckFollow(ctx context.Context, userID uint32, targetID uint32) (bool, error) {
@@ -210,12 +208,43 @@ func (r *ProfileRepo) CheckFollow(ctx context.Context, userID uint32, targetID u
 	return cnt > 0, nil
 }
 
+// CheckBlock userID 是否拉黑了 targetID
 func (r *ProfileRepo) CheckBlock(ctx context.Context, userID uint32, targetID uint32) (bool, error) {
-	return false, nil
+	keyBlockList := UserRedisKey(UserCachePrefix, "BlockList", userID) // 某人的黑名单
+	if err := r.loadBlockCache(ctx, userID); err != nil {
+		r.log.Errorf("load block list cache error: %v, fallback to DB", err)
+	} else {
+		isBlocked, err := r.data.Cache().SIsMember(ctx, keyBlockList, fmt.Sprintf("%d", targetID))
+		if err == nil {
+			return isBlocked, nil
+		}
+		r.log.Errorf("Redis SIsMember error: %v, fallback to DB", err)
+	}
+
+	var cnt int64
+	err := r.data.DB().Model(&bizProfile.BlockTB{}).
+		Where("user_id = ? AND blocked_id = ?", userID, targetID).
+		Count(&cnt).Error
+	if err != nil {
+		return false, err
+	}
+	return cnt > 0, nil
 }
 
 func (r *ProfileRepo) CheckFriend(ctx context.Context, userID uint32, targetID uint32) (bool, error) {
-	return false, nil
+	canAdd, err := r.CanAddFriendCache(ctx, userID, targetID)
+	if err != nil {
+		r.log.Errorf("Redis SIsMember error: %v, fallback to DB", err)
+	} else if !canAdd {
+		return true, nil
+	}
+
+	// 缓存中没有再去查mysql里面的好友关系表
+	canAdd, err = r.CanAddFriendSql(ctx, userID, targetID)
+	if err != nil {
+		return false, err
+	}
+	return !canAdd, nil
 }
 
 func (r *ProfileRepo) IncrementFollowCount(ctx context.Context, userID uint32, delta int) (uint32, error) {
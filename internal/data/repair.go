@@ -0,0 +1,125 @@
+package data
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+
+	bizProfile "kratos-realworld/internal/biz/profile"
+)
+
+// RepairFollowCache 以关注关系表为准修正一批用户的计数，并重建这些用户的 redis 关注、粉丝集合
+func (r *ProfileRepo) RepairFollowCache(ctx context.Context, afterUserID uint32, limit int) (*bizProfile.FollowRepairResult, error) {
+	result := &bizProfile.FollowRepairResult{LastUserID: afterUserID}
+
+	var profiles []*bizProfile.ProfileTB
+	err := r.data.DB().WithContext(ctx).
+		Select("user_id", "follow_count", "fan_count").
+		Where("user_id > ?", afterUserID).
+		Order("user_id ASC").
+		Limit(limit).
+		Find(&profiles).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(profiles) == 0 {
+		return result, nil
+	}
+	result.Scanned = len(profiles)
+	result.LastUserID = profiles[len(profiles)-1].UserID
+
+	ids := make([]uint32, 0, len(profiles))
+	for _, p := range profiles {
+		ids = append(ids, p.UserID)
+	}
+
+	// 批量查出每个用户实际的关注、粉丝关系
+	var edges []*bizProfile.FollowFanTB
+	err = r.data.DB().WithContext(ctx).
+		Select("follower_id", "followee_id").
+		Where("follower_id IN ? OR followee_id IN ?", ids, ids).
+		Find(&edges).Error
+	if err != nil {
+		return nil, err
+	}
+	inBatch := make(map[uint32]struct{}, len(ids))
+	for _, id := range ids {
+		inBatch[id] = struct{}{}
+	}
+	following := make(map[uint32][]string, len(ids))
+	fans := make(map[uint32][]string, len(ids))
+	for _, e := range edges {
+		if _, ok := inBatch[e.FollowerID]; ok {
+			following[e.FollowerID] = append(following[e.FollowerID], fmt.Sprintf("%d", e.FolloweeID))
+		}
+		if _, ok := inBatch[e.FolloweeID]; ok {
+			fans[e.FolloweeID] = append(fans[e.FolloweeID], fmt.Sprintf("%d", e.FollowerID))
+		}
+	}
+
+	for _, p := range profiles {
+		followCount := uint32(len(following[p.UserID]))
+		fanCount := uint32(len(fans[p.UserID]))
+		if followCount == p.FollowCount && fanCount == p.FanCount {
+			continue
+		}
+
+		// 用子查询更新，避免和并发的关注、取关互相覆盖
+		err := r.data.DB().WithContext(ctx).Model(&bizProfile.ProfileTB{}).
+			Where("user_id = ?", p.UserID).
+			Updates(map[string]interface{}{
+				"follow_count": gorm.Expr("(SELECT COUNT(*) FROM t_user_follow_relationships WHERE follower_id = ?)", p.UserID),
+				"fan_count":    gorm.Expr("(SELECT COUNT(*) FROM t_user_follow_relationships WHERE followee_id = ?)", p.UserID),
+			}).Error
+		if err != nil {
+			return nil, err
+		}
+		result.Drifts = append(result.Drifts, &bizProfile.FollowCountDrift{
+			UserID:         p.UserID,
+			OldFollowCount: p.FollowCount,
+			NewFollowCount: followCount,
+			OldFanCount:    p.FanCount,
+			NewFanCount:    fanCount,
+		})
+	}
+
+	err = r.data.Cache().Pipeline(ctx, func(pipe redis.Pipeliner) error {
+		for _, id := range ids {
+			keyFollowList := UserRedisKey(UserCachePrefix, "FollowList", id)
+			keyFanList := UserRedisKey(UserCachePrefix, "FanList", id)
+			// 有序集合和 profile 缓存直接删掉，下次读取时重新加载
+			pipe.Del(ctx,
+				keyFollowList,
+				keyFanList,
+				UserRedisKey(UserCachePrefix, followingQuery.subPrefix, id),
+				UserRedisKey(UserCachePrefix, followerQuery.subPrefix, id),
+			)
+			if members := following[id]; len(members) > 0 {
+				pipe.SAdd(ctx, keyFollowList, toInterfaces(members)...)
+				pipe.Expire(ctx, keyFollowList, UserCacheTTL)
+			}
+			if members := fans[id]; len(members) > 0 {
+				pipe.SAdd(ctx, keyFanList, toInterfaces(members)...)
+				pipe.Expire(ctx, keyFanList, UserCacheTTL)
+			}
+		}
+		for _, d := range result.Drifts {
+			pipe.Del(ctx, UserRedisKey(UserCachePrefix, "Profile", d.UserID))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func toInterfaces(values []string) []interface{} {
+	res := make([]interface{}, 0, len(values))
+	for _, v := range values {
+		res = append(res, v)
+	}
+	return res
+}
@@ -0,0 +1,230 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	bizMoment "kratos-realworld/internal/biz/moments"
+	"kratos-realworld/internal/model"
+	"strconv"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+// 广场动态池的 redis key
+// latest 按动态ID排序，hot 按热度排序，engagement 记录每条动态的互动数，SquareTag 是每个话题的动态，按动态ID排序
+// SquareUser 记录每个作者在池子里的动态，作者关闭公开时整体移出；SquareMeta 是缩略数据
+var (
+	keySquareLatest     = UserRedisKey(MomentCachePrefix, "Square", "latest")
+	keySquareHot        = UserRedisKey(MomentCachePrefix, "Square", "hot")
+	keySquareEngagement = UserRedisKey(MomentCachePrefix, "Square", "engagement")
+)
+
+type SquareRepo struct {
+	data *model.Data
+	log  *log.Helper
+}
+
+func NewSquareRepo(data *model.Data, logger log.Logger) bizMoment.SquareRepo {
+	return &SquareRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func squareMetaKey(momentID uint32) string {
+	return UserRedisKey(MomentCachePrefix, "SquareMeta", momentID)
+}
+
+func squareTagKey(tag string) string {
+	return UserRedisKey(MomentCachePrefix, "SquareTag", tag)
+}
+
+func squareUserKey(userID uint32) string {
+	return UserRedisKey(MomentCachePrefix, "SquareUser", userID)
+}
+
+func squareSeenKey(viewerID uint32, sessionID string) string {
+	return UserRedisKey(MomentCachePrefix, "SquareSeen", fmt.Sprintf("%d:%s", viewerID, sessionID))
+}
+
+// AddToSquare 写入池子，超过 poolSize 时淘汰最早的动态
+func (r *SquareRepo) AddToSquare(ctx context.Context, meta *bizMoment.MomentsMetaTB, hotScore float64, poolSize int) error {
+	body, err := json.Marshal(meta)
+	if err != nil {
+		return err
+	}
+	member := strconv.FormatUint(uint64(meta.MomentID), 10)
+	err = r.data.Cache().Pipeline(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, squareMetaKey(meta.MomentID), body, 0)
+		pipe.ZAdd(ctx, keySquareLatest, redis.Z{Score: float64(meta.MomentID), Member: member})
+		pipe.ZAdd(ctx, keySquareHot, redis.Z{Score: hotScore, Member: member})
+		for _, tag := range meta.Tags {
+			pipe.ZAdd(ctx, squareTagKey(tag), redis.Z{Score: float64(meta.MomentID), Member: member})
+		}
+		pipe.SAdd(ctx, squareUserKey(meta.UserID), member)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	total, err := r.data.Cache().ZCard(ctx, keySquareLatest)
+	if err != nil || total <= int64(poolSize) {
+		return err
+	}
+	evicted, err := r.data.Cache().ZRange(ctx, keySquareLatest, 0, total-int64(poolSize)-1)
+	if err != nil {
+		return err
+	}
+	return r.RemoveFromSquare(ctx, parseIDs(evicted)...)
+}
+
+// RemoveFromSquare 根据缩略数据里的作者和话题清理所有索引，缩略数据已经丢失的只清理全局索引
+func (r *SquareRepo) RemoveFromSquare(ctx context.Context, momentIDs ...uint32) error {
+	if len(momentIDs) == 0 {
+		return nil
+	}
+	metas, err := r.GetSquareMetas(ctx, momentIDs)
+	if err != nil {
+		return err
+	}
+	return r.data.Cache().Pipeline(ctx, func(pipe redis.Pipeliner) error {
+		for _, id := range momentIDs {
+			member := strconv.FormatUint(uint64(id), 10)
+			pipe.ZRem(ctx, keySquareLatest, member)
+			pipe.ZRem(ctx, keySquareHot, member)
+			pipe.HDel(ctx, keySquareEngagement, member)
+			pipe.Del(ctx, squareMetaKey(id))
+			if meta, ok := metas[id]; ok {
+				for _, tag := range meta.Tags {
+					pipe.ZRem(ctx, squareTagKey(tag), member)
+				}
+				pipe.SRem(ctx, squareUserKey(meta.UserID), member)
+			}
+		}
+		return nil
+	})
+}
+
+func (r *SquareRepo) RemoveUserFromSquare(ctx context.Context, userID uint32) error {
+	members, err := r.data.Cache().SMembers(ctx, squareUserKey(userID))
+	if err != nil {
+		return err
+	}
+	if err := r.RemoveFromSquare(ctx, parseIDs(members)...); err != nil {
+		return err
+	}
+	return r.data.Cache().Delete(ctx, squareUserKey(userID))
+}
+
+func (r *SquareRepo) IncrSquareEngagement(ctx context.Context, momentID uint32, delta int64) (int64, bool, error) {
+	member := strconv.FormatUint(uint64(momentID), 10)
+	_, err := r.data.Cache().ZScore(ctx, keySquareLatest, member)
+	if errors.Is(err, redis.Nil) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	engagement, err := r.data.Cache().HIncrBy(ctx, keySquareEngagement, member, delta)
+	if err != nil {
+		return 0, false, err
+	}
+	return engagement, true, nil
+}
+
+// SetSquareHotScore 只更新还在池子里的动态
+func (r *SquareRepo) SetSquareHotScore(ctx context.Context, momentID uint32, hotScore float64) error {
+	return r.data.Cache().Pipeline(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZAddXX(ctx, keySquareHot, redis.Z{Score: hotScore, Member: strconv.FormatUint(uint64(momentID), 10)})
+		return nil
+	})
+}
+
+func (r *SquareRepo) ListSquareHot(ctx context.Context, limit int) ([]uint32, error) {
+	members, err := r.data.Cache().ZRevRange(ctx, keySquareHot, 0, int64(limit)-1)
+	if err != nil {
+		return nil, err
+	}
+	return parseIDs(members), nil
+}
+
+func (r *SquareRepo) ListSquareLatest(ctx context.Context, tag string, cursor uint32, limit int) ([]uint32, error) {
+	key := keySquareLatest
+	if tag != "" {
+		key = squareTagKey(tag)
+	}
+	max := "+inf"
+	if cursor > 0 {
+		max = fmt.Sprintf("(%d", cursor)
+	}
+	var cmd *redis.StringSliceCmd
+	err := r.data.Cache().Pipeline(ctx, func(pipe redis.Pipeliner) error {
+		cmd = pipe.ZRevRangeByScore(ctx, key, &redis.ZRangeBy{Max: max, Min: "-inf", Count: int64(limit)})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return parseIDs(cmd.Val()), nil
+}
+
+// GetSquareMetas 缩略数据不存在的不返回
+func (r *SquareRepo) GetSquareMetas(ctx context.Context, momentIDs []uint32) (map[uint32]*bizMoment.MomentsMetaTB, error) {
+	metas := make(map[uint32]*bizMoment.MomentsMetaTB, len(momentIDs))
+	if len(momentIDs) == 0 {
+		return metas, nil
+	}
+	cmds := make(map[uint32]*redis.StringCmd, len(momentIDs))
+	err := r.data.Cache().Pipeline(ctx, func(pipe redis.Pipeliner) error {
+		for _, id := range momentIDs {
+			cmds[id] = pipe.Get(ctx, squareMetaKey(id))
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return nil, err
+	}
+	for id, cmd := range cmds {
+		body, err := cmd.Result()
+		if err != nil {
+			continue
+		}
+		meta := &bizMoment.MomentsMetaTB{}
+		if err := json.Unmarshal([]byte(body), meta); err != nil {
+			r.log.Warnf("invalid square meta of moment %d: %v", id, err)
+			continue
+		}
+		metas[id] = meta
+	}
+	return metas, nil
+}
+
+func (r *SquareRepo) GetSquareSeen(ctx context.Context, viewerID uint32, sessionID string) ([]uint32, error) {
+	members, err := r.data.Cache().SMembers(ctx, squareSeenKey(viewerID, sessionID))
+	if err != nil {
+		return nil, err
+	}
+	return parseIDs(members), nil
+}
+
+// MarkSquareSeen 记录本次会话已经返回过的动态，会话过期后重新开始
+func (r *SquareRepo) MarkSquareSeen(ctx context.Context, viewerID uint32, sessionID string, momentIDs []uint32, ttl time.Duration) error {
+	if len(momentIDs) == 0 {
+		return nil
+	}
+	key := squareSeenKey(viewerID, sessionID)
+	members := make([]interface{}, 0, len(momentIDs))
+	for _, id := range momentIDs {
+		members = append(members, strconv.FormatUint(uint64(id), 10))
+	}
+	return r.data.Cache().Pipeline(ctx, func(pipe redis.Pipeliner) error {
+		pipe.SAdd(ctx, key, members...)
+		pipe.Expire(ctx, key, ttl)
+		return nil
+	})
+}
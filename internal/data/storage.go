@@ -0,0 +1,65 @@
+package data
+
+import (
+	"context"
+	"io"
+	bizMoment "kratos-realworld/internal/biz/moments"
+	"kratos-realworld/internal/conf"
+	"os"
+	"path/filepath"
+)
+
+const defaultStaticDir = "./static/"
+
+// LocalMediaStorage 附件保存在本地静态目录，由静态文件服务对外提供
+type LocalMediaStorage struct {
+	dir string
+}
+
+func NewMediaStorage(dc *conf.Data) bizMoment.MediaStorage {
+	dir := defaultStaticDir
+	if dc != nil && dc.Storage != nil && dc.Storage.StaticDir != "" {
+		dir = dc.Storage.StaticDir
+	}
+	return &LocalMediaStorage{
+		dir: dir,
+	}
+}
+
+// path 只取文件名防止越界读写
+func (s *LocalMediaStorage) path(name string) string {
+	return filepath.Join(s.dir, filepath.Base(name))
+}
+
+// Save 先写到临时文件再改名，上传中断时静态目录里不会出现半个文件
+func (s *LocalMediaStorage) Save(ctx context.Context, name string, r io.Reader) (int64, error) {
+	if err := os.MkdirAll(s.dir, 0755); err != nil {
+		return 0, err
+	}
+	f, err := os.CreateTemp(s.dir, ".upload-*")
+	if err != nil {
+		return 0, err
+	}
+	n, err := io.Copy(f, r)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Chmod(f.Name(), 0644)
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), s.path(name))
+	}
+	if err != nil {
+		os.Remove(f.Name())
+		return 0, err
+	}
+	return n, nil
+}
+
+func (s *LocalMediaStorage) Remove(ctx context.Context, name string) error {
+	if err := os.Remove(s.path(name)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"kratos-realworld/internal/conf"
+)
+
+type failingReader struct{}
+
+func (failingReader) Read(p []byte) (int, error) {
+	return 0, errors.New("connection reset")
+}
+
+func TestLocalMediaStorage(t *testing.T) {
+	dir := t.TempDir()
+	st := NewMediaStorage(&conf.Data{Storage: &conf.Data_Storage{StaticDir: dir}})
+	ctx := context.Background()
+
+	n, err := st.Save(ctx, "a.mp4", strings.NewReader("video"))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), n)
+	data, err := os.ReadFile(filepath.Join(dir, "a.mp4"))
+	assert.NoError(t, err)
+	assert.Equal(t, "video", string(data))
+
+	// 上传中断时不留下文件，也不留下临时文件
+	_, err = st.Save(ctx, "b.mp4", failingReader{})
+	assert.Error(t, err)
+	entries, err := os.ReadDir(dir)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+
+	// 只取文件名，不会越界写到静态目录外面
+	_, err = st.Save(ctx, "../c.jpg", strings.NewReader("c"))
+	assert.NoError(t, err)
+	assert.FileExists(t, filepath.Join(dir, "c.jpg"))
+
+	assert.NoError(t, st.Remove(ctx, "a.mp4"))
+	assert.NoFileExists(t, filepath.Join(dir, "a.mp4"))
+	assert.NoError(t, st.Remove(ctx, "a.mp4"))
+}
+
+func TestOrphanUploadsSQL(t *testing.T) {
+	d, rec := newDryRunData(t)
+	repo := NewMomentRepo(d, testLogger)
+
+	before := time.Date(2026, 10, 18, 12, 0, 0, 0, time.Local)
+	_, err := repo.ListOrphanUploads(context.Background(), before, 100)
+	assert.NoError(t, err)
+	assert.Equal(t, "SELECT * FROM `t_media_uploads` WHERE used = false AND sys_created < '2026-10-18 12:00:00' ORDER BY id ASC LIMIT 100",
+		rec.find("FROM `t_media_uploads`"))
+
+	_, err = repo.DeleteOrphanUpload(context.Background(), 7)
+	assert.NoError(t, err)
+	assert.Equal(t, "DELETE FROM `t_media_uploads` WHERE id = 7 AND used = false",
+		rec.find("DELETE FROM `t_media_uploads`"))
+}
@@ -0,0 +1,204 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+
+	bizProfile "kratos-realworld/internal/biz/profile"
+)
+
+// ListActiveUserIDs 按 user_id 递增分批返回 since 之后活跃过的用户
+func (r *ProfileRepo) ListActiveUserIDs(ctx context.Context, since time.Time, afterUserID uint32, limit int) ([]uint32, error) {
+	var ids []uint32
+	err := r.data.DB().WithContext(ctx).Model(&bizProfile.ProfileTB{}).
+		Where("user_id > ? AND last_active >= ?", afterUserID, since).
+		Order("user_id ASC").
+		Limit(limit).
+		Pluck("user_id", &ids).Error
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+func (r *ProfileRepo) GetProfilesByUserIDs(ctx context.Context, userIDs []uint32) ([]*bizProfile.ProfileTB, error) {
+	var profiles []*bizProfile.ProfileTB
+	if len(userIDs) == 0 {
+		return profiles, nil
+	}
+	err := r.data.DB().WithContext(ctx).Where("user_id IN ?", userIDs).Find(&profiles).Error
+	if err != nil {
+		return nil, err
+	}
+	return profiles, nil
+}
+
+// GetFollowingIDs 以数据库为准查询关注列表，顺便把 redis 关注集合补齐
+func (r *ProfileRepo) GetFollowingIDs(ctx context.Context, userID uint32) ([]uint32, error) {
+	var ids []uint32
+	err := r.data.DB().WithContext(ctx).Model(&bizProfile.FollowFanTB{}).
+		Where("follower_id = ?", userID).
+		Pluck("followee_id", &ids).Error
+	if err != nil {
+		return nil, err
+	}
+
+	keyFollowList := UserRedisKey(UserCachePrefix, "FollowList", userID)
+	if err := r.fillIDSet(ctx, keyFollowList, ids); err != nil {
+		r.log.Warnf("failed to fill follow list cache %s: %v", keyFollowList, err)
+	}
+	return ids, nil
+}
+
+// GetFollowerIDs 以数据库为准查询粉丝列表，顺便把 redis 粉丝集合补齐
+func (r *ProfileRepo) GetFollowerIDs(ctx context.Context, userID uint32) ([]uint32, error) {
+	var ids []uint32
+	err := r.data.DB().WithContext(ctx).Model(&bizProfile.FollowFanTB{}).
+		Where("followee_id = ?", userID).
+		Pluck("follower_id", &ids).Error
+	if err != nil {
+		return nil, err
+	}
+
+	keyFanList := UserRedisKey(UserCachePrefix, "FanList", userID)
+	if err := r.fillIDSet(ctx, keyFanList, ids); err != nil {
+		r.log.Warnf("failed to fill fan list cache %s: %v", keyFanList, err)
+	}
+	return ids, nil
+}
+
+// UnionFollowing 多个用户关注列表的并集，即二度关系的候选人
+func (r *ProfileRepo) UnionFollowing(ctx context.Context, userIDs []uint32) ([]uint32, error) {
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+	keys := make([]string, 0, len(userIDs))
+	for _, id := range userIDs {
+		key, err := r.ensureFollowSet(ctx, "FollowList", "follower_id", "followee_id", id)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+
+	members, err := r.data.Cache().SUnion(ctx, keys...)
+	if err != nil {
+		return nil, err
+	}
+	return parseIDs(members), nil
+}
+
+// CountMutualFollows userID 关注的人里有多少也关注了 targetID
+func (r *ProfileRepo) CountMutualFollows(ctx context.Context, userID uint32, targetID uint32) (int, error) {
+	keyFollowList, err := r.ensureFollowSet(ctx, "FollowList", "follower_id", "followee_id", userID)
+	if err != nil {
+		return 0, err
+	}
+	keyFanList, err := r.ensureFollowSet(ctx, "FanList", "followee_id", "follower_id", targetID)
+	if err != nil {
+		return 0, err
+	}
+
+	members, err := r.data.Cache().SInter(ctx, keyFollowList, keyFanList)
+	if err != nil {
+		return 0, err
+	}
+	return len(members), nil
+}
+
+// ensureFollowSet 关注/粉丝集合不存在时从数据库加载，返回集合的 key
+func (r *ProfileRepo) ensureFollowSet(ctx context.Context, subPrefix, column, valueColumn string, userID uint32) (string, error) {
+	redisKey := UserRedisKey(UserCachePrefix, subPrefix, userID)
+	if r.data.Cache().Exists(ctx, redisKey) {
+		return redisKey, nil
+	}
+
+	var ids []uint32
+	err := r.data.DB().WithContext(ctx).Model(&bizProfile.FollowFanTB{}).
+		Where(column+" = ?", userID).
+		Pluck(valueColumn, &ids).Error
+	if err != nil {
+		return "", err
+	}
+	if err := r.fillIDSet(ctx, redisKey, ids); err != nil {
+		return "", err
+	}
+	return redisKey, nil
+}
+
+func (r *ProfileRepo) fillIDSet(ctx context.Context, redisKey string, ids []uint32) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	members := make([]string, 0, len(ids))
+	for _, id := range ids {
+		members = append(members, fmt.Sprintf("%d", id))
+	}
+	if _, err := r.data.Cache().SAdd(ctx, redisKey, members...); err != nil {
+		return err
+	}
+	r.data.Cache().Expire(ctx, redisKey, UserCacheTTL)
+	return nil
+}
+
+func parseIDs(members []string) []uint32 {
+	ids := make([]uint32, 0, len(members))
+	for _, m := range members {
+		id, err := strconv.ParseUint(m, 10, 32)
+		if err != nil {
+			continue
+		}
+		ids = append(ids, uint32(id))
+	}
+	return ids
+}
+
+func (r *ProfileRepo) AddSuggestionDismiss(ctx context.Context, userID uint32, targetID uint32) error {
+	dismiss := &bizProfile.SuggestionDismissTB{UserID: userID, TargetID: targetID}
+	err := r.data.DB().WithContext(ctx).Create(dismiss).Error
+	if err != nil && !errors.Is(err, gorm.ErrDuplicatedKey) {
+		return err
+	}
+	return nil
+}
+
+func (r *ProfileRepo) ListDismissedIDs(ctx context.Context, userID uint32) ([]uint32, error) {
+	var ids []uint32
+	err := r.data.DB().WithContext(ctx).Model(&bizProfile.SuggestionDismissTB{}).
+		Where("user_id = ?", userID).
+		Pluck("target_id", &ids).Error
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+func (r *ProfileRepo) SaveSuggestions(ctx context.Context, userID uint32, items []*bizProfile.SuggestionItem, ttl time.Duration) error {
+	body, err := json.Marshal(items)
+	if err != nil {
+		return err
+	}
+	redisKey := UserRedisKey(UserCachePrefix, "Suggestion", userID)
+	return r.data.Cache().Set(ctx, redisKey, string(body), ttl)
+}
+
+// GetSuggestions 读取预计算的推荐结果，还没有计算过时返回 nil
+func (r *ProfileRepo) GetSuggestions(ctx context.Context, userID uint32) ([]*bizProfile.SuggestionItem, error) {
+	redisKey := UserRedisKey(UserCachePrefix, "Suggestion", userID)
+	body, ok, err := r.data.Cache().Get(ctx, redisKey)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	var items []*bizProfile.SuggestionItem
+	if err := json.Unmarshal([]byte(body), &items); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,17 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// 重复忽略同一个推荐不报错
+func TestAddSuggestionDismissDuplicate(t *testing.T) {
+	repo := NewProfileRepo(newTestData(t, newDupConn()), testLogger)
+	ctx := context.Background()
+
+	assert.NoError(t, repo.AddSuggestionDismiss(ctx, 1, 2))
+	assert.NoError(t, repo.AddSuggestionDismiss(ctx, 1, 2))
+}
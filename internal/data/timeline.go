@@ -0,0 +1,94 @@
+package data
+
+import (
+	"context"
+	bizMoment "kratos-realworld/internal/biz/moments"
+	"strconv"
+
+	"gorm.io/gorm/clause"
+)
+
+// PushToReceiveBoxes 写入粉丝的接收盒，重复投递时已经存在的跳过
+func (r *MomentRepo) PushToReceiveBoxes(ctx context.Context, momentID uint32, authorID uint32, userIDs []uint32, batchSize int) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	boxes := make([]*bizMoment.MomentsBoxTB, 0, len(userIDs))
+	for _, id := range userIDs {
+		boxes = append(boxes, &bizMoment.MomentsBoxTB{
+			UserID:   id,
+			Box:      bizMoment.BoxReceive,
+			MomentID: momentID,
+			AuthorID: authorID,
+		})
+	}
+	return r.data.DB().WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		CreateInBatches(boxes, batchSize).Error
+}
+
+// RetractMoment 动态删除后从所有信箱撤回，包括作者的发送盒
+func (r *MomentRepo) RetractMoment(ctx context.Context, momentID uint32) error {
+	return r.data.DB().WithContext(ctx).
+		Where("moment_id = ?", momentID).
+		Delete(&bizMoment.MomentsBoxTB{}).Error
+}
+
+func (r *MomentRepo) RetractFromReceiveBoxes(ctx context.Context, momentID uint32, userIDs []uint32) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	return r.data.DB().WithContext(ctx).
+		Where("moment_id = ? AND box = ? AND user_id IN ?", momentID, bizMoment.BoxReceive, userIDs).
+		Delete(&bizMoment.MomentsBoxTB{}).Error
+}
+
+// ListBoxMoments userID 发送盒和接收盒里的动态，按动态ID倒序，cursor 为 0 表示第一页
+func (r *MomentRepo) ListBoxMoments(ctx context.Context, userID uint32, cursor uint32, limit int) ([]*bizMoment.MomentsMetaTB, error) {
+	var metas []*bizMoment.MomentsMetaTB
+	db := r.data.DB().WithContext(ctx).
+		Table("t_moments_meta AS meta").
+		Select("meta.*").
+		Joins("JOIN t_moments_box AS b ON b.moment_id = meta.moment_id").
+		Where("b.user_id = ? AND meta.deleted_at IS NULL", userID)
+	if cursor > 0 {
+		db = db.Where("b.moment_id < ?", cursor)
+	}
+	err := db.Order("b.moment_id DESC").Limit(limit).Find(&metas).Error
+	if err != nil {
+		return nil, err
+	}
+	return metas, nil
+}
+
+// ListPullMoments 粉丝过多、没有写接收盒的作者的动态，读时拉取；viewer 在动态黑名单里、不在可见范围内的不返回
+// authorIDs 是 viewer 关注的人，followers 可见范围不用再判断
+func (r *MomentRepo) ListPullMoments(ctx context.Context, viewerID uint32, authorIDs []uint32, cursor uint32, limit int) ([]*bizMoment.MomentsMetaTB, error) {
+	var metas []*bizMoment.MomentsMetaTB
+	if len(authorIDs) == 0 {
+		return metas, nil
+	}
+	viewer := strconv.FormatUint(uint64(viewerID), 10)
+	db := r.data.DB().WithContext(ctx).
+		Table("t_moments_meta AS meta").
+		Select("meta.*").
+		Joins("JOIN t_moments AS m ON m.id = meta.moment_id").
+		Where("m.user_id IN ? AND m.fanout_pull = ? AND m.deleted_at IS NULL", authorIDs, true).
+		Where("(m.black_list_ids IS NULL OR NOT JSON_CONTAINS(m.black_list_ids, ?))", viewer).
+		Where("(m.visibility IN ? "+
+			"OR (m.visibility = ? AND EXISTS (SELECT 1 FROM t_user_friend f WHERE f.user_id = m.user_id AND f.friend_id = ?)) "+
+			"OR (m.visibility = ? AND JSON_CONTAINS(m.scope_ids, ?)) "+
+			"OR (m.visibility = ? AND (m.scope_ids IS NULL OR NOT JSON_CONTAINS(m.scope_ids, ?))))",
+			[]string{bizMoment.VisibilityPublic, bizMoment.VisibilityFollowers},
+			bizMoment.VisibilityFriends, viewerID,
+			bizMoment.VisibilityAllow, viewer,
+			bizMoment.VisibilityHide, viewer)
+	if cursor > 0 {
+		db = db.Where("m.id < ?", cursor)
+	}
+	err := db.Order("m.id DESC").Limit(limit).Find(&metas).Error
+	if err != nil {
+		return nil, err
+	}
+	return metas, nil
+}
@@ -0,0 +1,33 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// 读时拉取要和 filterMomentScope 一致：黑名单、好友、allow/hide 都在 sql 里过滤
+func TestListPullMomentsFiltersScope(t *testing.T) {
+	d, rec := newDryRunData(t)
+	repo := NewMomentRepo(d, testLogger)
+
+	_, err := repo.ListPullMoments(context.Background(), 9, []uint32{1, 2}, 50, 11)
+	assert.NoError(t, err)
+	assert.Equal(t, "SELECT meta.* FROM t_moments_meta AS meta JOIN t_moments AS m ON m.id = meta.moment_id "+
+		"WHERE (m.user_id IN (1,2) AND m.fanout_pull = true AND m.deleted_at IS NULL) "+
+		"AND ((m.black_list_ids IS NULL OR NOT JSON_CONTAINS(m.black_list_ids, '9'))) "+
+		"AND ((m.visibility IN ('public','followers') "+
+		"OR (m.visibility = 'friends' AND EXISTS (SELECT 1 FROM t_user_friend f WHERE f.user_id = m.user_id AND f.friend_id = 9)) "+
+		"OR (m.visibility = 'allow' AND JSON_CONTAINS(m.scope_ids, '9')) "+
+		"OR (m.visibility = 'hide' AND (m.scope_ids IS NULL OR NOT JSON_CONTAINS(m.scope_ids, '9'))))) "+
+		"AND m.id < 50 ORDER BY m.id DESC LIMIT 11",
+		rec.find("FROM t_moments_meta"))
+
+	// 没有关注任何人时不查询
+	rec.sqls = nil
+	metas, err := repo.ListPullMoments(context.Background(), 9, nil, 0, 11)
+	assert.NoError(t, err)
+	assert.Empty(t, metas)
+	assert.Empty(t, rec.sqls)
+}
@@ -23,6 +23,13 @@ func NewUserRepo(data *model.Data, logger log.Logger) bizUser.UserRepo {
 	}
 }
 
+func (r *UserRepo) getDB(ctx context.Context) *gorm.DB {
+	if tx, ok := ctx.Value(model.TxKey).(*gorm.DB); ok {
+		return tx
+	}
+	return r.data.DB()
+}
+
 func (r *UserRepo) CreateUser(ctx context.Context, userRegister *bizUser.UserTB) error {
 	rv := r.data.DB().Create(userRegister)
 	if rv.Error != nil {
@@ -67,6 +74,22 @@ func (r *UserRepo) GetUserByUserID(ctx context.Context, userID uint32) (*bizUser
 	return user, nil
 }
 
+// GetUsersByIDs 批量查询用户基本信息，用于列表展示，不走缓存
+func (r *UserRepo) GetUsersByIDs(ctx context.Context, userIDs []uint32) ([]*bizUser.UserTB, error) {
+	var users []*bizUser.UserTB
+	if len(userIDs) == 0 {
+		return users, nil
+	}
+	err := r.data.DB().WithContext(ctx).
+		Select("id", "UserName", "Handle", "Image", "Bio").
+		Where("id IN ?", userIDs).
+		Find(&users).Error
+	if err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
 func (r *UserRepo) GetUserByPhone(ctx context.Context, phone string) (*bizUser.UserTB, error) {
 	user := &bizUser.UserTB{}
 	redisKey := UserRedisKey(UserCachePrefix, "Phone", phone)
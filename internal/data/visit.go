@@ -0,0 +1,175 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+
+	bizProfile "kratos-realworld/internal/biz/profile"
+)
+
+const (
+	// 还没刷回 mysql 的浏览次数，field 是被浏览的用户ID
+	profileViewPendingKey = UserCachePrefix + ":ProfileViewPending"
+	// 按天去重的集合跨过零点后还要保留一段时间，避免时区边界重复计数
+	profileViewDedupTTL = 48 * time.Hour
+	// 每个用户最多保留的最近访客数和保留时长
+	maxProfileVisitors = 200
+	profileVisitorTTL  = 30 * 24 * time.Hour
+)
+
+// RecordProfileView 同一个人一天内多次浏览只计一次，返回这次是否计数
+func (r *ProfileRepo) RecordProfileView(ctx context.Context, userID uint32, viewerID uint32, day string, recordVisitor bool) (bool, error) {
+	keyDedup := UserRedisKey(UserCachePrefix, "ProfileView", fmt.Sprintf("%d:%s", userID, day))
+	keyVisitor := UserRedisKey(UserCachePrefix, "Visitor", userID)
+	viewer := fmt.Sprintf("%d", viewerID)
+
+	var added *redis.IntCmd
+	err := r.data.Cache().Pipeline(ctx, func(pipe redis.Pipeliner) error {
+		added = pipe.SAdd(ctx, keyDedup, viewer)
+		pipe.Expire(ctx, keyDedup, profileViewDedupTTL)
+		if recordVisitor {
+			pipe.ZAdd(ctx, keyVisitor, redis.Z{Score: float64(time.Now().UnixMilli()), Member: viewer})
+			pipe.ZRemRangeByRank(ctx, keyVisitor, 0, -maxProfileVisitors-1)
+			pipe.Expire(ctx, keyVisitor, profileVisitorTTL)
+		}
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	if added.Val() == 0 {
+		return false, nil
+	}
+
+	if _, err := r.data.Cache().HIncrBy(ctx, profileViewPendingKey, fmt.Sprintf("%d", userID), 1); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *ProfileRepo) GetPendingViewCount(ctx context.Context, userID uint32) (uint32, error) {
+	val, err := r.data.Cache().HGet(ctx, profileViewPendingKey, fmt.Sprintf("%d", userID))
+	if errors.Is(err, redis.Nil) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	cnt, _ := strconv.ParseInt(val, 10, 64)
+	if cnt < 0 {
+		return 0, nil
+	}
+	return uint32(cnt), nil
+}
+
+func (r *ProfileRepo) ListPendingViewCounts(ctx context.Context) (map[uint32]int64, error) {
+	res, err := r.data.Cache().HGetAll(ctx, profileViewPendingKey)
+	if err != nil {
+		return nil, err
+	}
+	counts := make(map[uint32]int64, len(res))
+	for field, val := range res {
+		userID, err := strconv.ParseUint(field, 10, 32)
+		if err != nil {
+			continue
+		}
+		cnt, err := strconv.ParseInt(val, 10, 64)
+		if err != nil || cnt <= 0 {
+			continue
+		}
+		counts[uint32(userID)] = cnt
+	}
+	return counts, nil
+}
+
+// AckPendingViewCount 刷回 mysql 之后扣掉已经刷过的部分，刷的过程中新增的浏览会保留到下一轮
+func (r *ProfileRepo) AckPendingViewCount(ctx context.Context, userID uint32, flushed int64) error {
+	field := fmt.Sprintf("%d", userID)
+	left, err := r.data.Cache().HIncrBy(ctx, profileViewPendingKey, field, -flushed)
+	if err != nil {
+		return err
+	}
+	if left <= 0 {
+		_, err = r.data.Cache().HDel(ctx, profileViewPendingKey, field)
+	}
+	return err
+}
+
+func (r *ProfileRepo) IncrementViewCount(ctx context.Context, userID uint32, delta int) error {
+	err := r.getDB(ctx).Model(&bizProfile.ProfileTB{}).
+		Where("user_id = ?", userID).
+		UpdateColumn("view_count", gorm.Expr("view_count + ?", delta)).
+		Error
+	if err != nil {
+		return err
+	}
+
+	// profile 已经缓存的话直接累加，没缓存的下次读取时从 mysql 加载
+	redisKey := UserRedisKey(UserCachePrefix, "Profile", userID)
+	if r.data.Cache().Exists(ctx, redisKey) {
+		if _, err := r.data.Cache().HIncrBy(ctx, redisKey, "view_count", int64(delta)); err != nil {
+			r.log.Warnf("failed to update view_count to cache, %v", err)
+		}
+	}
+	return nil
+}
+
+// ListProfileVisitors 按访问时间倒序，cursor 为上一页最后一条的访问时间，0 表示第一页
+func (r *ProfileRepo) ListProfileVisitors(ctx context.Context, userID uint32, cursor int64, limit int) ([]*bizProfile.ProfileVisit, error) {
+	keyVisitor := UserRedisKey(UserCachePrefix, "Visitor", userID)
+	max := "+inf"
+	if cursor > 0 {
+		max = fmt.Sprintf("(%d", cursor)
+	}
+
+	var cmd *redis.ZSliceCmd
+	err := r.data.Cache().Pipeline(ctx, func(pipe redis.Pipeliner) error {
+		cmd = pipe.ZRevRangeByScoreWithScores(ctx, keyVisitor, &redis.ZRangeBy{
+			Max:   max,
+			Min:   "-inf",
+			Count: int64(limit),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	visits := make([]*bizProfile.ProfileVisit, 0, len(cmd.Val()))
+	for _, z := range cmd.Val() {
+		member, _ := z.Member.(string)
+		visitorID, err := strconv.ParseUint(member, 10, 32)
+		if err != nil {
+			continue
+		}
+		visits = append(visits, &bizProfile.ProfileVisit{
+			VisitorID: uint32(visitorID),
+			VisitedAt: int64(z.Score),
+		})
+	}
+	return visits, nil
+}
+
+func (r *ProfileRepo) UpdateHideVisits(ctx context.Context, userID uint32, hide bool) error {
+	err := r.data.DB().WithContext(ctx).Model(&bizProfile.ProfileTB{}).
+		Where("user_id = ?", userID).
+		UpdateColumn("hide_visits", hide).
+		Error
+	if err != nil {
+		return err
+	}
+
+	redisKey := UserRedisKey(UserCachePrefix, "Profile", userID)
+	if r.data.Cache().Exists(ctx, redisKey) {
+		if _, err := r.data.Cache().HSet(ctx, redisKey, "hide_visits", hide); err != nil {
+			r.log.Warnf("failed to update hide_visits to cache, %v", err)
+		}
+	}
+	return nil
+}
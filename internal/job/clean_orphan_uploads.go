@@ -0,0 +1,55 @@
+package job
+
+import (
+	"context"
+	"kratos-realworld/internal/biz"
+	"kratos-realworld/internal/conf"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	defaultOrphanUploadTTL     = 24 * time.Hour
+	defaultOrphanCleanInterval = time.Hour
+	orphanCleanBatchSize       = 100
+	orphanCleanMaxBatches      = 20
+)
+
+// StartCleanOrphanUploadsJob 定时删除上传后一直没有发布的动态附件
+// 删除带 used 条件，多个节点同时执行也不会删掉已经发布的附件，不需要加锁
+func StartCleanOrphanUploadsJob(moc *biz.MomentUsecase, c *conf.Data_Storage, logger log.Logger) {
+	helper := log.NewHelper(logger)
+
+	ttl := defaultOrphanUploadTTL
+	interval := defaultOrphanCleanInterval
+	if c != nil {
+		if c.OrphanUploadTtl != nil && c.OrphanUploadTtl.AsDuration() > 0 {
+			ttl = c.OrphanUploadTtl.AsDuration()
+		}
+		if c.OrphanCleanInterval != nil && c.OrphanCleanInterval.AsDuration() > 0 {
+			interval = c.OrphanCleanInterval.AsDuration()
+		}
+	}
+
+	ticker := time.NewTicker(interval)
+	go func() {
+		for range ticker.C {
+			total := 0
+			for i := 0; i < orphanCleanMaxBatches; i++ {
+				n, err := moc.CleanOrphanUploads(context.Background(), ttl, orphanCleanBatchSize)
+				if err != nil {
+					helper.Errorf("clean orphan uploads error: %v", err)
+					break
+				}
+				total += n
+				if n < orphanCleanBatchSize {
+					break
+				}
+			}
+			if total > 0 {
+				helper.Infof("cleaned %d orphan uploads", total)
+			}
+		}
+	}()
+}
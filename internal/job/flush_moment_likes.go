@@ -0,0 +1,49 @@
+package job
+
+import (
+	"context"
+	"kratos-realworld/internal/biz"
+	"kratos-realworld/internal/conf"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	defaultLikeFlushInterval  = time.Minute
+	defaultLikeFlushLockTTL   = 5 * time.Minute
+	defaultLikeFlushBatchSize = 500
+)
+
+// StartFlushMomentLikesJob 定时把 redis 里的动态点赞数刷回 mysql
+func StartFlushMomentLikesJob(moc *biz.MomentUsecase, c *conf.Data_LikeFlush, logger log.Logger) {
+	helper := log.NewHelper(logger)
+
+	interval := defaultLikeFlushInterval
+	lockTTL := defaultLikeFlushLockTTL
+	batchSize := defaultLikeFlushBatchSize
+	if c != nil {
+		if c.Interval != nil && c.Interval.AsDuration() > 0 {
+			interval = c.Interval.AsDuration()
+		}
+		if c.LockTtl != nil && c.LockTtl.AsDuration() > 0 {
+			lockTTL = c.LockTtl.AsDuration()
+		}
+		if c.BatchSize > 0 {
+			batchSize = int(c.BatchSize)
+		}
+	}
+
+	ticker := time.NewTicker(interval)
+	go func() {
+		for range ticker.C {
+			n, ok, err := moc.FlushMomentLikes(context.Background(), batchSize, lockTTL)
+			if err != nil {
+				helper.Errorf("flush moment likes error: %v", err)
+			}
+			if ok && n > 0 {
+				helper.Debugf("flushed like counts of %d moments", n)
+			}
+		}
+	}()
+}
@@ -0,0 +1,44 @@
+package job
+
+import (
+	"context"
+	"kratos-realworld/internal/biz"
+	"kratos-realworld/internal/conf"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	defaultProfileViewFlushInterval = time.Minute
+	defaultProfileViewLockTTL       = 5 * time.Minute
+)
+
+// StartFlushProfileViewsJob 定时把 redis 里累加的主页浏览次数刷回 mysql
+func StartFlushProfileViewsJob(pc *biz.ProfileUsecase, c *conf.Data_ProfileView, logger log.Logger) {
+	helper := log.NewHelper(logger)
+
+	interval := defaultProfileViewFlushInterval
+	lockTTL := defaultProfileViewLockTTL
+	if c != nil {
+		if c.FlushInterval != nil && c.FlushInterval.AsDuration() > 0 {
+			interval = c.FlushInterval.AsDuration()
+		}
+		if c.LockTtl != nil && c.LockTtl.AsDuration() > 0 {
+			lockTTL = c.LockTtl.AsDuration()
+		}
+	}
+
+	ticker := time.NewTicker(interval)
+	go func() {
+		for range ticker.C {
+			n, ok, err := pc.FlushProfileViews(context.Background(), lockTTL)
+			if err != nil {
+				helper.Errorf("flush profile views error: %v", err)
+			}
+			if ok && n > 0 {
+				helper.Debugf("flushed profile views of %d users", n)
+			}
+		}
+	}()
+}
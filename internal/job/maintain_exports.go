@@ -0,0 +1,45 @@
+package job
+
+import (
+	"context"
+	"kratos-realworld/internal/biz"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	exportMaintainInterval = time.Minute
+	exportStaleAfter       = 10 * time.Minute // 超过这么久没刷新的任务认为所在节点已经退出
+	exportMaintainBatch    = 100
+)
+
+// StartMaintainExportsJob 定时维护聊天记录导出任务
+// 刷新本节点的任务，重新执行其他节点丢下的任务，删除下载链接过期的文件
+func StartMaintainExportsJob(ec *biz.ExportUsecase, logger log.Logger) {
+	helper := log.NewHelper(logger)
+
+	ticker := time.NewTicker(exportMaintainInterval)
+	go func() {
+		for range ticker.C {
+			ctx := context.Background()
+			if err := ec.TouchActiveExports(ctx); err != nil {
+				helper.Errorf("touch active exports error: %v", err)
+			}
+			n, err := ec.ResumeStaleExports(ctx, exportStaleAfter, exportMaintainBatch)
+			if err != nil {
+				helper.Errorf("resume stale exports error: %v", err)
+			}
+			if n > 0 {
+				helper.Infof("resumed %d stale exports", n)
+			}
+			n, err = ec.PurgeExpiredExports(ctx, exportMaintainBatch)
+			if err != nil {
+				helper.Errorf("purge expired exports error: %v", err)
+			}
+			if n > 0 {
+				helper.Infof("purged %d expired exports", n)
+			}
+		}
+	}()
+}
@@ -0,0 +1,60 @@
+package job
+
+import (
+	"context"
+	"kratos-realworld/internal/biz"
+	"kratos-realworld/internal/conf"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	defaultPurgeInterval   = time.Minute
+	defaultPurgeBatchSize  = 500
+	defaultPurgeMaxBatches = 20
+	defaultPurgeBatchPause = 200 * time.Millisecond
+	defaultPurgeLockTTL    = 10 * time.Minute
+)
+
+// StartPurgeMessagesJob 定时硬删除过期消息和附件，多实例部署时只有抢到锁的实例执行
+// 每次最多删除 maxBatches 批，批之间停顿 batchPause，控制对数据库的压力
+func StartPurgeMessagesJob(mc *biz.MessageUseCase, c *conf.Data_Retention, logger log.Logger) {
+	helper := log.NewHelper(logger)
+
+	interval := defaultPurgeInterval
+	batchSize := defaultPurgeBatchSize
+	maxBatches := defaultPurgeMaxBatches
+	batchPause := defaultPurgeBatchPause
+	lockTTL := defaultPurgeLockTTL
+	if c != nil {
+		if c.PurgeInterval != nil && c.PurgeInterval.AsDuration() > 0 {
+			interval = c.PurgeInterval.AsDuration()
+		}
+		if c.PurgeBatchSize > 0 {
+			batchSize = int(c.PurgeBatchSize)
+		}
+		if c.PurgeMaxBatches > 0 {
+			maxBatches = int(c.PurgeMaxBatches)
+		}
+		if c.PurgeBatchPause != nil {
+			batchPause = c.PurgeBatchPause.AsDuration()
+		}
+		if c.PurgeLockTtl != nil && c.PurgeLockTtl.AsDuration() > 0 {
+			lockTTL = c.PurgeLockTtl.AsDuration()
+		}
+	}
+
+	ticker := time.NewTicker(interval)
+	go func() {
+		for range ticker.C {
+			n, ok, err := mc.PurgeExpiredMessages(context.Background(), batchSize, maxBatches, batchPause, lockTTL)
+			if err != nil {
+				helper.Errorf("purge expired messages error: %v", err)
+			}
+			if ok && n > 0 {
+				helper.Infof("purged %d expired messages", n)
+			}
+		}
+	}()
+}
@@ -0,0 +1,49 @@
+package job
+
+import (
+	"context"
+	"kratos-realworld/internal/biz"
+	"kratos-realworld/internal/conf"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	defaultCounterRepairInterval  = 24 * time.Hour
+	defaultCounterRepairLockTTL   = time.Hour
+	defaultCounterRepairBatchSize = 500
+)
+
+// StartRebuildProfileCountersJob 定时以动态、评论表为准重算主页统计字段，兜底消费端丢失或重复的计数事件
+func StartRebuildProfileCountersJob(pc *biz.ProfileUsecase, c *conf.Data_CounterRepair, logger log.Logger) {
+	helper := log.NewHelper(logger)
+
+	interval := defaultCounterRepairInterval
+	lockTTL := defaultCounterRepairLockTTL
+	batchSize := defaultCounterRepairBatchSize
+	if c != nil {
+		if c.Interval != nil && c.Interval.AsDuration() > 0 {
+			interval = c.Interval.AsDuration()
+		}
+		if c.LockTtl != nil && c.LockTtl.AsDuration() > 0 {
+			lockTTL = c.LockTtl.AsDuration()
+		}
+		if c.BatchSize > 0 {
+			batchSize = int(c.BatchSize)
+		}
+	}
+
+	ticker := time.NewTicker(interval)
+	go func() {
+		for range ticker.C {
+			n, ok, err := pc.RebuildProfileCounters(context.Background(), batchSize, lockTTL)
+			if err != nil {
+				helper.Errorf("rebuild profile counters error: %v", err)
+			}
+			if ok {
+				helper.Infof("rebuilt profile counters of %d users", n)
+			}
+		}
+	}()
+}
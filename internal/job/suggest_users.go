@@ -0,0 +1,70 @@
+package job
+
+import (
+	"context"
+	"kratos-realworld/internal/biz"
+	"kratos-realworld/internal/conf"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	defaultSuggestInterval     = time.Hour
+	defaultSuggestActiveWithin = 30 * 24 * time.Hour
+	defaultSuggestMaxResults   = 50
+	defaultSuggestBatchSize    = 100
+)
+
+// StartSuggestUsersJob 定时为活跃用户预计算关注推荐，SuggestUsers 接口直接读缓存
+func StartSuggestUsersJob(pc *biz.ProfileUsecase, c *conf.Data_Suggestion, logger log.Logger) {
+	helper := log.NewHelper(logger)
+
+	interval := defaultSuggestInterval
+	activeWithin := defaultSuggestActiveWithin
+	maxResults := defaultSuggestMaxResults
+	batchSize := defaultSuggestBatchSize
+	if c != nil {
+		if c.RefreshInterval != nil && c.RefreshInterval.AsDuration() > 0 {
+			interval = c.RefreshInterval.AsDuration()
+		}
+		if c.ActiveWithin != nil && c.ActiveWithin.AsDuration() > 0 {
+			activeWithin = c.ActiveWithin.AsDuration()
+		}
+		if c.MaxResults > 0 {
+			maxResults = int(c.MaxResults)
+		}
+		if c.BatchSize > 0 {
+			batchSize = int(c.BatchSize)
+		}
+	}
+
+	refresh := func() {
+		since := time.Now().Add(-activeWithin)
+		var lastID uint32
+		total := 0
+		for {
+			next, n, err := pc.RefreshActiveSuggestions(context.Background(), since, lastID, batchSize, maxResults)
+			if err != nil {
+				helper.Errorf("refresh suggestions error: %v", err)
+				break
+			}
+			total += n
+			if n < batchSize {
+				break
+			}
+			lastID = next
+		}
+		if total > 0 {
+			helper.Infof("refreshed follow suggestions for %d users", total)
+		}
+	}
+
+	ticker := time.NewTicker(interval)
+	go func() {
+		refresh()
+		for range ticker.C {
+			refresh()
+		}
+	}()
+}
@@ -2,15 +2,56 @@ package job
 
 import (
 	"context"
-	"kratos-realworld/internal/biz/profile"
+	"kratos-realworld/internal/biz"
+	"kratos-realworld/internal/conf"
 	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	defaultFollowRepairInterval  = 6 * time.Hour
+	defaultFollowRepairLockTTL   = 30 * time.Minute
+	defaultFollowRepairBatchSize = 500
 )
 
-func StartRepairJob(repo *profile.ProfileRepo) {
-	ticker := time.NewTicker(1 * time.Minute)
+// StartRepairFollowCacheJob 定时以关注关系表为准校正 follow_count、fan_count 并重建 redis 关注、粉丝集合
+func StartRepairFollowCacheJob(pc *biz.ProfileUsecase, c *conf.Data_FollowRepair, logger log.Logger) {
+	helper := log.NewHelper(logger)
+
+	interval := defaultFollowRepairInterval
+	lockTTL := defaultFollowRepairLockTTL
+	batchSize := defaultFollowRepairBatchSize
+	if c != nil {
+		if c.Interval != nil && c.Interval.AsDuration() > 0 {
+			interval = c.Interval.AsDuration()
+		}
+		if c.LockTtl != nil && c.LockTtl.AsDuration() > 0 {
+			lockTTL = c.LockTtl.AsDuration()
+		}
+		if c.BatchSize > 0 {
+			batchSize = int(c.BatchSize)
+		}
+	}
+
+	repair := func() {
+		report, ok, err := pc.RepairFollowCache(context.Background(), batchSize, lockTTL)
+		if err != nil {
+			helper.Errorf("repair follow cache error: %v", err)
+		}
+		if !ok {
+			return
+		}
+		if report != nil {
+			helper.Infof("repaired follow cache: scanned %d users, fixed %d drifted counts", report.Scanned, len(report.Drifts))
+		}
+	}
+
+	ticker := time.NewTicker(interval)
 	go func() {
+		repair()
 		for range ticker.C {
-			repo.RepairFollowCache(context.Background())
+			repair()
 		}
 	}()
 }
@@ -7,8 +7,26 @@ type MomentMessage struct {
 	PushIDs  []uint32 `json:"push_ids"`
 }
 
+// GroupDelivery 群消息落库后由发送者所在节点发出，每个节点投递给连在本节点上的成员
+type GroupDelivery struct {
+	Message      []byte   `json:"message"` // 发给成员的 proto 消息
+	ReceiverIDs  []uint32 `json:"receiver_ids"`
+	MutedIDs     []uint32 `json:"muted_ids"`
+	MentionedIDs []uint32 `json:"mentioned_ids"`
+}
+
 const (
+	// 群消息投递，客户端只能写聊天消息的 topic，这个 topic 里的消息都是校验并落库过的
+	GROUP_DELIVERY_TOPIC = "group_delivery_topic"
+
 	// 动态相关
 	MOMENT_TOPIC = "moment_topic"
+
+	MOMENT_ACTION_CREATE            = "create"
+	MOMENT_ACTION_DELETE            = "delete"
+	MOMENT_ACTION_BLACKLIST_ADDED   = "blacklist_update_added"
+	MOMENT_ACTION_BLACKLIST_REMOVED = "blacklist_update_removed"
+	MOMENT_ACTION_AUDIENCE_REMOVED  = "audience_update_removed"
+	MOMENT_ACTION_AUDIENCE_ADDED    = "audience_update_added"
 )
 
@@ -1,14 +1,22 @@
 package kafka
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"github.com/IBM/sarama"
 	"github.com/go-kratos/kratos/v2/log"
 	"strings"
+	"time"
 )
 
+const defaultConsumerGroup = "conduit"
+
 var consumer sarama.Consumer
 
+// consumerGroup 整个集群只需要处理一次的 topic 用消费组消费，分区分配给各个节点
+var consumerGroup sarama.ConsumerGroup
+
 type ConsumerCallback func(data []byte)
 
 // 初始化消费者
@@ -28,8 +36,30 @@ func InitConsumer(hosts string) error {
 	return nil
 }
 
+// InitConsumerGroup 初始化消费组，groupID 为空时使用默认的消费组
+func InitConsumerGroup(hosts string, groupID string) error {
+	if groupID == "" {
+		groupID = defaultConsumerGroup
+	}
+	config := sarama.NewConfig()
+	// 第一次启动还没有提交过位移时从最新的消息开始，和广播消费一致
+	config.Consumer.Offsets.Initial = sarama.OffsetNewest
+	group, err := sarama.NewConsumerGroup(strings.Split(hosts, ","), groupID, config)
+	if nil != err {
+		fmt.Println("init kafka consumer group error", err.Error())
+		return err
+	}
+	consumerGroup = group
+	return nil
+}
+
 // 消费消息，通过回调函数进行
 func ConsumerMsg(callBack ConsumerCallback) {
+	ConsumeTopic(topic, callBack)
+}
+
+// ConsumeTopic 消费指定 topic 的消息
+func ConsumeTopic(topic string, callBack ConsumerCallback) {
 	if consumer == nil {
 		log.Debug("Kafka consumer not initialized, skipping consumer")
 		return
@@ -78,8 +108,72 @@ func ConsumerMsg(callBack ConsumerCallback) {
 	}
 }
 
+// ConsumeRegistry 给每个注册了处理器的 topic 启动消费，消息交给 registry 分发
+func ConsumeRegistry(registry *NotifyHandlerRegistry) {
+	for _, t := range registry.Topics() {
+		topic := t
+		go ConsumeTopic(topic, func(data []byte) {
+			if err := registry.Push(topic, data); err != nil {
+				log.Errorf("handle kafka message of topic %s error: %v", topic, err)
+			}
+		})
+	}
+}
+
+// groupHandler 消费组拿到的消息按 topic 交给 registry，处理完再标记位移
+type groupHandler struct {
+	registry *NotifyHandlerRegistry
+}
+
+func (h *groupHandler) Setup(sarama.ConsumerGroupSession) error   { return nil }
+func (h *groupHandler) Cleanup(sarama.ConsumerGroupSession) error { return nil }
+
+func (h *groupHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	for {
+		select {
+		case msg, ok := <-claim.Messages():
+			if !ok {
+				return nil
+			}
+			if err := h.registry.Push(msg.Topic, msg.Value); err != nil {
+				log.Errorf("handle kafka message of topic %s error: %v", msg.Topic, err)
+			}
+			session.MarkMessage(msg, "")
+		case <-session.Context().Done():
+			return nil
+		}
+	}
+}
+
+// ConsumeGroupRegistry registry 里的 topic 在消费组里消费，每条消息只交给一个节点处理
+// 只推送给本节点在线用户的 topic 不能放在这里，要用 ConsumeRegistry 广播消费
+func ConsumeGroupRegistry(registry *NotifyHandlerRegistry) {
+	if consumerGroup == nil {
+		log.Debug("Kafka consumer group not initialized, skipping consumer")
+		return
+	}
+	topics := registry.Topics()
+	handler := &groupHandler{registry: registry}
+	go func() {
+		for {
+			// 分区重新分配时 Consume 会返回，需要循环调用重新加入
+			err := consumerGroup.Consume(context.Background(), topics, handler)
+			if errors.Is(err, sarama.ErrClosedConsumerGroup) {
+				return
+			}
+			if err != nil {
+				log.Errorf("consume group topics %v error: %v", topics, err)
+				time.Sleep(time.Second)
+			}
+		}
+	}()
+}
+
 func CloseConsumer() {
 	if consumer != nil {
 		consumer.Close()
 	}
+	if consumerGroup != nil {
+		consumerGroup.Close()
+	}
 }
@@ -0,0 +1,22 @@
+package kafka
+
+// CounterEvent 影响用户主页统计字段的领域事件
+type CounterEvent struct {
+	// 同一个事件重复投递时 EventID 相同，消费端按它去重
+	EventID  string `json:"event_id"`
+	Type     string `json:"type"`
+	UserID   uint32 `json:"user_id"`  // 计数归属的用户，比如被点赞动态的作者
+	ActorID  uint32 `json:"actor_id"` // 触发事件的用户
+	MomentID uint32 `json:"moment_id"`
+}
+
+const (
+	PROFILE_COUNTER_TOPIC = "profile_counter_topic"
+
+	EVENT_MOMENT_CREATED  = "moment_created"
+	EVENT_MOMENT_DELETED  = "moment_deleted"
+	EVENT_LIKE_ADDED      = "like_added"
+	EVENT_LIKE_REMOVED    = "like_removed"
+	EVENT_COMMENT_ADDED   = "comment_added"
+	EVENT_COMMENT_REMOVED = "comment_removed"
+)
@@ -1,20 +1,66 @@
 package kafka
 
-import "encoding/json"
+import (
+	"encoding/json"
+	v1 "kratos-realworld/api/conduit/v1"
+	"kratos-realworld/internal/common"
+	"strconv"
 
+	"google.golang.org/protobuf/proto"
+)
+
+// MomentNotifyHandler 动态 topic 的广播处理器，新发布的动态给连接在本节点上的粉丝推送通知，
+// 每个节点都会消费到同一条消息；维护接收盒只需要做一次，在消费组里由 ConsumeMomentMessage 处理
 type MomentNotifyHandler struct {
-	appPush string
+	online  func(names []string) []string // 过滤出连接在本节点上的用户
+	deliver ConsumerCallback              // 把系统通知交给本节点的 websocket 投递
+}
+
+func NewMomentNotifyHandler(online func(names []string) []string, deliver ConsumerCallback) *MomentNotifyHandler {
+	return &MomentNotifyHandler{
+		online:  online,
+		deliver: deliver,
+	}
 }
 
 func (h *MomentNotifyHandler) Topic() string {
 	return MOMENT_TOPIC
 }
 
+// Push 只通知 PushIDs 里的粉丝，读时拉取的大V动态没有 PushIDs，不推送通知
 func (h *MomentNotifyHandler) Push(msg []byte) error {
 	var moment MomentMessage
 	if err := json.Unmarshal(msg, &moment); err != nil {
 		return err
 	}
-	//todo
+	if moment.Action != MOMENT_ACTION_CREATE || len(moment.PushIDs) == 0 || h.online == nil || h.deliver == nil {
+		return nil
+	}
+
+	names := make([]string, 0, len(moment.PushIDs))
+	for _, id := range moment.PushIDs {
+		if id != moment.UserID {
+			names = append(names, strconv.FormatUint(uint64(id), 10))
+		}
+	}
+	content, err := json.Marshal(map[string]interface{}{
+		"momentId": moment.MomentID,
+		"userId":   moment.UserID,
+	})
+	if err != nil {
+		return err
+	}
+	for _, to := range h.online(names) {
+		body, err := proto.Marshal(&v1.Message{
+			From:    common.SYSTEM,
+			To:      to,
+			Type:    common.MOMENT_PUBLISHED,
+			Content: string(content),
+		})
+		if err != nil {
+			return err
+		}
+		h.deliver(body)
+	}
 	return nil
 }
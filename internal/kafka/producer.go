@@ -40,7 +40,18 @@ func InitProducer(topicInput, hosts string) error {
 	return nil
 }
 
+// Send 发送到聊天消息的 topic，只用于 websocket 消息和系统通知
 func Send(data []byte) {
+	SendTopic(topic, data)
+}
+
+// DefaultTopic 聊天消息的 topic
+func DefaultTopic() string {
+	return topic
+}
+
+// SendTopic 发送到指定 topic，不和聊天消息共用 topic 的业务事件使用
+func SendTopic(topic string, data []byte) {
 	if producer == nil {
 		fmt.Println("Kafka producer not initialized, skipping message")
 		return
@@ -42,10 +42,42 @@ func (r *NotifyHandlerRegistry) Push(topic string, msg []byte) error {
 	return handler.Push(msg)
 }
 
+// RegisterAll 由 Register 逐个加锁，这里不能再持有锁
 func (r *NotifyHandlerRegistry) RegisterAll(handlers []NotifyHandler) {
-	r.mu.Lock()
-	defer r.mu.Unlock()
 	for _, handler := range handlers {
 		r.Register(handler)
 	}
 }
+
+// Topics 已经注册了处理器的 topic
+func (r *NotifyHandlerRegistry) Topics() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	topics := make([]string, 0, len(r.handlers))
+	for topic := range r.handlers {
+		topics = append(topics, topic)
+	}
+	return topics
+}
+
+// CallbackHandler 把普通的消费回调包装成 NotifyHandler
+type CallbackHandler struct {
+	topic    string
+	callback ConsumerCallback
+}
+
+func NewCallbackHandler(topic string, callback ConsumerCallback) *CallbackHandler {
+	return &CallbackHandler{
+		topic:    topic,
+		callback: callback,
+	}
+}
+
+func (h *CallbackHandler) Topic() string {
+	return h.topic
+}
+
+func (h *CallbackHandler) Push(msg []byte) error {
+	h.callback(msg)
+	return nil
+}
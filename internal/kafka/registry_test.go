@@ -0,0 +1,87 @@
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	v1 "kratos-realworld/api/conduit/v1"
+	"kratos-realworld/internal/common"
+
+	"github.com/IBM/sarama"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestRegistryDispatchesMomentNotify(t *testing.T) {
+	var delivered []*v1.Message
+	handler := NewMomentNotifyHandler(
+		func(names []string) []string { return names[:1] },
+		func(data []byte) {
+			msg := &v1.Message{}
+			assert.NoError(t, proto.Unmarshal(data, msg))
+			delivered = append(delivered, msg)
+		},
+	)
+
+	registry := NewNotifyHandlerRegistry()
+	registry.RegisterAll([]NotifyHandler{handler, NewCallbackHandler("chat", func(data []byte) {})})
+	assert.ElementsMatch(t, []string{MOMENT_TOPIC, "chat"}, registry.Topics())
+
+	body, _ := json.Marshal(&MomentMessage{UserID: 1, MomentID: 9, Action: MOMENT_ACTION_CREATE, PushIDs: []uint32{1, 2, 3}})
+	assert.NoError(t, registry.Push(MOMENT_TOPIC, body))
+	if assert.Len(t, delivered, 1) {
+		assert.Equal(t, "2", delivered[0].To)
+		assert.Equal(t, common.MOMENT_PUBLISHED, delivered[0].Type)
+	}
+
+	body, _ = json.Marshal(&MomentMessage{MomentID: 9, Action: MOMENT_ACTION_DELETE})
+	assert.NoError(t, registry.Push(MOMENT_TOPIC, body))
+	assert.Len(t, delivered, 1)
+
+	assert.Error(t, registry.Push("unknown", body))
+}
+
+type fakeGroupSession struct {
+	sarama.ConsumerGroupSession
+	ctx    context.Context
+	marked []int64
+}
+
+func (s *fakeGroupSession) Context() context.Context { return s.ctx }
+
+func (s *fakeGroupSession) MarkMessage(msg *sarama.ConsumerMessage, metadata string) {
+	s.marked = append(s.marked, msg.Offset)
+}
+
+type fakeGroupClaim struct {
+	sarama.ConsumerGroupClaim
+	messages chan *sarama.ConsumerMessage
+}
+
+func (c *fakeGroupClaim) Messages() <-chan *sarama.ConsumerMessage { return c.messages }
+
+func TestGroupHandlerDispatchesAndMarks(t *testing.T) {
+	var consumed []string
+	registry := NewNotifyHandlerRegistry()
+	registry.Register(NewCallbackHandler(MOMENT_TOPIC, func(data []byte) { consumed = append(consumed, string(data)) }))
+
+	claim := &fakeGroupClaim{messages: make(chan *sarama.ConsumerMessage, 3)}
+	claim.messages <- &sarama.ConsumerMessage{Topic: MOMENT_TOPIC, Offset: 1, Value: []byte("a")}
+	// 没有处理器的消息也要标记，不能卡住分区
+	claim.messages <- &sarama.ConsumerMessage{Topic: "unknown", Offset: 2, Value: []byte("b")}
+	claim.messages <- &sarama.ConsumerMessage{Topic: MOMENT_TOPIC, Offset: 3, Value: []byte("c")}
+	close(claim.messages)
+
+	session := &fakeGroupSession{ctx: context.Background()}
+	h := &groupHandler{registry: registry}
+	assert.NoError(t, h.ConsumeClaim(session, claim))
+	assert.Equal(t, []string{"a", "c"}, consumed)
+	assert.Equal(t, []int64{1, 2, 3}, session.marked)
+
+	// 分区被收回时退出
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	claim = &fakeGroupClaim{messages: make(chan *sarama.ConsumerMessage)}
+	assert.NoError(t, h.ConsumeClaim(&fakeGroupSession{ctx: ctx}, claim))
+}
@@ -67,6 +67,11 @@ func Init(options ...Option) {
 	newRedisConn(newOptions(options...))
 }
 
+// UseConn 直接使用调用方创建好的连接，不做 ping，测试里用来接入自定义的 redis 客户端
+func UseConn(conn *redis.Client) {
+	redisConn = conn
+}
+
 func newRedisConn(options Options) {
 	redisConn = redis.NewClient(&redis.Options{
 		Addr:     options.addr,
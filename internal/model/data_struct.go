@@ -35,16 +35,36 @@ type contextTxKey struct{}
 
 var TxKey = contextTxKey{}
 
+type contextAfterCommitKey struct{}
+
 func (d *Data) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	var afterCommit []func()
 	//这个调用是为了把 ctx（上下文）注入到 GORM 的操作流程中
 	//.Transaction(func(tx *gorm.DB) error)，这个调用是 开启一个事务块，类似于：begin, commit
-	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+	err := d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		ctx = context.WithValue(ctx, contextTxKey{}, tx)
+		ctx = context.WithValue(ctx, contextAfterCommitKey{}, &afterCommit)
 		//将 GORM 的 tx 事务对象放入 context.Context 中；
 		//ontextTxKey{} 是上下文的 key（通常是一个私有结构体，避免 key 冲突）；
 		//这样下游调用（比如 repo.SaveUser(ctx, user)）就可以从 ctx 中取出 tx，然后用 tx 执行数据库操作
 		return fn(ctx) // 执行这个事务函数
 	})
+	if err != nil {
+		return err
+	}
+	for _, f := range afterCommit {
+		f()
+	}
+	return nil
+}
+
+// AfterCommit 在事务提交之后执行 fn，例如写缓存，事务回滚时不执行；不在事务中时直接执行
+func AfterCommit(ctx context.Context, fn func()) {
+	if hooks, ok := ctx.Value(contextAfterCommitKey{}).(*[]func()); ok {
+		*hooks = append(*hooks, fn)
+		return
+	}
+	fn()
 }
 
 // 也就是说，只要 *Data 实现了 InTx(ctx, fn) 方法，它就自动是一个 Transaction，返回的d本身
@@ -99,6 +99,13 @@ func newOptions(opts ...Option) Options {
 	return options
 }
 
+// NewConfig 打开错误翻译，唯一键冲突统一返回 gorm.ErrDuplicatedKey，调用方不用关心 mysql 的错误码
+func NewConfig() *gorm.Config {
+	return &gorm.Config{
+		TranslateError: true,
+	}
+}
+
 func Init(options ...Option) {
 	newDB(newOptions(options...))
 }
@@ -112,15 +119,13 @@ func newDB(options Options) {
 
 	if options.slowThresholdMillisecond != 0 {
 		//gormLogger := log.NewGormLogger(options.slowThresholdMillisecond)
-		db, err = gorm.Open(mysql.Open(connArgs), &gorm.Config{
-			//Logger: gormLogger,
-		})
+		//Logger: gormLogger,
+		db, err = gorm.Open(mysql.Open(connArgs), NewConfig())
 	} else {
 		// 没有配置慢查询阈值，直接不输出日志
 		// 不输出到终端
-		db, err = gorm.Open(mysql.Open(connArgs), &gorm.Config{
-			//Logger: logger.Default.LogMode(logger.Silent),
-		})
+		//Logger: logger.Default.LogMode(logger.Silent),
+		db, err = gorm.Open(mysql.Open(connArgs), NewConfig())
 	}
 
 	if err != nil {
@@ -134,9 +134,9 @@ func newRwDB(options RwOptions) *gorm.DB {
 			options.slavePassword[i], options.slaveAddr[i], options.slaveDataBase[i]))
 	}
 
-	db, err := gorm.Open(mysql.Open(masterDsn), &gorm.Config{
-		Logger: options.rwLogger,
-	})
+	config := NewConfig()
+	config.Logger = options.rwLogger
+	db, err := gorm.Open(mysql.Open(masterDsn), config)
 
 	if err != nil {
 		panic("failed to connect master database")
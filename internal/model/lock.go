@@ -0,0 +1,47 @@
+package model
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+)
+
+// Locker 基于 redis 的分布式锁，多实例部署时保证后台任务同一时间只有一个实例在执行
+type Locker interface {
+	// TryLock 加锁成功返回 token，解锁时需要带上，避免误删别的实例的锁
+	TryLock(ctx context.Context, key string, ttl time.Duration) (string, bool, error)
+	Unlock(ctx context.Context, key string, token string) error
+}
+
+// 只有 value 和自己的 token 一致才删除
+const unlockScript = `
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`
+
+func (d *Data) TryLock(ctx context.Context, key string, ttl time.Duration) (string, bool, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", false, err
+	}
+	token := hex.EncodeToString(buf)
+
+	ok, err := d.cache.SetNX(ctx, key, token, ttl)
+	if err != nil || !ok {
+		return "", false, err
+	}
+	return token, true, nil
+}
+
+func (d *Data) Unlock(ctx context.Context, key string, token string) error {
+	_, err := d.cache.EvalBool(ctx, unlockScript, []string{key}, token)
+	return err
+}
+
+// 和 Transaction 一样，*Data 本身就实现了 Locker
+func NewLocker(d *Data) Locker {
+	return d
+}
@@ -38,7 +38,10 @@ func GenerateToken(secret string, userid uint32, expire time.Duration) (string,
 	return tokenString, nil
 }
 
-func JWTAuth(secret string) middleware.Middleware {
+// UserChecker token 校验通过之后对用户做的额外检查，比如账号是否被封禁
+type UserChecker func(ctx context.Context, userID uint32) error
+
+func JWTAuth(secret string, checkers ...UserChecker) middleware.Middleware {
 	return func(handler middleware.Handler) middleware.Handler {
 		return func(ctx context.Context, req interface{}) (reply interface{}, err error) {
 			if tr, ok := transport.FromServerContext(ctx); ok {
@@ -64,6 +67,11 @@ func JWTAuth(secret string) middleware.Middleware {
 					// put CurrentUser into ctx
 					if u, ok := claims["userid"]; ok {
 						ctx = WithContext(ctx, &CurrentUser{UserID: uint(u.(float64))})
+						for _, check := range checkers {
+							if err := check(ctx, uint32(u.(float64))); err != nil {
+								return nil, err
+							}
+						}
 					}
 				} else {
 					return nil, fmt.Errorf("token invalid")
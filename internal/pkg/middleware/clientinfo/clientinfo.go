@@ -0,0 +1,174 @@
+package clientinfo
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	kratoshttp "github.com/go-kratos/kratos/v2/transport/http"
+)
+
+// 用单独的类型做 key，不能用 struct{} 类型的变量，否则会和 auth 里的 currentUserKey 相等
+type clientInfoKey struct{}
+
+// 客户端平台
+const (
+	PlatformIOS     = "ios"
+	PlatformAndroid = "android"
+	PlatformWindows = "windows"
+	PlatformMacOS   = "macos"
+	PlatformLinux   = "linux"
+	PlatformUnknown = "unknown"
+)
+
+// PlatformHeader 客户端可以主动上报平台，没有上报时从 User-Agent 推断
+const PlatformHeader = "X-Platform"
+
+const (
+	maxUserAgentLen = 255
+	maxPlatformLen  = 20
+)
+
+// ClientInfo 发起请求的客户端信息
+type ClientInfo struct {
+	IP        string
+	UserAgent string
+	Platform  string
+}
+
+// Resolver 只有直连的地址在受信任代理列表里时，才采信 X-Forwarded-For，避免客户端伪造IP
+type Resolver struct {
+	trusted []*net.IPNet
+}
+
+// NewResolver trusted 可以是单个IP或者CIDR，格式不对的忽略
+func NewResolver(trusted []string) *Resolver {
+	rs := &Resolver{}
+	for _, t := range trusted {
+		t = strings.TrimSpace(t)
+		if !strings.Contains(t, "/") {
+			if ip := net.ParseIP(t); ip != nil {
+				bits := 8 * net.IPv4len
+				if ip.To4() == nil {
+					bits = 8 * net.IPv6len
+				}
+				t = ip.String() + "/" + strconv.Itoa(bits)
+			}
+		}
+		_, ipNet, err := net.ParseCIDR(t)
+		if err != nil {
+			log.Warnf("ignore invalid trusted proxy %q: %v", t, err)
+			continue
+		}
+		rs.trusted = append(rs.trusted, ipNet)
+	}
+	return rs
+}
+
+func (rs *Resolver) FromRequest(r *http.Request) *ClientInfo {
+	ua := r.UserAgent()
+	if len(ua) > maxUserAgentLen {
+		ua = ua[:maxUserAgentLen]
+	}
+	return &ClientInfo{
+		IP:        rs.ClientIP(r),
+		UserAgent: ua,
+		Platform:  DetectPlatform(r.Header.Get(PlatformHeader), ua),
+	}
+}
+
+// ClientIP 从右往左跳过受信任的代理，第一个不受信任的地址就是客户端；全部受信任时取最左边的
+func (rs *Resolver) ClientIP(r *http.Request) string {
+	remote := r.RemoteAddr
+	if host, _, err := net.SplitHostPort(remote); err == nil {
+		remote = host
+	}
+	if !rs.isTrusted(remote) {
+		return remote
+	}
+
+	var hops []string
+	for _, h := range r.Header.Values("X-Forwarded-For") {
+		for _, ip := range strings.Split(h, ",") {
+			if ip = strings.TrimSpace(ip); ip != "" {
+				hops = append(hops, ip)
+			}
+		}
+	}
+	for i := len(hops) - 1; i >= 0; i-- {
+		if net.ParseIP(hops[i]) == nil {
+			// 格式不对说明这一跳之前的内容都不可信
+			return remote
+		}
+		if !rs.isTrusted(hops[i]) || i == 0 {
+			return hops[i]
+		}
+	}
+	return remote
+}
+
+func (rs *Resolver) isTrusted(addr string) bool {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+	for _, n := range rs.trusted {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// DetectPlatform 优先使用客户端上报的平台
+func DetectPlatform(declared string, ua string) string {
+	declared = strings.ToLower(strings.TrimSpace(declared))
+	if declared != "" {
+		if len(declared) > maxPlatformLen {
+			declared = declared[:maxPlatformLen]
+		}
+		return declared
+	}
+
+	switch {
+	case strings.Contains(ua, "iPhone"), strings.Contains(ua, "iPad"), strings.Contains(ua, "iOS"):
+		return PlatformIOS
+	case strings.Contains(ua, "Android"):
+		return PlatformAndroid
+	case strings.Contains(ua, "Windows"):
+		return PlatformWindows
+	case strings.Contains(ua, "Macintosh"), strings.Contains(ua, "Mac OS X"):
+		return PlatformMacOS
+	case strings.Contains(ua, "Linux"):
+		return PlatformLinux
+	}
+	return PlatformUnknown
+}
+
+// Server 把客户端信息放进 ctx，登录等不需要鉴权的接口也要经过
+func Server(rs *Resolver) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if r, ok := kratoshttp.RequestFromServerContext(ctx); ok {
+				ctx = NewContext(ctx, rs.FromRequest(r))
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+// FromContext ctx 里没有时返回空的 ClientInfo
+func FromContext(ctx context.Context) *ClientInfo {
+	if info, ok := ctx.Value(clientInfoKey{}).(*ClientInfo); ok {
+		return info
+	}
+	return &ClientInfo{}
+}
+
+func NewContext(ctx context.Context, info *ClientInfo) context.Context {
+	return context.WithValue(ctx, clientInfoKey{}, info)
+}
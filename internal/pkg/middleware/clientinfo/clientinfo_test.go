@@ -0,0 +1,69 @@
+package clientinfo
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"kratos-realworld/internal/pkg/middleware/auth"
+)
+
+func TestClientIP(t *testing.T) {
+	rs := NewResolver([]string{"10.0.0.0/8", "127.0.0.1"})
+
+	cases := []struct {
+		name   string
+		remote string
+		xff    string
+		want   string
+	}{
+		{"direct client ignores header", "1.2.3.4:5000", "8.8.8.8", "1.2.3.4"},
+		{"trusted proxy without header", "127.0.0.1:5000", "", "127.0.0.1"},
+		{"trusted proxy", "127.0.0.1:5000", "8.8.8.8", "8.8.8.8"},
+		{"skip trusted hops", "10.0.0.2:5000", "9.9.9.9, 8.8.8.8, 10.0.0.1", "8.8.8.8"},
+		{"all hops trusted", "10.0.0.2:5000", "10.0.0.3, 10.0.0.1", "10.0.0.3"},
+		{"malformed hop", "10.0.0.2:5000", "8.8.8.8, garbage", "10.0.0.2"},
+	}
+	for _, c := range cases {
+		r := httptest.NewRequest("GET", "/", nil)
+		r.RemoteAddr = c.remote
+		if c.xff != "" {
+			r.Header.Set("X-Forwarded-For", c.xff)
+		}
+		if got := rs.ClientIP(r); got != c.want {
+			t.Errorf("%s: got %s, want %s", c.name, got, c.want)
+		}
+	}
+}
+
+func TestDetectPlatform(t *testing.T) {
+	cases := []struct {
+		declared string
+		ua       string
+		want     string
+	}{
+		{"", "Mozilla/5.0 (iPhone; CPU iPhone OS 17_0 like Mac OS X)", PlatformIOS},
+		{"", "Mozilla/5.0 (Linux; Android 14; Pixel 8)", PlatformAndroid},
+		{"", "Mozilla/5.0 (Windows NT 10.0; Win64; x64)", PlatformWindows},
+		{"", "curl/8.0", PlatformUnknown},
+		{" Android ", "Mozilla/5.0 (Windows NT 10.0; Win64; x64)", PlatformAndroid},
+	}
+	for _, c := range cases {
+		if got := DetectPlatform(c.declared, c.ua); got != c.want {
+			t.Errorf("DetectPlatform(%q, %q) = %s, want %s", c.declared, c.ua, got, c.want)
+		}
+	}
+}
+
+// 和 auth 的用户信息放在同一个 ctx 里时互不覆盖
+func TestContextKeyDoesNotCollideWithAuth(t *testing.T) {
+	ctx := auth.WithContext(context.Background(), &auth.CurrentUser{UserID: 7})
+	ctx = NewContext(ctx, &ClientInfo{IP: "1.2.3.4"})
+
+	if got := auth.FromContext(ctx).UserID; got != 7 {
+		t.Fatalf("auth user = %d, want 7", got)
+	}
+	if got := FromContext(ctx).IP; got != "1.2.3.4" {
+		t.Fatalf("client ip = %q, want 1.2.3.4", got)
+	}
+}
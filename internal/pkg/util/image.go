@@ -0,0 +1,132 @@
+package util
+
+import (
+	"image"
+	"math"
+	"strings"
+)
+
+const base83Chars = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz#$%*+,-.:;=?@[]^_{|}~"
+
+// Thumbnail 按比例缩小到最长边不超过 maxEdge，比 maxEdge 小的原样返回
+// 最近邻采样，只用来生成列表里的小图
+func Thumbnail(img image.Image, maxEdge int) image.Image {
+	b := img.Bounds()
+	w, h := b.Dx(), b.Dy()
+	if w <= maxEdge && h <= maxEdge {
+		return img
+	}
+	tw, th := maxEdge, maxEdge
+	if w > h {
+		th = int(math.Max(1, math.Round(float64(h)*float64(maxEdge)/float64(w))))
+	} else {
+		tw = int(math.Max(1, math.Round(float64(w)*float64(maxEdge)/float64(h))))
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, tw, th))
+	for y := 0; y < th; y++ {
+		sy := b.Min.Y + y*h/th
+		for x := 0; x < tw; x++ {
+			dst.Set(x, y, img.At(b.Min.X+x*w/tw, sy))
+		}
+	}
+	return dst
+}
+
+// BlurHash 计算图片的 blurhash 占位符，xComponents/yComponents 取值 1-9
+// 算法见 https://github.com/woltapp/blurhash/blob/master/Algorithm.md
+func BlurHash(img image.Image, xComponents, yComponents int) string {
+	b := img.Bounds()
+	w, h := b.Dx(), b.Dy()
+	if w == 0 || h == 0 {
+		return ""
+	}
+
+	// 先把像素转成线性空间，避免每个分量重复计算
+	linear := make([][3]float64, w*h)
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			r, g, bl, _ := img.At(b.Min.X+x, b.Min.Y+y).RGBA()
+			linear[y*w+x] = [3]float64{srgbToLinear(r >> 8), srgbToLinear(g >> 8), srgbToLinear(bl >> 8)}
+		}
+	}
+
+	factors := make([][3]float64, 0, xComponents*yComponents)
+	for j := 0; j < yComponents; j++ {
+		for i := 0; i < xComponents; i++ {
+			var f [3]float64
+			for y := 0; y < h; y++ {
+				cy := math.Cos(math.Pi * float64(j) * float64(y) / float64(h))
+				for x := 0; x < w; x++ {
+					basis := math.Cos(math.Pi*float64(i)*float64(x)/float64(w)) * cy
+					p := linear[y*w+x]
+					f[0] += basis * p[0]
+					f[1] += basis * p[1]
+					f[2] += basis * p[2]
+				}
+			}
+			norm := 2.0
+			if i == 0 && j == 0 {
+				norm = 1
+			}
+			scale := norm / float64(w*h)
+			factors = append(factors, [3]float64{f[0] * scale, f[1] * scale, f[2] * scale})
+		}
+	}
+
+	var sb strings.Builder
+	sb.WriteString(encodeBase83((xComponents-1)+(yComponents-1)*9, 1))
+
+	maxValue := 1.0
+	if len(factors) > 1 {
+		actualMax := 0.0
+		for _, f := range factors[1:] {
+			for _, v := range f {
+				actualMax = math.Max(actualMax, math.Abs(v))
+			}
+		}
+		quantisedMax := int(math.Max(0, math.Min(82, math.Floor(actualMax*166-0.5))))
+		maxValue = float64(quantisedMax+1) / 166
+		sb.WriteString(encodeBase83(quantisedMax, 1))
+	} else {
+		sb.WriteString(encodeBase83(0, 1))
+	}
+
+	dc := factors[0]
+	sb.WriteString(encodeBase83(linearToSrgb(dc[0])<<16+linearToSrgb(dc[1])<<8+linearToSrgb(dc[2]), 4))
+	for _, f := range factors[1:] {
+		quant := func(v float64) int {
+			return int(math.Max(0, math.Min(18, math.Floor(signPow(v/maxValue, 0.5)*9+9.5))))
+		}
+		sb.WriteString(encodeBase83(quant(f[0])*19*19+quant(f[1])*19+quant(f[2]), 2))
+	}
+	return sb.String()
+}
+
+func encodeBase83(value, length int) string {
+	res := make([]byte, length)
+	for i := 1; i <= length; i++ {
+		digit := (value / int(math.Pow(83, float64(length-i)))) % 83
+		res[i-1] = base83Chars[digit]
+	}
+	return string(res)
+}
+
+func srgbToLinear(v uint32) float64 {
+	f := float64(v) / 255
+	if f <= 0.04045 {
+		return f / 12.92
+	}
+	return math.Pow((f+0.055)/1.055, 2.4)
+}
+
+func linearToSrgb(v float64) int {
+	v = math.Max(0, math.Min(1, v))
+	if v <= 0.0031308 {
+		return int(v*12.92*255 + 0.5)
+	}
+	return int((1.055*math.Pow(v, 1/2.4)-0.055)*255 + 0.5)
+}
+
+func signPow(v, exp float64) float64 {
+	return math.Copysign(math.Pow(math.Abs(v), exp), v)
+}
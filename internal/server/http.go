@@ -5,15 +5,20 @@ import (
 	"fmt"
 	"github.com/golang-jwt/jwt/v4"
 	v1 "kratos-realworld/api/conduit/v1"
+	"kratos-realworld/internal/biz"
 	"kratos-realworld/internal/conf"
 	"kratos-realworld/internal/pkg/middleware/auth"
+	"kratos-realworld/internal/pkg/middleware/clientinfo"
 	"kratos-realworld/internal/service"
 	swaggerui "kratos-realworld/internal/swagger-ui"
 	wsrv "kratos-realworld/internal/websocket"
+	"io"
+	"mime"
 	"net/http"
 	"strconv"
 	"strings"
 
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/logging"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
@@ -24,12 +29,18 @@ import (
 )
 
 type websocketHandler struct {
-	jwtc *conf.JWT
+	jwtc     *conf.JWT
+	check    auth.UserChecker
+	gt       *biz.GateWayUsecase
+	resolver *clientinfo.Resolver
 }
 
-func NewWebsocketHandler(jwtc *conf.JWT) *websocketHandler {
+func NewWebsocketHandler(jwtc *conf.JWT, check auth.UserChecker, gt *biz.GateWayUsecase, resolver *clientinfo.Resolver) *websocketHandler {
 	return &websocketHandler{
-		jwtc: jwtc,
+		jwtc:     jwtc,
+		check:    check,
+		gt:       gt,
+		resolver: resolver,
 	}
 }
 
@@ -71,6 +82,12 @@ func (h *websocketHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	userID := uint32(claims["userid"].(float64))
 	log.Debugf("[WS] 用户 %d 成功通过JWT鉴权 ", userID)
 
+	// 封禁中的账号不允许建立连接
+	if err := h.check(r.Context(), userID); err != nil {
+		http.Error(w, errors.FromError(err).Message, http.StatusForbidden)
+		return
+	}
+
 	// 允许跨域升级
 	var upGrader = websocket.Upgrader{
 		CheckOrigin: func(r *http.Request) bool { return true },
@@ -81,17 +98,98 @@ func (h *websocketHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// 每次建立连接都记录客户端IP、设备，方便用户发现异常登录
+	h.gt.RecordSession(clientinfo.NewContext(r.Context(), h.resolver.FromRequest(r)), userID)
+
 	// 这里的 Client、MyServer 来自 internal/websocket 包
-	c := &wsrv.Client{
-		Name: strconv.Itoa(int(userID)),
-		Conn: conn,
-		Send: make(chan []byte, 16),
-	}
+	c := wsrv.NewClient(strconv.Itoa(int(userID)), conn)
 	wsrv.MyServer.Register <- c
 	go c.Read()
 	go c.Write()
 }
 
+// exportDownloadHandler 聊天记录导出的下载，token 本身就是凭证，不走 JWT
+type exportDownloadHandler struct {
+	ec *biz.ExportUsecase
+}
+
+func NewExportDownloadHandler(ec *biz.ExportUsecase) *exportDownloadHandler {
+	return &exportDownloadHandler{
+		ec: ec,
+	}
+}
+
+func (h *exportDownloadHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	token := r.URL.Query().Get("token")
+	if token == "" {
+		http.Error(w, "missing token", http.StatusBadRequest)
+		return
+	}
+
+	path, fileName, err := h.ec.OpenExport(r.Context(), token)
+	if err != nil {
+		http.Error(w, "download link is invalid or has expired", http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", "attachment; filename=\""+fileName+"\"")
+	http.ServeFile(w, r, path)
+}
+
+// momentMediaUploadHandler 动态附件上传，视频最大 100MB，请求体边读边写进存储
+// 支持 multipart 的 file 字段，也可以直接把文件作为请求体
+type momentMediaUploadHandler struct {
+	s *service.ConduitService
+}
+
+func NewMomentMediaUploadHandler(s *service.ConduitService) *momentMediaUploadHandler {
+	return &momentMediaUploadHandler{
+		s: s,
+	}
+}
+
+func (h *momentMediaUploadHandler) Handle(ctx kratoshttp.Context) error {
+	file, err := uploadFile(ctx.Request())
+	if err != nil {
+		return biz.NewErr(biz.ErrCodeInvalidParams, biz.INVALID_PARAMS, err.Error())
+	}
+	// 走和 rpc 一样的中间件，JWT 鉴权、封禁检查、日志都在里面
+	next := ctx.Middleware(func(c context.Context, _ interface{}) (interface{}, error) {
+		return h.s.UploadMomentMedia(c, file)
+	})
+	// 大文件上传会超过 server 配置的超时，这里不继承请求的超时，由大小上限约束
+	out, err := next(context.WithoutCancel(ctx), nil)
+	if err != nil {
+		return err
+	}
+	return ctx.Result(http.StatusOK, out)
+}
+
+// uploadFile multipart 请求取第一个 file 字段，不解析整个表单，避免先落到临时文件
+func uploadFile(r *http.Request) (io.Reader, error) {
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if mediaType != "multipart/form-data" {
+		return r.Body, nil
+	}
+	mr, err := r.MultipartReader()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		part, err := mr.NextPart()
+		if err == io.EOF {
+			return nil, fmt.Errorf("missing file")
+		}
+		if err != nil {
+			return nil, err
+		}
+		if part.FormName() == "file" {
+			return part, nil
+		}
+	}
+}
+
 func NewSkipRoutersMatcher() selector.MatchFunc {
 
 	skipRouters := map[string]struct{}{
@@ -111,17 +209,19 @@ func NewSkipRoutersMatcher() selector.MatchFunc {
 
 // NewHTTPServer new a HTTP server.
 func NewHTTPServer(c *conf.Server, jwtc *conf.JWT, s *service.ConduitService, logger log.Logger) *kratoshttp.Server {
+	resolver := clientinfo.NewResolver(c.TrustedProxies)
 	var opts = []kratoshttp.ServerOption{
 		kratoshttp.ErrorEncoder(errorEncoder),
 
 		kratoshttp.Middleware(
 			recovery.Recovery(),
-			selector.Server(auth.JWTAuth(jwtc.Secret)).Match(NewSkipRoutersMatcher()).Build(),
+			clientinfo.Server(resolver),
+			selector.Server(auth.JWTAuth(jwtc.Secret, s.GetAdminUsecase().CheckBanned)).Match(NewSkipRoutersMatcher()).Build(),
 			logging.Server(logger),
 		),
 		kratoshttp.Filter(
 			handlers.CORS(
-				handlers.AllowedHeaders([]string{"X-Requested-With", "Content-Type", "Authorization"}),
+				handlers.AllowedHeaders([]string{"X-Requested-With", "Content-Type", "Authorization", clientinfo.PlatformHeader}),
 				handlers.AllowedMethods([]string{"GET", "POST", "PUT", "HEAD", "OPTIONS", "DELETE"}),
 				handlers.AllowedOrigins([]string{"*"}),
 			),
@@ -145,7 +245,10 @@ func NewHTTPServer(c *conf.Server, jwtc *conf.JWT, s *service.ConduitService, lo
 
 	// s.mc 是 ConduitService 里已经初始化的 MessageUseCase
 	wsrv.InitWebsocketServer(s.GetMessageUseCase())
-	srv.Handle("/ws", NewWebsocketHandler(jwtc))
+	srv.Handle("/ws", NewWebsocketHandler(jwtc, s.GetAdminUsecase().CheckBanned, s.GetGateWayUsecase(), resolver))
+
+	srv.Handle(biz.ExportDownloadPath, NewExportDownloadHandler(s.GetExportUsecase()))
+	srv.Route("/").POST(biz.MomentMediaUploadPath, NewMomentMediaUploadHandler(s).Handle)
 
 	return srv
 }
@@ -0,0 +1,47 @@
+package server
+
+import (
+	"bytes"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUploadFile(t *testing.T) {
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	assert.NoError(t, w.WriteField("name", "a.png"))
+	fw, err := w.CreateFormFile("file", "a.png")
+	assert.NoError(t, err)
+	_, _ = fw.Write([]byte("image"))
+	assert.NoError(t, w.Close())
+
+	r, _ := http.NewRequest(http.MethodPost, "/api/moments/media", &body)
+	r.Header.Set("Content-Type", w.FormDataContentType())
+	file, err := uploadFile(r)
+	assert.NoError(t, err)
+	data, _ := io.ReadAll(file)
+	assert.Equal(t, "image", string(data))
+
+	// 没有 file 字段
+	body.Reset()
+	w = multipart.NewWriter(&body)
+	assert.NoError(t, w.WriteField("name", "a.png"))
+	assert.NoError(t, w.Close())
+	r, _ = http.NewRequest(http.MethodPost, "/api/moments/media", &body)
+	r.Header.Set("Content-Type", w.FormDataContentType())
+	_, err = uploadFile(r)
+	assert.Error(t, err)
+
+	// 直接把文件作为请求体
+	r, _ = http.NewRequest(http.MethodPost, "/api/moments/media", strings.NewReader("video"))
+	r.Header.Set("Content-Type", "video/mp4")
+	file, err = uploadFile(r)
+	assert.NoError(t, err)
+	data, _ = io.ReadAll(file)
+	assert.Equal(t, "video", string(data))
+}
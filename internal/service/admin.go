@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	v1 "kratos-realworld/api/conduit/v1"
+	"log"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (cs *ConduitService) BanUser(ctx context.Context, req *v1.BanUserRequest) (*v1.BanUserReply, error) {
+	var until time.Time
+	if req.Until != nil {
+		until = req.Until.AsTime()
+	}
+	err := cs.ac.BanUser(ctx, req.UserId, until, req.Reason)
+	if err != nil {
+		log.Printf("BanUser err: %v\n", err)
+
+		return &v1.BanUserReply{
+			Code: 1,
+			Res:  ErrorToRes(err),
+		}, nil
+	}
+
+	return &v1.BanUserReply{
+		Code: 0,
+		Res:  ErrorToRes(err),
+	}, nil
+}
+
+func (cs *ConduitService) UnbanUser(ctx context.Context, req *v1.UnbanUserRequest) (*v1.BanUserReply, error) {
+	err := cs.ac.UnbanUser(ctx, req.UserId, req.Reason)
+	if err != nil {
+		log.Printf("UnbanUser err: %v\n", err)
+
+		return &v1.BanUserReply{
+			Code: 1,
+			Res:  ErrorToRes(err),
+		}, nil
+	}
+
+	return &v1.BanUserReply{
+		Code: 0,
+		Res:  ErrorToRes(err),
+	}, nil
+}
+
+func (cs *ConduitService) ListBanAudits(ctx context.Context, req *v1.ListBanAuditsRequest) (*v1.ListBanAuditsReply, error) {
+	res, err := cs.ac.ListBanAudits(ctx, req.UserId, int(req.Page), int(req.PageSize))
+	if err != nil {
+		log.Printf("ListBanAudits err: %v\n", err)
+
+		return &v1.ListBanAuditsReply{
+			Code: 1,
+			Res:  ErrorToRes(err),
+		}, nil
+	}
+
+	data := make([]*v1.BanAuditData, 0, len(res.Audits))
+	for _, a := range res.Audits {
+		audit := &v1.BanAuditData{
+			Id:         a.ID,
+			UserId:     a.UserID,
+			OperatorId: a.OperatorID,
+			Action:     a.Action,
+			Reason:     a.Reason,
+		}
+		if a.BannedUntil != nil {
+			audit.BannedUntil = timestamppb.New(*a.BannedUntil)
+		}
+		if a.SysCreated != nil {
+			audit.CreatedAt = timestamppb.New(*a.SysCreated)
+		}
+		data = append(data, audit)
+	}
+	return &v1.ListBanAuditsReply{
+		Code:     0,
+		Res:      ErrorToRes(err),
+		Data:     data,
+		Total:    int32(res.Total),
+		Page:     int32(res.Page),
+		PageSize: int32(res.PageSize),
+	}, nil
+}
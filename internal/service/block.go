@@ -0,0 +1,72 @@
+package service
+
+import (
+	"context"
+	v1 "kratos-realworld/api/conduit/v1"
+	"log"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (cs *ConduitService) BlockUser(ctx context.Context, req *v1.BlockUserReq) (*v1.BlockReply, error) {
+	err := cs.pc.BlockUser(ctx, req.TargetId)
+	if err != nil {
+		log.Printf("BlockUser err: %v\n", err)
+
+		return &v1.BlockReply{
+			Code: 1,
+			Res:  ErrorToRes(err),
+		}, nil
+	}
+
+	return &v1.BlockReply{
+		Code: 0,
+		Res:  ErrorToRes(err),
+	}, nil
+}
+
+func (cs *ConduitService) UnblockUser(ctx context.Context, req *v1.UnblockUserReq) (*v1.BlockReply, error) {
+	err := cs.pc.UnblockUser(ctx, req.TargetId)
+	if err != nil {
+		log.Printf("UnblockUser err: %v\n", err)
+
+		return &v1.BlockReply{
+			Code: 1,
+			Res:  ErrorToRes(err),
+		}, nil
+	}
+
+	return &v1.BlockReply{
+		Code: 0,
+		Res:  ErrorToRes(err),
+	}, nil
+}
+
+func (cs *ConduitService) ListBlocks(ctx context.Context, req *v1.ListBlocksReq) (*v1.ListBlocksReply, error) {
+	res, err := cs.pc.ListBlocks(ctx, int(req.Page), int(req.PageSize))
+	if err != nil {
+		log.Printf("ListBlocks err: %v\n", err)
+
+		return &v1.ListBlocksReply{
+			Code: 1,
+			Res:  ErrorToRes(err),
+		}, nil
+	}
+
+	data := make([]*v1.BlockData, 0, len(res.Blocks))
+	for _, b := range res.Blocks {
+		block := &v1.BlockData{UserId: b.BlockedID}
+		if b.SysCreated != nil {
+			block.BlockedAt = timestamppb.New(*b.SysCreated)
+		}
+		data = append(data, block)
+	}
+	return &v1.ListBlocksReply{
+		Code:     0,
+		Res:      ErrorToRes(err),
+		Data:     data,
+		Total:    int32(res.Total),
+		Page:     int32(res.Page),
+		PageSize: int32(res.PageSize),
+	}, nil
+}
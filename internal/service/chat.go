@@ -3,8 +3,12 @@ package service
 import (
 	"context"
 	v1 "kratos-realworld/api/conduit/v1"
+	"kratos-realworld/internal/biz"
 	"kratos-realworld/internal/common"
 	"log"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func ConvertToMessage(req *v1.GetMessagesRequest) *common.MessageRequest {
@@ -34,3 +38,137 @@ func (cs *ConduitService) GetMessages(ctx context.Context, req *v1.GetMessagesRe
 		Res:  ErrorToRes(err),
 	}, nil
 }
+
+func ConvertToConversationData(c *biz.ConversationReply) *v1.ConversationData {
+	var mutedUntil *timestamppb.Timestamp
+	if c.MutedUntil != nil {
+		mutedUntil = timestamppb.New(*c.MutedUntil)
+	}
+	return &v1.ConversationData{
+		TargetId:          c.TargetID,
+		ConversationType:  uint32(c.ConversationType),
+		LastMessageId:     c.LastMessageID,
+		LastReadMessageId: c.LastReadMessageID,
+		UnreadCount:       c.UnreadCount,
+		Mentioned:         c.Mentioned,
+		MentionMessageId:  c.MentionMessageID,
+		Muted:             c.Muted,
+		MutedUntil:        mutedUntil,
+		PinnedOrder:       c.PinnedOrder,
+		Archived:          c.Archived,
+	}
+}
+
+func (cs *ConduitService) ListConversations(ctx context.Context, req *v1.ListConversationsRequest) (*v1.ListConversationsReply, error) {
+	res, err := cs.mc.ListConversations(ctx, req.Archived)
+	if err != nil {
+		log.Printf("ListConversations err: %v\n", err)
+
+		return &v1.ListConversationsReply{
+			Code: 1,
+			Res:  ErrorToRes(err),
+		}, nil
+	}
+
+	data := make([]*v1.ConversationData, 0, len(res))
+	for _, c := range res {
+		data = append(data, ConvertToConversationData(c))
+	}
+
+	return &v1.ListConversationsReply{
+		Code: 0,
+		Res:  ErrorToRes(err),
+		Data: data,
+	}, nil
+}
+
+func (cs *ConduitService) ReadConversation(ctx context.Context, req *v1.ReadConversationRequest) (*v1.ReadConversationReply, error) {
+	res, err := cs.mc.ReadConversation(ctx, req.TargetId, req.MessageId)
+	if err != nil {
+		log.Printf("ReadConversation err: %v\n", err)
+
+		return &v1.ReadConversationReply{
+			Code: 1,
+			Res:  ErrorToRes(err),
+		}, nil
+	}
+
+	return &v1.ReadConversationReply{
+		Code: 0,
+		Res:  ErrorToRes(err),
+		Data: ConvertToConversationData(res),
+	}, nil
+}
+
+func (cs *ConduitService) UpdateConversationSettings(ctx context.Context, req *v1.UpdateConversationSettingsRequest) (*v1.UpdateConversationSettingsReply, error) {
+	var mutedUntil *time.Time
+	if req.MutedUntil != nil {
+		t := req.MutedUntil.AsTime()
+		mutedUntil = &t
+	}
+
+	res, err := cs.mc.UpdateConversationSettings(ctx, req.TargetId, uint16(req.ConversationType), &biz.ConversationSettingsPatch{
+		MutedUntil:  mutedUntil,
+		MuteForever: req.MuteForever,
+		Pinned:      req.Pinned,
+		Archived:    req.Archived,
+	})
+	if err != nil {
+		log.Printf("UpdateConversationSettings err: %v\n", err)
+
+		return &v1.UpdateConversationSettingsReply{
+			Code: 1,
+			Res:  ErrorToRes(err),
+		}, nil
+	}
+
+	return &v1.UpdateConversationSettingsReply{
+		Code: 0,
+		Res:  ErrorToRes(err),
+		Data: ConvertToConversationData(res),
+	}, nil
+}
+
+func ConvertToConversationTimerData(t *biz.ConversationTimerReply) *v1.ConversationTimerData {
+	return &v1.ConversationTimerData{
+		TargetId:         t.TargetID,
+		ConversationType: uint32(t.ConversationType),
+		TimerSeconds:     t.TimerSeconds,
+	}
+}
+
+func (cs *ConduitService) GetConversationTimer(ctx context.Context, req *v1.GetConversationTimerRequest) (*v1.ConversationTimerReply, error) {
+	res, err := cs.mc.GetConversationTimer(ctx, req.TargetId, uint16(req.ConversationType))
+	if err != nil {
+		log.Printf("GetConversationTimer err: %v\n", err)
+
+		return &v1.ConversationTimerReply{
+			Code: 1,
+			Res:  ErrorToRes(err),
+		}, nil
+	}
+
+	return &v1.ConversationTimerReply{
+		Code: 0,
+		Res:  ErrorToRes(err),
+		Data: ConvertToConversationTimerData(res),
+	}, nil
+}
+
+func (cs *ConduitService) SetConversationTimer(ctx context.Context, req *v1.SetConversationTimerRequest) (*v1.ConversationTimerReply, error) {
+	res, err := cs.mc.SetConversationTimer(ctx, req.TargetId, uint16(req.ConversationType), req.TimerSeconds)
+	if err != nil {
+		log.Printf("SetConversationTimer err: %v\n", err)
+
+		return &v1.ConversationTimerReply{
+			Code: 1,
+			Res:  ErrorToRes(err),
+		}, nil
+	}
+
+	return &v1.ConversationTimerReply{
+		Code: 0,
+		Res:  ErrorToRes(err),
+		Data: ConvertToConversationTimerData(res),
+	}, nil
+}
@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+	v1 "kratos-realworld/api/conduit/v1"
+	"kratos-realworld/internal/biz"
+	"log"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ConvertToChatExportData(res *biz.ExportReply) *v1.ChatExportData {
+	data := &v1.ChatExportData{
+		ExportId:    res.Job.ID,
+		Status:      uint32(res.Job.Status),
+		DownloadUrl: res.DownloadUrl,
+		ErrorMsg:    res.Job.ErrorMsg,
+	}
+	if res.Job.ExpireAt != nil {
+		data.ExpireAt = timestamppb.New(*res.Job.ExpireAt)
+	}
+	return data
+}
+
+func (cs *ConduitService) CreateChatExport(ctx context.Context, req *v1.CreateChatExportRequest) (*v1.ChatExportReply, error) {
+	if req.StartTime == nil || req.EndTime == nil {
+		err := biz.NewErr(biz.ErrCodeInvalidParams, biz.INVALID_PARAMS, "start_time and end_time are required")
+		return &v1.ChatExportReply{
+			Code: 1,
+			Res:  ErrorToRes(err),
+		}, nil
+	}
+
+	res, err := cs.ec.CreateExport(ctx, req.TargetId, uint16(req.ConversationType), req.StartTime.AsTime(), req.EndTime.AsTime())
+	if err != nil {
+		log.Printf("CreateChatExport err: %v\n", err)
+
+		return &v1.ChatExportReply{
+			Code: 1,
+			Res:  ErrorToRes(err),
+		}, nil
+	}
+
+	return &v1.ChatExportReply{
+		Code: 0,
+		Res:  ErrorToRes(err),
+		Data: ConvertToChatExportData(res),
+	}, nil
+}
+
+func (cs *ConduitService) GetChatExport(ctx context.Context, req *v1.GetChatExportRequest) (*v1.ChatExportReply, error) {
+	res, err := cs.ec.GetExport(ctx, req.ExportId)
+	if err != nil {
+		log.Printf("GetChatExport err: %v\n", err)
+
+		return &v1.ChatExportReply{
+			Code: 1,
+			Res:  ErrorToRes(err),
+		}, nil
+	}
+
+	return &v1.ChatExportReply{
+		Code: 0,
+		Res:  ErrorToRes(err),
+		Data: ConvertToChatExportData(res),
+	}, nil
+}
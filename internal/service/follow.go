@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	v1 "kratos-realworld/api/conduit/v1"
+	"kratos-realworld/internal/biz"
+	"log"
+)
+
+func ConvertToFollowUserData(users []*biz.FollowUserItem) []*v1.FollowUserData {
+	data := make([]*v1.FollowUserData, 0, len(users))
+	for _, u := range users {
+		data = append(data, &v1.FollowUserData{
+			UserId:       u.UserID,
+			Username:     u.UserName,
+			Image:        u.HeadImage,
+			Bio:          u.Bio,
+			IsFollowing:  u.IsFollowing,
+			IsFollowedBy: u.IsFollowedBy,
+			IsMutual:     u.IsMutual,
+			IsFriend:     u.IsFriend,
+			Handle:       u.Handle,
+		})
+	}
+	return data
+}
+
+func ConvertToListFollowReply(res *biz.FollowListReply) *v1.ListFollowReply {
+	data := ConvertToFollowUserData(res.Users)
+	return &v1.ListFollowReply{
+		Code:       0,
+		Data:       data,
+		NextCursor: res.NextCursor,
+		HasMore:    res.HasMore,
+	}
+}
+
+func (cs *ConduitService) ListFollowing(ctx context.Context, req *v1.ListFollowRequest) (*v1.ListFollowReply, error) {
+	res, err := cs.pc.ListFollowing(ctx, req.UserId, req.Cursor, int(req.Limit))
+	if err != nil {
+		log.Printf("ListFollowing err: %v\n", err)
+
+		return &v1.ListFollowReply{
+			Code: 1,
+			Res:  ErrorToRes(err),
+		}, nil
+	}
+
+	reply := ConvertToListFollowReply(res)
+	reply.Res = ErrorToRes(err)
+	return reply, nil
+}
+
+func (cs *ConduitService) ListFollowers(ctx context.Context, req *v1.ListFollowRequest) (*v1.ListFollowReply, error) {
+	res, err := cs.pc.ListFollowers(ctx, req.UserId, req.Cursor, int(req.Limit))
+	if err != nil {
+		log.Printf("ListFollowers err: %v\n", err)
+
+		return &v1.ListFollowReply{
+			Code: 1,
+			Res:  ErrorToRes(err),
+		}, nil
+	}
+
+	reply := ConvertToListFollowReply(res)
+	reply.Res = ErrorToRes(err)
+	return reply, nil
+}
@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	v1 "kratos-realworld/api/conduit/v1"
+	bizProfile "kratos-realworld/internal/biz/profile"
+	"log"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ConvertToFriendRequestData(r *bizProfile.FriendRequestTB) *v1.FriendRequestData {
+	data := &v1.FriendRequestData{
+		Id:       r.ID,
+		FromId:   r.FromID,
+		ToId:     r.ToID,
+		Greeting: r.Greeting,
+		Status:   uint32(r.Status),
+	}
+	if r.SysCreated != nil {
+		data.CreatedAt = timestamppb.New(*r.SysCreated)
+	}
+	if r.HandledAt != nil {
+		data.HandledAt = timestamppb.New(*r.HandledAt)
+	}
+	return data
+}
+
+func (cs *ConduitService) SendFriendRequest(ctx context.Context, req *v1.SendFriendRequestReq) (*v1.FriendRequestReply, error) {
+	res, err := cs.pc.SendFriendRequest(ctx, req.TargetId, req.Greeting)
+	if err != nil {
+		log.Printf("SendFriendRequest err: %v\n", err)
+
+		return &v1.FriendRequestReply{
+			Code: 1,
+			Res:  ErrorToRes(err),
+		}, nil
+	}
+
+	return &v1.FriendRequestReply{
+		Code: 0,
+		Res:  ErrorToRes(err),
+		Data: ConvertToFriendRequestData(res),
+	}, nil
+}
+
+func (cs *ConduitService) HandleFriendRequest(ctx context.Context, req *v1.HandleFriendRequestReq) (*v1.FriendRequestReply, error) {
+	res, err := cs.pc.HandleFriendRequest(ctx, req.RequestId, req.Action)
+	if err != nil {
+		log.Printf("HandleFriendRequest err: %v\n", err)
+
+		return &v1.FriendRequestReply{
+			Code: 1,
+			Res:  ErrorToRes(err),
+		}, nil
+	}
+
+	return &v1.FriendRequestReply{
+		Code: 0,
+		Res:  ErrorToRes(err),
+		Data: ConvertToFriendRequestData(res),
+	}, nil
+}
+
+func (cs *ConduitService) ListFriendRequests(ctx context.Context, req *v1.ListFriendRequestsReq) (*v1.ListFriendRequestsReply, error) {
+	res, err := cs.pc.ListFriendRequests(ctx, req.Direction != "outgoing")
+	if err != nil {
+		log.Printf("ListFriendRequests err: %v\n", err)
+
+		return &v1.ListFriendRequestsReply{
+			Code: 1,
+			Res:  ErrorToRes(err),
+		}, nil
+	}
+
+	data := make([]*v1.FriendRequestData, 0, len(res))
+	for _, r := range res {
+		data = append(data, ConvertToFriendRequestData(r))
+	}
+	return &v1.ListFriendRequestsReply{
+		Code: 0,
+		Res:  ErrorToRes(err),
+		Data: data,
+	}, nil
+}
+
+func (cs *ConduitService) ListFriends(ctx context.Context, req *v1.ListFriendsReq) (*v1.ListFriendsReply, error) {
+	res, err := cs.pc.ListFriends(ctx, int(req.Page), int(req.PageSize))
+	if err != nil {
+		log.Printf("ListFriends err: %v\n", err)
+
+		return &v1.ListFriendsReply{
+			Code: 1,
+			Res:  ErrorToRes(err),
+		}, nil
+	}
+
+	data := make([]*v1.FriendData, 0, len(res.Friends))
+	for _, f := range res.Friends {
+		friend := &v1.FriendData{UserId: f.FriendID}
+		if f.SysCreated != nil {
+			friend.Since = timestamppb.New(*f.SysCreated)
+		}
+		data = append(data, friend)
+	}
+	return &v1.ListFriendsReply{
+		Code:     0,
+		Res:      ErrorToRes(err),
+		Data:     data,
+		Total:    int32(res.Total),
+		Page:     int32(res.Page),
+		PageSize: int32(res.PageSize),
+	}, nil
+}
+
+func (cs *ConduitService) RemoveFriend(ctx context.Context, req *v1.RemoveFriendReq) (*v1.RemoveFriendReply, error) {
+	err := cs.pc.RemoveFriend(ctx, req.TargetId)
+	if err != nil {
+		log.Printf("RemoveFriend err: %v\n", err)
+
+		return &v1.RemoveFriendReply{
+			Code: 1,
+			Res:  ErrorToRes(err),
+		}, nil
+	}
+
+	return &v1.RemoveFriendReply{
+		Code: 0,
+		Res:  ErrorToRes(err),
+	}, nil
+}
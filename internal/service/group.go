@@ -0,0 +1,251 @@
+package service
+
+import (
+	"context"
+	v1 "kratos-realworld/api/conduit/v1"
+	"kratos-realworld/internal/biz"
+	bizChat "kratos-realworld/internal/biz/messageGroup"
+	"log"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ConvertToGroupData(group *bizChat.GroupTB) *v1.GroupData {
+	return &v1.GroupData{
+		GroupId:       group.Uuid,
+		OwnerId:       group.UserID,
+		Name:          group.Name,
+		Notice:        group.Notice,
+		MuteAll:       group.MuteAll != 0,
+		OnlyAdminEdit: group.OnlyAdminEdit != 0,
+		JoinApproval:  group.JoinApproval != 0,
+	}
+}
+
+func ConvertToGroupMemberData(group *bizChat.GroupTB, member *bizChat.GroupMemberTB) *v1.GroupMemberData {
+	var muteUntil *timestamppb.Timestamp
+	if member.MuteUntil != nil {
+		muteUntil = timestamppb.New(*member.MuteUntil)
+	}
+	return &v1.GroupMemberData{
+		GroupId:   group.Uuid,
+		UserId:    member.UserID,
+		Role:      uint32(member.Role),
+		Mute:      member.Mute != 0,
+		MuteUntil: muteUntil,
+	}
+}
+
+func ConvertToGroupJoinRequestData(group *bizChat.GroupTB, req *bizChat.GroupJoinRequestTB) *v1.GroupJoinRequestData {
+	var createdAt *timestamppb.Timestamp
+	if req.SysCreated != nil {
+		createdAt = timestamppb.New(*req.SysCreated)
+	}
+	return &v1.GroupJoinRequestData{
+		RequestId: req.ID,
+		GroupId:   group.Uuid,
+		UserId:    req.UserID,
+		Message:   req.Message,
+		Status:    uint32(req.Status),
+		HandlerId: req.HandlerID,
+		CreatedAt: createdAt,
+	}
+}
+
+func (cs *ConduitService) UpdateGroupInfo(ctx context.Context, req *v1.UpdateGroupInfoRequest) (*v1.GroupReply, error) {
+	group, err := cs.gc.UpdateGroupInfo(ctx, req.GroupId, req.Name, req.Notice)
+	if err != nil {
+		log.Printf("UpdateGroupInfo err: %v\n", err)
+
+		return &v1.GroupReply{
+			Code: 1,
+			Res:  ErrorToRes(err),
+		}, nil
+	}
+
+	return &v1.GroupReply{
+		Code: 0,
+		Res:  ErrorToRes(err),
+		Data: ConvertToGroupData(group),
+	}, nil
+}
+
+func (cs *ConduitService) UpdateGroupSettings(ctx context.Context, req *v1.UpdateGroupSettingsRequest) (*v1.GroupReply, error) {
+	group, err := cs.gc.UpdateGroupSettings(ctx, req.GroupId, &biz.GroupSettingsPatch{
+		MuteAll:       req.MuteAll,
+		OnlyAdminEdit: req.OnlyAdminEdit,
+		JoinApproval:  req.JoinApproval,
+	})
+	if err != nil {
+		log.Printf("UpdateGroupSettings err: %v\n", err)
+
+		return &v1.GroupReply{
+			Code: 1,
+			Res:  ErrorToRes(err),
+		}, nil
+	}
+
+	return &v1.GroupReply{
+		Code: 0,
+		Res:  ErrorToRes(err),
+		Data: ConvertToGroupData(group),
+	}, nil
+}
+
+func (cs *ConduitService) SetGroupAdmin(ctx context.Context, req *v1.SetGroupAdminRequest) (*v1.GroupMemberReply, error) {
+	group, member, err := cs.gc.SetGroupAdmin(ctx, req.GroupId, req.UserId, req.IsAdmin)
+	if err != nil {
+		log.Printf("SetGroupAdmin err: %v\n", err)
+
+		return &v1.GroupMemberReply{
+			Code: 1,
+			Res:  ErrorToRes(err),
+		}, nil
+	}
+
+	return &v1.GroupMemberReply{
+		Code: 0,
+		Res:  ErrorToRes(err),
+		Data: ConvertToGroupMemberData(group, member),
+	}, nil
+}
+
+func (cs *ConduitService) MuteGroupMember(ctx context.Context, req *v1.MuteGroupMemberRequest) (*v1.GroupMemberReply, error) {
+	var muteUntil *time.Time
+	if req.MuteUntil != nil {
+		t := req.MuteUntil.AsTime()
+		muteUntil = &t
+	}
+
+	group, member, err := cs.gc.MuteGroupMember(ctx, req.GroupId, req.UserId, muteUntil, req.Forever)
+	if err != nil {
+		log.Printf("MuteGroupMember err: %v\n", err)
+
+		return &v1.GroupMemberReply{
+			Code: 1,
+			Res:  ErrorToRes(err),
+		}, nil
+	}
+
+	return &v1.GroupMemberReply{
+		Code: 0,
+		Res:  ErrorToRes(err),
+		Data: ConvertToGroupMemberData(group, member),
+	}, nil
+}
+
+func (cs *ConduitService) CreateGroupInvite(ctx context.Context, req *v1.CreateGroupInviteRequest) (*v1.GroupInviteReply, error) {
+	res, err := cs.gc.CreateInvite(ctx, req.GroupId, req.ExpireSeconds, req.MaxUses)
+	if err != nil {
+		log.Printf("CreateGroupInvite err: %v\n", err)
+
+		return &v1.GroupInviteReply{
+			Code: 1,
+			Res:  ErrorToRes(err),
+		}, nil
+	}
+
+	var expireAt *timestamppb.Timestamp
+	if res.Invite.ExpireAt != nil {
+		expireAt = timestamppb.New(*res.Invite.ExpireAt)
+	}
+
+	return &v1.GroupInviteReply{
+		Code: 0,
+		Res:  ErrorToRes(err),
+		Data: &v1.GroupInviteData{
+			GroupId:   res.Group.Uuid,
+			Token:     res.Invite.Token,
+			Link:      res.Link,
+			QrPayload: res.QrPayload,
+			ExpireAt:  expireAt,
+			MaxUses:   res.Invite.MaxUses,
+			UsedCount: res.Invite.UsedCount,
+		},
+	}, nil
+}
+
+func (cs *ConduitService) RevokeGroupInvite(ctx context.Context, req *v1.RevokeGroupInviteRequest) (*v1.RevokeGroupInviteReply, error) {
+	err := cs.gc.RevokeInvite(ctx, req.GroupId, req.Token)
+	if err != nil {
+		log.Printf("RevokeGroupInvite err: %v\n", err)
+
+		return &v1.RevokeGroupInviteReply{
+			Code: 1,
+			Res:  ErrorToRes(err),
+		}, nil
+	}
+
+	return &v1.RevokeGroupInviteReply{
+		Code: 0,
+		Res:  ErrorToRes(err),
+	}, nil
+}
+
+func (cs *ConduitService) JoinGroup(ctx context.Context, req *v1.JoinGroupRequest) (*v1.JoinGroupReply, error) {
+	res, err := cs.gc.JoinGroup(ctx, req.Token, req.Message)
+	if err != nil {
+		log.Printf("JoinGroup err: %v\n", err)
+
+		return &v1.JoinGroupReply{
+			Code: 1,
+			Res:  ErrorToRes(err),
+		}, nil
+	}
+
+	data := &v1.JoinGroupData{
+		Group:  ConvertToGroupData(res.Group),
+		Joined: res.Joined,
+	}
+	if res.Request != nil {
+		data.Request = ConvertToGroupJoinRequestData(res.Group, res.Request)
+	}
+
+	return &v1.JoinGroupReply{
+		Code: 0,
+		Res:  ErrorToRes(err),
+		Data: data,
+	}, nil
+}
+
+func (cs *ConduitService) ListGroupJoinRequests(ctx context.Context, req *v1.ListGroupJoinRequestsRequest) (*v1.ListGroupJoinRequestsReply, error) {
+	group, reqs, err := cs.gc.ListJoinRequests(ctx, req.GroupId)
+	if err != nil {
+		log.Printf("ListGroupJoinRequests err: %v\n", err)
+
+		return &v1.ListGroupJoinRequestsReply{
+			Code: 1,
+			Res:  ErrorToRes(err),
+		}, nil
+	}
+
+	data := make([]*v1.GroupJoinRequestData, 0, len(reqs))
+	for _, r := range reqs {
+		data = append(data, ConvertToGroupJoinRequestData(group, r))
+	}
+
+	return &v1.ListGroupJoinRequestsReply{
+		Code: 0,
+		Res:  ErrorToRes(err),
+		Data: data,
+	}, nil
+}
+
+func (cs *ConduitService) HandleGroupJoinRequest(ctx context.Context, req *v1.HandleGroupJoinRequestRequest) (*v1.GroupJoinRequestReply, error) {
+	group, joinReq, err := cs.gc.HandleJoinRequest(ctx, req.GroupId, req.RequestId, req.Approve)
+	if err != nil {
+		log.Printf("HandleGroupJoinRequest err: %v\n", err)
+
+		return &v1.GroupJoinRequestReply{
+			Code: 1,
+			Res:  ErrorToRes(err),
+		}, nil
+	}
+
+	return &v1.GroupJoinRequestReply{
+		Code: 0,
+		Res:  ErrorToRes(err),
+		Data: ConvertToGroupJoinRequestData(group, joinReq),
+	}, nil
+}
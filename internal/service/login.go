@@ -0,0 +1,43 @@
+package service
+
+import (
+	"context"
+	v1 "kratos-realworld/api/conduit/v1"
+	"log"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (cs *ConduitService) ListLoginHistory(ctx context.Context, req *v1.ListLoginHistoryRequest) (*v1.ListLoginHistoryReply, error) {
+	res, err := cs.gt.ListLoginHistory(ctx, req.Cursor, int(req.Limit))
+	if err != nil {
+		log.Printf("ListLoginHistory err: %v\n", err)
+
+		return &v1.ListLoginHistoryReply{
+			Code: 1,
+			Res:  ErrorToRes(err),
+		}, nil
+	}
+
+	data := make([]*v1.LoginHistoryData, 0, len(res.Histories))
+	for _, h := range res.Histories {
+		history := &v1.LoginHistoryData{
+			Id:        h.ID,
+			Source:    h.Source,
+			Ip:        h.IP,
+			UserAgent: h.UserAgent,
+			Platform:  h.Platform,
+		}
+		if h.SysCreated != nil {
+			history.CreatedAt = timestamppb.New(*h.SysCreated)
+		}
+		data = append(data, history)
+	}
+	return &v1.ListLoginHistoryReply{
+		Code:       0,
+		Res:        ErrorToRes(err),
+		Data:       data,
+		NextCursor: res.NextCursor,
+		HasMore:    res.HasMore,
+	}, nil
+}
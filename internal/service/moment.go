@@ -0,0 +1,403 @@
+package service
+
+import (
+	"context"
+	v1 "kratos-realworld/api/conduit/v1"
+	"kratos-realworld/internal/biz"
+	bizMoment "kratos-realworld/internal/biz/moments"
+	"io"
+	"kratos-realworld/internal/pkg/middleware/auth"
+	"log"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func convertToCommentData(c *bizMoment.CommentsTB) *v1.CommentData {
+	data := &v1.CommentData{
+		Id:            c.ID,
+		MomentId:      c.MomentID,
+		UserId:        c.UserID,
+		Comment:       c.Comment,
+		ParentId:      c.ParentID,
+		ReplyToUserId: c.ReplyToUserID,
+		LikeCount:     c.LikeCount,
+	}
+	if c.SysCreated != nil {
+		data.CreatedAt = timestamppb.New(*c.SysCreated)
+	}
+	return data
+}
+
+func convertToCommentList(res *biz.CommentListReply) []*v1.CommentData {
+	data := make([]*v1.CommentData, 0, len(res.Comments))
+	for _, c := range res.Comments {
+		item := convertToCommentData(c)
+		item.Liked = res.Liked[c.ID]
+		data = append(data, item)
+	}
+	return data
+}
+
+// convertToMomentData 黑名单和可见范围只返回给作者本人
+func convertToMomentData(m *bizMoment.MomentTB, viewerID uint32) *v1.MomentData {
+	data := &v1.MomentData{
+		Id:        m.ID,
+		UserId:    m.UserID,
+		Message:   m.Message,
+		MediaUrl:  m.MediaURL,
+		LikeCount: int32(m.LikeCount),
+		Tags:      m.Tags,
+	}
+	if m.UserID == viewerID {
+		data.BlackListIds = m.BlackListIDs
+		data.Visibility = m.Visibility
+		data.ScopeIds = m.ScopeIDs
+	}
+	for _, media := range m.Media {
+		data.Media = append(data.Media, &v1.MomentMediaData{
+			Kind:         media.Kind,
+			Url:          media.URL,
+			CoverUrl:     media.CoverURL,
+			ThumbnailUrl: media.ThumbnailURL,
+			Width:        media.Width,
+			Height:       media.Height,
+			BlurHash:     media.BlurHash,
+			Sort:         int32(media.Sort),
+		})
+	}
+	if m.SysCreated != nil {
+		data.CreatedAt = timestamppb.New(*m.SysCreated)
+	}
+	return data
+}
+
+func convertToMomentSummaries(moments []*bizMoment.MomentsMetaTB) []*v1.MomentSummaryData {
+	data := make([]*v1.MomentSummaryData, 0, len(moments))
+	for _, m := range moments {
+		item := &v1.MomentSummaryData{
+			MomentId:  m.MomentID,
+			UserId:    m.UserID,
+			Message:   m.Message,
+			MediaUrl:  m.MediaURL,
+			Tags:      m.Tags,
+			Thumbnail: m.Thumbnail,
+		}
+		if m.SysCreated != nil {
+			item.CreatedAt = timestamppb.New(*m.SysCreated)
+		}
+		data = append(data, item)
+	}
+	return data
+}
+
+func (cs *ConduitService) CreateMoment(ctx context.Context, req *v1.CreateMomentRequest) (*v1.MomentReply, error) {
+	moment := &bizMoment.MomentTB{
+		Message:    req.Message,
+		MediaURL:   req.MediaUrl,
+		Tags:       req.Tags,
+		Visibility: req.Visibility,
+		ScopeIDs:   req.ScopeIds,
+	}
+	media := make([]biz.MomentMediaInput, 0, len(req.Media))
+	for _, m := range req.Media {
+		media = append(media, biz.MomentMediaInput{UploadID: m.UploadId, CoverUploadID: m.CoverUploadId})
+	}
+	err := cs.moc.CreateMoment(ctx, moment, media)
+	if err != nil {
+		log.Printf("CreateMoment err: %v\n", err)
+
+		return &v1.MomentReply{
+			Code: 1,
+			Res:  ErrorToRes(err),
+		}, nil
+	}
+
+	return &v1.MomentReply{
+		Code: 0,
+		Res:  ErrorToRes(err),
+		Data: convertToMomentData(moment, moment.UserID),
+	}, nil
+}
+
+// UploadMomentMedia 不是 proto 里的 rpc，由 server 里的上传路由把请求体以流的方式传进来
+func (cs *ConduitService) UploadMomentMedia(ctx context.Context, file io.Reader) (*v1.UploadMomentMediaReply, error) {
+	upload, err := cs.moc.UploadMomentMedia(ctx, file)
+	if err != nil {
+		log.Printf("UploadMomentMedia err: %v\n", err)
+
+		return &v1.UploadMomentMediaReply{
+			Code: 1,
+			Res:  ErrorToRes(err),
+		}, nil
+	}
+
+	return &v1.UploadMomentMediaReply{
+		Code: 0,
+		Res:  ErrorToRes(err),
+		Data: &v1.MediaUploadData{
+			Id:           upload.ID,
+			Kind:         upload.Kind,
+			Url:          upload.URL,
+			ThumbnailUrl: upload.ThumbnailURL,
+			Width:        upload.Width,
+			Height:       upload.Height,
+			BlurHash:     upload.BlurHash,
+			Size:         upload.Size,
+		},
+	}, nil
+}
+
+func (cs *ConduitService) GetMoment(ctx context.Context, req *v1.GetMomentRequest) (*v1.MomentReply, error) {
+	viewerID := uint32(auth.FromContext(ctx).UserID)
+	res, err := cs.moc.GetMomentDetail(ctx, req.MomentId)
+	if err != nil {
+		log.Printf("GetMoment err: %v\n", err)
+
+		return &v1.MomentReply{
+			Code: 1,
+			Res:  ErrorToRes(err),
+		}, nil
+	}
+
+	data := convertToMomentData(res.Moment, viewerID)
+	data.Liked = res.Liked
+	data.Comments = convertToCommentList(res.Comments)
+	data.CommentsNextCursor = res.Comments.NextCursor
+	data.CommentsHasMore = res.Comments.HasMore
+	return &v1.MomentReply{
+		Code: 0,
+		Res:  ErrorToRes(err),
+		Data: data,
+	}, nil
+}
+
+func (cs *ConduitService) DeleteMoment(ctx context.Context, req *v1.DeleteMomentRequest) (*v1.DeleteMomentReply, error) {
+	err := cs.moc.DeleteMoment(ctx, req.MomentId)
+	if err != nil {
+		log.Printf("DeleteMoment err: %v\n", err)
+
+		return &v1.DeleteMomentReply{
+			Code: 1,
+			Res:  ErrorToRes(err),
+		}, nil
+	}
+
+	return &v1.DeleteMomentReply{
+		Code: 0,
+		Res:  ErrorToRes(err),
+	}, nil
+}
+
+func (cs *ConduitService) CreateComment(ctx context.Context, req *v1.CreateCommentRequest) (*v1.CommentReply, error) {
+	comment := &bizMoment.CommentsTB{
+		MomentID: req.MomentId,
+		Comment:  req.Comment,
+	}
+	err := cs.moc.CreateComment(ctx, comment, req.ReplyToCommentId)
+	if err != nil {
+		log.Printf("CreateComment err: %v\n", err)
+
+		return &v1.CommentReply{
+			Code: 1,
+			Res:  ErrorToRes(err),
+		}, nil
+	}
+
+	return &v1.CommentReply{
+		Code: 0,
+		Res:  ErrorToRes(err),
+		Data: convertToCommentData(comment),
+	}, nil
+}
+
+func (cs *ConduitService) DeleteComment(ctx context.Context, req *v1.DeleteCommentRequest) (*v1.DeleteCommentReply, error) {
+	err := cs.moc.DeleteComment(ctx, req.MomentId, req.CommentId)
+	if err != nil {
+		log.Printf("DeleteComment err: %v\n", err)
+
+		return &v1.DeleteCommentReply{
+			Code: 1,
+			Res:  ErrorToRes(err),
+		}, nil
+	}
+
+	return &v1.DeleteCommentReply{
+		Code: 0,
+		Res:  ErrorToRes(err),
+	}, nil
+}
+
+func (cs *ConduitService) UpdateMomentBlackList(ctx context.Context, req *v1.UpdateMomentBlackListRequest) (*v1.UpdateMomentBlackListReply, error) {
+	added, removed, err := cs.moc.UpdateMomentBlackList(ctx, req.MomentId, req.BlackListIds)
+	if err != nil {
+		log.Printf("UpdateMomentBlackList err: %v\n", err)
+
+		return &v1.UpdateMomentBlackListReply{
+			Code: 1,
+			Res:  ErrorToRes(err),
+		}, nil
+	}
+
+	return &v1.UpdateMomentBlackListReply{
+		Code:       0,
+		Res:        ErrorToRes(err),
+		AddedIds:   added,
+		RemovedIds: removed,
+	}, nil
+}
+
+func (cs *ConduitService) UpdateMomentVisibility(ctx context.Context, req *v1.UpdateMomentVisibilityRequest) (*v1.UpdateMomentVisibilityReply, error) {
+	added, removed, err := cs.moc.UpdateMomentVisibility(ctx, req.MomentId, req.Visibility, req.ScopeIds)
+	if err != nil {
+		log.Printf("UpdateMomentVisibility err: %v\n", err)
+
+		return &v1.UpdateMomentVisibilityReply{
+			Code: 1,
+			Res:  ErrorToRes(err),
+		}, nil
+	}
+
+	return &v1.UpdateMomentVisibilityReply{
+		Code:       0,
+		Res:        ErrorToRes(err),
+		AddedIds:   added,
+		RemovedIds: removed,
+	}, nil
+}
+
+func (cs *ConduitService) GetTimeline(ctx context.Context, req *v1.GetTimelineRequest) (*v1.GetTimelineReply, error) {
+	res, err := cs.moc.GetTimeline(ctx, req.Cursor, int(req.Limit))
+	if err != nil {
+		log.Printf("GetTimeline err: %v\n", err)
+
+		return &v1.GetTimelineReply{
+			Code: 1,
+			Res:  ErrorToRes(err),
+		}, nil
+	}
+
+	return &v1.GetTimelineReply{
+		Code:       0,
+		Res:        ErrorToRes(err),
+		Data:       convertToMomentSummaries(res.Moments),
+		NextCursor: res.NextCursor,
+		HasMore:    res.HasMore,
+	}, nil
+}
+
+func (cs *ConduitService) GetSquare(ctx context.Context, req *v1.GetSquareRequest) (*v1.GetSquareReply, error) {
+	res, err := cs.moc.GetSquare(ctx, req.Mode, req.Tag, req.SessionId, req.Cursor, int(req.Limit))
+	if err != nil {
+		log.Printf("GetSquare err: %v\n", err)
+
+		return &v1.GetSquareReply{
+			Code: 1,
+			Res:  ErrorToRes(err),
+		}, nil
+	}
+
+	return &v1.GetSquareReply{
+		Code:       0,
+		Res:        ErrorToRes(err),
+		Data:       convertToMomentSummaries(res.Moments),
+		SessionId:  res.SessionID,
+		NextCursor: res.NextCursor,
+		HasMore:    res.HasMore,
+	}, nil
+}
+
+func (cs *ConduitService) LikeMoment(ctx context.Context, req *v1.LikeMomentRequest) (*v1.MomentLikeReply, error) {
+	res, err := cs.moc.LikeMoment(ctx, req.MomentId)
+	if err != nil {
+		log.Printf("LikeMoment err: %v\n", err)
+
+		return &v1.MomentLikeReply{
+			Code: 1,
+			Res:  ErrorToRes(err),
+		}, nil
+	}
+
+	return &v1.MomentLikeReply{
+		Code:      0,
+		Res:       ErrorToRes(err),
+		LikeCount: res.LikeCount,
+		Liked:     res.Liked,
+	}, nil
+}
+
+func (cs *ConduitService) UnlikeMoment(ctx context.Context, req *v1.UnlikeMomentRequest) (*v1.MomentLikeReply, error) {
+	res, err := cs.moc.UnlikeMoment(ctx, req.MomentId)
+	if err != nil {
+		log.Printf("UnlikeMoment err: %v\n", err)
+
+		return &v1.MomentLikeReply{
+			Code: 1,
+			Res:  ErrorToRes(err),
+		}, nil
+	}
+
+	return &v1.MomentLikeReply{
+		Code:      0,
+		Res:       ErrorToRes(err),
+		LikeCount: res.LikeCount,
+		Liked:     res.Liked,
+	}, nil
+}
+
+func (cs *ConduitService) ListComments(ctx context.Context, req *v1.ListCommentsRequest) (*v1.ListCommentsReply, error) {
+	res, err := cs.moc.ListComments(ctx, req.MomentId, req.ParentId, req.Cursor, int(req.Limit))
+	if err != nil {
+		log.Printf("ListComments err: %v\n", err)
+
+		return &v1.ListCommentsReply{
+			Code: 1,
+			Res:  ErrorToRes(err),
+		}, nil
+	}
+
+	return &v1.ListCommentsReply{
+		Code:       0,
+		Res:        ErrorToRes(err),
+		Data:       convertToCommentList(res),
+		NextCursor: res.NextCursor,
+		HasMore:    res.HasMore,
+	}, nil
+}
+
+func (cs *ConduitService) LikeComment(ctx context.Context, req *v1.LikeCommentRequest) (*v1.CommentLikeReply, error) {
+	res, err := cs.moc.LikeComment(ctx, req.MomentId, req.CommentId)
+	if err != nil {
+		log.Printf("LikeComment err: %v\n", err)
+
+		return &v1.CommentLikeReply{
+			Code: 1,
+			Res:  ErrorToRes(err),
+		}, nil
+	}
+
+	return &v1.CommentLikeReply{
+		Code:      0,
+		Res:       ErrorToRes(err),
+		LikeCount: res.LikeCount,
+		Liked:     res.Liked,
+	}, nil
+}
+
+func (cs *ConduitService) UnlikeComment(ctx context.Context, req *v1.UnlikeCommentRequest) (*v1.CommentLikeReply, error) {
+	res, err := cs.moc.UnlikeComment(ctx, req.MomentId, req.CommentId)
+	if err != nil {
+		log.Printf("UnlikeComment err: %v\n", err)
+
+		return &v1.CommentLikeReply{
+			Code: 1,
+			Res:  ErrorToRes(err),
+		}, nil
+	}
+
+	return &v1.CommentLikeReply{
+		Code:      0,
+		Res:       ErrorToRes(err),
+		LikeCount: res.LikeCount,
+		Liked:     res.Liked,
+	}, nil
+}
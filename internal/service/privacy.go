@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	v1 "kratos-realworld/api/conduit/v1"
+	"kratos-realworld/internal/biz"
+	bizProfile "kratos-realworld/internal/biz/profile"
+	"log"
+)
+
+func convertToPrivacySettingsData(s *bizProfile.PrivacySettingTB) *v1.PrivacySettingsData {
+	return &v1.PrivacySettingsData{
+		WhoCanMessage:        s.WhoCanMessage,
+		MomentVisibility:     s.MomentVisibility,
+		FollowListVisibility: s.FollowListVisibility,
+		LastSeenVisibility:   s.LastSeenVisibility,
+		DiscoverableByPhone:  s.DiscoverableByPhone,
+		DiscoverableBySearch: s.DiscoverableBySearch,
+	}
+}
+
+func (cs *ConduitService) GetPrivacySettings(ctx context.Context, req *v1.GetPrivacySettingsRequest) (*v1.PrivacySettingsReply, error) {
+	res, err := cs.pc.GetPrivacySettings(ctx)
+	if err != nil {
+		log.Printf("GetPrivacySettings err: %v\n", err)
+
+		return &v1.PrivacySettingsReply{
+			Code: 1,
+			Res:  ErrorToRes(err),
+		}, nil
+	}
+
+	return &v1.PrivacySettingsReply{
+		Code: 0,
+		Res:  ErrorToRes(err),
+		Data: convertToPrivacySettingsData(res),
+	}, nil
+}
+
+func (cs *ConduitService) UpdatePrivacySettings(ctx context.Context, req *v1.UpdatePrivacySettingsRequest) (*v1.PrivacySettingsReply, error) {
+	res, err := cs.pc.UpdatePrivacySettings(ctx, &biz.PrivacySettingsUpdate{
+		WhoCanMessage:        req.WhoCanMessage,
+		MomentVisibility:     req.MomentVisibility,
+		FollowListVisibility: req.FollowListVisibility,
+		LastSeenVisibility:   req.LastSeenVisibility,
+		DiscoverableByPhone:  req.DiscoverableByPhone,
+		DiscoverableBySearch: req.DiscoverableBySearch,
+	})
+	if err != nil {
+		log.Printf("UpdatePrivacySettings err: %v\n", err)
+
+		return &v1.PrivacySettingsReply{
+			Code: 1,
+			Res:  ErrorToRes(err),
+		}, nil
+	}
+
+	return &v1.PrivacySettingsReply{
+		Code: 0,
+		Res:  ErrorToRes(err),
+		Data: convertToPrivacySettingsData(res),
+	}, nil
+}
+
+// UpdateSearchPrivacy 已废弃，只改 discoverable_by_phone，新客户端用 UpdatePrivacySettings
+func (cs *ConduitService) UpdateSearchPrivacy(ctx context.Context, req *v1.UpdateSearchPrivacyRequest) (*v1.UpdateSearchPrivacyReply, error) {
+	_, err := cs.pc.UpdatePrivacySettings(ctx, &biz.PrivacySettingsUpdate{
+		DiscoverableByPhone: &req.DiscoverableByPhone,
+	})
+	if err != nil {
+		log.Printf("UpdateSearchPrivacy err: %v\n", err)
+
+		return &v1.UpdateSearchPrivacyReply{
+			Code: 1,
+			Res:  ErrorToRes(err),
+		}, nil
+	}
+
+	return &v1.UpdateSearchPrivacyReply{
+		Code: 0,
+		Res:  ErrorToRes(err),
+	}, nil
+}
@@ -2,7 +2,6 @@ package service
 
 import (
 	"context"
-	"fmt"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	v1 "kratos-realworld/api/conduit/v1"
 	"kratos-realworld/internal/biz"
@@ -127,20 +126,25 @@ func (cs *ConduitService) GetRelationship(ctx context.Context, req *v1.Relations
 
 func (cs *ConduitService) CanAddFriend(ctx context.Context, req *v1.CanAddFriendReq) (*v1.CanAddFriendRes, error) {
 	res, err := cs.pc.CanAddFriend(ctx, req.TargetId)
-	fmt.Println(res)
 	if err != nil {
 		log.Printf("CanAddFriend err: %v", err)
 
 		return &v1.CanAddFriendRes{
 			Code: 1,
 			Res:  ErrorToRes(err),
-			//Data: nil,
+			Data: nil,
 		}, nil
 	}
 
 	return &v1.CanAddFriendRes{
 		Code: 0,
 		Res:  ErrorToRes(err),
-		//Data: res,
+		Data: &v1.AddFriendRes{
+			CanAdd:            res.CanAdd,
+			IsFriend:          res.IsFriend,
+			PendingRequestId:  res.PendingRequestID,
+			IncomingRequestId: res.IncomingRequestID,
+			Reason:            res.Reason,
+		},
 	}, nil
 }
@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+	v1 "kratos-realworld/api/conduit/v1"
+	"log"
+)
+
+func (cs *ConduitService) ChangeHandle(ctx context.Context, req *v1.ChangeHandleRequest) (*v1.ChangeHandleReply, error) {
+	handle, err := cs.pc.ChangeHandle(ctx, req.Handle)
+	if err != nil {
+		log.Printf("ChangeHandle err: %v\n", err)
+
+		return &v1.ChangeHandleReply{
+			Code: 1,
+			Res:  ErrorToRes(err),
+		}, nil
+	}
+
+	return &v1.ChangeHandleReply{
+		Code:   0,
+		Res:    ErrorToRes(err),
+		Handle: handle,
+	}, nil
+}
+
+func (cs *ConduitService) SearchUsers(ctx context.Context, req *v1.SearchUsersRequest) (*v1.SearchUsersReply, error) {
+	res, err := cs.pc.SearchUsers(ctx, req.Keyword, int(req.Page), int(req.PageSize))
+	if err != nil {
+		log.Printf("SearchUsers err: %v\n", err)
+
+		return &v1.SearchUsersReply{
+			Code: 1,
+			Res:  ErrorToRes(err),
+		}, nil
+	}
+
+	return &v1.SearchUsersReply{
+		Code:    0,
+		Res:     ErrorToRes(err),
+		Data:    ConvertToFollowUserData(res.Users),
+		HasMore: res.HasMore,
+	}, nil
+}
@@ -17,17 +17,37 @@ type ConduitService struct {
 	gt  *biz.GateWayUsecase
 	pc  *biz.ProfileUsecase
 	mc  *biz.MessageUseCase
+	gc  *biz.GroupUsecase
+	ec  *biz.ExportUsecase
+	ac  *biz.AdminUsecase
+	moc *biz.MomentUsecase
 	log *log.Helper
 }
 
-func NewConduitService(gt *biz.GateWayUsecase, pc *biz.ProfileUsecase, mc *biz.MessageUseCase, logger log.Logger) *ConduitService {
+func NewConduitService(gt *biz.GateWayUsecase, pc *biz.ProfileUsecase, mc *biz.MessageUseCase, gc *biz.GroupUsecase, ec *biz.ExportUsecase, ac *biz.AdminUsecase, moc *biz.MomentUsecase, logger log.Logger) *ConduitService {
 	return &ConduitService{
 		gt:  gt,
 		pc:  pc,
 		mc:  mc,
+		gc:  gc,
+		ec:  ec,
+		ac:  ac,
+		moc: moc,
 		log: log.NewHelper(logger)}
 }
 
 func (cs *ConduitService) GetMessageUseCase() *biz.MessageUseCase {
 	return cs.mc
 }
+
+func (cs *ConduitService) GetExportUsecase() *biz.ExportUsecase {
+	return cs.ec
+}
+
+func (cs *ConduitService) GetGateWayUsecase() *biz.GateWayUsecase {
+	return cs.gt
+}
+
+func (cs *ConduitService) GetAdminUsecase() *biz.AdminUsecase {
+	return cs.ac
+}
@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	v1 "kratos-realworld/api/conduit/v1"
+	"log"
+)
+
+func (cs *ConduitService) SuggestUsers(ctx context.Context, req *v1.SuggestUsersRequest) (*v1.SuggestUsersReply, error) {
+	res, err := cs.pc.SuggestUsers(ctx, int(req.Limit))
+	if err != nil {
+		log.Printf("SuggestUsers err: %v\n", err)
+
+		return &v1.SuggestUsersReply{
+			Code: 1,
+			Res:  ErrorToRes(err),
+		}, nil
+	}
+
+	data := make([]*v1.SuggestUserData, 0, len(res))
+	for _, item := range res {
+		data = append(data, &v1.SuggestUserData{
+			UserId:      item.UserID,
+			Username:    item.UserName,
+			Image:       item.HeadImage,
+			MutualCount: int32(item.MutualCount),
+			SharedTags:  int32(item.SharedTags),
+			Score:       item.Score,
+		})
+	}
+	return &v1.SuggestUsersReply{
+		Code: 0,
+		Res:  ErrorToRes(err),
+		Data: data,
+	}, nil
+}
+
+func (cs *ConduitService) DismissSuggestion(ctx context.Context, req *v1.DismissSuggestionRequest) (*v1.DismissSuggestionReply, error) {
+	err := cs.pc.DismissSuggestion(ctx, req.TargetId)
+	if err != nil {
+		log.Printf("DismissSuggestion err: %v\n", err)
+
+		return &v1.DismissSuggestionReply{
+			Code: 1,
+			Res:  ErrorToRes(err),
+		}, nil
+	}
+
+	return &v1.DismissSuggestionReply{
+		Code: 0,
+		Res:  ErrorToRes(err),
+	}, nil
+}
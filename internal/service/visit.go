@@ -0,0 +1,55 @@
+package service
+
+import (
+	"context"
+	v1 "kratos-realworld/api/conduit/v1"
+	"log"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (cs *ConduitService) ListProfileVisitors(ctx context.Context, req *v1.ListProfileVisitorsRequest) (*v1.ListProfileVisitorsReply, error) {
+	res, err := cs.pc.ListProfileVisitors(ctx, req.Cursor, int(req.Limit))
+	if err != nil {
+		log.Printf("ListProfileVisitors err: %v\n", err)
+
+		return &v1.ListProfileVisitorsReply{
+			Code: 1,
+			Res:  ErrorToRes(err),
+		}, nil
+	}
+
+	data := make([]*v1.VisitorData, 0, len(res.Visitors))
+	for _, v := range res.Visitors {
+		data = append(data, &v1.VisitorData{
+			UserId:    v.UserID,
+			Username:  v.UserName,
+			Image:     v.HeadImage,
+			VisitedAt: timestamppb.New(v.VisitedAt),
+		})
+	}
+	return &v1.ListProfileVisitorsReply{
+		Code:       0,
+		Res:        ErrorToRes(err),
+		Data:       data,
+		NextCursor: res.NextCursor,
+		HasMore:    res.HasMore,
+	}, nil
+}
+
+func (cs *ConduitService) UpdateVisitPrivacy(ctx context.Context, req *v1.UpdateVisitPrivacyRequest) (*v1.UpdateVisitPrivacyReply, error) {
+	err := cs.pc.UpdateVisitPrivacy(ctx, req.HideVisits)
+	if err != nil {
+		log.Printf("UpdateVisitPrivacy err: %v\n", err)
+
+		return &v1.UpdateVisitPrivacyReply{
+			Code: 1,
+			Res:  ErrorToRes(err),
+		}, nil
+	}
+
+	return &v1.UpdateVisitPrivacyReply{
+		Code: 0,
+		Res:  ErrorToRes(err),
+	}, nil
+}
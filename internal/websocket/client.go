@@ -13,13 +13,46 @@ import (
 	"kratos-realworld/internal/kafka"
 )
 
+// Client Send 只由 hub 和读协程写入，不能关闭；下线通过 Close 关闭 done 通知写协程退出
 type Client struct {
 	Conn      *websocket.Conn
 	Name      string
 	Send      chan []byte
+	done      chan struct{}
 	closeOnce sync.Once
 }
 
+func NewClient(name string, conn *websocket.Conn) *Client {
+	return &Client{
+		Conn: conn,
+		Name: name,
+		Send: make(chan []byte, 16),
+		done: make(chan struct{}),
+	}
+}
+
+// Close 可以重复调用，写协程发完缓冲里的消息后断开连接
+func (c *Client) Close() {
+	c.closeOnce.Do(func() {
+		close(c.done)
+	})
+}
+
+// trySend 连接已经关闭时丢弃消息，不会阻塞也不会向已经关闭的连接写入
+func (c *Client) trySend(message []byte) bool {
+	select {
+	case <-c.done:
+		return false
+	default:
+	}
+	select {
+	case c.Send <- message:
+		return true
+	case <-c.done:
+		return false
+	}
+}
+
 const (
 	pongWait   = 60 * time.Second // 服务器等待客户端 pong 的最大时间
 	pingPeriod = 50 * time.Second // 服务器主动发送 ping 的周期，通常 < pongWait
@@ -58,9 +91,22 @@ func (c *Client) Read() {
 			pongByte, err2 := proto.Marshal(pong)
 			if err2 == nil {
 				//c.Conn.WriteMessage(websocket.BinaryMessage, pongByte)
-				c.Send <- pongByte // 发给写协程
+				c.trySend(pongByte) // 发给写协程，被踢下线后丢弃
 			}
 		} else {
+			// 发送者以连接身份为准，防止冒充他人发消息
+			if msg.From != c.Name {
+				msg.From = c.Name
+				message, err = proto.Marshal(msg)
+				if err != nil {
+					continue
+				}
+			}
+			// 双方有拉黑关系或者私信设置不允许时不进消息队列，直接回复发送者
+			if err := MyServer.checkPrivateMessage(msg); err != nil {
+				sendError(c, msg, err)
+				continue
+			}
 			// 直接放到消息队列里面，回调放到broadcast里面
 			kafka.Send(message)
 		}
@@ -78,19 +124,29 @@ func (c *Client) Write() {
 
 	for {
 		select {
-		case message, ok := <-c.Send:
+		case message := <-c.Send:
 			// 监听 c.Send 通道中是否有要发送的消息
 			c.Conn.SetWriteDeadline(time.Now().Add(10 * time.Second))
-			if !ok {
-				// channel 关闭，发送 close 消息
-				c.Conn.WriteMessage(websocket.CloseMessage, []byte{})
-				return
-			}
 			err := c.Conn.WriteMessage(websocket.BinaryMessage, message)
 			if err != nil {
 				return
 			}
 
+		case <-c.done:
+			// 下线前先发完缓冲里的消息，例如封禁原因，再发送 close 消息
+			for {
+				select {
+				case message := <-c.Send:
+					c.Conn.SetWriteDeadline(time.Now().Add(10 * time.Second))
+					if err := c.Conn.WriteMessage(websocket.BinaryMessage, message); err != nil {
+						return
+					}
+				default:
+					c.Conn.WriteMessage(websocket.CloseMessage, []byte{})
+					return
+				}
+			}
+
 		case <-ticker.C:
 			// 定期发送 Ping
 			c.Conn.SetWriteDeadline(time.Now().Add(10 * time.Second))
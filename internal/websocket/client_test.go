@@ -0,0 +1,36 @@
+package websocket
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+
+	v1 "kratos-realworld/api/conduit/v1"
+	"kratos-realworld/internal/common"
+)
+
+// 被封禁踢下线后读协程还可能回复心跳，不能向已经关闭的连接写入
+func TestBannedClientDropsHeartbeat(t *testing.T) {
+	s := NewServer(nil)
+	go s.Start()
+
+	c := NewClient("1", nil)
+	s.Register <- c
+	assert.Eventually(t, func() bool { return len(s.OnlineUsers([]string{"1"})) == 1 }, time.Second, time.Millisecond)
+	banned, _ := proto.Marshal(&v1.Message{From: common.SYSTEM, To: "1", Type: common.ACCOUNT_BANNED})
+	s.Broadcast <- banned
+
+	select {
+	case <-c.done:
+	case <-time.After(time.Second):
+		t.Fatal("banned client was not closed")
+	}
+	assert.Empty(t, s.OnlineUsers([]string{"1"}))
+	assert.False(t, c.trySend([]byte("pong")))
+	c.Close()
+
+	// 欢迎消息和封禁原因都留在缓冲里，由写协程发完再断开
+	assert.Len(t, c.Send, 2)
+}
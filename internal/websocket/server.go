@@ -1,13 +1,18 @@
 package websocket
 
 import (
+	"context"
 	"encoding/base64"
+	"encoding/json"
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/uuid"
 	"kratos-realworld/internal/biz"
+	"kratos-realworld/internal/kafka"
 	"kratos-realworld/internal/pkg/util"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -18,7 +23,6 @@ import (
 	bizChat "kratos-realworld/internal/biz/messageGroup"
 	"kratos-realworld/internal/common"
 	// "kratos-realworld/internal/pkg/util"
-	"fmt"
 	// bizUser "kratos-realworld/internal/biz/user"
 )
 
@@ -28,10 +32,12 @@ func InitWebsocketServer(mc *biz.MessageUseCase) {
 	MyServer = NewServer(mc)
 }
 
+// Server Clients 只在 Start 所在的 hub 协程里修改，修改时加锁，其他协程通过 OnlineUsers 加锁读取
 type Server struct {
 	Clients    map[string]*Client
 	mutex      *sync.Mutex
 	Broadcast  chan []byte
+	Deliver    chan []byte
 	Register   chan *Client
 	Unregister chan *Client
 	mc         *biz.MessageUseCase
@@ -42,6 +48,7 @@ func NewServer(mc *biz.MessageUseCase) *Server {
 		mutex:      &sync.Mutex{},
 		Clients:    make(map[string]*Client),
 		Broadcast:  make(chan []byte, 500),
+		Deliver:    make(chan []byte, 500),
 		Register:   make(chan *Client, 50),
 		Unregister: make(chan *Client, 50),
 		mc:         mc,
@@ -62,14 +69,34 @@ func ConsumerKafkaMsg(data []byte) {
 	MyServer.Broadcast <- data
 }
 
+// ConsumeGroupDelivery 消费已经落库的群消息，交给 hub 协程投递
+func ConsumeGroupDelivery(data []byte) {
+	MyServer.Deliver <- data
+}
+
+// OnlineUsers 返回 names 中连接在本节点上的用户
+func (s *Server) OnlineUsers(names []string) []string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	online := make([]string, 0)
+	for _, name := range names {
+		if _, ok := s.Clients[name]; ok {
+			online = append(online, name)
+		}
+	}
+	return online
+}
+
 // Start TODO 如果多端（app/微信小程序/网页）同时在线，可能需要考虑互斥锁的问题，目前先不用
 func (s *Server) Start() {
 	for {
 		select {
 		case conn := <-s.Register:
+			s.mutex.Lock()
 			s.Clients[conn.Name] = conn
+			s.mutex.Unlock()
 			msg := &v1.Message{
-				From:    "System",
+				From:    common.SYSTEM,
 				To:      conn.Name,
 				Content: "welcome!",
 			}
@@ -77,11 +104,17 @@ func (s *Server) Start() {
 			conn.Send <- protoMsg
 
 		case conn := <-s.Unregister:
-			if _, ok := s.Clients[conn.Name]; ok {
-				close(conn.Send)
+			// 同一个用户重新连接后旧连接才注销，不能把新连接删掉
+			if client, ok := s.Clients[conn.Name]; ok && client == conn {
+				conn.Close()
+				s.mutex.Lock()
 				delete(s.Clients, conn.Name)
+				s.mutex.Unlock()
 			}
 
+		case data := <-s.Deliver:
+			deliverGroupMessage(data, s)
+
 		case message := <-s.Broadcast:
 			msg := &v1.Message{}
 			err := proto.Unmarshal(message, msg)
@@ -90,26 +123,52 @@ func (s *Server) Start() {
 				continue
 			}
 
+			if msg.From == common.SYSTEM {
+				// 系统通知只投递给 To 对应的连接
+				if client, ok := s.Clients[msg.To]; ok {
+					client.Send <- message
+					// 账号被封禁，下发原因之后关闭连接，写协程发完缓冲里的消息再断开
+					if msg.Type == common.ACCOUNT_BANNED {
+						client.Close()
+						s.mutex.Lock()
+						delete(s.Clients, msg.To)
+						s.mutex.Unlock()
+					}
+				}
+				continue
+			}
+
 			if msg.To != "" {
 				if msg.ContentType >= common.TEXT && msg.ContentType <= common.VIDEO {
 					// 1.文字 2.普通文件 3.图片 4.音频 5.视频
+					if msg.MessageType == common.MESSAGE_TYPE_GROUP {
+						// 群聊
+						sendGroupMessage(msg, s)
+						continue
+					}
+
 					_, exits := s.Clients[msg.From]
 					if exits {
-						s.saveMessage(msg)
+						if saved := s.saveMessage(msg); saved != nil {
+							msg.MessageId = saved.ID
+							s.touchPrivateConversation(saved)
+						}
 					}
 
-					if msg.ContentType == common.MESSAGE_TYPE_USER {
+					if msg.MessageType == common.MESSAGE_TYPE_USER {
 						// 单聊
 						client, ok := s.Clients[msg.To]
 						if ok {
+							// 接收者对发送者开启了免打扰，照常投递但不提醒
+							if toID, err := strconv.ParseUint(msg.To, 10, 32); err == nil {
+								muted := s.mc.MutedReceivers(context.Background(), []uint32{uint32(toID)}, msg.From)
+								_, msg.Silent = muted[uint32(toID)]
+							}
 							msgByte, err := proto.Marshal(msg)
 							if err == nil {
 								client.Send <- msgByte
 							}
 						}
-					} else if msg.MessageType == common.MESSAGE_TYPE_GROUP {
-						// 群聊
-						sendGroupMessage(msg, s)
 					} else {
 						clent, ok := s.Clients[msg.To]
 						if ok {
@@ -122,8 +181,10 @@ func (s *Server) Start() {
 						select {
 						case conn.Send <- message:
 						default:
-							close(conn.Send)
+							conn.Close()
+							s.mutex.Lock()
 							delete(s.Clients, id)
+							s.mutex.Unlock()
 						}
 					}
 				}
@@ -132,47 +193,168 @@ func (s *Server) Start() {
 	}
 }
 
-// 发送给群组消息,需要查询该群所有人员依次发送
+// 发送给群组消息，只有发送者所在的节点负责校验和落库
+// 落库后发到投递 topic，每个节点收到后再投递给连在本节点上的成员
 func sendGroupMessage(msg *v1.Message, s *Server) {
-	// 发送给群组的消息，查找该群所有的用户进行发送
-	// todo: 实现接口
-	// users := service.GroupService.GetUserIdByGroupUuid(msg.To)
-	// for _, user := range users {
-	// 	if user.Uuid == msg.From {
-	// 		continue
-	// 	}
-
-	// 	client, ok := s.Clients[user.Uuid]
-	// 	if !ok {
-	// 		continue
-	// 	}
-
-	// 	fromUserDetails := service.UserService.GetUserDetails(msg.From)
-	// 	// 由于发送群聊时，from是个人，to是群聊uuid。所以在返回消息时，将form修改为群聊uuid，和单聊进行统一
-	// 	msgSend := v1.Message{
-	// 		Avatar:       fromUserDetails.Avatar,  //todo: 实现接口
-	// 		FromUsername: msg.FromUsername,
-	// 		From:         msg.To,
-	// 		To:           msg.From,
-	// 		Content:      msg.Content,
-	// 		ContentType:  msg.ContentType,
-	//         Type:         msg.Type,
-	//         MessageType:  msg.MessageType,
-	// 		Url:          msg.Url,
-	// 	}
-
-	// 	msgByte, err := proto.Marshal(&msgSend)
-	// 	if err == nil {
-	// 		client.Send <- msgByte
-	// 	}
-	// }
-	fmt.Println("sendGroupMessage")
+	sender, ok := s.Clients[msg.From]
+	if !ok {
+		return
+	}
+
+	ctx := context.Background()
+	delivery, err := s.mc.PrepareGroupMessage(ctx, msg.From, msg.To, msg.Mentions)
+	if err != nil {
+		sendError(sender, msg, err)
+		return
+	}
+
+	saved := s.saveMessage(msg)
+	if saved == nil {
+		sendError(sender, msg, biz.NewErr(biz.ErrCodeMessageFailed, biz.MESSAGE_FAILED, "Save message to database failed"))
+		return
+	}
+	if err := s.mc.TouchConversations(ctx, saved, delivery.ReceiverIDs, delivery.MentionedIDs); err != nil {
+		log.Error(err.Error())
+	}
+
+	// 由于发送群聊时，from是个人，to是群聊uuid。所以在返回消息时，将form修改为群聊uuid，和单聊进行统一
+	msgSend := &v1.Message{
+		Avatar:       msg.Avatar,
+		FromUserName: msg.FromUserName,
+		From:         msg.To,
+		To:           msg.From,
+		Content:      msg.Content,
+		ContentType:  msg.ContentType,
+		Type:         msg.Type,
+		MessageType:  msg.MessageType,
+		Url:          msg.Url,
+		Mentions:     msg.Mentions,
+		MessageId:    saved.ID,
+	}
+	msgByte, err := proto.Marshal(msgSend)
+	if err != nil {
+		return
+	}
+	muted := s.mc.MutedReceivers(ctx, delivery.ReceiverIDs, msg.To)
+	mutedIDs := make([]uint32, 0, len(muted))
+	for id := range muted {
+		mutedIDs = append(mutedIDs, id)
+	}
+
+	body, err := json.Marshal(&kafka.GroupDelivery{
+		Message:      msgByte,
+		ReceiverIDs:  delivery.ReceiverIDs,
+		MutedIDs:     mutedIDs,
+		MentionedIDs: delivery.MentionedIDs,
+	})
+	if err != nil {
+		log.Error(err.Error())
+		return
+	}
+	kafka.SendTopic(kafka.GROUP_DELIVERY_TOPIC, body)
+}
+
+// 投递给连在本节点上的群成员
+// 被@的成员额外收到一条 type=mention 的通知
+func deliverGroupMessage(data []byte, s *Server) {
+	delivery := &kafka.GroupDelivery{}
+	if err := json.Unmarshal(data, delivery); err != nil {
+		log.Errorf("Unmarshal GroupDelivery error: %v", err)
+		return
+	}
+	msg := &v1.Message{}
+	if err := proto.Unmarshal(delivery.Message, msg); err != nil {
+		log.Errorf("Unmarshal group message error: %v", err)
+		return
+	}
+	msg.Silent = true
+	silentByte, err := proto.Marshal(msg)
+	if err != nil {
+		return
+	}
+
+	muted := make(map[uint32]struct{}, len(delivery.MutedIDs))
+	for _, id := range delivery.MutedIDs {
+		muted[id] = struct{}{}
+	}
+	mentioned := make(map[uint32]struct{}, len(delivery.MentionedIDs))
+	for _, id := range delivery.MentionedIDs {
+		mentioned[id] = struct{}{}
+	}
+
+	for _, userID := range delivery.ReceiverIDs {
+		client, ok := s.Clients[strconv.Itoa(int(userID))]
+		if !ok {
+			continue
+		}
+		if _, ok := muted[userID]; ok {
+			client.Send <- silentByte
+		} else {
+			client.Send <- delivery.Message
+		}
+
+		// @我的提醒不受免打扰影响
+		if _, ok := mentioned[userID]; ok {
+			notify := &v1.Message{
+				FromUserName: msg.FromUserName,
+				From:         msg.From,
+				To:           client.Name,
+				Content:      msg.Content,
+				Type:         common.MENTION,
+				MessageType:  common.MESSAGE_TYPE_GROUP,
+				MessageId:    msg.MessageId,
+			}
+			notifyByte, err := proto.Marshal(notify)
+			if err == nil {
+				client.Send <- notifyByte
+			}
+		}
+	}
+}
+
+// checkPrivateMessage 单聊消息进入消息队列前在发送者所在的节点校验一次，其他消息不校验
+func (s *Server) checkPrivateMessage(msg *v1.Message) error {
+	if msg.MessageType != common.MESSAGE_TYPE_USER || msg.To == "" {
+		return nil
+	}
+	if msg.ContentType < common.TEXT || msg.ContentType > common.VIDEO {
+		return nil
+	}
+	return s.mc.CheckPrivateMessage(context.Background(), msg.From, msg.To)
+}
+
+// 单聊消息落库后更新双方的会话
+func (s *Server) touchPrivateConversation(message *bizChat.MessageTB) {
+	toID, err := strconv.ParseUint(message.ToUserID, 10, 32)
+	if err != nil {
+		return
+	}
+	err = s.mc.TouchConversations(context.Background(), message, []uint32{uint32(toID)}, nil)
+	if err != nil {
+		log.Error(err.Error())
+	}
+}
+
+// 发送失败时通知发送者，content 为失败原因
+func sendError(client *Client, msg *v1.Message, err error) {
+	e := errors.FromError(err)
+	res := &v1.Message{
+		From:        common.SYSTEM,
+		To:          client.Name,
+		Content:     e.Message,
+		Type:        common.ERROR,
+		MessageType: msg.MessageType,
+	}
+	resByte, err := proto.Marshal(res)
+	if err == nil {
+		client.trySend(resByte)
+	}
 }
 
 // 保存消息
 // 主要实现文件上传到文件服务器 + 消息存储到数据库
 // TODO 目前暂时将文件保存到本地静态目录下，后续考虑单独起文件服务器
-func (s *Server) saveMessage(message *v1.Message) {
+func (s *Server) saveMessage(message *v1.Message) *bizChat.MessageTB {
 	if message.ContentType == 2 {
 		// 普通的文件二进制上传
 		message = SaveFile(message)
@@ -183,11 +365,15 @@ func (s *Server) saveMessage(message *v1.Message) {
 
 	// 消息数据持久化到数据库
 	msg := ConvertToMessage(message)
+	if msg == nil {
+		return nil
+	}
 	err := s.mc.SaveMessage(msg)
 	if err != nil {
 		log.Error(err.Error())
-		return
+		return nil
 	}
+	return msg
 }
 
 func SaveFile(message *v1.Message) *v1.Message {
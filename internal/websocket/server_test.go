@@ -0,0 +1,40 @@
+package websocket
+
+import (
+	"context"
+	"testing"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+
+	v1 "kratos-realworld/api/conduit/v1"
+	"kratos-realworld/internal/biz"
+	bizProfile "kratos-realworld/internal/biz/profile"
+	"kratos-realworld/internal/common"
+)
+
+// blockedRepo 所有人之间都有拉黑关系，calls 记录查询次数
+type blockedRepo struct {
+	bizProfile.ProfileRepo
+	calls int
+}
+
+func (r *blockedRepo) CheckBlock(ctx context.Context, userID uint32, targetID uint32) (bool, error) {
+	r.calls++
+	return true, nil
+}
+
+// 只有单聊的聊天消息在进入消息队列前校验
+func TestCheckPrivateMessage(t *testing.T) {
+	pr := &blockedRepo{}
+	s := NewServer(biz.NewMessageUseCase(nil, nil, nil, pr, nil, nil, nil, log.DefaultLogger))
+
+	err := s.checkPrivateMessage(&v1.Message{From: "1", To: "2", MessageType: common.MESSAGE_TYPE_USER, ContentType: common.TEXT})
+	assert.Equal(t, biz.USER_BLOCKED, kerrors.FromError(err).Reason)
+
+	pr.calls = 0
+	assert.NoError(t, s.checkPrivateMessage(&v1.Message{From: "1", To: "g1", MessageType: common.MESSAGE_TYPE_GROUP, ContentType: common.TEXT}))
+	assert.NoError(t, s.checkPrivateMessage(&v1.Message{From: "1", To: "2", MessageType: common.MESSAGE_TYPE_USER, ContentType: common.AUDIO_ONLINE}))
+	assert.Zero(t, pr.calls)
+}